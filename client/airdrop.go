@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+const defaultAirdropRetryBackoff = time.Second
+
+type RequestAirdropAndConfirmConfig struct {
+	// Commitment is used to request the airdrop and to wait for, default is the client default commitment or finalized
+	Commitment rpc.Commitment
+	// MaxRetries is the number of times to request again after the faucet rate-limited the request
+	MaxRetries int
+	// RetryBackoff is the first wait before requesting again, default is 1s. it doubles after every retry.
+	RetryBackoff time.Duration
+	// PollInterval is the first interval between status polls, see WaitForConfirmationConfig
+	PollInterval time.Duration
+}
+
+// RequestAirdropAndConfirm requests an airdrop and waits until it is finalized
+func (c *Client) RequestAirdropAndConfirm(ctx context.Context, base58Addr string, lamports uint64) (string, error) {
+	return c.RequestAirdropAndConfirmWithConfig(ctx, base58Addr, lamports, RequestAirdropAndConfirmConfig{})
+}
+
+// RequestAirdropAndConfirmWithConfig requests an airdrop and waits until it reaches the commitment.
+// a rate-limited request is retried up to cfg.MaxRetries times. the blockhash of the airdrop
+// transaction is chosen by the faucet, so it waits until ctx is done if the airdrop never lands.
+func (c *Client) RequestAirdropAndConfirmWithConfig(ctx context.Context, base58Addr string, lamports uint64, cfg RequestAirdropAndConfirmConfig) (string, error) {
+	commitment := c.commitmentOrDefault(cfg.Commitment, rpc.CommitmentFinalized)
+	backoff := cfg.RetryBackoff
+	if backoff <= 0 {
+		backoff = defaultAirdropRetryBackoff
+	}
+
+	for attempt := 0; ; attempt++ {
+		sig, err := c.RequestAirdropWithConfig(ctx, base58Addr, lamports, RequestAirdropConfig{Commitment: commitment})
+		if err != nil {
+			if !isAirdropRateLimited(err) || attempt >= cfg.MaxRetries {
+				return "", err
+			}
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			backoff *= 2
+			continue
+		}
+
+		_, err = c.WaitForConfirmationWithConfig(ctx, sig, commitment, WaitForConfirmationConfig{
+			PollInterval: cfg.PollInterval,
+		})
+		return sig, err
+	}
+}
+
+// isAirdropRateLimited reports whether the faucet refused the airdrop because of its rate limit,
+// either by a http 429 or by a json rpc error
+func isAirdropRateLimited(err error) bool {
+	var rpcErr *rpc.JsonRpcError
+	if errors.As(err, &rpcErr) {
+		msg := strings.ToLower(rpcErr.Message)
+		return rpcErr.Code == 429 || strings.Contains(msg, "rate limit") || strings.Contains(msg, "airdrop request failed")
+	}
+	return strings.Contains(err.Error(), "status code: 429")
+}
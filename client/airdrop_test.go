@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_RequestAirdropAndConfirm(t *testing.T) {
+	var methods []string
+	airdrops := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+		methods = append(methods, body.Method)
+		switch body.Method {
+		case "requestAirdrop":
+			airdrops++
+			switch airdrops {
+			case 1:
+				rw.WriteHeader(http.StatusTooManyRequests)
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":429,"message":"Too many requests"},"id":1}`))
+			case 2:
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32603,"message":"Internal error: airdrop request failed. This can happen when the rate limit is reached."},"id":1}`))
+			default:
+				assert.JSONEq(t, `{"commitment":"finalized"}`, string(body.Params[2]))
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":"sig","id":1}`))
+			}
+		case "getSignatureStatuses":
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{"context":{"slot":100},"value":[{"slot":100,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]},"id":1}`))
+		}
+	}))
+	defer server.Close()
+
+	sig, err := NewClient(server.URL).RequestAirdropAndConfirmWithConfig(
+		context.Background(),
+		"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+		1e9,
+		RequestAirdropAndConfirmConfig{
+			MaxRetries:   2,
+			RetryBackoff: time.Millisecond,
+			PollInterval: time.Millisecond,
+		},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "sig", sig)
+	assert.Equal(t, []string{"requestAirdrop", "requestAirdrop", "requestAirdrop", "getSignatureStatuses"}, methods)
+}
+
+func TestClient_RequestAirdropAndConfirmRateLimited(t *testing.T) {
+	airdrops := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		airdrops++
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":429,"message":"Too many requests"},"id":1}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).RequestAirdropAndConfirmWithConfig(
+		context.Background(),
+		"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+		1e9,
+		RequestAirdropAndConfirmConfig{
+			MaxRetries:   1,
+			RetryBackoff: time.Millisecond,
+		},
+	)
+	assert.Equal(t, &rpc.JsonRpcError{Code: 429, Message: "Too many requests"}, err)
+	assert.Equal(t, 2, airdrops)
+}
+
+func TestClient_RequestAirdropAndConfirmFailed(t *testing.T) {
+	airdrops := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		airdrops++
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param"},"id":1}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).RequestAirdropAndConfirmWithConfig(
+		context.Background(),
+		"invalid",
+		1e9,
+		RequestAirdropAndConfirmConfig{MaxRetries: 3},
+	)
+	assert.Equal(t, &rpc.JsonRpcError{Code: -32602, Message: "Invalid param"}, err)
+	assert.Equal(t, 1, airdrops)
+}
+
+func TestIsAirdropRateLimited(t *testing.T) {
+	assert.True(t, isAirdropRateLimited(&rpc.JsonRpcError{Code: 429, Message: "Too many requests"}))
+	assert.True(t, isAirdropRateLimited(fmt.Errorf("rpc: call error, err: get status code: 429, body: ")))
+	assert.False(t, isAirdropRateLimited(errors.New("rpc: call error, err: get status code: 500, body: ")))
+}
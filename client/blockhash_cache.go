@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+const (
+	defaultBlockhashRefreshInterval = 5 * time.Second
+	defaultBlockhashMaxAge          = 30 * time.Second
+)
+
+type BlockhashCacheConfig struct {
+	// Commitment is used to fetch the blockhash, default is the client default commitment or confirmed
+	Commitment rpc.Commitment
+	// RefreshInterval is the interval between two fetches, default is 5s
+	RefreshInterval time.Duration
+	// MaxAge is the age after which Get fetches a new blockhash instead of returning the cached one, default is 30s.
+	// a blockhash expires after 150 blocks, which is about 60s.
+	MaxAge time.Duration
+}
+
+// BlockhashCache keeps a recent blockhash which is refreshed in the background,
+// so senders don't have to call getLatestBlockhash for every transaction.
+//
+//	cache := c.NewBlockhashCache(client.BlockhashCacheConfig{})
+//	go cache.Run(ctx)
+//	blockhash, err := cache.Get(ctx)
+type BlockhashCache struct {
+	client *Client
+	cfg    BlockhashCacheConfig
+
+	mu        sync.RWMutex
+	value     rpc.GetLatestBlockhashValue
+	fetchedAt time.Time
+	err       error
+}
+
+// NewBlockhashCache returns an empty cache, call Run to refresh it in the background
+func (c *Client) NewBlockhashCache(cfg BlockhashCacheConfig) *BlockhashCache {
+	cfg.Commitment = c.commitmentOrDefault(cfg.Commitment, rpc.CommitmentConfirmed)
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultBlockhashRefreshInterval
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = defaultBlockhashMaxAge
+	}
+	return &BlockhashCache{
+		client: c,
+		cfg:    cfg,
+	}
+}
+
+// Run refreshes the blockhash every cfg.RefreshInterval until ctx is done.
+// a failed refresh keeps the previous blockhash, the error can be checked by Err.
+func (b *BlockhashCache) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		_, _ = b.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh fetches a new blockhash and stores it, unless the cached one is newer
+func (b *BlockhashCache) Refresh(ctx context.Context) (rpc.GetLatestBlockhashValue, error) {
+	value, err := b.client.GetLatestBlockhashWithConfig(ctx, GetLatestBlockhashConfig{Commitment: b.cfg.Commitment})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.err = err
+	if err != nil {
+		return rpc.GetLatestBlockhashValue{}, err
+	}
+	// an overlapping refresh may have stored a newer blockhash already
+	if value.LatestValidBlockHeight < b.value.LatestValidBlockHeight {
+		return b.value, nil
+	}
+	b.value, b.fetchedAt = value, time.Now()
+	return value, nil
+}
+
+// Get returns the cached blockhash with its last valid block height.
+// it fetches one if the cache is empty or the cached one is older than cfg.MaxAge, e.g. the refreshes
+// in the background keep failing, and the error of the fetch returns then.
+func (b *BlockhashCache) Get(ctx context.Context) (rpc.GetLatestBlockhashValue, error) {
+	b.mu.RLock()
+	value, fetchedAt := b.value, b.fetchedAt
+	b.mu.RUnlock()
+	if !fetchedAt.IsZero() && time.Since(fetchedAt) < b.cfg.MaxAge {
+		return value, nil
+	}
+	return b.Refresh(ctx)
+}
+
+// FetchedAt returns the time of the last successful refresh, zero if there is none
+func (b *BlockhashCache) FetchedAt() time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.fetchedAt
+}
+
+// Err returns the error of the last refresh
+func (b *BlockhashCache) Err() error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.err
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockhashCache(t *testing.T) {
+	fetches := 0
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		assert.Equal(t, "getLatestBlockhash", method)
+		assert.JSONEq(t, `{"commitment":"confirmed"}`, string(params[0]))
+		fetches++
+		if fetches == 2 {
+			return `null, "error":{"code":-32000,"message":"node is behind"}`
+		}
+		return fmt.Sprintf(`{"context":{"slot":%v},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":%v}}`, fetches, 100+fetches)
+	})
+	defer server.Close()
+
+	cache := NewClient(server.URL).NewBlockhashCache(BlockhashCacheConfig{})
+	assert.True(t, cache.FetchedAt().IsZero())
+
+	// an empty cache fetches on demand
+	value, err := cache.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, rpc.GetLatestBlockhashValue{
+		Blockhash:              "9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e",
+		LatestValidBlockHeight: 101,
+	}, value)
+
+	// cached
+	_, err = cache.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, fetches)
+
+	// a failed refresh keeps the previous value
+	_, err = cache.Refresh(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, err, cache.Err())
+	value, err = cache.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(101), value.LatestValidBlockHeight)
+
+	_, err = cache.Refresh(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, cache.Err())
+	value, _ = cache.Get(context.Background())
+	assert.Equal(t, uint64(103), value.LatestValidBlockHeight)
+}
+
+func TestBlockhashCache_MaxAge(t *testing.T) {
+	fetches := 0
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		fetches++
+		if fetches == 2 {
+			return `null, "error":{"code":-32000,"message":"node is behind"}`
+		}
+		return fmt.Sprintf(`{"context":{"slot":%v},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":%v}}`, fetches, 100+fetches)
+	})
+	defer server.Close()
+
+	cache := NewClient(server.URL).NewBlockhashCache(BlockhashCacheConfig{MaxAge: time.Minute})
+	_, err := cache.Get(context.Background())
+	assert.Nil(t, err)
+
+	// a stale blockhash is never returned, the error of the fetch returns instead
+	cache.fetchedAt = time.Now().Add(-time.Minute)
+	_, err = cache.Get(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, fetches)
+
+	value, err := cache.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(103), value.LatestValidBlockHeight)
+	assert.Equal(t, 3, fetches)
+}
+
+func TestBlockhashCache_RefreshKeepsNewer(t *testing.T) {
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":100}}`
+	})
+	defer server.Close()
+
+	// a refresh which overlapped this one stored a newer blockhash first
+	cache := NewClient(server.URL).NewBlockhashCache(BlockhashCacheConfig{})
+	newer := rpc.GetLatestBlockhashValue{Blockhash: "CWfyLmBd8PWBQwQaE3rUSHLMHJGqa3gDMhdBDVdEZaRU", LatestValidBlockHeight: 150}
+	cache.value, cache.fetchedAt = newer, time.Now()
+
+	value, err := cache.Refresh(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, newer, value)
+
+	value, err = cache.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, newer, value)
+}
+
+func TestBlockhashCache_Run(t *testing.T) {
+	fetched := make(chan struct{}, 10)
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		fetched <- struct{}{}
+		return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":100}}`
+	})
+	defer server.Close()
+
+	cache := NewClient(server.URL).NewBlockhashCache(BlockhashCacheConfig{RefreshInterval: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		cache.Run(ctx)
+		close(done)
+	}()
+	for i := 0; i < 3; i++ {
+		select {
+		case <-fetched:
+		case <-time.After(time.Second):
+			t.Fatal("blockhash is not refreshed")
+		}
+	}
+	cancel()
+	<-done
+	assert.False(t, cache.FetchedAt().IsZero())
+}
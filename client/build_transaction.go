@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+type BuildTransactionParam struct {
+	Instructions []types.Instruction
+	// Signers and ExternalSigners sign the transaction, the fee payer doesn't have to be one of them
+	Signers         []types.Account
+	ExternalSigners []types.Signer
+	FeePayer        common.PublicKey
+	// v0 transaction
+	AddressLookupTableAccounts []types.AddressLookupTableAccount
+}
+
+type BuildTransactionConfig struct {
+	// Commitment is used to fetch the blockhash, default is the client default commitment or confirmed
+	Commitment rpc.Commitment
+	// ComputeBudget prepends the compute budget instructions if it is set
+	ComputeBudget *ComputeBudgetConfig
+}
+
+type BuildTransactionResult struct {
+	Transaction types.Transaction
+	// LastValidBlockHeight is the last block height the transaction can land, see WaitForConfirmationConfig
+	LastValidBlockHeight uint64
+}
+
+// BuildTransaction fetches the latest blockhash and builds a transaction signed by the signers.
+// the slots of the missing signers are left empty, e.g. an external fee payer, see BuildTransactionWithConfig.
+func (c *Client) BuildTransaction(ctx context.Context, param BuildTransactionParam) (BuildTransactionResult, error) {
+	return c.BuildTransactionWithConfig(ctx, param, BuildTransactionConfig{})
+}
+
+// BuildTransactionWithConfig fetches the latest blockhash and builds a transaction signed by the signers.
+// for a gasless transaction, set FeePayer to the account of a relay and leave it out of the signers,
+// then serialize the transaction and pass it to the relay, which signs it with types.Transaction.SignAsFeePayer.
+func (c *Client) BuildTransactionWithConfig(ctx context.Context, param BuildTransactionParam, cfg BuildTransactionConfig) (BuildTransactionResult, error) {
+	latestBlockhash, err := c.GetLatestBlockhashWithConfig(ctx, GetLatestBlockhashConfig{
+		Commitment: c.commitmentOrDefault(cfg.Commitment, rpc.CommitmentConfirmed),
+	})
+	if err != nil {
+		return BuildTransactionResult{}, fmt.Errorf("failed to get latest blockhash, err: %w", err)
+	}
+	instructions := param.Instructions
+	if cfg.ComputeBudget != nil {
+		prefix, err := c.computeBudgetInstructions(ctx, param, latestBlockhash.Blockhash, *cfg.ComputeBudget)
+		if err != nil {
+			return BuildTransactionResult{}, err
+		}
+		instructions = append(prefix, instructions...)
+	}
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:                   param.FeePayer,
+			Instructions:               instructions,
+			RecentBlockhash:            latestBlockhash.Blockhash,
+			AddressLookupTableAccounts: param.AddressLookupTableAccounts,
+		}),
+		Signers:         param.Signers,
+		ExternalSigners: param.ExternalSigners,
+	})
+	if err != nil {
+		return BuildTransactionResult{}, fmt.Errorf("failed to create new tx, err: %v", err)
+	}
+	return BuildTransactionResult{
+		Transaction:          tx,
+		LastValidBlockHeight: latestBlockhash.LatestValidBlockHeight,
+	}, nil
+}
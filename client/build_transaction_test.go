@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/program/memo"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_BuildTransaction(t *testing.T) {
+	relay := types.NewAccount()
+	user := types.NewAccount()
+
+	var built BuildTransactionResult
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLatestBlockhash", "params":[{"commitment":"confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":150}},"id":1}`,
+				F: func(url string) (any, error) {
+					var err error
+					built, err = NewClient(url).BuildTransaction(context.Background(), BuildTransactionParam{
+						Instructions: []types.Instruction{
+							memo.BuildMemo(memo.BuildMemoParam{
+								SignerPubkeys: []common.PublicKey{user.PublicKey},
+								Memo:          []byte("gasless"),
+							}),
+						},
+						Signers:  []types.Account{user},
+						FeePayer: relay.PublicKey,
+					})
+					return built.LastValidBlockHeight, err
+				},
+				ExpectedValue: uint64(150),
+				ExpectedError: nil,
+			},
+		},
+	)
+
+	// the user passes the tx to the relay, which pays the fee
+	tx := built.Transaction
+	assert.Equal(t, relay.PublicKey, tx.Message.FeePayer())
+	assert.Equal(t, []common.PublicKey{relay.PublicKey}, tx.MissingSigners())
+	raw, err := tx.Serialize()
+	assert.Nil(t, err)
+
+	tx, err = types.TransactionDeserialize(raw)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.SignAsFeePayer(relay.AsSigner()))
+	assert.Nil(t, tx.VerifySignatures())
+}
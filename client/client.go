@@ -24,6 +24,18 @@ func NewClient(endpoint string) *Client {
 	return &Client{rpc.New(rpc.WithEndpoint(endpoint))}
 }
 
+// commitmentOrDefault returns commitment if it is set, otherwise the default commitment of the
+// rpc client, see rpc.WithDefaultCommitment, and fallback if neither is set
+func (c *Client) commitmentOrDefault(commitment, fallback rpc.Commitment) rpc.Commitment {
+	if commitment != "" {
+		return commitment
+	}
+	if commitment = c.RpcClient.DefaultCommitment(); commitment != "" {
+		return commitment
+	}
+	return fallback
+}
+
 type QuickSendTransactionParam struct {
 	Instructions []types.Instruction
 	Signers      []types.Account
@@ -35,7 +47,7 @@ type QuickSendTransactionParam struct {
 func (c *Client) QuickSendTransaction(ctx context.Context, param QuickSendTransactionParam) (string, error) {
 	recentBlockhashRes, err := c.GetLatestBlockhash(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get recent blockhash, err: %v", err)
+		return "", fmt.Errorf("failed to get recent blockhash, err: %w", err)
 	}
 	tx, err := types.NewTransaction(types.NewTransactionParam{
 		Message: types.NewMessage(types.NewMessageParam{
@@ -59,7 +71,7 @@ func (c *Client) QuickSendTransaction(ctx context.Context, param QuickSendTransa
 	)
 	err = checkJsonRpcResponse(res, err)
 	if err != nil {
-		return "", err
+		return "", newPreflightError(err)
 	}
 	return res.Result, nil
 }
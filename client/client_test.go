@@ -68,3 +68,13 @@ func Test_checkJsonRpcResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_commitmentOrDefault(t *testing.T) {
+	c := New()
+	assert.Equal(t, rpc.CommitmentProcessed, c.commitmentOrDefault(rpc.CommitmentProcessed, rpc.CommitmentConfirmed))
+	assert.Equal(t, rpc.CommitmentConfirmed, c.commitmentOrDefault("", rpc.CommitmentConfirmed))
+
+	c = New(rpc.WithDefaultCommitment(rpc.CommitmentFinalized))
+	assert.Equal(t, rpc.CommitmentProcessed, c.commitmentOrDefault(rpc.CommitmentProcessed, rpc.CommitmentConfirmed))
+	assert.Equal(t, rpc.CommitmentFinalized, c.commitmentOrDefault("", rpc.CommitmentConfirmed))
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/compute_budget"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const (
+	// MaxComputeUnitLimit is the max compute unit limit of a transaction
+	MaxComputeUnitLimit uint32 = 1_400_000
+
+	defaultComputeUnitLimitMargin = 0.1
+	defaultPriorityFeePercentile  = 50
+)
+
+var ErrComputeBudgetInstructionExists = errors.New("instructions already contain compute budget instructions")
+
+// ComputeBudgetConfig prepends SetComputeUnitLimit and SetComputeUnitPrice to a transaction
+type ComputeBudgetConfig struct {
+	// UnitLimit is the compute unit limit, 0 means to estimate it by EstimateComputeUnits
+	UnitLimit uint32
+	// UnitLimitMargin is added to the estimated units, e.g. 0.1 adds 10%, default is 0.1
+	UnitLimitMargin float64
+	// UnitPrice is the price in micro-lamports, nil means to estimate it by EstimatePriorityFee
+	UnitPrice *uint64
+	// PriorityFeePercentile is passed to EstimatePriorityFee, default is 50
+	PriorityFeePercentile uint8
+	// MaxUnitPrice caps the estimated price, 0 means no cap
+	MaxUnitPrice uint64
+}
+
+// EstimateComputeUnits simulates the transaction without verifying the signatures and returns the consumed units.
+// the transaction should have a compute unit limit high enough, otherwise the simulation fails at the default limit.
+func (c *Client) EstimateComputeUnits(ctx context.Context, tx types.Transaction) (uint64, error) {
+	res, err := c.SimulateTransactionWithConfig(ctx, tx, SimulateTransactionConfig{
+		ReplaceRecentBlockhash: true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to simulate transaction, err: %w", err)
+	}
+	if res.Err != nil {
+		return 0, fmt.Errorf("simulation failed, err: %v, logs: %v", res.Err, res.Logs)
+	}
+	if res.UnitsConsumed == nil {
+		return 0, errors.New("simulation doesn't return the consumed units")
+	}
+	return *res.UnitsConsumed, nil
+}
+
+// computeBudgetInstructions returns the compute budget instructions for the instructions of param
+func (c *Client) computeBudgetInstructions(ctx context.Context, param BuildTransactionParam, recentBlockhash string, cfg ComputeBudgetConfig) ([]types.Instruction, error) {
+	for _, instruction := range param.Instructions {
+		if instruction.ProgramID == common.ComputeBudgetProgramID {
+			return nil, ErrComputeBudgetInstructionExists
+		}
+	}
+	newMessage := func(prefix ...types.Instruction) types.Message {
+		return types.NewMessage(types.NewMessageParam{
+			FeePayer:                   param.FeePayer,
+			Instructions:               append(prefix, param.Instructions...),
+			RecentBlockhash:            recentBlockhash,
+			AddressLookupTableAccounts: param.AddressLookupTableAccounts,
+		})
+	}
+
+	var price uint64
+	if cfg.UnitPrice != nil {
+		price = *cfg.UnitPrice
+	} else {
+		percentile := cfg.PriorityFeePercentile
+		if percentile == 0 {
+			percentile = defaultPriorityFeePercentile
+		}
+		var err error
+		price, err = c.EstimatePriorityFee(ctx, writableAccounts(newMessage()), percentile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate priority fee, err: %w", err)
+		}
+		if cfg.MaxUnitPrice > 0 && price > cfg.MaxUnitPrice {
+			price = cfg.MaxUnitPrice
+		}
+	}
+	priceInstruction := compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{MicroLamports: price})
+
+	limit := cfg.UnitLimit
+	if limit == 0 {
+		// simulate with the same instructions, so the compute budget instructions are counted
+		message := newMessage(
+			compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: MaxComputeUnitLimit}),
+			priceInstruction,
+		)
+		tx := types.Transaction{
+			Signatures: make([]types.Signature, message.Header.NumRequireSignatures),
+			Message:    message,
+		}
+		for i := range tx.Signatures {
+			tx.Signatures[i] = make([]byte, 64)
+		}
+		units, err := c.EstimateComputeUnits(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate compute units, err: %w", err)
+		}
+		margin := cfg.UnitLimitMargin
+		if margin <= 0 {
+			margin = defaultComputeUnitLimitMargin
+		}
+		estimated := uint64(float64(units) * (1 + margin))
+		if estimated > uint64(MaxComputeUnitLimit) {
+			estimated = uint64(MaxComputeUnitLimit)
+		}
+		limit = uint32(estimated)
+	}
+
+	return []types.Instruction{
+		compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: limit}),
+		priceInstruction,
+	}, nil
+}
+
+// writableAccounts returns the static writable accounts of a message
+func writableAccounts(m types.Message) []string {
+	numSigners := int(m.Header.NumRequireSignatures)
+	numWritableSigners := numSigners - int(m.Header.NumReadonlySignedAccounts)
+	numWritableUnsigned := len(m.Accounts) - numSigners - int(m.Header.NumReadonlyUnsignedAccounts)
+
+	output := []string{}
+	for i, account := range m.Accounts {
+		if i < numWritableSigners || (i >= numSigners && i < numSigners+numWritableUnsigned) {
+			output = append(output, account.ToBase58())
+		}
+	}
+	return output
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/program/compute_budget"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_BuildTransactionWithConfig_ComputeBudget(t *testing.T) {
+	feePayer := types.NewAccount()
+	to := common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	transfer := system.Transfer(system.TransferParam{From: feePayer.PublicKey, To: to, Amount: 1})
+	param := BuildTransactionParam{
+		Instructions: []types.Instruction{transfer},
+		Signers:      []types.Account{feePayer},
+		FeePayer:     feePayer.PublicKey,
+	}
+
+	tests := []struct {
+		name                 string
+		cfg                  ComputeBudgetConfig
+		expectedMethods      []string
+		expectedInstructions []types.Instruction
+	}{
+		{
+			name:            "estimate",
+			cfg:             ComputeBudgetConfig{MaxUnitPrice: 3000},
+			expectedMethods: []string{"getLatestBlockhash", "getRecentPrioritizationFees", "simulateTransaction"},
+			expectedInstructions: []types.Instruction{
+				compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: 495}),
+				compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{MicroLamports: 3000}),
+				transfer,
+			},
+		},
+		{
+			name:            "fixed",
+			cfg:             ComputeBudgetConfig{UnitLimit: 1000, UnitPrice: pointer.Get[uint64](1)},
+			expectedMethods: []string{"getLatestBlockhash"},
+			expectedInstructions: []types.Instruction{
+				compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: 1000}),
+				compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{MicroLamports: 1}),
+				transfer,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var methods []string
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				methods = append(methods, method)
+				switch method {
+				case "getLatestBlockhash":
+					return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":150}}`
+				case "getRecentPrioritizationFees":
+					assert.JSONEq(t, `["`+feePayer.PublicKey.ToBase58()+`","`+to.ToBase58()+`"]`, string(params[0]))
+					return `[{"slot":1,"prioritizationFee":1000},{"slot":2,"prioritizationFee":5000},{"slot":3,"prioritizationFee":8000}]`
+				case "simulateTransaction":
+					var raw string
+					assert.Nil(t, json.Unmarshal(params[0], &raw))
+					b, err := base64.StdEncoding.DecodeString(raw)
+					assert.Nil(t, err)
+					tx, err := types.TransactionDeserialize(b)
+					assert.Nil(t, err)
+					// the simulation runs with the max limit
+					assert.Equal(t, compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: MaxComputeUnitLimit}), tx.Message.DecompileInstructions()[0])
+					assert.JSONEq(t, `{"encoding":"base64","replaceRecentBlockhash":true}`, string(params[1]))
+					return `{"context":{"slot":1},"value":{"err":null,"logs":[],"accounts":null,"unitsConsumed":450}}`
+				}
+				t.Fatalf("unexpected method: %v", method)
+				return ""
+			})
+			defer server.Close()
+
+			built, err := NewClient(server.URL).BuildTransactionWithConfig(context.Background(), param, BuildTransactionConfig{ComputeBudget: &tt.cfg})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedMethods, methods)
+			assert.Equal(t, tt.expectedInstructions, built.Transaction.Message.DecompileInstructions())
+			assert.Nil(t, built.Transaction.VerifySignatures())
+		})
+	}
+
+	t.Run("already set", func(t *testing.T) {
+		server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+			return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":150}}`
+		})
+		defer server.Close()
+
+		param := param
+		param.Instructions = append([]types.Instruction{compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: 1})}, param.Instructions...)
+		_, err := NewClient(server.URL).BuildTransactionWithConfig(context.Background(), param, BuildTransactionConfig{ComputeBudget: &ComputeBudgetConfig{}})
+		assert.ErrorIs(t, err, ErrComputeBudgetInstructionExists)
+	})
+}
+
+func TestWritableAccounts(t *testing.T) {
+	message := types.Message{
+		Header: types.MessageHeader{NumRequireSignatures: 2, NumReadonlySignedAccounts: 1, NumReadonlyUnsignedAccounts: 1},
+		Accounts: []common.PublicKey{
+			common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+			common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
+			common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+			common.SystemProgramID,
+		},
+	}
+	assert.Equal(t, []string{"EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}, writableAccounts(message))
+}
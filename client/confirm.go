@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+const (
+	defaultConfirmationPollInterval    = 500 * time.Millisecond
+	defaultConfirmationMaxPollInterval = 2 * time.Second
+)
+
+type WaitForConfirmationConfig struct {
+	// LastValidBlockHeight is the one returned with the blockhash of the transaction. if it is set,
+	// ErrTransactionExpired returns once the block height passes it and the transaction has no status.
+	// otherwise it waits until ctx is done.
+	LastValidBlockHeight uint64
+	// PollInterval is the first interval between polls, default is 500ms. it grows up to MaxPollInterval, default is 2s.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+}
+
+// WaitForConfirmation polls the status of the signature until it reaches commitment.
+// a *TransactionError returns if the transaction failed.
+func (c *Client) WaitForConfirmation(ctx context.Context, signature string, commitment rpc.Commitment) (rpc.SignatureStatus, error) {
+	return c.WaitForConfirmationWithConfig(ctx, signature, commitment, WaitForConfirmationConfig{})
+}
+
+// WaitForConfirmationWithConfig polls the status of the signature until it reaches commitment or its blockhash expires.
+// a *TransactionError returns if the transaction failed.
+func (c *Client) WaitForConfirmationWithConfig(ctx context.Context, signature string, commitment rpc.Commitment, cfg WaitForConfirmationConfig) (rpc.SignatureStatus, error) {
+	interval, maxInterval := cfg.PollInterval, cfg.MaxPollInterval
+	if interval <= 0 {
+		interval = defaultConfirmationPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = defaultConfirmationMaxPollInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	for {
+		// fetch the block height before the status, so a status fetched after expiry is never missed
+		var blockHeight uint64
+		if cfg.LastValidBlockHeight > 0 {
+			var err error
+			blockHeight, err = c.getBlockHeight(ctx, commitment)
+			if err != nil {
+				return rpc.SignatureStatus{}, err
+			}
+		}
+
+		status, err := c.GetSignatureStatus(ctx, signature)
+		if err != nil {
+			return rpc.SignatureStatus{}, err
+		}
+		if status != nil {
+			if status.Err != nil {
+				return *status, &TransactionError{Signature: signature, Err: status.Err}
+			}
+			if isCommitmentReached(*status, commitment) {
+				return *status, nil
+			}
+		}
+		// a transaction with a status has landed, it is never expired even if it hasn't reached commitment yet
+		if status == nil && cfg.LastValidBlockHeight > 0 && blockHeight > cfg.LastValidBlockHeight {
+			return rpc.SignatureStatus{}, ErrTransactionExpired
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return rpc.SignatureStatus{}, ctx.Err()
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func (c *Client) getBlockHeight(ctx context.Context, commitment rpc.Commitment) (uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[uint64], error) {
+			return c.RpcClient.GetBlockHeightWithConfig(ctx, rpc.GetBlockHeightConfig{Commitment: commitment})
+		},
+		forward[uint64],
+	)
+}
+
+func isCommitmentReached(status rpc.SignatureStatus, commitment rpc.Commitment) bool {
+	reached := rpc.CommitmentProcessed
+	if status.ConfirmationStatus != nil {
+		reached = *status.ConfirmationStatus
+	} else if status.Confirmations == nil {
+		// confirmations is null once the block is rooted
+		reached = rpc.CommitmentFinalized
+	}
+	return commitmentLevel(reached) >= commitmentLevel(commitment)
+}
+
+func commitmentLevel(commitment rpc.Commitment) int {
+	switch commitment {
+	case rpc.CommitmentProcessed:
+		return 0
+	case rpc.CommitmentConfirmed:
+		return 1
+	}
+	// default is finalized
+	return 2
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeRpcServer replies every request with the result returned by handler
+func newFakeRpcServer(t *testing.T, handler func(method string, params []json.RawMessage) string) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+		mu.Lock()
+		result := handler(body.Method, body.Params)
+		mu.Unlock()
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":%v}`, result)))
+	}))
+}
+
+func TestClient_WaitForConfirmation(t *testing.T) {
+	statuses := []string{
+		`null`,
+		`{"slot":100,"confirmations":0,"err":null,"confirmationStatus":"processed"}`,
+		`{"slot":100,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}`,
+	}
+	calls := 0
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		assert.Equal(t, "getSignatureStatuses", method)
+		assert.JSONEq(t, `["5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"]`, string(params[0]))
+		status := statuses[calls]
+		calls++
+		return fmt.Sprintf(`{"context":{"slot":100},"value":[%v]}`, status)
+	})
+	defer server.Close()
+
+	status, err := NewClient(server.URL).WaitForConfirmationWithConfig(
+		context.Background(),
+		"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv",
+		rpc.CommitmentConfirmed,
+		WaitForConfirmationConfig{PollInterval: time.Millisecond},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, rpc.SignatureStatus{
+		Slot:               100,
+		Confirmations:      pointer.Get[uint64](1),
+		ConfirmationStatus: pointer.Get(rpc.CommitmentConfirmed),
+	}, status)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_WaitForConfirmationFailed(t *testing.T) {
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		return `{"context":{"slot":100},"value":[{"slot":100,"confirmations":0,"err":{"InstructionError":[0,{"Custom":1}]},"confirmationStatus":"processed"}]}`
+	})
+	defer server.Close()
+
+	_, err := NewClient(server.URL).WaitForConfirmation(context.Background(), "sig", rpc.CommitmentFinalized)
+	assert.Equal(t, &TransactionError{
+		Signature: "sig",
+		Err:       map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}},
+	}, err)
+}
+
+func TestClient_WaitForConfirmationExpired(t *testing.T) {
+	blockHeight := 98
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getBlockHeight":
+			assert.JSONEq(t, `{"commitment":"finalized"}`, string(params[0]))
+			blockHeight++
+			return fmt.Sprint(blockHeight)
+		case "getSignatureStatuses":
+			return `{"context":{"slot":100},"value":[null]}`
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	})
+	defer server.Close()
+
+	_, err := NewClient(server.URL).WaitForConfirmationWithConfig(
+		context.Background(),
+		"sig",
+		rpc.CommitmentFinalized,
+		WaitForConfirmationConfig{LastValidBlockHeight: 100, PollInterval: time.Millisecond},
+	)
+	assert.Equal(t, ErrTransactionExpired, err)
+	assert.Equal(t, 101, blockHeight)
+}
+
+func TestClient_WaitForConfirmationLandedBeforeExpiry(t *testing.T) {
+	polls := 0
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getBlockHeight":
+			return "101"
+		case "getSignatureStatuses":
+			// it stays confirmed for a few polls after the expiry
+			polls++
+			if polls <= 3 {
+				return `{"context":{"slot":100},"value":[{"slot":100,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+			}
+			return `{"context":{"slot":100},"value":[{"slot":100,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	})
+	defer server.Close()
+
+	_, err := NewClient(server.URL).WaitForConfirmationWithConfig(
+		context.Background(),
+		"sig",
+		rpc.CommitmentFinalized,
+		WaitForConfirmationConfig{LastValidBlockHeight: 100, PollInterval: time.Millisecond},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, polls)
+}
+
+func TestClient_WaitForConfirmationContextDone(t *testing.T) {
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		return `{"context":{"slot":100},"value":[null]}`
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := NewClient(server.URL).WaitForConfirmationWithConfig(ctx, "sig", rpc.CommitmentFinalized, WaitForConfirmationConfig{PollInterval: time.Millisecond})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestIsCommitmentReached(t *testing.T) {
+	tests := []struct {
+		status     rpc.SignatureStatus
+		commitment rpc.Commitment
+		expected   bool
+	}{
+		{rpc.SignatureStatus{ConfirmationStatus: pointer.Get(rpc.CommitmentProcessed), Confirmations: pointer.Get[uint64](0)}, rpc.CommitmentProcessed, true},
+		{rpc.SignatureStatus{ConfirmationStatus: pointer.Get(rpc.CommitmentProcessed), Confirmations: pointer.Get[uint64](0)}, rpc.CommitmentConfirmed, false},
+		{rpc.SignatureStatus{ConfirmationStatus: pointer.Get(rpc.CommitmentFinalized)}, rpc.CommitmentConfirmed, true},
+		{rpc.SignatureStatus{ConfirmationStatus: pointer.Get(rpc.CommitmentConfirmed), Confirmations: pointer.Get[uint64](5)}, rpc.CommitmentFinalized, false},
+		{rpc.SignatureStatus{}, rpc.CommitmentFinalized, true},
+		{rpc.SignatureStatus{Confirmations: pointer.Get[uint64](5)}, rpc.CommitmentProcessed, true},
+		{rpc.SignatureStatus{Confirmations: pointer.Get[uint64](5)}, rpc.CommitmentConfirmed, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, isCommitmentReached(tt.status, tt.commitment))
+	}
+}
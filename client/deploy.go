@@ -0,0 +1,345 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/bpfloader"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const defaultDeployConcurrency = 8
+
+// maxWriteBufferRounds is the number of times the chunks which don't match the program are written again
+const maxWriteBufferRounds = 3
+
+var ErrBufferMismatch = errors.New("buffer doesn't match the program")
+
+type WriteBufferParam struct {
+	FeePayer types.Account
+	// Buffer is a new keypair, pass the same one again to resume an interrupted write
+	Buffer types.Account
+	// Authority of the buffer, default is the fee payer
+	Authority   types.Account
+	ProgramData []byte
+	// Concurrency is the number of write transactions in flight, default is 8
+	Concurrency int
+}
+
+// WriteBuffer creates the buffer if it doesn't exist and writes the program into it, one Write transaction per chunk.
+// the chunks are sent in parallel, the buffer is then fetched and the chunks which don't match are written again,
+// so calling it again with the same buffer resumes an interrupted write without sending the written chunks.
+// ErrBufferMismatch returns if the existing buffer has another authority or size.
+func (c *Client) WriteBuffer(ctx context.Context, param WriteBufferParam) error {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+
+	written, err := c.getBufferData(ctx, param.Buffer.PublicKey, authority.PublicKey, len(param.ProgramData))
+	if err != nil {
+		return err
+	}
+	if written == nil {
+		rent, err := c.GetMinimumBalanceForRentExemption(ctx, bpfloader.GetBufferSize(uint64(len(param.ProgramData))))
+		if err != nil {
+			return fmt.Errorf("failed to get rent, err: %w", err)
+		}
+		_, err = c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+			Instructions: []types.Instruction{
+				system.CreateAccount(system.CreateAccountParam{
+					From:     param.FeePayer.PublicKey,
+					New:      param.Buffer.PublicKey,
+					Owner:    common.BPFLoaderUpgradeableProgramID,
+					Lamports: rent,
+					Space:    bpfloader.GetBufferSize(uint64(len(param.ProgramData))),
+				}),
+				bpfloader.InitializeBuffer(bpfloader.InitializeBufferParam{
+					Buffer:    param.Buffer.PublicKey,
+					Authority: authority.PublicKey,
+				}),
+			},
+			Signers:  uniqueSigners(param.FeePayer, param.Buffer),
+			FeePayer: param.FeePayer.PublicKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create buffer, err: %w", err)
+		}
+		written = make([]byte, len(param.ProgramData))
+	}
+
+	chunkSize, err := writeChunkSize(param.FeePayer.PublicKey, param.Buffer.PublicKey, authority.PublicKey)
+	if err != nil {
+		return err
+	}
+	concurrency := param.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultDeployConcurrency
+	}
+
+	var lastErr error
+	for round := 0; ; round++ {
+		offsets := []int{}
+		for offset := 0; offset < len(param.ProgramData); offset += chunkSize {
+			end := offset + chunkSize
+			if end > len(param.ProgramData) {
+				end = len(param.ProgramData)
+			}
+			if !bytes.Equal(written[offset:end], param.ProgramData[offset:end]) {
+				offsets = append(offsets, offset)
+			}
+		}
+		if len(offsets) == 0 {
+			return nil
+		}
+		if round == maxWriteBufferRounds {
+			return fmt.Errorf("failed to write buffer, %v chunks left, err: %w", len(offsets), lastErr)
+		}
+
+		if err := c.writeChunks(ctx, param, authority, offsets, chunkSize, concurrency); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			lastErr = err
+		}
+
+		written, err = c.getBufferData(ctx, param.Buffer.PublicKey, authority.PublicKey, len(param.ProgramData))
+		if err != nil {
+			return err
+		}
+		if written == nil {
+			return fmt.Errorf("%w, the buffer is closed", ErrBufferMismatch)
+		}
+	}
+}
+
+// writeChunks sends the Write transactions of the chunks at the offsets, it returns the first error after all of them are done
+func (c *Client) writeChunks(ctx context.Context, param WriteBufferParam, authority types.Account, offsets []int, chunkSize, concurrency int) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, offset := range offsets {
+		end := offset + chunkSize
+		if end > len(param.ProgramData) {
+			end = len(param.ProgramData)
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func(offset, end int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			_, err := c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+				Instructions: []types.Instruction{
+					bpfloader.Write(bpfloader.WriteParam{
+						Buffer:    param.Buffer.PublicKey,
+						Authority: authority.PublicKey,
+						Offset:    uint32(offset),
+						Bytes:     param.ProgramData[offset:end],
+					}),
+				},
+				Signers:  uniqueSigners(param.FeePayer, authority),
+				FeePayer: param.FeePayer.PublicKey,
+			})
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("failed to write chunk, offset: %v, err: %w", offset, err)
+				}
+				mu.Unlock()
+			}
+		}(offset, end)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// getBufferData returns the program written to the buffer, nil returns if the buffer doesn't exist
+func (c *Client) getBufferData(ctx context.Context, buffer, authority common.PublicKey, size int) ([]byte, error) {
+	accu, err := c.GetAccountInfoWithConfig(ctx, buffer.ToBase58(), GetAccountInfoConfig{Commitment: rpc.CommitmentConfirmed})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buffer, err: %w", err)
+	}
+	if accu.Owner == (common.PublicKey{}) {
+		return nil, nil
+	}
+	state, err := bpfloader.DeserializeBuffer(accu.Data, accu.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize buffer, err: %w", err)
+	}
+	if state.Authority == nil || *state.Authority != authority {
+		return nil, fmt.Errorf("%w, authority mismatch", ErrBufferMismatch)
+	}
+	if len(state.Data) != size {
+		return nil, fmt.Errorf("%w, size mismatch, expected: %v, got: %v", ErrBufferMismatch, size, len(state.Data))
+	}
+	return state.Data, nil
+}
+
+// writeChunkSize returns the max number of bytes a Write transaction carries
+func writeChunkSize(feePayer, buffer, authority common.PublicKey) (int, error) {
+	tx := types.Transaction{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer: feePayer,
+			Instructions: []types.Instruction{
+				bpfloader.Write(bpfloader.WriteParam{Buffer: buffer, Authority: authority}),
+			},
+			RecentBlockhash: common.PublicKey{}.ToBase58(),
+		}),
+	}
+	size, err := tx.Size()
+	if err != nil {
+		return 0, err
+	}
+	// the length of the instruction data takes one more byte once it is over 127
+	return types.PacketDataSize - size - 1, nil
+}
+
+type DeployProgramParam struct {
+	FeePayer types.Account
+	// Program is the keypair of the program id
+	Program types.Account
+	// Buffer is a new keypair, pass the same one again to resume a failed deploy
+	Buffer types.Account
+	// Authority is the upgrade authority, default is the fee payer
+	Authority   types.Account
+	ProgramData []byte
+	// MaxDataLen is the max size the program can be upgraded to, default is twice the size of the program
+	MaxDataLen uint64
+	// Concurrency is the number of write transactions in flight, default is 8
+	Concurrency int
+}
+
+// DeployProgram writes the program to the buffer, then creates the program account and deploys the buffer to it
+func (c *Client) DeployProgram(ctx context.Context, param DeployProgramParam) (string, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+	maxDataLen := param.MaxDataLen
+	if maxDataLen == 0 {
+		maxDataLen = 2 * uint64(len(param.ProgramData))
+	}
+
+	err := c.WriteBuffer(ctx, WriteBufferParam{
+		FeePayer:    param.FeePayer,
+		Buffer:      param.Buffer,
+		Authority:   authority,
+		ProgramData: param.ProgramData,
+		Concurrency: param.Concurrency,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	rent, err := c.GetMinimumBalanceForRentExemption(ctx, bpfloader.ProgramSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to get rent, err: %w", err)
+	}
+	sig, err := c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			system.CreateAccount(system.CreateAccountParam{
+				From:     param.FeePayer.PublicKey,
+				New:      param.Program.PublicKey,
+				Owner:    common.BPFLoaderUpgradeableProgramID,
+				Lamports: rent,
+				Space:    bpfloader.ProgramSize,
+			}),
+			bpfloader.DeployWithMaxDataLen(bpfloader.DeployWithMaxDataLenParam{
+				Payer:      param.FeePayer.PublicKey,
+				Program:    param.Program.PublicKey,
+				Buffer:     param.Buffer.PublicKey,
+				Authority:  authority.PublicKey,
+				MaxDataLen: maxDataLen,
+			}),
+		},
+		Signers:  uniqueSigners(param.FeePayer, param.Program, authority),
+		FeePayer: param.FeePayer.PublicKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to deploy program, err: %w", err)
+	}
+	return sig, nil
+}
+
+type UpgradeProgramParam struct {
+	FeePayer types.Account
+	Program  common.PublicKey
+	// Buffer is a new keypair, pass the same one again to resume a failed upgrade
+	Buffer types.Account
+	// Authority is the upgrade authority, default is the fee payer
+	Authority   types.Account
+	ProgramData []byte
+	// Spill receives the lamports of the buffer, default is the fee payer
+	Spill common.PublicKey
+	// Concurrency is the number of write transactions in flight, default is 8
+	Concurrency int
+}
+
+// UpgradeProgram writes the program to the buffer, then upgrades the program with it
+func (c *Client) UpgradeProgram(ctx context.Context, param UpgradeProgramParam) (string, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+	spill := param.Spill
+	if spill == (common.PublicKey{}) {
+		spill = param.FeePayer.PublicKey
+	}
+
+	err := c.WriteBuffer(ctx, WriteBufferParam{
+		FeePayer:    param.FeePayer,
+		Buffer:      param.Buffer,
+		Authority:   authority,
+		ProgramData: param.ProgramData,
+		Concurrency: param.Concurrency,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			bpfloader.Upgrade(bpfloader.UpgradeParam{
+				Program:   param.Program,
+				Buffer:    param.Buffer.PublicKey,
+				Spill:     spill,
+				Authority: authority.PublicKey,
+			}),
+		},
+		Signers:  uniqueSigners(param.FeePayer, authority),
+		FeePayer: param.FeePayer.PublicKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upgrade program, err: %w", err)
+	}
+	return sig, nil
+}
+
+func uniqueSigners(accounts ...types.Account) []types.Account {
+	signers := make([]types.Account, 0, len(accounts))
+	seen := map[common.PublicKey]bool{}
+	for _, account := range accounts {
+		if seen[account.PublicKey] {
+			continue
+		}
+		seen[account.PublicKey] = true
+		signers = append(signers, account)
+	}
+	return signers
+}
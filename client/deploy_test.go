@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/bpfloader"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLoader keeps a buffer account, it applies the create and write instructions sent to it
+type fakeLoader struct {
+	buffer []byte
+	// dropWrites is the number of write transactions confirmed without being applied
+	dropWrites int
+	writes     []uint32
+	sent       []types.Transaction
+}
+
+func bufferData(authority common.PublicKey, program []byte) []byte {
+	data := make([]byte, bpfloader.BufferMetadataSize, bpfloader.BufferMetadataSize+len(program))
+	binary.LittleEndian.PutUint32(data, uint32(bpfloader.AccountTypeBuffer))
+	data[4] = 1
+	copy(data[5:37], authority.Bytes())
+	return append(data, program...)
+}
+
+func (l *fakeLoader) handler(t *testing.T) func(method string, params []json.RawMessage) string {
+	return func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getMinimumBalanceForRentExemption":
+			return "1000"
+		case "getLatestBlockhash":
+			return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":100}}`
+		case "sendTransaction":
+			var raw string
+			assert.Nil(t, json.Unmarshal(params[0], &raw))
+			b, err := base64.StdEncoding.DecodeString(raw)
+			assert.Nil(t, err)
+			tx, err := types.TransactionDeserialize(b)
+			assert.Nil(t, err)
+			assert.Nil(t, tx.ValidateSize())
+			l.sent = append(l.sent, tx)
+			for _, instruction := range tx.Message.DecompileInstructions() {
+				l.apply(instruction)
+			}
+			return fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+		case "getBlockHeight":
+			return "50"
+		case "getSignatureStatuses":
+			return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`
+		case "getAccountInfo":
+			if l.buffer == nil {
+				return `{"context":{"slot":1},"value":null}`
+			}
+			return fmt.Sprintf(`{"context":{"slot":1},"value":{"data":["%v","base64"],"executable":false,"lamports":1000,"owner":"BPFLoaderUpgradeab1e11111111111111111111111","rentEpoch":0}}`, base64.StdEncoding.EncodeToString(l.buffer))
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	}
+}
+
+func (l *fakeLoader) apply(instruction types.Instruction) {
+	switch {
+	case instruction.ProgramID == common.SystemProgramID:
+		space := binary.LittleEndian.Uint64(instruction.Data[12:20])
+		l.buffer = make([]byte, space)
+	case instruction.ProgramID == common.BPFLoaderUpgradeableProgramID:
+		switch bpfloader.Instruction(binary.LittleEndian.Uint32(instruction.Data)) {
+		case bpfloader.InstructionInitializeBuffer:
+			copy(l.buffer, bufferData(instruction.Accounts[1].PubKey, nil))
+		case bpfloader.InstructionWrite:
+			offset := binary.LittleEndian.Uint32(instruction.Data[4:8])
+			l.writes = append(l.writes, offset)
+			if l.dropWrites > 0 {
+				l.dropWrites--
+				return
+			}
+			copy(l.buffer[bpfloader.BufferMetadataSize+int(offset):], instruction.Data[16:])
+		}
+	}
+}
+
+func TestClient_WriteBuffer(t *testing.T) {
+	feePayer := types.NewAccount()
+	buffer := types.NewAccount()
+	program := make([]byte, 5000)
+	for i := range program {
+		program[i] = byte(i%251 + 1)
+	}
+
+	t.Run("new buffer", func(t *testing.T) {
+		loader := &fakeLoader{dropWrites: 1}
+		server := newFakeRpcServer(t, loader.handler(t))
+		defer server.Close()
+
+		err := NewClient(server.URL).WriteBuffer(context.Background(), WriteBufferParam{
+			FeePayer:    feePayer,
+			Buffer:      buffer,
+			ProgramData: program,
+			Concurrency: 2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, bufferData(feePayer.PublicKey, program), loader.buffer)
+
+		// create, 5 chunks and the dropped one again
+		assert.Len(t, loader.sent, 7)
+		assert.Len(t, loader.sent[0].Signatures, 2)
+		assert.Len(t, loader.writes, 6)
+		assert.Equal(t, loader.writes[0], loader.writes[5])
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		written := make([]byte, len(program))
+		copy(written[:3000], program[:3000])
+		loader := &fakeLoader{buffer: bufferData(feePayer.PublicKey, written)}
+		server := newFakeRpcServer(t, loader.handler(t))
+		defer server.Close()
+
+		err := NewClient(server.URL).WriteBuffer(context.Background(), WriteBufferParam{
+			FeePayer:    feePayer,
+			Buffer:      buffer,
+			ProgramData: program,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, bufferData(feePayer.PublicKey, program), loader.buffer)
+		chunkSize, err := writeChunkSize(feePayer.PublicKey, buffer.PublicKey, feePayer.PublicKey)
+		assert.Nil(t, err)
+		// only the chunks from the one 3000 falls in are sent
+		expected := []uint32{}
+		for offset := 3000 / chunkSize * chunkSize; offset < len(program); offset += chunkSize {
+			expected = append(expected, uint32(offset))
+		}
+		assert.ElementsMatch(t, expected, loader.writes)
+		assert.Len(t, loader.sent, len(expected))
+	})
+
+	t.Run("authority mismatch", func(t *testing.T) {
+		loader := &fakeLoader{buffer: bufferData(types.NewAccount().PublicKey, make([]byte, len(program)))}
+		server := newFakeRpcServer(t, loader.handler(t))
+		defer server.Close()
+
+		err := NewClient(server.URL).WriteBuffer(context.Background(), WriteBufferParam{
+			FeePayer:    feePayer,
+			Buffer:      buffer,
+			ProgramData: program,
+		})
+		assert.True(t, errors.Is(err, ErrBufferMismatch))
+		assert.Len(t, loader.sent, 0)
+	})
+
+	t.Run("write keeps failing", func(t *testing.T) {
+		loader := &fakeLoader{dropWrites: 100}
+		server := newFakeRpcServer(t, loader.handler(t))
+		defer server.Close()
+
+		err := NewClient(server.URL).WriteBuffer(context.Background(), WriteBufferParam{
+			FeePayer:    feePayer,
+			Buffer:      buffer,
+			ProgramData: program,
+		})
+		assert.NotNil(t, err)
+		assert.Len(t, loader.writes, 5*maxWriteBufferRounds)
+	})
+}
+
+func TestClient_DeployProgram(t *testing.T) {
+	feePayer := types.NewAccount()
+	programAccount := types.NewAccount()
+	buffer := types.NewAccount()
+	program := []byte{1, 2, 3, 4, 5}
+
+	loader := &fakeLoader{}
+	server := newFakeRpcServer(t, loader.handler(t))
+	defer server.Close()
+
+	sig, err := NewClient(server.URL).DeployProgram(context.Background(), DeployProgramParam{
+		FeePayer:    feePayer,
+		Program:     programAccount,
+		Buffer:      buffer,
+		ProgramData: program,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, loader.sent, 3)
+
+	deploy := loader.sent[2]
+	assert.Equal(t, base58.Encode(deploy.Signatures[0]), sig)
+	assert.Len(t, deploy.Signatures, 2)
+	instructions := deploy.Message.DecompileInstructions()
+	assert.Equal(t, common.SystemProgramID, instructions[0].ProgramID)
+	assert.Equal(t, bpfloader.DeployWithMaxDataLen(bpfloader.DeployWithMaxDataLenParam{
+		Payer:      feePayer.PublicKey,
+		Program:    programAccount.PublicKey,
+		Buffer:     buffer.PublicKey,
+		Authority:  feePayer.PublicKey,
+		MaxDataLen: 10,
+	}).Data, instructions[1].Data)
+}
+
+func TestClient_UpgradeProgram(t *testing.T) {
+	feePayer := types.NewAccount()
+	authority := types.NewAccount()
+	buffer := types.NewAccount()
+	programID := types.NewAccount().PublicKey
+	program := []byte{1, 2, 3, 4, 5}
+
+	loader := &fakeLoader{buffer: bufferData(authority.PublicKey, program)}
+	server := newFakeRpcServer(t, loader.handler(t))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).UpgradeProgram(context.Background(), UpgradeProgramParam{
+		FeePayer:    feePayer,
+		Program:     programID,
+		Buffer:      buffer,
+		Authority:   authority,
+		ProgramData: program,
+	})
+	assert.Nil(t, err)
+	// the buffer is already written
+	assert.Len(t, loader.sent, 1)
+	assert.Len(t, loader.sent[0].Signatures, 2)
+	upgrade := loader.sent[0].Message.DecompileInstructions()[0]
+	assert.Equal(t, common.BPFLoaderUpgradeableProgramID, upgrade.ProgramID)
+	assert.Equal(t, []byte{3, 0, 0, 0}, upgrade.Data)
+	// the spill is the fee payer
+	assert.Equal(t, feePayer.PublicKey, upgrade.Accounts[3].PubKey)
+}
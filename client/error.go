@@ -0,0 +1,98 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/pkg/programerror"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// ErrTransactionExpired means the blockhash of the transaction expired before it was confirmed,
+// the transaction will never land and it is safe to resend it with a new blockhash
+var ErrTransactionExpired = errors.New("transaction expired")
+
+// TransactionError is returned when a transaction landed but failed
+type TransactionError struct {
+	Signature string
+	// Err is the `err` of the transaction status, e.g. map[InstructionError:[0 map[Custom:1]]]
+	Err any
+}
+
+func (e *TransactionError) Error() string {
+	return fmt.Sprintf("transaction %v failed, err: %v", e.Signature, e.Err)
+}
+
+// PreflightError is returned when the node refuses to send a transaction because its simulation failed,
+// it carries the simulation from the error so the transaction doesn't need to be simulated again.
+// it unwraps to the *rpc.JsonRpcError.
+type PreflightError struct {
+	// Message is the message of the json rpc error, e.g. "Transaction simulation failed: Blockhash not found"
+	Message string
+	// Err is the error of the simulation, e.g. map[InstructionError:[0 map[Custom:1]]] or BlockhashNotFound
+	Err           any
+	Logs          []string
+	UnitsConsumed *uint64
+	// InstructionIndex and InstructionErr are set if an instruction failed, e.g. 0 and map[Custom:1]
+	InstructionIndex *int
+	InstructionErr   any
+	// ProgramError is the custom program error named by programerror.DefaultRegistry, nil if it isn't one
+	ProgramError *programerror.Error
+
+	rpcErr *rpc.JsonRpcError
+}
+
+func (e *PreflightError) Error() string {
+	s := e.Message
+	if e.ProgramError != nil {
+		s = fmt.Sprintf("%v, %v", s, e.ProgramError)
+	}
+	if len(e.Logs) > 0 {
+		s = fmt.Sprintf("%v, logs: %q", s, e.Logs)
+	}
+	return s
+}
+
+func (e *PreflightError) Unwrap() error {
+	return e.rpcErr
+}
+
+// isBlockhashNotFound reports whether the preflight failed because the node doesn't know the blockhash,
+// e.g. it is behind the node the blockhash is fetched from
+func isBlockhashNotFound(err error) bool {
+	var preflightErr *PreflightError
+	return errors.As(err, &preflightErr) && preflightErr.Err == "BlockhashNotFound"
+}
+
+// newPreflightError converts a preflight failure to a *PreflightError, other errors return as they are
+func newPreflightError(err error) error {
+	var rpcErr *rpc.JsonRpcError
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+	simulation, ok := rpcErr.SendTransactionPreflightFailure()
+	if !ok {
+		return err
+	}
+	var programErr *programerror.Error
+	errors.As(programerror.FromLogs(simulation.Logs), &programErr)
+	preflightErr := &PreflightError{
+		Message:       rpcErr.Message,
+		Err:           simulation.Err,
+		Logs:          simulation.Logs,
+		UnitsConsumed: simulation.UnitsConsumed,
+		ProgramError:  programErr,
+		rpcErr:        rpcErr,
+	}
+	// {"InstructionError":[0,{"Custom":1}]}
+	if m, ok := simulation.Err.(map[string]any); ok {
+		if v, ok := m["InstructionError"].([]any); ok && len(v) == 2 {
+			if index, ok := v[0].(float64); ok {
+				i := int(index)
+				preflightErr.InstructionIndex = &i
+				preflightErr.InstructionErr = v[1]
+			}
+		}
+	}
+	return preflightErr
+}
@@ -0,0 +1,109 @@
+// Package geyser is a client of the Yellowstone gRPC interface of a geyser plugin.
+// it streams accounts, transactions, slots and blocks, which is a lot faster than the websocket subscriptions.
+package geyser
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	subscribeMethod = "/geyser.Geyser/Subscribe"
+
+	// defaultMaxRecvMsgSize is large enough for a block with its transactions
+	defaultMaxRecvMsgSize = 1 << 30
+)
+
+// Client talks to a Yellowstone gRPC endpoint
+type Client struct {
+	conn  *grpc.ClientConn
+	token string
+}
+
+type config struct {
+	token       string
+	insecure    bool
+	dialOptions []grpc.DialOption
+}
+
+// Option is a configuration type for the Client
+type Option func(*config)
+
+// WithToken is an Option that sets the x-token of every call, most providers require it
+func WithToken(token string) Option {
+	return func(c *config) {
+		c.token = token
+	}
+}
+
+// WithInsecure is an Option that disables tls, it is the default for a http:// endpoint
+func WithInsecure() Option {
+	return func(c *config) {
+		c.insecure = true
+	}
+}
+
+// WithDialOptions is an Option that appends grpc dial options, e.g. keepalive params
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *config) {
+		c.dialOptions = append(c.dialOptions, opts...)
+	}
+}
+
+// Dial creates a client of the endpoint, which is a host:port or an url e.g. https://host.
+// the connection is made lazily, a bad endpoint fails at the first call.
+func Dial(ctx context.Context, endpoint string, opts ...Option) (*Client, error) {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	target := endpoint
+	port := "443"
+	if s := strings.TrimPrefix(target, "http://"); s != target {
+		target, port = s, "80"
+		cfg.insecure = true
+	}
+	target = strings.TrimSuffix(strings.TrimPrefix(target, "https://"), "/")
+	if _, _, err := net.SplitHostPort(target); err != nil {
+		target = net.JoinHostPort(target, port)
+	}
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if cfg.insecure {
+		creds = insecure.NewCredentials()
+	}
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(defaultMaxRecvMsgSize)),
+	}, cfg.dialOptions...)
+
+	conn, err := grpc.DialContext(ctx, target, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %v, err: %v", endpoint, err)
+	}
+	return &Client{
+		conn:  conn,
+		token: cfg.token,
+	}, nil
+}
+
+// Close closes the connection, all streams will be closed
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) outgoingContext(ctx context.Context) context.Context {
+	if c.token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "x-token", c.token)
+}
@@ -0,0 +1,302 @@
+package geyser
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// rawCodec lets the test server read and write the encoded messages
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) { return *(v.(*[]byte)), nil }
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	*(v.(*[]byte)) = append([]byte{}, data...)
+	return nil
+}
+func (rawCodec) Name() string { return "proto" }
+
+// newTestServer starts a server which calls handler with every Subscribe stream
+func newTestServer(t *testing.T, handler func(stream grpc.ServerStream) error) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(srv any, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			assert.Equal(t, subscribeMethod, method)
+			return handler(stream)
+		}),
+	)
+	go func() { _ = server.Serve(listener) }()
+	return "http://" + listener.Addr().String(), server.Stop
+}
+
+// msg builds a message from encoded fields
+func msg(fields ...[]byte) []byte {
+	output := []byte{}
+	for _, f := range fields {
+		output = append(output, f...)
+	}
+	return output
+}
+
+func varintField(num protowire.Number, v uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), v)
+}
+
+func bytesField(num protowire.Number, v []byte) []byte {
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), v)
+}
+
+func stringField(num protowire.Number, v string) []byte {
+	return bytesField(num, []byte(v))
+}
+
+func mapEntry(num protowire.Number, key string, value []byte) []byte {
+	return bytesField(num, msg(stringField(1, key), bytesField(2, value)))
+}
+
+func TestSubscribeRequest_marshal(t *testing.T) {
+	owner := common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	account := common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	b, err := SubscribeRequest{
+		Accounts: map[string]AccountsFilter{
+			"tokens": {
+				Owner:   []common.PublicKey{owner},
+				Filters: []AccountsFilterCondition{NewDataSizeFilter(165), NewMemcmpFilter(32, account.Bytes()), {TokenAccountState: true}},
+			},
+		},
+		Slots: map[string]SlotsFilter{"slots": {FilterByCommitment: pointer.Get(true)}},
+		Transactions: map[string]TransactionsFilter{
+			"b": {Vote: pointer.Get(false), AccountInclude: []common.PublicKey{account}},
+			"a": {Failed: pointer.Get(true), Signature: pointer.Get("sig")},
+		},
+		Blocks:            map[string]BlocksFilter{"blocks": {AccountInclude: []common.PublicKey{owner}, IncludeTransactions: pointer.Get(true)}},
+		BlocksMeta:        map[string]BlocksMetaFilter{"meta": {}},
+		Commitment:        rpc.CommitmentConfirmed,
+		AccountsDataSlice: []DataSlice{{Offset: 0, Length: 64}},
+		FromSlot:          pointer.Get[uint64](100),
+	}.marshal()
+	assert.Nil(t, err)
+	assert.Equal(t, msg(
+		mapEntry(1, "tokens", msg(
+			stringField(3, owner.ToBase58()),
+			bytesField(4, varintField(2, 165)),
+			bytesField(4, bytesField(1, msg(varintField(1, 32), bytesField(2, account.Bytes())))),
+			bytesField(4, varintField(3, 1)),
+		)),
+		mapEntry(2, "slots", varintField(1, 1)),
+		mapEntry(3, "a", msg(varintField(2, 1), stringField(5, "sig"))),
+		mapEntry(3, "b", msg(varintField(1, 0), stringField(3, account.ToBase58()))),
+		mapEntry(4, "blocks", msg(stringField(1, owner.ToBase58()), varintField(2, 1))),
+		mapEntry(5, "meta", nil),
+		varintField(6, 1),
+		bytesField(7, varintField(2, 64)),
+		varintField(11, 100),
+	), b)
+
+	_, err = SubscribeRequest{Commitment: "recent"}.marshal()
+	assert.EqualError(t, err, "unsupported commitment: recent")
+}
+
+func TestSubscribe(t *testing.T) {
+	pubkey := common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	owner := common.SystemProgramID
+	blockhash := common.PublicKeyFromString("9ipJh5xfyoyDaiq8trtrdqQeAhQbQkWy2eANizKvx75K")
+	signature := make([]byte, 64)
+	signature[0] = 1
+
+	updates := [][]byte{
+		// a ping of the node is skipped
+		msg(bytesField(6, nil)),
+		msg(
+			stringField(1, "accounts"),
+			bytesField(2, msg(
+				bytesField(1, msg(
+					bytesField(1, pubkey.Bytes()),
+					varintField(2, 1000),
+					bytesField(3, owner.Bytes()),
+					varintField(5, 18446744073709551615),
+					bytesField(6, []byte{1, 2, 3}),
+					varintField(7, 7),
+					bytesField(8, signature),
+				)),
+				varintField(2, 100),
+			)),
+			bytesField(11, msg(varintField(1, 1700000000), varintField(2, 5))),
+		),
+		msg(
+			stringField(1, "slots"),
+			bytesField(3, msg(varintField(1, 101), varintField(2, 100), varintField(3, 2))),
+		),
+		msg(
+			stringField(1, "txs"),
+			bytesField(4, msg(
+				bytesField(1, msg(
+					bytesField(1, signature),
+					bytesField(3, msg(
+						bytesField(1, signature),
+						bytesField(2, msg(
+							bytesField(1, msg(varintField(1, 1), varintField(3, 1))),
+							bytesField(2, pubkey.Bytes()),
+							bytesField(2, owner.Bytes()),
+							bytesField(3, blockhash.Bytes()),
+							bytesField(4, msg(varintField(1, 1), bytesField(2, []byte{0}), bytesField(3, []byte{2, 0, 0, 0}))),
+						)),
+					)),
+					bytesField(4, msg(
+						varintField(2, 5000),
+						bytesField(3, protowire.AppendVarint(protowire.AppendVarint(nil, 10000), 0)),
+						bytesField(4, protowire.AppendVarint(protowire.AppendVarint(nil, 5000), 0)),
+						stringField(6, "Program 11111111111111111111111111111111 invoke [1]"),
+						varintField(16, 150),
+					)),
+					varintField(5, 3),
+				)),
+				varintField(2, 102),
+			)),
+		),
+		msg(
+			stringField(1, "meta"),
+			bytesField(7, msg(
+				varintField(1, 103),
+				stringField(2, blockhash.ToBase58()),
+				bytesField(3, msg(bytesField(1, msg(stringField(1, pubkey.ToBase58()), varintField(2, 10), varintField(3, 1010), varintField(4, 4), stringField(5, "5"))))),
+				bytesField(4, varintField(1, 1700000000)),
+				bytesField(5, varintField(1, 90)),
+				varintField(6, 102),
+				stringField(7, blockhash.ToBase58()),
+				varintField(8, 1),
+			)),
+		),
+		msg(bytesField(9, varintField(1, 7))),
+	}
+
+	var requests [][]byte
+	endpoint, stop := newTestServer(t, func(stream grpc.ServerStream) error {
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		assert.Equal(t, []string{"token"}, md.Get("x-token"))
+		for i := 0; i < 2; i++ {
+			var req []byte
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+			requests = append(requests, req)
+		}
+		for _, update := range updates {
+			update := update
+			if err := stream.SendMsg(&update); err != nil {
+				return err
+			}
+		}
+		<-stream.Context().Done()
+		return nil
+	})
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := Dial(ctx, endpoint, WithToken("token"))
+	assert.Nil(t, err)
+	defer c.Close()
+
+	stream, err := c.Subscribe(ctx, SubscribeRequest{Slots: map[string]SlotsFilter{"slots": {}}})
+	assert.Nil(t, err)
+	defer stream.Close()
+	assert.Nil(t, stream.Ping(7))
+
+	var got []*Update
+	for i := 0; i < 5; i++ {
+		u, err := stream.Recv()
+		assert.Nil(t, err)
+		got = append(got, u)
+	}
+	assert.Equal(t, [][]byte{
+		mapEntry(2, "slots", nil),
+		bytesField(9, varintField(1, 7)),
+	}, requests)
+
+	assert.Equal(t, &Update{
+		Filters:   []string{"accounts"},
+		CreatedAt: time.Unix(1700000000, 5),
+		Account: &AccountUpdate{
+			Slot: 100,
+			Account: AccountInfo{
+				PublicKey:    pubkey,
+				Lamports:     1000,
+				Owner:        owner,
+				RentEpoch:    18446744073709551615,
+				Data:         []byte{1, 2, 3},
+				WriteVersion: 7,
+				TxnSignature: signature,
+			},
+		},
+	}, got[0])
+	assert.Equal(t, &Update{
+		Filters: []string{"slots"},
+		Slot:    &SlotUpdate{Slot: 101, Parent: pointer.Get[uint64](100), Status: SlotStatusFinalized},
+	}, got[1])
+	assert.Equal(t, &Update{
+		Filters: []string{"txs"},
+		Transaction: &TransactionUpdate{
+			Slot: 102,
+			Transaction: TransactionInfo{
+				Signature: signature,
+				Index:     3,
+				Transaction: types.Transaction{
+					Signatures: []types.Signature{signature},
+					Message: types.Message{
+						Version:         types.MessageVersionLegacy,
+						Header:          types.MessageHeader{NumRequireSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+						Accounts:        []common.PublicKey{pubkey, owner},
+						RecentBlockHash: base58.Encode(blockhash.Bytes()),
+						Instructions: []types.CompiledInstruction{
+							{ProgramIDIndex: 1, Accounts: []int{0}, Data: []byte{2, 0, 0, 0}},
+						},
+					},
+				},
+				Meta: &TransactionMeta{
+					Fee:                  5000,
+					PreBalances:          []uint64{10000, 0},
+					PostBalances:         []uint64{5000, 0},
+					LogMessages:          []string{"Program 11111111111111111111111111111111 invoke [1]"},
+					ComputeUnitsConsumed: pointer.Get[uint64](150),
+				},
+			},
+		},
+	}, got[2])
+	assert.Equal(t, &Update{
+		Filters: []string{"meta"},
+		BlockMeta: &BlockMetaUpdate{
+			Slot:                     103,
+			Blockhash:                blockhash.ToBase58(),
+			ParentSlot:               102,
+			ParentBlockhash:          blockhash.ToBase58(),
+			BlockTime:                pointer.Get[int64](1700000000),
+			BlockHeight:              pointer.Get[uint64](90),
+			ExecutedTransactionCount: 1,
+			Rewards: []rpc.Reward{
+				{
+					Pubkey:       pubkey.ToBase58(),
+					Lamports:     10,
+					PostBalances: 1010,
+					RewardType:   pointer.Get(rpc.RewardTypeVoting),
+					Commission:   pointer.Get[uint8](5),
+				},
+			},
+		},
+	}, got[3])
+	assert.Equal(t, &Update{Pong: pointer.Get[int32](7)}, got[4])
+}
@@ -0,0 +1,37 @@
+package geyser
+
+import (
+	"fmt"
+)
+
+type marshaler interface {
+	marshal() ([]byte, error)
+}
+
+type unmarshaler interface {
+	unmarshal([]byte) error
+}
+
+// codec encodes the hand written messages of this package
+type codec struct{}
+
+func (codec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(marshaler)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return m.marshal()
+}
+
+func (codec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(unmarshaler)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	return m.unmarshal(data)
+}
+
+// Name is the content subtype, the messages are the protobuf messages of geyser.proto
+func (codec) Name() string {
+	return "proto"
+}
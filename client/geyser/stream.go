@@ -0,0 +1,82 @@
+package geyser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+var subscribeStreamDesc = &grpc.StreamDesc{
+	StreamName:    "Subscribe",
+	ServerStreams: true,
+	ClientStreams: true,
+}
+
+// Stream is a subscription, read the updates with Recv
+type Stream struct {
+	stream grpc.ClientStream
+	cancel context.CancelFunc
+
+	sendMu sync.Mutex
+}
+
+// Subscribe opens a stream with the filters of req. the stream is closed when ctx is done.
+func (c *Client) Subscribe(ctx context.Context, req SubscribeRequest) (*Stream, error) {
+	ctx, cancel := context.WithCancel(c.outgoingContext(ctx))
+	stream, err := c.conn.NewStream(ctx, subscribeStreamDesc, subscribeMethod, grpc.ForceCodec(codec{}))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to open stream, err: %v", err)
+	}
+	s := &Stream{
+		stream: stream,
+		cancel: cancel,
+	}
+	if err := s.Update(req); err != nil {
+		cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update replaces the filters of the stream
+func (s *Stream) Update(req SubscribeRequest) error {
+	return s.send(req)
+}
+
+// Ping asks the node to send an update with Pong set to id, it keeps the stream alive behind a load balancer
+func (s *Stream) Ping(id int32) error {
+	return s.send(pingRequest{id: id})
+}
+
+func (s *Stream) send(m marshaler) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	if err := s.stream.SendMsg(m); err != nil {
+		return fmt.Errorf("failed to send request, err: %v", err)
+	}
+	return nil
+}
+
+// Recv blocks until the next update. the pings of the node and the kinds of updates
+// which this package doesn't support are skipped.
+// it must not be called concurrently.
+func (s *Stream) Recv() (*Update, error) {
+	for {
+		var u Update
+		if err := s.stream.RecvMsg(&u); err != nil {
+			return nil, err
+		}
+		if !u.isEmpty() {
+			return &u, nil
+		}
+	}
+}
+
+// Close closes the stream, a blocked Recv returns an error
+func (s *Stream) Close() error {
+	s.cancel()
+	return nil
+}
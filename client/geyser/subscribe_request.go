@@ -0,0 +1,182 @@
+package geyser
+
+import (
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// SubscribeRequest is the subscription of a stream. the keys of the filter maps are names chosen by the caller,
+// an update carries the names of the filters it matches.
+type SubscribeRequest struct {
+	Accounts     map[string]AccountsFilter
+	Slots        map[string]SlotsFilter
+	Transactions map[string]TransactionsFilter
+	Blocks       map[string]BlocksFilter
+	BlocksMeta   map[string]BlocksMetaFilter
+	// Commitment defaults to processed
+	Commitment rpc.Commitment
+	// AccountsDataSlice only sends the slices of the account data
+	AccountsDataSlice []DataSlice
+	// FromSlot replays the updates from a slot, if the node still has them
+	FromSlot *uint64
+}
+
+// AccountsFilter matches an account if it is in Account or owned by Owner, and it matches all Filters
+type AccountsFilter struct {
+	Account []common.PublicKey
+	Owner   []common.PublicKey
+	Filters []AccountsFilterCondition
+	// NonemptyTxnSignature only matches the updates which are caused by a transaction
+	NonemptyTxnSignature *bool
+}
+
+// AccountsFilterCondition sets one of its fields
+type AccountsFilterCondition struct {
+	Memcmp   *MemcmpFilter
+	DataSize *uint64
+	// TokenAccountState matches valid token accounts
+	TokenAccountState bool
+}
+
+type MemcmpFilter struct {
+	Offset uint64
+	Bytes  []byte
+}
+
+type SlotsFilter struct {
+	// FilterByCommitment only sends the slots which reach the commitment of the request
+	FilterByCommitment *bool
+}
+
+// TransactionsFilter matches a transaction if it matches all the set fields
+type TransactionsFilter struct {
+	Vote      *bool
+	Failed    *bool
+	Signature *string
+	// AccountInclude matches a transaction which uses any of the accounts
+	AccountInclude []common.PublicKey
+	// AccountExclude drops a transaction which uses any of the accounts
+	AccountExclude []common.PublicKey
+	// AccountRequired matches a transaction which uses all of the accounts
+	AccountRequired []common.PublicKey
+}
+
+type BlocksFilter struct {
+	// AccountInclude only keeps the transactions and the accounts which use any of the accounts
+	AccountInclude      []common.PublicKey
+	IncludeTransactions *bool
+	IncludeAccounts     *bool
+	IncludeEntries      *bool
+}
+
+type BlocksMetaFilter struct{}
+
+type DataSlice struct {
+	Offset uint64
+	Length uint64
+}
+
+func NewMemcmpFilter(offset uint64, b []byte) AccountsFilterCondition {
+	return AccountsFilterCondition{Memcmp: &MemcmpFilter{Offset: offset, Bytes: b}}
+}
+
+func NewDataSizeFilter(size uint64) AccountsFilterCondition {
+	return AccountsFilterCondition{DataSize: &size}
+}
+
+func commitmentLevel(commitment rpc.Commitment) (uint64, error) {
+	switch commitment {
+	case "", rpc.CommitmentProcessed:
+		return 0, nil
+	case rpc.CommitmentConfirmed:
+		return 1, nil
+	case rpc.CommitmentFinalized:
+		return 2, nil
+	}
+	return 0, fmt.Errorf("unsupported commitment: %v", commitment)
+}
+
+func base58s(keys []common.PublicKey) []string {
+	output := make([]string, 0, len(keys))
+	for _, key := range keys {
+		output = append(output, key.ToBase58())
+	}
+	return output
+}
+
+func (r SubscribeRequest) marshal() ([]byte, error) {
+	var e encoder
+	mapEntries(&e, 1, r.Accounts, func(e *encoder, v AccountsFilter) {
+		e.strings(2, base58s(v.Account))
+		e.strings(3, base58s(v.Owner))
+		for _, condition := range v.Filters {
+			condition := condition
+			e.message(4, func(e *encoder) {
+				switch {
+				case condition.Memcmp != nil:
+					e.message(1, func(e *encoder) {
+						e.uint64(1, condition.Memcmp.Offset)
+						e.bytes(2, condition.Memcmp.Bytes)
+					})
+				case condition.DataSize != nil:
+					e.optionalUint64(2, condition.DataSize)
+				case condition.TokenAccountState:
+					e.bool(3, true)
+				}
+			})
+		}
+		e.optionalBool(5, v.NonemptyTxnSignature)
+	})
+	mapEntries(&e, 2, r.Slots, func(e *encoder, v SlotsFilter) {
+		e.optionalBool(1, v.FilterByCommitment)
+	})
+	mapEntries(&e, 3, r.Transactions, func(e *encoder, v TransactionsFilter) {
+		e.optionalBool(1, v.Vote)
+		e.optionalBool(2, v.Failed)
+		e.strings(3, base58s(v.AccountInclude))
+		e.strings(4, base58s(v.AccountExclude))
+		if v.Signature != nil {
+			e.string(5, *v.Signature)
+		}
+		e.strings(6, base58s(v.AccountRequired))
+	})
+	mapEntries(&e, 4, r.Blocks, func(e *encoder, v BlocksFilter) {
+		e.strings(1, base58s(v.AccountInclude))
+		e.optionalBool(2, v.IncludeTransactions)
+		e.optionalBool(3, v.IncludeAccounts)
+		e.optionalBool(4, v.IncludeEntries)
+	})
+	mapEntries(&e, 5, r.BlocksMeta, func(e *encoder, v BlocksMetaFilter) {})
+
+	commitment, err := commitmentLevel(r.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	if r.Commitment != "" {
+		e.optionalUint64(6, &commitment)
+	}
+	for _, slice := range r.AccountsDataSlice {
+		slice := slice
+		e.message(7, func(e *encoder) {
+			e.uint64(1, slice.Offset)
+			e.uint64(2, slice.Length)
+		})
+	}
+	e.optionalUint64(11, r.FromSlot)
+	return e.b, nil
+}
+
+// pingRequest asks the node to reply a pong, some load balancers close idle streams
+type pingRequest struct {
+	id int32
+}
+
+func (r pingRequest) marshal() ([]byte, error) {
+	var e encoder
+	e.message(9, func(e *encoder) {
+		e.uint64(1, uint64(r.id))
+	})
+	return e.b, nil
+}
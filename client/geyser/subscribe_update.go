@@ -0,0 +1,607 @@
+package geyser
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+)
+
+// Update is an update of a stream, one of the typed fields is set
+type Update struct {
+	// Filters are the names of the filters which the update matches
+	Filters   []string
+	CreatedAt time.Time
+
+	Account     *AccountUpdate
+	Slot        *SlotUpdate
+	Transaction *TransactionUpdate
+	Block       *BlockUpdate
+	BlockMeta   *BlockMetaUpdate
+	// Pong is the id of a Stream.Ping
+	Pong *int32
+}
+
+type AccountUpdate struct {
+	Slot    uint64
+	Account AccountInfo
+	// IsStartup is true for the accounts sent when the node starts
+	IsStartup bool
+}
+
+type AccountInfo struct {
+	PublicKey    common.PublicKey
+	Lamports     uint64
+	Owner        common.PublicKey
+	Executable   bool
+	RentEpoch    uint64
+	Data         []byte
+	WriteVersion uint64
+	// TxnSignature is the transaction which changed the account, it is nil if the update is not caused by a transaction
+	TxnSignature types.Signature
+}
+
+type SlotStatus uint8
+
+const (
+	SlotStatusProcessed SlotStatus = iota
+	SlotStatusConfirmed
+	SlotStatusFinalized
+	SlotStatusFirstShredReceived
+	SlotStatusCompleted
+	SlotStatusCreatedBank
+	SlotStatusDead
+)
+
+type SlotUpdate struct {
+	Slot      uint64
+	Parent    *uint64
+	Status    SlotStatus
+	DeadError *string
+}
+
+type TransactionUpdate struct {
+	Slot        uint64
+	Transaction TransactionInfo
+}
+
+type TransactionInfo struct {
+	Signature types.Signature
+	IsVote    bool
+	// Index is the index of the transaction in the block
+	Index       uint64
+	Transaction types.Transaction
+	Meta        *TransactionMeta
+}
+
+type TransactionMeta struct {
+	// Err is the bincode encoded TransactionError, it is nil if the transaction succeeded
+	Err                     []byte
+	Fee                     uint64
+	PreBalances             []uint64
+	PostBalances            []uint64
+	InnerInstructions       []InnerInstructions
+	LogMessages             []string
+	PreTokenBalances        []TokenBalance
+	PostTokenBalances       []TokenBalance
+	Rewards                 []rpc.Reward
+	LoadedWritableAddresses []common.PublicKey
+	LoadedReadonlyAddresses []common.PublicKey
+	ReturnData              *ReturnData
+	ComputeUnitsConsumed    *uint64
+}
+
+type InnerInstructions struct {
+	Index        uint64
+	Instructions []InnerInstruction
+}
+
+type InnerInstruction struct {
+	types.CompiledInstruction
+	StackHeight *uint64
+}
+
+type TokenBalance struct {
+	AccountIndex uint64
+	Mint         common.PublicKey
+	Owner        common.PublicKey
+	ProgramID    common.PublicKey
+	Amount       uint64
+	Decimals     uint8
+}
+
+type ReturnData struct {
+	ProgramID common.PublicKey
+	Data      []byte
+}
+
+type BlockUpdate struct {
+	BlockMetaUpdate
+	// Transactions and Accounts are only sent if the filter includes them
+	Transactions        []TransactionInfo
+	UpdatedAccountCount uint64
+	Accounts            []AccountInfo
+}
+
+type BlockMetaUpdate struct {
+	Slot                     uint64
+	Blockhash                string
+	ParentSlot               uint64
+	ParentBlockhash          string
+	BlockTime                *int64
+	BlockHeight              *uint64
+	ExecutedTransactionCount uint64
+	EntriesCount             uint64
+	Rewards                  []rpc.Reward
+}
+
+func (u *Update) unmarshal(b []byte) error {
+	return decode(b, func(fd field) (err error) {
+		switch fd.num {
+		case 1:
+			u.Filters = append(u.Filters, string(fd.bytes))
+		case 2:
+			u.Account = new(AccountUpdate)
+			err = u.Account.unmarshal(fd.bytes)
+		case 3:
+			u.Slot = new(SlotUpdate)
+			err = u.Slot.unmarshal(fd.bytes)
+		case 4:
+			u.Transaction = new(TransactionUpdate)
+			err = u.Transaction.unmarshal(fd.bytes)
+		case 5:
+			u.Block = new(BlockUpdate)
+			err = u.Block.unmarshal(fd.bytes)
+		case 7:
+			u.BlockMeta = new(BlockMetaUpdate)
+			err = u.BlockMeta.unmarshal(fd.bytes)
+		case 9:
+			var id int32
+			err = decode(fd.bytes, func(fd field) error {
+				if fd.num == 1 {
+					id = int32(fd.varint)
+				}
+				return nil
+			})
+			u.Pong = &id
+		case 11:
+			var seconds, nanos uint64
+			err = decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 1:
+					seconds = fd.varint
+				case 2:
+					nanos = fd.varint
+				}
+				return nil
+			})
+			u.CreatedAt = time.Unix(int64(seconds), int64(int32(nanos)))
+		}
+		return err
+	})
+}
+
+// isEmpty reports whether the update is a kind which is not supported, e.g. a ping of the node
+func (u *Update) isEmpty() bool {
+	return u.Account == nil && u.Slot == nil && u.Transaction == nil && u.Block == nil && u.BlockMeta == nil && u.Pong == nil
+}
+
+func (u *AccountUpdate) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			return u.Account.unmarshal(fd.bytes)
+		case 2:
+			u.Slot = fd.varint
+		case 3:
+			u.IsStartup = fd.varint != 0
+		}
+		return nil
+	})
+}
+
+func (a *AccountInfo) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			a.PublicKey = common.PublicKeyFromBytes(fd.bytes)
+		case 2:
+			a.Lamports = fd.varint
+		case 3:
+			a.Owner = common.PublicKeyFromBytes(fd.bytes)
+		case 4:
+			a.Executable = fd.varint != 0
+		case 5:
+			a.RentEpoch = fd.varint
+		case 6:
+			a.Data = fd.bytesCopy()
+		case 7:
+			a.WriteVersion = fd.varint
+		case 8:
+			a.TxnSignature = fd.bytesCopy()
+		}
+		return nil
+	})
+}
+
+func (u *SlotUpdate) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			u.Slot = fd.varint
+		case 2:
+			parent := fd.varint
+			u.Parent = &parent
+		case 3:
+			u.Status = SlotStatus(fd.varint)
+		case 4:
+			deadError := string(fd.bytes)
+			u.DeadError = &deadError
+		}
+		return nil
+	})
+}
+
+func (u *TransactionUpdate) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			return u.Transaction.unmarshal(fd.bytes)
+		case 2:
+			u.Slot = fd.varint
+		}
+		return nil
+	})
+}
+
+func (t *TransactionInfo) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			t.Signature = fd.bytesCopy()
+		case 2:
+			t.IsVote = fd.varint != 0
+		case 3:
+			return unmarshalTransaction(&t.Transaction, fd.bytes)
+		case 4:
+			t.Meta = new(TransactionMeta)
+			return t.Meta.unmarshal(fd.bytes)
+		case 5:
+			t.Index = fd.varint
+		}
+		return nil
+	})
+}
+
+func unmarshalTransaction(tx *types.Transaction, b []byte) error {
+	tx.Message.Version = types.MessageVersionLegacy
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			tx.Signatures = append(tx.Signatures, fd.bytesCopy())
+		case 2:
+			return unmarshalMessage(&tx.Message, fd.bytes)
+		}
+		return nil
+	})
+}
+
+func unmarshalMessage(m *types.Message, b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			return decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 1:
+					m.Header.NumRequireSignatures = uint8(fd.varint)
+				case 2:
+					m.Header.NumReadonlySignedAccounts = uint8(fd.varint)
+				case 3:
+					m.Header.NumReadonlyUnsignedAccounts = uint8(fd.varint)
+				}
+				return nil
+			})
+		case 2:
+			m.Accounts = append(m.Accounts, common.PublicKeyFromBytes(fd.bytes))
+		case 3:
+			m.RecentBlockHash = base58.Encode(fd.bytes)
+		case 4:
+			var instruction types.CompiledInstruction
+			if err := unmarshalCompiledInstruction(&instruction, nil, fd.bytes); err != nil {
+				return err
+			}
+			m.Instructions = append(m.Instructions, instruction)
+		case 5:
+			if fd.varint != 0 {
+				m.Version = types.MessageVersionV0
+			}
+		case 6:
+			var table types.CompiledAddressLookupTable
+			err := decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 1:
+					table.AccountKey = common.PublicKeyFromBytes(fd.bytes)
+				case 2:
+					table.WritableIndexes = fd.bytesCopy()
+				case 3:
+					table.ReadonlyIndexes = fd.bytesCopy()
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.AddressLookupTables = append(m.AddressLookupTables, table)
+		}
+		return nil
+	})
+}
+
+// unmarshalCompiledInstruction decodes a CompiledInstruction or an InnerInstruction, the stack height is only set for the latter
+func unmarshalCompiledInstruction(instruction *types.CompiledInstruction, stackHeight **uint64, b []byte) error {
+	instruction.Accounts = []int{}
+	instruction.Data = []byte{}
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			instruction.ProgramIDIndex = int(fd.varint)
+		case 2:
+			for _, index := range fd.bytes {
+				instruction.Accounts = append(instruction.Accounts, int(index))
+			}
+		case 3:
+			instruction.Data = fd.bytesCopy()
+		case 4:
+			if stackHeight != nil {
+				v := fd.varint
+				*stackHeight = &v
+			}
+		}
+		return nil
+	})
+}
+
+func (m *TransactionMeta) unmarshal(b []byte) error {
+	return decode(b, func(fd field) (err error) {
+		switch fd.num {
+		case 1:
+			err = decode(fd.bytes, func(fd field) error {
+				if fd.num == 1 {
+					m.Err = fd.bytesCopy()
+				}
+				return nil
+			})
+		case 2:
+			m.Fee = fd.varint
+		case 3:
+			var balances []uint64
+			balances, err = fd.uint64s()
+			m.PreBalances = append(m.PreBalances, balances...)
+		case 4:
+			var balances []uint64
+			balances, err = fd.uint64s()
+			m.PostBalances = append(m.PostBalances, balances...)
+		case 5:
+			var inner InnerInstructions
+			err = decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 1:
+					inner.Index = fd.varint
+				case 2:
+					var instruction InnerInstruction
+					if err := unmarshalCompiledInstruction(&instruction.CompiledInstruction, &instruction.StackHeight, fd.bytes); err != nil {
+						return err
+					}
+					inner.Instructions = append(inner.Instructions, instruction)
+				}
+				return nil
+			})
+			m.InnerInstructions = append(m.InnerInstructions, inner)
+		case 6:
+			m.LogMessages = append(m.LogMessages, string(fd.bytes))
+		case 7, 8:
+			var balance TokenBalance
+			if err = balance.unmarshal(fd.bytes); err != nil {
+				return err
+			}
+			if fd.num == 7 {
+				m.PreTokenBalances = append(m.PreTokenBalances, balance)
+			} else {
+				m.PostTokenBalances = append(m.PostTokenBalances, balance)
+			}
+		case 9:
+			var reward rpc.Reward
+			err = unmarshalReward(&reward, fd.bytes)
+			m.Rewards = append(m.Rewards, reward)
+		case 12:
+			m.LoadedWritableAddresses = append(m.LoadedWritableAddresses, common.PublicKeyFromBytes(fd.bytes))
+		case 13:
+			m.LoadedReadonlyAddresses = append(m.LoadedReadonlyAddresses, common.PublicKeyFromBytes(fd.bytes))
+		case 14:
+			m.ReturnData = new(ReturnData)
+			err = decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 1:
+					m.ReturnData.ProgramID = common.PublicKeyFromBytes(fd.bytes)
+				case 2:
+					m.ReturnData.Data = fd.bytesCopy()
+				}
+				return nil
+			})
+		case 16:
+			v := fd.varint
+			m.ComputeUnitsConsumed = &v
+		}
+		return err
+	})
+}
+
+func (t *TokenBalance) unmarshal(b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			t.AccountIndex = fd.varint
+		case 2:
+			t.Mint = common.PublicKeyFromString(string(fd.bytes))
+		case 3:
+			return decode(fd.bytes, func(fd field) error {
+				switch fd.num {
+				case 2:
+					t.Decimals = uint8(fd.varint)
+				case 3:
+					amount, err := strconv.ParseUint(string(fd.bytes), 10, 64)
+					if err != nil {
+						return fmt.Errorf("failed to parse amount, err: %v", err)
+					}
+					t.Amount = amount
+				}
+				return nil
+			})
+		case 4:
+			t.Owner = common.PublicKeyFromString(string(fd.bytes))
+		case 5:
+			t.ProgramID = common.PublicKeyFromString(string(fd.bytes))
+		}
+		return nil
+	})
+}
+
+var rewardTypes = map[uint64]rpc.RewardType{
+	1: rpc.RewardTypeFee,
+	2: rpc.RewardTypeRent,
+	3: rpc.RewardTypeStaking,
+	4: rpc.RewardTypeVoting,
+}
+
+func unmarshalReward(r *rpc.Reward, b []byte) error {
+	return decode(b, func(fd field) error {
+		switch fd.num {
+		case 1:
+			r.Pubkey = string(fd.bytes)
+		case 2:
+			r.Lamports = int64(fd.varint)
+		case 3:
+			r.PostBalances = fd.varint
+		case 4:
+			if rewardType, ok := rewardTypes[fd.varint]; ok {
+				r.RewardType = &rewardType
+			}
+		case 5:
+			// the commission is a string, it is empty if the reward has no commission
+			if len(fd.bytes) > 0 {
+				commission, err := strconv.ParseUint(string(fd.bytes), 10, 8)
+				if err != nil {
+					return fmt.Errorf("failed to parse commission, err: %v", err)
+				}
+				v := uint8(commission)
+				r.Commission = &v
+			}
+		}
+		return nil
+	})
+}
+
+// unmarshalRewards decodes the Rewards message of a block
+func unmarshalRewards(b []byte) ([]rpc.Reward, error) {
+	rewards := []rpc.Reward{}
+	err := decode(b, func(fd field) error {
+		if fd.num != 1 {
+			return nil
+		}
+		var reward rpc.Reward
+		if err := unmarshalReward(&reward, fd.bytes); err != nil {
+			return err
+		}
+		rewards = append(rewards, reward)
+		return nil
+	})
+	return rewards, err
+}
+
+// unmarshalWrapped decodes a message which wraps a single varint, e.g. UnixTimestamp and BlockHeight
+func unmarshalWrapped(b []byte) (uint64, error) {
+	var v uint64
+	err := decode(b, func(fd field) error {
+		if fd.num == 1 {
+			v = fd.varint
+		}
+		return nil
+	})
+	return v, err
+}
+
+func (u *BlockUpdate) unmarshal(b []byte) error {
+	return decode(b, func(fd field) (err error) {
+		switch fd.num {
+		case 1:
+			u.Slot = fd.varint
+		case 2:
+			u.Blockhash = string(fd.bytes)
+		case 3:
+			u.Rewards, err = unmarshalRewards(fd.bytes)
+		case 4:
+			var v uint64
+			v, err = unmarshalWrapped(fd.bytes)
+			blockTime := int64(v)
+			u.BlockTime = &blockTime
+		case 5:
+			var v uint64
+			v, err = unmarshalWrapped(fd.bytes)
+			u.BlockHeight = &v
+		case 6:
+			var tx TransactionInfo
+			err = tx.unmarshal(fd.bytes)
+			u.Transactions = append(u.Transactions, tx)
+		case 7:
+			u.ParentSlot = fd.varint
+		case 8:
+			u.ParentBlockhash = string(fd.bytes)
+		case 9:
+			u.ExecutedTransactionCount = fd.varint
+		case 10:
+			u.UpdatedAccountCount = fd.varint
+		case 11:
+			var account AccountInfo
+			err = account.unmarshal(fd.bytes)
+			u.Accounts = append(u.Accounts, account)
+		case 12:
+			u.EntriesCount = fd.varint
+		}
+		return err
+	})
+}
+
+func (u *BlockMetaUpdate) unmarshal(b []byte) error {
+	return decode(b, func(fd field) (err error) {
+		switch fd.num {
+		case 1:
+			u.Slot = fd.varint
+		case 2:
+			u.Blockhash = string(fd.bytes)
+		case 3:
+			u.Rewards, err = unmarshalRewards(fd.bytes)
+		case 4:
+			var v uint64
+			v, err = unmarshalWrapped(fd.bytes)
+			blockTime := int64(v)
+			u.BlockTime = &blockTime
+		case 5:
+			var v uint64
+			v, err = unmarshalWrapped(fd.bytes)
+			u.BlockHeight = &v
+		case 6:
+			u.ParentSlot = fd.varint
+		case 7:
+			u.ParentBlockhash = string(fd.bytes)
+		case 8:
+			u.ExecutedTransactionCount = fd.varint
+		case 9:
+			u.EntriesCount = fd.varint
+		}
+		return err
+	})
+}
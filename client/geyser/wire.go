@@ -0,0 +1,148 @@
+package geyser
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// the messages are encoded by hand with protowire so the package doesn't need generated code.
+// the field numbers follow geyser.proto and solana-storage.proto of yellowstone-grpc.
+
+type encoder struct {
+	b []byte
+}
+
+func (e *encoder) uint64(num protowire.Number, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.b = protowire.AppendTag(e.b, num, protowire.VarintType)
+	e.b = protowire.AppendVarint(e.b, v)
+}
+
+func (e *encoder) optionalUint64(num protowire.Number, v *uint64) {
+	if v == nil {
+		return
+	}
+	e.b = protowire.AppendTag(e.b, num, protowire.VarintType)
+	e.b = protowire.AppendVarint(e.b, *v)
+}
+
+func (e *encoder) optionalBool(num protowire.Number, v *bool) {
+	if v == nil {
+		return
+	}
+	e.b = protowire.AppendTag(e.b, num, protowire.VarintType)
+	e.b = protowire.AppendVarint(e.b, protowire.EncodeBool(*v))
+}
+
+func (e *encoder) bool(num protowire.Number, v bool) {
+	if !v {
+		return
+	}
+	e.optionalBool(num, &v)
+}
+
+func (e *encoder) string(num protowire.Number, v string) {
+	e.b = protowire.AppendTag(e.b, num, protowire.BytesType)
+	e.b = protowire.AppendString(e.b, v)
+}
+
+func (e *encoder) strings(num protowire.Number, vs []string) {
+	for _, v := range vs {
+		e.string(num, v)
+	}
+}
+
+func (e *encoder) bytes(num protowire.Number, v []byte) {
+	e.b = protowire.AppendTag(e.b, num, protowire.BytesType)
+	e.b = protowire.AppendBytes(e.b, v)
+}
+
+// message appends an embedded message, it is appended even if it is empty
+func (e *encoder) message(num protowire.Number, f func(e *encoder)) {
+	var m encoder
+	f(&m)
+	e.bytes(num, m.b)
+}
+
+// mapEntries appends the entries of a map<string, message> in the order of the keys
+func mapEntries[T any](e *encoder, num protowire.Number, m map[string]T, f func(e *encoder, v T)) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := m[k]
+		e.message(num, func(e *encoder) {
+			e.string(1, k)
+			e.message(2, func(e *encoder) { f(e, v) })
+		})
+	}
+}
+
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+// decode calls f with every field of a message, fixed size fields are skipped
+func decode(b []byte, f func(field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("failed to decode tag, err: %v", protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		fd := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			fd.varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			fd.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return fmt.Errorf("failed to decode field %v, err: %v", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := f(fd); err != nil {
+			return fmt.Errorf("field %v: %v", num, err)
+		}
+	}
+	return nil
+}
+
+// uint64s returns a repeated varint field which is either packed or not
+func (fd field) uint64s() ([]uint64, error) {
+	if fd.typ == protowire.VarintType {
+		return []uint64{fd.varint}, nil
+	}
+	output := []uint64{}
+	b := fd.bytes
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, fmt.Errorf("failed to decode packed varint, err: %v", protowire.ParseError(n))
+		}
+		output = append(output, v)
+		b = b[n:]
+	}
+	return output, nil
+}
+
+// bytesCopy copies a bytes field, the buffer of a grpc message can be reused after it is decoded
+func (fd field) bytesCopy() []byte {
+	return append([]byte{}, fd.bytes...)
+}
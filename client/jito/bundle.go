@@ -0,0 +1,157 @@
+package jito
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// MaxBundleSize is the max number of transactions of a bundle
+const MaxBundleSize = 5
+
+const (
+	defaultBundlePollInterval = time.Second
+)
+
+var (
+	ErrEmptyBundle    = errors.New("bundle is empty")
+	ErrBundleTooLarge = fmt.Errorf("bundle has more than %v transactions", MaxBundleSize)
+)
+
+// the statuses of an in-flight bundle
+type InflightBundleStatusType string
+
+const (
+	// InflightBundleStatusInvalid is returned if the bundle is not found in the last 5 minutes
+	InflightBundleStatusInvalid InflightBundleStatusType = "Invalid"
+	InflightBundleStatusPending InflightBundleStatusType = "Pending"
+	// InflightBundleStatusFailed is returned if all the regions failed to land the bundle
+	InflightBundleStatusFailed InflightBundleStatusType = "Failed"
+	InflightBundleStatusLanded InflightBundleStatusType = "Landed"
+)
+
+// Bundle is an ordered list of signed transactions, which land in the same slot or not at all
+type Bundle []types.Transaction
+
+// Serialize validates the size of the bundle and serializes every transaction
+func (b Bundle) Serialize() ([][]byte, error) {
+	if len(b) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	if len(b) > MaxBundleSize {
+		return nil, ErrBundleTooLarge
+	}
+	output := make([][]byte, 0, len(b))
+	for i, tx := range b {
+		raw, err := tx.Serialize()
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize transaction %v, err: %v", i, err)
+		}
+		output = append(output, raw)
+	}
+	return output, nil
+}
+
+// SendBundle sends a bundle and returns the bundle id
+func (c *Client) SendBundle(ctx context.Context, bundle Bundle) (string, error) {
+	rawTxs, err := bundle.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return c.SendRawBundle(ctx, rawTxs)
+}
+
+// SendRawBundle sends a bundle of serialized transactions and returns the bundle id
+func (c *Client) SendRawBundle(ctx context.Context, rawTxs [][]byte) (string, error) {
+	if len(rawTxs) == 0 {
+		return "", ErrEmptyBundle
+	}
+	if len(rawTxs) > MaxBundleSize {
+		return "", ErrBundleTooLarge
+	}
+	txs := make([]string, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		txs = append(txs, base64.StdEncoding.EncodeToString(rawTx))
+	}
+	return call[string](c, ctx, "sendBundle", txs, map[string]any{"encoding": "base64"})
+}
+
+// BundleStatus is the status of a landed bundle
+type BundleStatus struct {
+	BundleID           string         `json:"bundle_id"`
+	Transactions       []string       `json:"transactions"`
+	Slot               uint64         `json:"slot"`
+	ConfirmationStatus rpc.Commitment `json:"confirmation_status"`
+	// Err is {"Ok": null} if the bundle succeeded
+	Err map[string]any `json:"err"`
+}
+
+// GetBundleStatuses returns the statuses of landed bundles, a bundle which is not landed is nil
+func (c *Client) GetBundleStatuses(ctx context.Context, bundleIDs []string) ([]*BundleStatus, error) {
+	res, err := call[rpc.ValueWithContext[[]*BundleStatus]](c, ctx, "getBundleStatuses", bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// InflightBundleStatus is the status of a bundle sent in the last 5 minutes
+type InflightBundleStatus struct {
+	BundleID string                   `json:"bundle_id"`
+	Status   InflightBundleStatusType `json:"status"`
+	// LandedSlot is set if the bundle is landed
+	LandedSlot *uint64 `json:"landed_slot"`
+}
+
+// GetInflightBundleStatuses returns the statuses of the bundles sent in the last 5 minutes
+func (c *Client) GetInflightBundleStatuses(ctx context.Context, bundleIDs []string) ([]InflightBundleStatus, error) {
+	res, err := call[rpc.ValueWithContext[[]InflightBundleStatus]](c, ctx, "getInflightBundleStatuses", bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// BundleError is returned by WaitForBundle if the bundle failed or is not found
+type BundleError struct {
+	BundleID string
+	Status   InflightBundleStatusType
+}
+
+func (e *BundleError) Error() string {
+	return fmt.Sprintf("bundle %v is %v", e.BundleID, e.Status)
+}
+
+// WaitForBundle polls the in-flight status of a bundle every interval until it is landed.
+// a *BundleError returns if it failed or is invalid. the default interval is 1s.
+func (c *Client) WaitForBundle(ctx context.Context, bundleID string, interval time.Duration) (InflightBundleStatus, error) {
+	if interval <= 0 {
+		interval = defaultBundlePollInterval
+	}
+	for {
+		statuses, err := c.GetInflightBundleStatuses(ctx, []string{bundleID})
+		if err != nil {
+			return InflightBundleStatus{}, err
+		}
+		if len(statuses) > 0 {
+			status := statuses[0]
+			switch status.Status {
+			case InflightBundleStatusLanded:
+				return status, nil
+			case InflightBundleStatusFailed, InflightBundleStatusInvalid:
+				return status, &BundleError{BundleID: bundleID, Status: status.Status}
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return InflightBundleStatus{}, ctx.Err()
+		}
+	}
+}
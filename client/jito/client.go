@@ -0,0 +1,53 @@
+// Package jito is a client of the Jito block engine, which sends bundles of transactions
+// that land atomically and in order, or not at all.
+package jito
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+const (
+	MainnetBlockEngineEndpoint   = "https://mainnet.block-engine.jito.wtf"
+	AmsterdamBlockEngineEndpoint = "https://amsterdam.mainnet.block-engine.jito.wtf"
+	FrankfurtBlockEngineEndpoint = "https://frankfurt.mainnet.block-engine.jito.wtf"
+	NewYorkBlockEngineEndpoint   = "https://ny.mainnet.block-engine.jito.wtf"
+	TokyoBlockEngineEndpoint     = "https://tokyo.mainnet.block-engine.jito.wtf"
+	TestnetBlockEngineEndpoint   = "https://dallas.testnet.block-engine.jito.wtf"
+
+	bundlesPath = "/api/v1/bundles"
+)
+
+// Client talks to the json rpc api of a block engine
+type Client struct {
+	RpcClient rpc.RpcClient
+}
+
+// New creates a client of a block engine, e.g. MainnetBlockEngineEndpoint. the options are passed to the rpc client,
+// e.g. rpc.WithHeader("x-jito-auth", uuid) if the engine gives you an uuid.
+func New(endpoint string, opts ...rpc.Option) *Client {
+	opts = append(opts[:len(opts):len(opts)], rpc.WithEndpoint(strings.TrimSuffix(endpoint, "/")+bundlesPath))
+	return &Client{
+		RpcClient: rpc.New(opts...),
+	}
+}
+
+func call[T any](c *Client, ctx context.Context, params ...any) (T, error) {
+	var output T
+	body, err := c.RpcClient.Call(ctx, params...)
+	if err != nil {
+		return output, err
+	}
+	var res rpc.JsonRpcResponse[T]
+	if err := json.Unmarshal(body, &res); err != nil {
+		return output, fmt.Errorf("failed to json decode body, err: %v", err)
+	}
+	if res.Error != nil {
+		return output, res.Error
+	}
+	return res.Result, nil
+}
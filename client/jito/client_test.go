@@ -0,0 +1,153 @@
+package jito
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTx = "AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs="
+
+func TestClient_SendBundle(t *testing.T) {
+	b, _ := base64.StdEncoding.DecodeString(testTx)
+	tx, _ := types.TransactionDeserialize(b)
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendBundle", "params":[["` + testTx + `","` + testTx + `"], {"encoding":"base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"2id3YC2jK9G5Wo2phDx4gJVAew8DcY5NAojnVuao8rkxwPYPe8cSwE5GzhEgJA2y8fVjDEo6iR6ykBvDxrTQrtpb","id":1}`,
+				F: func(url string) (any, error) {
+					c := New(url)
+					return c.SendBundle(context.Background(), Bundle{tx, tx})
+				},
+				ExpectedValue: "2id3YC2jK9G5Wo2phDx4gJVAew8DcY5NAojnVuao8rkxwPYPe8cSwE5GzhEgJA2y8fVjDEo6iR6ykBvDxrTQrtpb",
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendBundle", "params":[["` + testTx + `"], {"encoding":"base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","error":{"code":-32602,"message":"bundle contains an already processed transaction"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(url)
+					return c.SendBundle(context.Background(), Bundle{tx})
+				},
+				ExpectedValue: "",
+				ExpectedError: &rpc.JsonRpcError{Code: -32602, Message: "bundle contains an already processed transaction"},
+			},
+		},
+	)
+}
+
+func TestBundle_Serialize(t *testing.T) {
+	b, _ := base64.StdEncoding.DecodeString(testTx)
+	tx, _ := types.TransactionDeserialize(b)
+
+	_, err := Bundle{}.Serialize()
+	assert.Equal(t, ErrEmptyBundle, err)
+	_, err = Bundle{tx, tx, tx, tx, tx, tx}.Serialize()
+	assert.Equal(t, ErrBundleTooLarge, err)
+	got, err := Bundle{tx}.Serialize()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{b}, got)
+}
+
+func TestClient_GetBundleStatuses(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBundleStatuses", "params":[["a","b"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":242806119},"value":[{"bundle_id":"a","transactions":["3bC2M9fiACSjkTXZDgeNAuQ4ScTsdKGwR42ytFdhUvikqTmBheUxfsR1fDVsM5ADCMMspuwGkdm1uKbU246x5aE3"],"slot":242804011,"confirmation_status":"finalized","err":{"Ok":null}},null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(url)
+					return c.GetBundleStatuses(context.Background(), []string{"a", "b"})
+				},
+				ExpectedValue: []*BundleStatus{
+					{
+						BundleID:           "a",
+						Transactions:       []string{"3bC2M9fiACSjkTXZDgeNAuQ4ScTsdKGwR42ytFdhUvikqTmBheUxfsR1fDVsM5ADCMMspuwGkdm1uKbU246x5aE3"},
+						Slot:               242804011,
+						ConfirmationStatus: rpc.CommitmentFinalized,
+						Err:                map[string]any{"Ok": nil},
+					},
+					nil,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetInflightBundleStatuses(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getInflightBundleStatuses", "params":[["a","b"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":280999028},"value":[{"bundle_id":"a","status":"Landed","landed_slot":280999020},{"bundle_id":"b","status":"Pending","landed_slot":null}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(url)
+					return c.GetInflightBundleStatuses(context.Background(), []string{"a", "b"})
+				},
+				ExpectedValue: []InflightBundleStatus{
+					{BundleID: "a", Status: InflightBundleStatusLanded, LandedSlot: pointer.Get[uint64](280999020)},
+					{BundleID: "b", Status: InflightBundleStatusPending},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_WaitForBundle(t *testing.T) {
+	tests := []struct {
+		name           string
+		responses      []string
+		expectedStatus InflightBundleStatus
+		expectedError  error
+	}{
+		{
+			name: "landed",
+			responses: []string{
+				`{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":[{"bundle_id":"a","status":"Pending","landed_slot":null}]},"id":1}`,
+				`{"jsonrpc":"2.0","result":{"context":{"slot":2},"value":[{"bundle_id":"a","status":"Landed","landed_slot":2}]},"id":1}`,
+			},
+			expectedStatus: InflightBundleStatus{BundleID: "a", Status: InflightBundleStatusLanded, LandedSlot: pointer.Get[uint64](2)},
+		},
+		{
+			name: "failed",
+			responses: []string{
+				`{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":[{"bundle_id":"a","status":"Failed","landed_slot":null}]},"id":1}`,
+			},
+			expectedStatus: InflightBundleStatus{BundleID: "a", Status: InflightBundleStatusFailed},
+			expectedError:  &BundleError{BundleID: "a", Status: InflightBundleStatusFailed},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, bundlesPath, req.URL.Path)
+				n := atomic.AddInt32(&calls, 1)
+				_, _ = rw.Write([]byte(tt.responses[n-1]))
+			}))
+			defer server.Close()
+
+			c := New(server.URL + "/")
+			status, err := c.WaitForBundle(context.Background(), "a", time.Millisecond)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, int32(len(tt.responses)), calls)
+		})
+	}
+}
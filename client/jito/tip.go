@@ -0,0 +1,79 @@
+package jito
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// MinTipLamports is the min tip the block engine accepts for a bundle
+const MinTipLamports uint64 = 1000
+
+// TipAccounts are the tip accounts of mainnet, GetTipAccounts returns the ones of the connected engine
+var TipAccounts = []common.PublicKey{
+	common.PublicKeyFromString("96gYZGLnJYVFmbjzopPSU6QiEV5fGqZNyN9nmNhvrZU5"),
+	common.PublicKeyFromString("HFqU5x63VTqvQss8hp11i4wVV8bD44PvwucfZ2bU7gRe"),
+	common.PublicKeyFromString("Cw8CFyM9FkoMi7K7Crf6HNQqf4uEMzpKw6QNghXLvLkY"),
+	common.PublicKeyFromString("ADaUMid9yfUytqMBgopwjb2DTLSokTSzL1zt6iGPaS49"),
+	common.PublicKeyFromString("DfXygSm4jCyNCybVYYK6DwvWqjKee8pbDmJGcLWNDXjh"),
+	common.PublicKeyFromString("ADuUkR4vqLUMWXxW9gh6D6L8pMSawimctcNZ5pGwDcEt"),
+	common.PublicKeyFromString("DttWaMuVvTiduZRnguLF7jNxTgiMBZ1hyAumKUiL2KRL"),
+	common.PublicKeyFromString("3AVi9Tg9Uo68tJfuvoKvqKNWKkC5wPdSSdeBnizKZ6jT"),
+}
+
+// GetTipAccounts returns the tip accounts of the block engine
+func (c *Client) GetTipAccounts(ctx context.Context) ([]common.PublicKey, error) {
+	accounts, err := call[[]string](c, ctx, "getTipAccounts")
+	if err != nil {
+		return nil, err
+	}
+	output := make([]common.PublicKey, 0, len(accounts))
+	for _, account := range accounts {
+		output = append(output, common.PublicKeyFromString(account))
+	}
+	return output, nil
+}
+
+// PickTipAccount returns a random account of accounts, it picks from TipAccounts if accounts is empty.
+// tipping a random account reduces the write lock contention of the tip accounts.
+func PickTipAccount(accounts []common.PublicKey) common.PublicKey {
+	if len(accounts) == 0 {
+		accounts = TipAccounts
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(accounts))))
+	if err != nil {
+		return accounts[0]
+	}
+	return accounts[n.Int64()]
+}
+
+type TipParam struct {
+	From common.PublicKey
+	// Lamports is the tip, a bundle needs at least MinTipLamports
+	Lamports uint64
+	// TipAccount defaults to a random one of TipAccounts
+	TipAccount *common.PublicKey
+}
+
+// TipInstruction returns a transfer to a tip account. put it in the last transaction of a bundle,
+// so the tip is only paid if every transaction before it succeeds.
+func TipInstruction(param TipParam) types.Instruction {
+	to := PickTipAccount(nil)
+	if param.TipAccount != nil {
+		to = *param.TipAccount
+	}
+	return system.Transfer(system.TransferParam{
+		From:   param.From,
+		To:     to,
+		Amount: param.Lamports,
+	})
+}
+
+// AppendTip appends a tip instruction to instructions
+func AppendTip(instructions []types.Instruction, param TipParam) []types.Instruction {
+	return append(instructions[:len(instructions):len(instructions)], TipInstruction(param))
+}
@@ -0,0 +1,52 @@
+package jito
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetTipAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTipAccounts"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":["96gYZGLnJYVFmbjzopPSU6QiEV5fGqZNyN9nmNhvrZU5","HFqU5x63VTqvQss8hp11i4wVV8bD44PvwucfZ2bU7gRe"],"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(url)
+					return c.GetTipAccounts(context.Background())
+				},
+				ExpectedValue: TipAccounts[:2],
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestPickTipAccount(t *testing.T) {
+	assert.Contains(t, TipAccounts, PickTipAccount(nil))
+
+	accounts := []common.PublicKey{common.PublicKeyFromString("DttWaMuVvTiduZRnguLF7jNxTgiMBZ1hyAumKUiL2KRL")}
+	assert.Equal(t, accounts[0], PickTipAccount(accounts))
+}
+
+func TestAppendTip(t *testing.T) {
+	from := common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	memo := types.Instruction{ProgramID: common.MemoProgramID, Data: []byte("hi")}
+
+	instructions := AppendTip([]types.Instruction{memo}, TipParam{From: from, Lamports: 10000, TipAccount: &TipAccounts[3]})
+	assert.Equal(t, []types.Instruction{
+		memo,
+		system.Transfer(system.TransferParam{From: from, To: TipAccounts[3], Amount: 10000}),
+	}, instructions)
+
+	tip := TipInstruction(TipParam{From: from, Lamports: MinTipLamports})
+	assert.Contains(t, TipAccounts, tip.Accounts[1].PubKey)
+	assert.Equal(t, from, tip.Accounts[0].PubKey)
+}
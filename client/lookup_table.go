@@ -0,0 +1,315 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/address_lookup_table"
+	"github.com/liangjies/solana-go-sdk/program/sysvar"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// MaxLookupTableExtendAddresses is the number of addresses appended by one transaction which is signed by the fee payer only,
+// more addresses don't fit in a transaction
+const MaxLookupTableExtendAddresses = 30
+
+// LookupTableDeactivationCooldown is the number of blocks a deactivated table stays usable,
+// it can be closed once its deactivation slot is no longer in the slot hashes sysvar
+const LookupTableDeactivationCooldown = 512
+
+const defaultLookupTablePollInterval = 400 * time.Millisecond
+
+var (
+	ErrLookupTableNotFound       = errors.New("lookup table not found")
+	ErrLookupTableNotDeactivated = errors.New("lookup table is not deactivated")
+)
+
+// GetAddressLookupTable fetches and decodes a lookup table account
+func (c *Client) GetAddressLookupTable(ctx context.Context, base58Addr string) (address_lookup_table.AddressLookupTable, error) {
+	accu, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return address_lookup_table.AddressLookupTable{}, err
+	}
+	if accu.Owner == (common.PublicKey{}) {
+		return address_lookup_table.AddressLookupTable{}, ErrLookupTableNotFound
+	}
+	return address_lookup_table.DeserializeLookupTable(accu.Data, accu.Owner)
+}
+
+type CreateLookupTableParam struct {
+	FeePayer types.Account
+	// Authority can extend, deactivate and close the table, default is the fee payer
+	Authority types.Account
+	// Addresses are appended right after the table is created
+	Addresses []common.PublicKey
+	// PollInterval is the interval between slot polls while waiting for the table to warm up, default is 400ms
+	PollInterval time.Duration
+}
+
+// CreateLookupTable creates a lookup table, extends it with the addresses and waits until they can be used.
+// the returned account can be passed to a message as it is.
+func (c *Client) CreateLookupTable(ctx context.Context, param CreateLookupTableParam) (types.AddressLookupTableAccount, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+
+	// the recent slot has to be in the slot hashes sysvar
+	recentSlot, err := c.GetSlotWithConfig(ctx, GetSlotConfig{Commitment: rpc.CommitmentFinalized})
+	if err != nil {
+		return types.AddressLookupTableAccount{}, fmt.Errorf("failed to get slot, err: %w", err)
+	}
+	lookupTable, bumpSeed := address_lookup_table.DeriveLookupTableAddress(authority.PublicKey, recentSlot)
+
+	_, err = c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			address_lookup_table.CreateLookupTable(address_lookup_table.CreateLookupTableParams{
+				LookupTable: lookupTable,
+				Authority:   authority.PublicKey,
+				Payer:       param.FeePayer.PublicKey,
+				RecentSlot:  recentSlot,
+				BumpSeed:    bumpSeed,
+			}),
+		},
+		Signers:  lookupTableSigners(param.FeePayer, authority),
+		FeePayer: param.FeePayer.PublicKey,
+	})
+	if err != nil {
+		return types.AddressLookupTableAccount{}, fmt.Errorf("failed to create lookup table, err: %w", err)
+	}
+
+	if len(param.Addresses) == 0 {
+		return types.AddressLookupTableAccount{Key: lookupTable}, nil
+	}
+
+	_, err = c.ExtendLookupTable(ctx, ExtendLookupTableParam{
+		FeePayer:    param.FeePayer,
+		Authority:   authority,
+		LookupTable: lookupTable,
+		Addresses:   param.Addresses,
+	})
+	if err != nil {
+		return types.AddressLookupTableAccount{}, err
+	}
+
+	return c.WaitForLookupTable(ctx, lookupTable, param.PollInterval)
+}
+
+type ExtendLookupTableParam struct {
+	FeePayer types.Account
+	// Authority of the table, default is the fee payer
+	Authority   types.Account
+	LookupTable common.PublicKey
+	Addresses   []common.PublicKey
+}
+
+// ExtendLookupTable appends the addresses in chunks of up to MaxLookupTableExtendAddresses, one transaction per chunk.
+// every transaction is confirmed before the next one is sent, so the addresses keep their order in the table.
+// the signatures of the confirmed transactions return even if a later one fails.
+func (c *Client) ExtendLookupTable(ctx context.Context, param ExtendLookupTableParam) ([]string, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+
+	chunkSize := MaxLookupTableExtendAddresses
+	if authority.PublicKey != param.FeePayer.PublicKey {
+		// the signature and the key of the authority take the room of 3 addresses
+		chunkSize -= 3
+	}
+
+	signatures := make([]string, 0, (len(param.Addresses)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(param.Addresses); start += chunkSize {
+		end := start + chunkSize
+		if end > len(param.Addresses) {
+			end = len(param.Addresses)
+		}
+		sig, err := c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+			Instructions: []types.Instruction{
+				address_lookup_table.ExtendLookupTable(address_lookup_table.ExtendLookupTableParams{
+					LookupTable: param.LookupTable,
+					Authority:   authority.PublicKey,
+					Payer:       &param.FeePayer.PublicKey,
+					Addresses:   param.Addresses[start:end],
+				}),
+			},
+			Signers:  lookupTableSigners(param.FeePayer, authority),
+			FeePayer: param.FeePayer.PublicKey,
+		})
+		if err != nil {
+			return signatures, fmt.Errorf("failed to extend lookup table, index: %v, err: %w", start, err)
+		}
+		signatures = append(signatures, sig)
+	}
+	return signatures, nil
+}
+
+// WaitForLookupTable waits until the addresses appended last can be used, which is the slot after the table was extended.
+// pollInterval defaults to 400ms.
+func (c *Client) WaitForLookupTable(ctx context.Context, lookupTable common.PublicKey, pollInterval time.Duration) (types.AddressLookupTableAccount, error) {
+	table, err := c.GetAddressLookupTable(ctx, lookupTable.ToBase58())
+	if err != nil {
+		return types.AddressLookupTableAccount{}, fmt.Errorf("failed to get lookup table, err: %w", err)
+	}
+	if err := c.waitForSlot(ctx, table.LastExtendedSlot+1, pollInterval); err != nil {
+		return types.AddressLookupTableAccount{}, err
+	}
+	return types.AddressLookupTableAccount{
+		Key:       lookupTable,
+		Addresses: table.Addresses,
+	}, nil
+}
+
+type DeactivateLookupTableParam struct {
+	FeePayer types.Account
+	// Authority of the table, default is the fee payer
+	Authority   types.Account
+	LookupTable common.PublicKey
+}
+
+// DeactivateLookupTable deactivates a lookup table, it can be closed after LookupTableDeactivationCooldown blocks
+func (c *Client) DeactivateLookupTable(ctx context.Context, param DeactivateLookupTableParam) (string, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+
+	return c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			address_lookup_table.DeactivateLookupTable(address_lookup_table.DeactivateLookupTableParams{
+				LookupTable: param.LookupTable,
+				Authority:   authority.PublicKey,
+			}),
+		},
+		Signers:  lookupTableSigners(param.FeePayer, authority),
+		FeePayer: param.FeePayer.PublicKey,
+	})
+}
+
+type CloseLookupTableParam struct {
+	FeePayer types.Account
+	// Authority of the table, default is the fee payer
+	Authority   types.Account
+	LookupTable common.PublicKey
+	// Recipient receives the rent of the table, default is the fee payer
+	Recipient common.PublicKey
+	// PollInterval is the interval between slot hashes polls while waiting for the cooldown, default is 400ms
+	PollInterval time.Duration
+}
+
+// CloseLookupTable waits until the deactivation cooldown of the table passes, then closes it.
+// ErrLookupTableNotDeactivated returns if the table is still active, see DeactivateLookupTable.
+func (c *Client) CloseLookupTable(ctx context.Context, param CloseLookupTableParam) (string, error) {
+	authority := param.Authority
+	if authority.PublicKey == (common.PublicKey{}) {
+		authority = param.FeePayer
+	}
+	recipient := param.Recipient
+	if recipient == (common.PublicKey{}) {
+		recipient = param.FeePayer.PublicKey
+	}
+
+	table, err := c.GetAddressLookupTable(ctx, param.LookupTable.ToBase58())
+	if err != nil {
+		return "", fmt.Errorf("failed to get lookup table, err: %w", err)
+	}
+	if table.DeactivationSlot == math.MaxUint64 {
+		return "", ErrLookupTableNotDeactivated
+	}
+	if err := c.waitForDeactivation(ctx, table.DeactivationSlot, param.PollInterval); err != nil {
+		return "", err
+	}
+
+	return c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			address_lookup_table.CloseLookupTable(address_lookup_table.CloseLookupTableParams{
+				LookupTable: param.LookupTable,
+				Authority:   authority.PublicKey,
+				Recipient:   recipient,
+			}),
+		},
+		Signers:  lookupTableSigners(param.FeePayer, authority),
+		FeePayer: param.FeePayer.PublicKey,
+	})
+}
+
+// waitForSlot polls the confirmed slot until it reaches slot. it sleeps about as long as the remaining slots take, up to 10s.
+func (c *Client) waitForSlot(ctx context.Context, slot uint64, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = defaultLookupTablePollInterval
+	}
+	for {
+		current, err := c.GetSlotWithConfig(ctx, GetSlotConfig{Commitment: rpc.CommitmentConfirmed})
+		if err != nil {
+			return fmt.Errorf("failed to get slot, err: %w", err)
+		}
+		if current >= slot {
+			return nil
+		}
+
+		interval := time.Duration(slot-current) * pollInterval
+		if interval > 10*time.Second {
+			interval = 10 * time.Second
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// waitForDeactivation polls the slot hashes sysvar until the deactivation slot is no longer in it. the sysvar keeps
+// the last LookupTableDeactivationCooldown blocks, so it takes longer than that many slots if some are skipped.
+// it sleeps about as long as the remaining blocks take, up to 10s.
+func (c *Client) waitForDeactivation(ctx context.Context, deactivationSlot uint64, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = defaultLookupTablePollInterval
+	}
+	for {
+		accu, err := c.GetAccountInfoWithConfig(ctx, common.SysVarSlotHashesPubkey.ToBase58(), GetAccountInfoConfig{Commitment: rpc.CommitmentConfirmed})
+		if err != nil {
+			return fmt.Errorf("failed to get slot hashes, err: %w", err)
+		}
+		slotHashes, err := sysvar.DeserializeSlotHashes(accu.Data, accu.Owner)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize slot hashes, err: %v", err)
+		}
+
+		// the newest slot hash is the first one
+		remaining := uint64(LookupTableDeactivationCooldown)
+		deactivated := len(slotHashes) > 0 && slotHashes[0].Slot > deactivationSlot
+		for i, slotHash := range slotHashes {
+			if slotHash.Slot == deactivationSlot {
+				remaining = uint64(LookupTableDeactivationCooldown - i)
+				deactivated = false
+				break
+			}
+		}
+		if deactivated {
+			return nil
+		}
+
+		interval := time.Duration(remaining) * pollInterval
+		if interval > 10*time.Second {
+			interval = 10 * time.Second
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func lookupTableSigners(feePayer, authority types.Account) []types.Account {
+	if feePayer.PublicKey == authority.PublicKey {
+		return []types.Account{feePayer}
+	}
+	return []types.Account{feePayer, authority}
+}
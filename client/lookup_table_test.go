@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/address_lookup_table"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func lookupTableData(deactivationSlot, lastExtendedSlot uint64, authority common.PublicKey, addresses []common.PublicKey) string {
+	data := make([]byte, address_lookup_table.LOOKUP_TABLE_META_SIZE, int(address_lookup_table.LOOKUP_TABLE_META_SIZE)+32*len(addresses))
+	binary.LittleEndian.PutUint32(data[0:4], uint32(address_lookup_table.ProgramStateLookupTable))
+	binary.LittleEndian.PutUint64(data[4:12], deactivationSlot)
+	binary.LittleEndian.PutUint64(data[12:20], lastExtendedSlot)
+	data[21] = 1
+	copy(data[22:54], authority.Bytes())
+	for _, address := range addresses {
+		data = append(data, address.Bytes()...)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// slotHashesData returns the slot hashes sysvar at slot, skipped slots have no hash
+func slotHashesData(slot uint64, skipped func(slot uint64) bool) string {
+	data := make([]byte, 8, 8+40*LookupTableDeactivationCooldown)
+	n := uint64(0)
+	for s := slot; s > 0 && n < LookupTableDeactivationCooldown; s-- {
+		if skipped != nil && skipped(s) {
+			continue
+		}
+		data = binary.LittleEndian.AppendUint64(data, s)
+		data = append(data, make([]byte, 32)...)
+		n++
+	}
+	binary.LittleEndian.PutUint64(data[0:8], n)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// newFakeLookupTableServer confirms every transaction at once, the slot goes up by one on every getSlot
+func newFakeLookupTableServer(t *testing.T, slot uint64, account func() string, sent *[]types.Transaction) func(method string, params []json.RawMessage) string {
+	return newFakeLookupTableServerWithSlotHashes(t, slot, 1, nil, account, sent)
+}
+
+// newFakeLookupTableServerWithSlotHashes is newFakeLookupTableServer whose slot also goes up by slotsPerPoll on every slot hashes poll
+func newFakeLookupTableServerWithSlotHashes(t *testing.T, slot, slotsPerPoll uint64, skipped func(slot uint64) bool, account func() string, sent *[]types.Transaction) func(method string, params []json.RawMessage) string {
+	return func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getSlot":
+			slot++
+			return fmt.Sprintf("%v", slot)
+		case "getLatestBlockhash":
+			return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":100}}`
+		case "sendTransaction":
+			var raw string
+			assert.Nil(t, json.Unmarshal(params[0], &raw))
+			b, err := base64.StdEncoding.DecodeString(raw)
+			assert.Nil(t, err)
+			tx, err := types.TransactionDeserialize(b)
+			assert.Nil(t, err)
+			*sent = append(*sent, tx)
+			return fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+		case "getBlockHeight":
+			return "50"
+		case "getSignatureStatuses":
+			return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`
+		case "getAccountInfo":
+			var addr string
+			assert.Nil(t, json.Unmarshal(params[0], &addr))
+			if addr == common.SysVarSlotHashesPubkey.ToBase58() {
+				slot += slotsPerPoll
+				return fmt.Sprintf(`{"context":{"slot":1},"value":{"data":["%v","base64"],"executable":false,"lamports":143487360,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}}`, slotHashesData(slot, skipped))
+			}
+			return fmt.Sprintf(`{"context":{"slot":1},"value":{"data":["%v","base64"],"executable":false,"lamports":1447680,"owner":"AddressLookupTab1e1111111111111111111111111","rentEpoch":0}}`, account())
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	}
+}
+
+func TestClient_CreateLookupTable(t *testing.T) {
+	feePayer := types.NewAccount()
+	authority := types.NewAccount()
+	addresses := make([]common.PublicKey, 0, 45)
+	for i := 0; i < 45; i++ {
+		addresses = append(addresses, types.NewAccount().PublicKey)
+	}
+
+	tests := []struct {
+		name           string
+		authority      types.Account
+		expectedChunks []int
+	}{
+		{
+			name:           "fee payer is the authority",
+			expectedChunks: []int{30, 15},
+		},
+		{
+			name:           "separate authority",
+			authority:      authority,
+			expectedChunks: []int{27, 18},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tableAuthority := feePayer.PublicKey
+			if tt.authority.PublicKey != (common.PublicKey{}) {
+				tableAuthority = tt.authority.PublicKey
+			}
+			var sent []types.Transaction
+			server := newFakeRpcServer(t, newFakeLookupTableServer(t, 100, func() string {
+				return lookupTableData(math.MaxUint64, 105, tableAuthority, addresses)
+			}, &sent))
+			defer server.Close()
+
+			account, err := NewClient(server.URL).CreateLookupTable(context.Background(), CreateLookupTableParam{
+				FeePayer:     feePayer,
+				Authority:    tt.authority,
+				Addresses:    addresses,
+				PollInterval: time.Millisecond,
+			})
+			assert.Nil(t, err)
+
+			lookupTable, _ := address_lookup_table.DeriveLookupTableAddress(tableAuthority, 101)
+			assert.Equal(t, types.AddressLookupTableAccount{Key: lookupTable, Addresses: addresses}, account)
+
+			assert.Len(t, sent, 1+len(tt.expectedChunks))
+			for i, tx := range sent {
+				assert.Nil(t, tx.ValidateSize())
+				assert.Equal(t, feePayer.PublicKey, tx.Message.Accounts[0])
+				if i == 0 {
+					continue
+				}
+				// instruction + vec length + addresses
+				assert.Len(t, tx.Message.Instructions[0].Data, 4+8+32*tt.expectedChunks[i-1])
+			}
+		})
+	}
+}
+
+func TestClient_CloseLookupTable(t *testing.T) {
+	feePayer := types.NewAccount()
+	lookupTable := common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY")
+
+	tests := []struct {
+		name             string
+		deactivationSlot uint64
+		skipped          func(slot uint64) bool
+		expectedPolls    int
+		expectedSends    int
+		expectedErr      error
+	}{
+		{
+			name:             "active",
+			deactivationSlot: math.MaxUint64,
+			expectedPolls:    0,
+			expectedSends:    0,
+			expectedErr:      ErrLookupTableNotDeactivated,
+		},
+		{
+			name:             "deactivated",
+			deactivationSlot: 80,
+			expectedPolls:    1,
+			expectedSends:    1,
+			expectedErr:      nil,
+		},
+		{
+			// the slot hashes keep 512 blocks, which span 1024 slots
+			name:             "deactivated with skipped slots",
+			deactivationSlot: 80,
+			skipped:          func(slot uint64) bool { return slot%2 == 1 },
+			expectedPolls:    9,
+			expectedSends:    1,
+			expectedErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []types.Transaction
+			polls := 0
+			fake := newFakeLookupTableServerWithSlotHashes(t, 590, 64, tt.skipped, func() string {
+				return lookupTableData(tt.deactivationSlot, 50, feePayer.PublicKey, nil)
+			}, &sent)
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				if method == "getAccountInfo" && string(params[0]) == fmt.Sprintf(`"%v"`, common.SysVarSlotHashesPubkey.ToBase58()) {
+					polls++
+				}
+				return fake(method, params)
+			})
+			defer server.Close()
+
+			_, err := NewClient(server.URL).CloseLookupTable(context.Background(), CloseLookupTableParam{
+				FeePayer:     feePayer,
+				LookupTable:  lookupTable,
+				PollInterval: time.Microsecond,
+			})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedPolls, polls)
+			assert.Len(t, sent, tt.expectedSends)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+)
+
+var ErrMetadataNotFound = errors.New("metadata not found")
+
+// defaultMaxNFTJsonSize is the default cap of the off-chain metadata, the uri is set by anyone who mints
+const defaultMaxNFTJsonSize = 1 << 20
+
+type NFT struct {
+	Mint common.PublicKey
+	// MetadataAddress is the metadata account derived from the mint
+	MetadataAddress common.PublicKey
+	Metadata        token_metadata.Metadata
+	// Json is the off-chain metadata at Metadata.Data.Uri, it is nil unless GetNFTConfig.FetchJson is set
+	Json *NFTJson
+}
+
+// NFTJson is the off-chain metadata of the metaplex token standard
+type NFTJson struct {
+	Name                 string         `json:"name"`
+	Symbol               string         `json:"symbol"`
+	Description          string         `json:"description"`
+	SellerFeeBasisPoints uint16         `json:"seller_fee_basis_points"`
+	Image                string         `json:"image"`
+	AnimationUrl         string         `json:"animation_url"`
+	ExternalUrl          string         `json:"external_url"`
+	Attributes           []NFTAttribute `json:"attributes"`
+	Properties           NFTProperties  `json:"properties"`
+	// Raw is the whole document, which may have fields out of the standard
+	Raw json.RawMessage `json:"-"`
+}
+
+type NFTAttribute struct {
+	TraitType string `json:"trait_type"`
+	// Value is a string or a number
+	Value any `json:"value"`
+}
+
+type NFTProperties struct {
+	Category string    `json:"category"`
+	Files    []NFTFile `json:"files"`
+}
+
+type NFTFile struct {
+	Uri  string `json:"uri"`
+	Type string `json:"type"`
+}
+
+type GetNFTConfig struct {
+	// FetchJson fetches the off-chain metadata at the uri of the metadata
+	FetchJson bool
+	// HTTPClient is used to fetch the off-chain metadata, default is http.DefaultClient
+	HTTPClient *http.Client
+	// MaxJsonSize is the max size in bytes of the off-chain metadata, default is 1 MiB
+	MaxJsonSize int64
+}
+
+// GetNFT fetches the metaplex metadata of mint
+func (c *Client) GetNFT(ctx context.Context, mint common.PublicKey) (NFT, error) {
+	return c.GetNFTWithConfig(ctx, mint, GetNFTConfig{})
+}
+
+// GetNFTWithConfig fetches the metaplex metadata of mint, and the off-chain metadata if cfg.FetchJson is set.
+// ErrMetadataNotFound returns if the mint has no metadata account.
+func (c *Client) GetNFTWithConfig(ctx context.Context, mint common.PublicKey, cfg GetNFTConfig) (NFT, error) {
+	metadataAddress, err := token_metadata.GetTokenMetaPubkey(mint)
+	if err != nil {
+		return NFT{}, fmt.Errorf("failed to derive metadata address, err: %v", err)
+	}
+	accountInfo, err := c.GetAccountInfo(ctx, metadataAddress.ToBase58())
+	if err != nil {
+		return NFT{}, fmt.Errorf("failed to get metadata account, err: %w", err)
+	}
+	if accountInfo.Owner == (common.PublicKey{}) {
+		return NFT{}, ErrMetadataNotFound
+	}
+	if accountInfo.Owner != common.MetaplexTokenMetaProgramID {
+		return NFT{}, fmt.Errorf("metadata account owner mismatch, expected: %v, got: %v", common.MetaplexTokenMetaProgramID.ToBase58(), accountInfo.Owner.ToBase58())
+	}
+	metadata, err := token_metadata.MetadataDeserialize(accountInfo.Data)
+	if err != nil {
+		return NFT{}, err
+	}
+
+	nft := NFT{
+		Mint:            mint,
+		MetadataAddress: metadataAddress,
+		Metadata:        metadata,
+	}
+	if cfg.FetchJson && metadata.Data.Uri != "" {
+		httpClient := cfg.HTTPClient
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		maxJsonSize := cfg.MaxJsonSize
+		if maxJsonSize <= 0 {
+			maxJsonSize = defaultMaxNFTJsonSize
+		}
+		nftJson, err := fetchNFTJson(ctx, httpClient, metadata.Data.Uri, maxJsonSize)
+		if err != nil {
+			return NFT{}, fmt.Errorf("failed to fetch json, uri: %v, err: %v", metadata.Data.Uri, err)
+		}
+		nft.Json = &nftJson
+	}
+	return nft, nil
+}
+
+func fetchNFTJson(ctx context.Context, httpClient *http.Client, uri string, maxSize int64) (NFTJson, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return NFTJson{}, err
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return NFTJson{}, err
+	}
+	defer res.Body.Close()
+
+	// read one more byte to tell a body of exactly maxSize from a larger one
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return NFTJson{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return NFTJson{}, fmt.Errorf("unexpected status code: %v", res.StatusCode)
+	}
+	if int64(len(body)) > maxSize {
+		return NFTJson{}, fmt.Errorf("json is larger than %v bytes", maxSize)
+	}
+
+	var nftJson NFTJson
+	if err := json.Unmarshal(body, &nftJson); err != nil {
+		return NFTJson{}, err
+	}
+	nftJson.Raw = body
+	return nftJson, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetNFTWithConfig(t *testing.T) {
+	mint := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	nftJson := `{"name":"Degen Ape #1829","symbol":"DAPE","description":"a degen ape","seller_fee_basis_points":420,"image":"https://arweave.net/image.png","attributes":[{"trait_type":"Fur","value":"Gold"},{"trait_type":"Level","value":3}],"properties":{"category":"image","files":[{"uri":"https://arweave.net/image.png","type":"image/png"}]},"collection":{"name":"Degen Ape Academy"}}`
+
+	jsonServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/1829.json" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(nftJson))
+	}))
+	defer jsonServer.Close()
+
+	metadata := token_metadata.Metadata{
+		Key:             token_metadata.KeyMetadataV1,
+		UpdateAuthority: common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+		Mint:            mint,
+		Data: token_metadata.Data{
+			Name:                 "Degen Ape #1829",
+			Symbol:               "DAPE",
+			Uri:                  jsonServer.URL + "/1829.json",
+			SellerFeeBasisPoints: 420,
+		},
+		IsMutable: true,
+	}
+	data, err := borsh.Serialize(metadata)
+	assert.Nil(t, err)
+	metadataAddress, err := token_metadata.GetTokenMetaPubkey(mint)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		account     string
+		cfg         GetNFTConfig
+		want        NFT
+		expectedErr error
+	}{
+		{
+			name:    "metadata only",
+			account: fmt.Sprintf(`{"data":["%v","base64"],"executable":false,"lamports":5616720,"owner":"metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s","rentEpoch":0}`, base64.StdEncoding.EncodeToString(data)),
+			cfg:     GetNFTConfig{},
+			want: NFT{
+				Mint:            mint,
+				MetadataAddress: metadataAddress,
+				Metadata:        metadata,
+			},
+		},
+		{
+			name:    "with json",
+			account: fmt.Sprintf(`{"data":["%v","base64"],"executable":false,"lamports":5616720,"owner":"metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s","rentEpoch":0}`, base64.StdEncoding.EncodeToString(data)),
+			cfg:     GetNFTConfig{FetchJson: true, HTTPClient: jsonServer.Client()},
+			want: NFT{
+				Mint:            mint,
+				MetadataAddress: metadataAddress,
+				Metadata:        metadata,
+				Json: &NFTJson{
+					Name:                 "Degen Ape #1829",
+					Symbol:               "DAPE",
+					Description:          "a degen ape",
+					SellerFeeBasisPoints: 420,
+					Image:                "https://arweave.net/image.png",
+					Attributes: []NFTAttribute{
+						{TraitType: "Fur", Value: "Gold"},
+						{TraitType: "Level", Value: float64(3)},
+					},
+					Properties: NFTProperties{
+						Category: "image",
+						Files:    []NFTFile{{Uri: "https://arweave.net/image.png", Type: "image/png"}},
+					},
+					Raw: json.RawMessage(nftJson),
+				},
+			},
+		},
+		{
+			name:        "not found",
+			account:     `null`,
+			cfg:         GetNFTConfig{},
+			want:        NFT{},
+			expectedErr: ErrMetadataNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				assert.Equal(t, "getAccountInfo", method)
+				assert.JSONEq(t, fmt.Sprintf(`"%v"`, metadataAddress.ToBase58()), string(params[0]))
+				return fmt.Sprintf(`{"context":{"slot":1},"value":%v}`, tt.account)
+			})
+			defer server.Close()
+
+			got, err := NewClient(server.URL).GetNFTWithConfig(context.Background(), mint, tt.cfg)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("json too large", func(t *testing.T) {
+		server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+			return fmt.Sprintf(`{"context":{"slot":1},"value":{"data":["%v","base64"],"executable":false,"lamports":5616720,"owner":"metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s","rentEpoch":0}}`, base64.StdEncoding.EncodeToString(data))
+		})
+		defer server.Close()
+
+		_, err := NewClient(server.URL).GetNFTWithConfig(context.Background(), mint, GetNFTConfig{
+			FetchJson:   true,
+			HTTPClient:  jsonServer.Client(),
+			MaxJsonSize: int64(len(nftJson) - 1),
+		})
+		assert.EqualError(t, err, fmt.Sprintf("failed to fetch json, uri: %v, err: json is larger than %v bytes", metadata.Data.Uri, len(nftJson)-1))
+
+		// a json of exactly the max size is fine
+		got, err := NewClient(server.URL).GetNFTWithConfig(context.Background(), mint, GetNFTConfig{
+			FetchJson:   true,
+			HTTPClient:  jsonServer.Client(),
+			MaxJsonSize: int64(len(nftJson)),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, json.RawMessage(nftJson), got.Json.Raw)
+	})
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+var ErrNonceAccountUninitialized = errors.New("nonce account is uninitialized")
+
+type CreateNonceAccountParam struct {
+	FeePayer     types.Account
+	NonceAccount types.Account
+	// Authority can advance, withdraw and authorize the nonce account, default is the fee payer
+	Authority common.PublicKey
+}
+
+// CreateNonceAccount creates and initializes a rent exempt nonce account and waits until it is confirmed
+func (c *Client) CreateNonceAccount(ctx context.Context, param CreateNonceAccountParam) (string, error) {
+	authority := param.Authority
+	if authority == (common.PublicKey{}) {
+		authority = param.FeePayer.PublicKey
+	}
+
+	lamports, err := c.GetMinimumBalanceForRentExemption(ctx, system.NonceAccountSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to get minimum balance for rent exemption, err: %w", err)
+	}
+
+	return c.SendAndConfirmTransaction(ctx, SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			system.CreateAccount(system.CreateAccountParam{
+				From:     param.FeePayer.PublicKey,
+				New:      param.NonceAccount.PublicKey,
+				Owner:    common.SystemProgramID,
+				Lamports: lamports,
+				Space:    system.NonceAccountSize,
+			}),
+			system.InitializeNonceAccount(system.InitializeNonceAccountParam{
+				Nonce: param.NonceAccount.PublicKey,
+				Auth:  authority,
+			}),
+		},
+		Signers:  []types.Account{param.FeePayer, param.NonceAccount},
+		FeePayer: param.FeePayer.PublicKey,
+	})
+}
+
+type NewNonceTransactionParam struct {
+	NonceAccount common.PublicKey
+	// NonceAuthority must be one of the signers
+	NonceAuthority common.PublicKey
+	Instructions   []types.Instruction
+	Signers        []types.Account
+	// ExternalSigners are signers without a local private key, e.g. a ledger
+	ExternalSigners []types.Signer
+	FeePayer        common.PublicKey
+	// v0 transaction
+	AddressLookupTableAccounts []types.AddressLookupTableAccount
+}
+
+// NewNonceTransaction fetches the current nonce and builds a signed transaction which uses it as the recent blockhash.
+// an advance nonce instruction is put in front of the instructions, so the nonce is advanced once the transaction is processed.
+func (c *Client) NewNonceTransaction(ctx context.Context, param NewNonceTransactionParam) (types.Transaction, error) {
+	nonceAccount, err := c.GetNonceAccount(ctx, param.NonceAccount.ToBase58())
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("failed to get nonce account, err: %w", err)
+	}
+	if nonceAccount.State != system.NonceStateInitialized {
+		return types.Transaction{}, ErrNonceAccountUninitialized
+	}
+	if nonceAccount.AuthorizedPubkey != param.NonceAuthority {
+		return types.Transaction{}, fmt.Errorf("nonce authority mismatch, expected: %v, got: %v", nonceAccount.AuthorizedPubkey.ToBase58(), param.NonceAuthority.ToBase58())
+	}
+
+	instructions := make([]types.Instruction, 0, len(param.Instructions)+1)
+	instructions = append(instructions, system.AdvanceNonceAccount(system.AdvanceNonceAccountParam{
+		Nonce: param.NonceAccount,
+		Auth:  param.NonceAuthority,
+	}))
+	instructions = append(instructions, param.Instructions...)
+
+	return types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:                   param.FeePayer,
+			Instructions:               instructions,
+			RecentBlockhash:            nonceAccount.Nonce.ToBase58(),
+			AddressLookupTableAccounts: param.AddressLookupTableAccounts,
+		}),
+		Signers:         param.Signers,
+		ExternalSigners: param.ExternalSigners,
+	})
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/memo"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func nonceAccountData(state uint32, auth, nonce common.PublicKey) string {
+	data := make([]byte, system.NonceAccountSize)
+	binary.LittleEndian.PutUint32(data[0:4], system.NonceVersionCurrent)
+	binary.LittleEndian.PutUint32(data[4:8], state)
+	copy(data[8:40], auth.Bytes())
+	copy(data[40:72], nonce.Bytes())
+	binary.LittleEndian.PutUint64(data[72:80], 5000)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func TestClient_NewNonceTransaction(t *testing.T) {
+	feePayer := types.NewAccount()
+	authority := types.NewAccount()
+	nonceAccountPubkey := common.PublicKeyFromString("DJyNpXgggw1WGgjTVzFsNjb3fuQZVMqhoakvSBfX9LYx")
+	nonce := common.PublicKeyFromString("8wx8PoVMibdYTrfweG2wCFuYz7EhwkaZLm8hutyFgh8T")
+
+	tests := []struct {
+		name        string
+		state       uint32
+		auth        common.PublicKey
+		expectedErr error
+	}{
+		{
+			name:        "initialized",
+			state:       system.NonceStateInitialized,
+			auth:        authority.PublicKey,
+			expectedErr: nil,
+		},
+		{
+			name:        "uninitialized",
+			state:       system.NonceStateUninitialized,
+			auth:        authority.PublicKey,
+			expectedErr: ErrNonceAccountUninitialized,
+		},
+		{
+			name:        "authority mismatch",
+			state:       system.NonceStateInitialized,
+			auth:        feePayer.PublicKey,
+			expectedErr: fmt.Errorf("nonce authority mismatch, expected: %v, got: %v", feePayer.PublicKey.ToBase58(), authority.PublicKey.ToBase58()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				assert.Equal(t, "getAccountInfo", method)
+				assert.JSONEq(t, `"DJyNpXgggw1WGgjTVzFsNjb3fuQZVMqhoakvSBfX9LYx"`, string(params[0]))
+				return fmt.Sprintf(`{"context":{"slot":1},"value":{"data":["%v","base64"],"executable":false,"lamports":1447680,"owner":"11111111111111111111111111111111","rentEpoch":0}}`, nonceAccountData(tt.state, tt.auth, nonce))
+			})
+			defer server.Close()
+
+			tx, err := NewClient(server.URL).NewNonceTransaction(context.Background(), NewNonceTransactionParam{
+				NonceAccount:   nonceAccountPubkey,
+				NonceAuthority: authority.PublicKey,
+				Instructions: []types.Instruction{
+					memo.BuildMemo(memo.BuildMemoParam{Memo: []byte("use nonce")}),
+				},
+				Signers:  []types.Account{feePayer, authority},
+				FeePayer: feePayer.PublicKey,
+			})
+			assert.Equal(t, tt.expectedErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, nonce.ToBase58(), tx.Message.RecentBlockHash)
+			assert.Len(t, tx.Message.Instructions, 2)
+			assert.Equal(t, system.AdvanceNonceAccount(system.AdvanceNonceAccountParam{
+				Nonce: nonceAccountPubkey,
+				Auth:  authority.PublicKey,
+			}), tx.Message.DecompileInstructions()[0])
+			assert.Len(t, tx.Signatures, 2)
+		})
+	}
+}
+
+func TestClient_CreateNonceAccount(t *testing.T) {
+	feePayer := types.NewAccount()
+	nonceAccount := types.NewAccount()
+
+	var sent types.Transaction
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getMinimumBalanceForRentExemption":
+			assert.JSONEq(t, `80`, string(params[0]))
+			return "1447680"
+		case "getLatestBlockhash":
+			return `{"context":{"slot":1},"value":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":100}}`
+		case "sendTransaction":
+			var raw string
+			assert.Nil(t, json.Unmarshal(params[0], &raw))
+			b, err := base64.StdEncoding.DecodeString(raw)
+			assert.Nil(t, err)
+			sent, err = types.TransactionDeserialize(b)
+			assert.Nil(t, err)
+			return `"sig"`
+		case "getBlockHeight":
+			return "50"
+		case "getSignatureStatuses":
+			return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	})
+	defer server.Close()
+
+	sig, err := NewClient(server.URL).CreateNonceAccount(context.Background(), CreateNonceAccountParam{
+		FeePayer:     feePayer,
+		NonceAccount: nonceAccount,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "sig", sig)
+	// the signer flags are merged in a message, so only compare the data
+	instructions := sent.Message.DecompileInstructions()
+	assert.Len(t, instructions, 2)
+	assert.Equal(t, system.CreateAccount(system.CreateAccountParam{
+		From:     feePayer.PublicKey,
+		New:      nonceAccount.PublicKey,
+		Owner:    common.SystemProgramID,
+		Lamports: 1447680,
+		Space:    system.NonceAccountSize,
+	}).Data, instructions[0].Data)
+	assert.Equal(t, system.InitializeNonceAccount(system.InitializeNonceAccountParam{
+		Nonce: nonceAccount.PublicKey,
+		Auth:  feePayer.PublicKey,
+	}).Data, instructions[1].Data)
+}
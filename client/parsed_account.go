@@ -0,0 +1,244 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// the program names of jsonParsed account data
+const (
+	ParsedProgramNonce        = "nonce"
+	ParsedProgramSplToken     = "spl-token"
+	ParsedProgramSplToken2022 = "spl-token-2022"
+	ParsedProgramStake        = "stake"
+	ParsedProgramVote         = "vote"
+)
+
+type ParsedAccountInfo struct {
+	Lamports   uint64
+	Owner      common.PublicKey
+	Executable bool
+	RentEpoch  uint64
+	Data       ParsedAccountData
+}
+
+// ParsedAccountData is the data of an account fetched with jsonParsed encoding.
+// at most one of the typed fields is set, it depends on Program and Type.
+type ParsedAccountData struct {
+	// Program is empty if the node is not able to parse the account, the data is in Raw
+	Program string
+	Space   uint64
+	Type    string
+
+	Nonce        *ParsedNonceAccount
+	TokenAccount *ParsedTokenAccount
+	Mint         *ParsedMint
+	Stake        *ParsedStakeAccount
+	Vote         *ParsedVoteAccount
+
+	// Info is the raw info of the parsed data, it is only kept for the programs which are not typed
+	Info json.RawMessage
+	// Raw is the account data when the node falls back to base64
+	Raw []byte
+}
+
+type ParsedNonceAccount struct {
+	Authority     common.PublicKey    `json:"authority"`
+	Blockhash     string              `json:"blockhash"`
+	FeeCalculator ParsedFeeCalculator `json:"feeCalculator"`
+}
+
+type ParsedFeeCalculator struct {
+	LamportsPerSignature uint64 `json:"lamportsPerSignature,string"`
+}
+
+type ParsedTokenAccount struct {
+	Mint              common.PublicKey  `json:"mint"`
+	Owner             common.PublicKey  `json:"owner"`
+	TokenAmount       TokenAmount       `json:"tokenAmount"`
+	Delegate          *common.PublicKey `json:"delegate"`
+	DelegatedAmount   *TokenAmount      `json:"delegatedAmount"`
+	State             string            `json:"state"`
+	IsNative          bool              `json:"isNative"`
+	RentExemptReserve *TokenAmount      `json:"rentExemptReserve"`
+	CloseAuthority    *common.PublicKey `json:"closeAuthority"`
+	// Extensions are the token-2022 extensions
+	Extensions []json.RawMessage `json:"extensions"`
+}
+
+type ParsedMint struct {
+	MintAuthority   *common.PublicKey `json:"mintAuthority"`
+	Supply          uint64            `json:"supply,string"`
+	Decimals        uint8             `json:"decimals"`
+	IsInitialized   bool              `json:"isInitialized"`
+	FreezeAuthority *common.PublicKey `json:"freezeAuthority"`
+	// Extensions are the token-2022 extensions
+	Extensions []json.RawMessage `json:"extensions"`
+}
+
+type ParsedStakeAccount struct {
+	Meta  ParsedStakeMeta   `json:"meta"`
+	Stake *ParsedStakeStake `json:"stake"`
+}
+
+type ParsedStakeMeta struct {
+	RentExemptReserve uint64                `json:"rentExemptReserve,string"`
+	Authorized        ParsedStakeAuthorized `json:"authorized"`
+	Lockup            ParsedStakeLockup     `json:"lockup"`
+}
+
+type ParsedStakeAuthorized struct {
+	Staker     common.PublicKey `json:"staker"`
+	Withdrawer common.PublicKey `json:"withdrawer"`
+}
+
+type ParsedStakeLockup struct {
+	UnixTimestamp int64            `json:"unixTimestamp"`
+	Epoch         uint64           `json:"epoch"`
+	Custodian     common.PublicKey `json:"custodian"`
+}
+
+type ParsedStakeStake struct {
+	Delegation      ParsedStakeDelegation `json:"delegation"`
+	CreditsObserved uint64                `json:"creditsObserved"`
+}
+
+type ParsedStakeDelegation struct {
+	Voter              common.PublicKey `json:"voter"`
+	Stake              uint64           `json:"stake,string"`
+	ActivationEpoch    uint64           `json:"activationEpoch,string"`
+	DeactivationEpoch  uint64           `json:"deactivationEpoch,string"`
+	WarmupCooldownRate float64          `json:"warmupCooldownRate"`
+}
+
+type ParsedVoteAccount struct {
+	NodePubkey           common.PublicKey            `json:"nodePubkey"`
+	AuthorizedWithdrawer common.PublicKey            `json:"authorizedWithdrawer"`
+	Commission           uint8                       `json:"commission"`
+	Votes                []ParsedVoteLockout         `json:"votes"`
+	RootSlot             *uint64                     `json:"rootSlot"`
+	AuthorizedVoters     []ParsedVoteAuthorizedVoter `json:"authorizedVoters"`
+	PriorVoters          []ParsedVotePriorVoter      `json:"priorVoters"`
+	EpochCredits         []ParsedVoteEpochCredits    `json:"epochCredits"`
+	LastTimestamp        ParsedVoteTimestamp         `json:"lastTimestamp"`
+}
+
+type ParsedVoteLockout struct {
+	Slot              uint64 `json:"slot"`
+	ConfirmationCount uint32 `json:"confirmationCount"`
+}
+
+type ParsedVoteAuthorizedVoter struct {
+	Epoch           uint64           `json:"epoch"`
+	AuthorizedVoter common.PublicKey `json:"authorizedVoter"`
+}
+
+type ParsedVotePriorVoter struct {
+	AuthorizedPubkey            common.PublicKey `json:"authorizedPubkey"`
+	EpochOfLastAuthorizedSwitch uint64           `json:"epochOfLastAuthorizedSwitch"`
+	TargetEpoch                 uint64           `json:"targetEpoch"`
+}
+
+type ParsedVoteEpochCredits struct {
+	Epoch           uint64 `json:"epoch"`
+	Credits         uint64 `json:"credits,string"`
+	PreviousCredits uint64 `json:"previousCredits,string"`
+}
+
+type ParsedVoteTimestamp struct {
+	Slot      uint64 `json:"slot"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func convertParsedAccountInfo(v rpc.AccountInfo) (ParsedAccountInfo, error) {
+	if v == (rpc.AccountInfo{}) {
+		return ParsedAccountInfo{}, nil
+	}
+	data, err := convertParsedAccountData(v.Data)
+	if err != nil {
+		return ParsedAccountInfo{}, err
+	}
+	return ParsedAccountInfo{
+		Lamports:   v.Lamports,
+		Owner:      common.PublicKeyFromString(v.Owner),
+		Executable: v.Executable,
+		RentEpoch:  v.RentEpoch,
+		Data:       data,
+	}, nil
+}
+
+func convertParsedAccountData(v any) (ParsedAccountData, error) {
+	// the node returns the base64 data if the account is not parsable
+	if data, ok := v.([]any); ok {
+		raw, err := decodeAccountData(data)
+		if err != nil {
+			return ParsedAccountData{}, err
+		}
+		return ParsedAccountData{Raw: raw}, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ParsedAccountData{}, fmt.Errorf("failed to marshal parsed data, err: %v", err)
+	}
+	var parsed struct {
+		Program string `json:"program"`
+		Space   uint64 `json:"space"`
+		Parsed  struct {
+			Type string          `json:"type"`
+			Info json.RawMessage `json:"info"`
+		} `json:"parsed"`
+	}
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		return ParsedAccountData{}, fmt.Errorf("failed to unmarshal parsed data, err: %v", err)
+	}
+
+	output := ParsedAccountData{
+		Program: parsed.Program,
+		Space:   parsed.Space,
+		Type:    parsed.Parsed.Type,
+		Info:    parsed.Parsed.Info,
+	}
+	// uninitialized accounts have no info
+	if len(output.Info) == 0 || string(output.Info) == "null" {
+		return output, nil
+	}
+
+	var target any
+	switch output.Program {
+	case ParsedProgramNonce:
+		if output.Type == "initialized" {
+			output.Nonce = new(ParsedNonceAccount)
+			target = output.Nonce
+		}
+	case ParsedProgramSplToken, ParsedProgramSplToken2022:
+		switch output.Type {
+		case "account":
+			output.TokenAccount = new(ParsedTokenAccount)
+			target = output.TokenAccount
+		case "mint":
+			output.Mint = new(ParsedMint)
+			target = output.Mint
+		}
+	case ParsedProgramStake:
+		if output.Type == "initialized" || output.Type == "delegated" {
+			output.Stake = new(ParsedStakeAccount)
+			target = output.Stake
+		}
+	case ParsedProgramVote:
+		if output.Type == "vote" {
+			output.Vote = new(ParsedVoteAccount)
+			target = output.Vote
+		}
+	}
+	if target != nil {
+		if err := json.Unmarshal(output.Info, target); err != nil {
+			return ParsedAccountData{}, fmt.Errorf("failed to unmarshal %v %v info, err: %v", output.Program, output.Type, err)
+		}
+		output.Info = nil
+	}
+	return output, nil
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+)
+
+// ParsedTransaction is a transaction fetched with jsonParsed encoding
+type ParsedTransaction struct {
+	Slot            uint64
+	BlockTime       *int64
+	Version         types.MessageVersion
+	Signatures      []string
+	AccountKeys     []ParsedAccountKey
+	RecentBlockhash string
+	Instructions    []ParsedInstruction
+	Meta            *ParsedTransactionMeta
+}
+
+type ParsedAccountKey struct {
+	PublicKey common.PublicKey `json:"pubkey"`
+	Signer    bool             `json:"signer"`
+	Writable  bool             `json:"writable"`
+	// Source is "transaction" or "lookupTable"
+	Source string `json:"source"`
+}
+
+type ParsedTransactionMeta struct {
+	Err                  any
+	Fee                  uint64
+	PreBalances          []int64
+	PostBalances         []int64
+	PreTokenBalances     []rpc.TransactionMetaTokenBalance
+	PostTokenBalances    []rpc.TransactionMetaTokenBalance
+	LogMessages          []string
+	InnerInstructions    []ParsedInnerInstruction
+	ComputeUnitsConsumed *uint64
+	// TypedPreTokenBalances and TypedPostTokenBalances are PreTokenBalances and PostTokenBalances with
+	// public keys and a parsed TokenAmount
+	TypedPreTokenBalances  []TransactionTokenBalance
+	TypedPostTokenBalances []TransactionTokenBalance
+}
+
+type ParsedInnerInstruction struct {
+	Index        uint64
+	Instructions []ParsedInstruction
+}
+
+// ParsedInstruction is an instruction of a jsonParsed transaction.
+// Type and Info are set if the node parses the instruction, otherwise Accounts and Data are set.
+type ParsedInstruction struct {
+	Program   string
+	ProgramID common.PublicKey
+	Type      string
+	// Info is the parsed info, some programs e.g. spl-memo are parsed into a json string
+	Info        json.RawMessage
+	Accounts    []common.PublicKey
+	Data        []byte
+	StackHeight *uint64
+}
+
+// IsParsed reports whether the node parses the instruction
+func (i ParsedInstruction) IsParsed() bool {
+	return len(i.Info) > 0
+}
+
+// DecodeInfo unmarshals the parsed info into v, e.g. a *ParsedSystemTransfer
+func (i ParsedInstruction) DecodeInfo(v any) error {
+	if !i.IsParsed() {
+		return fmt.Errorf("instruction is not parsed")
+	}
+	return json.Unmarshal(i.Info, v)
+}
+
+// the parsed infos of the common instructions, decode them with ParsedInstruction.DecodeInfo
+
+type ParsedSystemTransfer struct {
+	Source      common.PublicKey `json:"source"`
+	Destination common.PublicKey `json:"destination"`
+	Lamports    uint64           `json:"lamports"`
+}
+
+type ParsedSystemCreateAccount struct {
+	Source     common.PublicKey `json:"source"`
+	NewAccount common.PublicKey `json:"newAccount"`
+	Lamports   uint64           `json:"lamports"`
+	Space      uint64           `json:"space"`
+	Owner      common.PublicKey `json:"owner"`
+}
+
+type ParsedSystemAdvanceNonce struct {
+	NonceAccount            common.PublicKey `json:"nonceAccount"`
+	RecentBlockhashesSysvar common.PublicKey `json:"recentBlockhashesSysvar"`
+	NonceAuthority          common.PublicKey `json:"nonceAuthority"`
+}
+
+type ParsedTokenTransfer struct {
+	Source      common.PublicKey `json:"source"`
+	Destination common.PublicKey `json:"destination"`
+	Amount      uint64           `json:"amount,string"`
+	// Authority is empty if the source is owned by a multisig
+	Authority         *common.PublicKey  `json:"authority"`
+	MultisigAuthority *common.PublicKey  `json:"multisigAuthority"`
+	Signers           []common.PublicKey `json:"signers"`
+}
+
+type ParsedTokenTransferChecked struct {
+	Source            common.PublicKey   `json:"source"`
+	Mint              common.PublicKey   `json:"mint"`
+	Destination       common.PublicKey   `json:"destination"`
+	TokenAmount       TokenAmount        `json:"tokenAmount"`
+	Authority         *common.PublicKey  `json:"authority"`
+	MultisigAuthority *common.PublicKey  `json:"multisigAuthority"`
+	Signers           []common.PublicKey `json:"signers"`
+}
+
+type ParsedStakeDelegate struct {
+	StakeAccount       common.PublicKey `json:"stakeAccount"`
+	VoteAccount        common.PublicKey `json:"voteAccount"`
+	ClockSysvar        common.PublicKey `json:"clockSysvar"`
+	StakeHistorySysvar common.PublicKey `json:"stakeHistorySysvar"`
+	StakeConfigAccount common.PublicKey `json:"stakeConfigAccount"`
+	StakeAuthority     common.PublicKey `json:"stakeAuthority"`
+}
+
+type rpcParsedTransaction struct {
+	Signatures []string `json:"signatures"`
+	Message    struct {
+		AccountKeys     []ParsedAccountKey `json:"accountKeys"`
+		RecentBlockhash string             `json:"recentBlockhash"`
+		Instructions    []json.RawMessage  `json:"instructions"`
+	} `json:"message"`
+}
+
+type rpcParsedInstruction struct {
+	Program     string          `json:"program"`
+	ProgramID   string          `json:"programId"`
+	Parsed      json.RawMessage `json:"parsed"`
+	Accounts    []string        `json:"accounts"`
+	Data        string          `json:"data"`
+	StackHeight *uint64         `json:"stackHeight"`
+}
+
+func convertParsedTransaction(v *rpc.GetTransaction) (*ParsedTransaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction, err: %v", err)
+	}
+	var tx rpcParsedTransaction
+	if err := json.Unmarshal(b, &tx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parsed transaction, err: %v", err)
+	}
+	instructions, err := convertParsedInstructions(tx.Message.Instructions)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := convertParsedTransactionMeta(v.Meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert transaction meta, err: %v", err)
+	}
+
+	version := types.MessageVersion(types.MessageVersionLegacy)
+	if n, ok := v.Version.(float64); ok {
+		version = types.MessageVersion(fmt.Sprintf("v%v", n))
+	}
+
+	return &ParsedTransaction{
+		Slot:            v.Slot,
+		BlockTime:       v.BlockTime,
+		Version:         version,
+		Signatures:      tx.Signatures,
+		AccountKeys:     tx.Message.AccountKeys,
+		RecentBlockhash: tx.Message.RecentBlockhash,
+		Instructions:    instructions,
+		Meta:            meta,
+	}, nil
+}
+
+func convertParsedTransactionMeta(meta *rpc.TransactionMeta) (*ParsedTransactionMeta, error) {
+	if meta == nil {
+		return nil, nil
+	}
+
+	innerInstructions, err := convertParsedInnerInstructions(meta.InnerInstructions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParsedTransactionMeta{
+		Err:                  meta.Err,
+		Fee:                  meta.Fee,
+		PreBalances:          meta.PreBalances,
+		PostBalances:         meta.PostBalances,
+		PreTokenBalances:     meta.PreTokenBalances,
+		PostTokenBalances:    meta.PostTokenBalances,
+		LogMessages:          meta.LogMessages,
+		InnerInstructions:    innerInstructions,
+		ComputeUnitsConsumed: meta.ComputeUnitsConsumed,
+
+		TypedPreTokenBalances:  convertTokenBalances(meta.PreTokenBalances),
+		TypedPostTokenBalances: convertTokenBalances(meta.PostTokenBalances),
+	}, nil
+}
+
+func convertParsedInnerInstructions(metaInnerInstructions []rpc.TransactionMetaInnerInstruction) ([]ParsedInnerInstruction, error) {
+	innerInstructions := make([]ParsedInnerInstruction, 0, len(metaInnerInstructions))
+	for _, innerInstruction := range metaInnerInstructions {
+		raws := make([]json.RawMessage, 0, len(innerInstruction.Instructions))
+		for _, instruction := range innerInstruction.Instructions {
+			b, err := json.Marshal(instruction)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal inner instruction, err: %v", err)
+			}
+			raws = append(raws, b)
+		}
+		instructions, err := convertParsedInstructions(raws)
+		if err != nil {
+			return nil, err
+		}
+		innerInstructions = append(innerInstructions, ParsedInnerInstruction{
+			Index:        innerInstruction.Index,
+			Instructions: instructions,
+		})
+	}
+	return innerInstructions, nil
+}
+
+func convertParsedInstructions(raws []json.RawMessage) ([]ParsedInstruction, error) {
+	output := make([]ParsedInstruction, 0, len(raws))
+	for _, raw := range raws {
+		var v rpcParsedInstruction
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal instruction, err: %v", err)
+		}
+		instruction := ParsedInstruction{
+			Program:     v.Program,
+			ProgramID:   common.PublicKeyFromString(v.ProgramID),
+			StackHeight: v.StackHeight,
+		}
+
+		if len(v.Parsed) > 0 {
+			var parsed struct {
+				Type string          `json:"type"`
+				Info json.RawMessage `json:"info"`
+			}
+			// the parsed value is either {"type", "info"} or a plain value e.g. a memo
+			if err := json.Unmarshal(v.Parsed, &parsed); err == nil && parsed.Type != "" {
+				instruction.Type = parsed.Type
+				instruction.Info = parsed.Info
+			} else {
+				instruction.Info = v.Parsed
+			}
+		} else {
+			accounts := make([]common.PublicKey, 0, len(v.Accounts))
+			for _, account := range v.Accounts {
+				accounts = append(accounts, common.PublicKeyFromString(account))
+			}
+			data := []byte{}
+			if len(v.Data) > 0 {
+				var err error
+				data, err = base58.Decode(v.Data)
+				if err != nil {
+					return nil, fmt.Errorf("failed to base58 decode data, data: %v, err: %v", v.Data, err)
+				}
+			}
+			instruction.Accounts = accounts
+			instruction.Data = data
+		}
+
+		output = append(output, instruction)
+	}
+	return output, nil
+}
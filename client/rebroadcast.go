@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const defaultRebroadcastInterval = 2 * time.Second
+
+type RebroadcasterConfig struct {
+	// Commitment is used to wait for and to fetch the block height and the new blockhash,
+	// default is the client default commitment or confirmed
+	Commitment rpc.Commitment
+	// Interval is the interval between two broadcasts of the same transaction, default is 2s
+	Interval time.Duration
+	// PollInterval is the interval between two status polls, default is 500ms
+	PollInterval time.Duration
+	// Rebuild is called with a new blockhash once the blockhash of the transaction expires, it returns the
+	// transaction signed again with it. ErrTransactionExpired returns on the expiry if it is not set.
+	Rebuild func(ctx context.Context, blockhash string) (types.Transaction, error)
+	// MaxRebuilds is the number of times Rebuild is called before ErrTransactionExpired returns, 0 means no limit
+	MaxRebuilds int
+	// SendTransactionConfig is used for the first send of a transaction, the rebroadcasts skip the preflight
+	SendTransactionConfig SendTransactionConfig
+}
+
+// Rebroadcaster keeps sending a signed transaction until it is confirmed, so a transaction dropped
+// by a leader still lands before its blockhash expires. the node is asked not to retry by itself.
+//
+//	r := c.NewRebroadcaster(client.RebroadcasterConfig{})
+//	sig, err := r.Broadcast(ctx, tx, lastValidBlockHeight)
+type Rebroadcaster struct {
+	client *Client
+	cfg    RebroadcasterConfig
+}
+
+// NewRebroadcaster returns a rebroadcaster, it can be shared by many transactions
+func (c *Client) NewRebroadcaster(cfg RebroadcasterConfig) *Rebroadcaster {
+	cfg.Commitment = c.commitmentOrDefault(cfg.Commitment, rpc.CommitmentConfirmed)
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultRebroadcastInterval
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultConfirmationPollInterval
+	}
+	return &Rebroadcaster{
+		client: c,
+		cfg:    cfg,
+	}
+}
+
+// Broadcast sends tx every cfg.Interval until it lands and returns the signature once it reaches cfg.Commitment.
+// lastValidBlockHeight is the one returned with the blockhash of tx. once the block height passes it and tx hasn't
+// landed, tx is rebuilt by cfg.Rebuild if it is set, otherwise ErrTransactionExpired returns.
+// a *TransactionError returns if the transaction failed, the errors of the first send of a transaction, e.g. a
+// *PreflightError, return as they are and the errors of the rebroadcasts are ignored.
+func (r *Rebroadcaster) Broadcast(ctx context.Context, tx types.Transaction, lastValidBlockHeight uint64) (string, error) {
+	rawTx, sig, err := r.send(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	sentAt := time.Now()
+
+	for rebuilds := 0; ; {
+		// fetch the block height before the status, so a status fetched after expiry is never missed
+		blockHeight, err := r.client.getBlockHeight(ctx, r.cfg.Commitment)
+		if err != nil {
+			return "", err
+		}
+		status, err := r.client.GetSignatureStatus(ctx, sig)
+		if err != nil {
+			return "", err
+		}
+		if status != nil {
+			if status.Err != nil {
+				return sig, &TransactionError{Signature: sig, Err: status.Err}
+			}
+			if isCommitmentReached(*status, r.cfg.Commitment) {
+				return sig, nil
+			}
+		}
+
+		// a transaction with a status has landed, it is never expired or rebuilt, otherwise it could land twice
+		if status == nil && blockHeight > lastValidBlockHeight {
+			if r.cfg.Rebuild == nil || (r.cfg.MaxRebuilds > 0 && rebuilds >= r.cfg.MaxRebuilds) {
+				return sig, ErrTransactionExpired
+			}
+			rebuilds++
+			latestBlockhash, err := r.client.GetLatestBlockhashWithConfig(ctx, GetLatestBlockhashConfig{Commitment: r.cfg.Commitment})
+			if err != nil {
+				return "", fmt.Errorf("failed to get latest blockhash, err: %w", err)
+			}
+			tx, err = r.cfg.Rebuild(ctx, latestBlockhash.Blockhash)
+			if err != nil {
+				return "", fmt.Errorf("failed to rebuild tx, err: %v", err)
+			}
+			rawTx, sig, err = r.send(ctx, tx)
+			if err != nil {
+				return "", err
+			}
+			sentAt = time.Now()
+			lastValidBlockHeight = latestBlockhash.LatestValidBlockHeight
+			continue
+		}
+
+		if status == nil && time.Since(sentAt) >= r.cfg.Interval {
+			cfg := r.cfg.SendTransactionConfig
+			cfg.SkipPreflight = true
+			_, _ = r.client.SendRawTransactionWithConfig(ctx, rawTx, cfg, rpc.WithMaxRetries(0))
+			sentAt = time.Now()
+		}
+
+		select {
+		case <-time.After(r.cfg.PollInterval):
+		case <-ctx.Done():
+			return sig, ctx.Err()
+		}
+	}
+}
+
+func (r *Rebroadcaster) send(ctx context.Context, tx types.Transaction) ([]byte, string, error) {
+	if err := tx.ValidateSize(); err != nil {
+		return nil, "", err
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to serialize tx, err: %v", err)
+	}
+	sig, err := r.client.SendRawTransactionWithConfig(ctx, rawTx, r.cfg.SendTransactionConfig, rpc.WithMaxRetries(0))
+	if err != nil {
+		return nil, "", err
+	}
+	return rawTx, sig, nil
+}
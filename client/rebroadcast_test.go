@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/program/memo"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebroadcaster_Broadcast(t *testing.T) {
+	feePayer := types.NewAccount()
+	blockhashes := []string{
+		"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e",
+		"FBVKaoAJUiFiDHLoKaPTDHwUHwLSjPV5NXT3VsPhSvDi",
+	}
+	newTx := func(blockhash string) types.Transaction {
+		tx, err := types.NewTransaction(types.NewTransactionParam{
+			Message: types.NewMessage(types.NewMessageParam{
+				FeePayer:        feePayer.PublicKey,
+				RecentBlockhash: blockhash,
+				Instructions: []types.Instruction{
+					memo.BuildMemo(memo.BuildMemoParam{Memo: []byte("hello")}),
+				},
+			}),
+			Signers: []types.Account{feePayer},
+		})
+		assert.Nil(t, err)
+		return tx
+	}
+
+	tests := []struct {
+		name        string
+		rebuild     bool
+		maxRebuilds int
+		// blockHeight returns the block height by the number of the blockhashes sent
+		blockHeight func(blockhashes int) string
+		// confirmAfter is the number of sends of the last blockhash before it is confirmed, 0 means never
+		confirmAfter        int
+		expectedBlockhashes []string
+		expectedErr         error
+	}{
+		{
+			name:                "rebroadcast until confirmed",
+			blockHeight:         func(int) string { return "50" },
+			confirmAfter:        3,
+			expectedBlockhashes: []string{blockhashes[0], blockhashes[0], blockhashes[0]},
+			expectedErr:         nil,
+		},
+		{
+			name:    "rebuild after expiry",
+			rebuild: true,
+			blockHeight: func(n int) string {
+				if n == 1 {
+					return "101"
+				}
+				return "150"
+			},
+			confirmAfter:        2,
+			expectedBlockhashes: []string{blockhashes[0], blockhashes[1], blockhashes[1]},
+			expectedErr:         nil,
+		},
+		{
+			name:                "expired without rebuild",
+			blockHeight:         func(int) string { return "101" },
+			expectedBlockhashes: []string{blockhashes[0]},
+			expectedErr:         ErrTransactionExpired,
+		},
+		{
+			name:                "max rebuilds",
+			rebuild:             true,
+			maxRebuilds:         1,
+			blockHeight:         func(int) string { return "301" },
+			expectedBlockhashes: []string{blockhashes[0], blockhashes[1]},
+			expectedErr:         ErrTransactionExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []types.Transaction
+			var configs []string
+			lastSends := func() int {
+				n := 0
+				for _, tx := range sent {
+					if tx.Message.RecentBlockHash == sent[len(sent)-1].Message.RecentBlockHash {
+						n++
+					}
+				}
+				return n
+			}
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				switch method {
+				case "getLatestBlockhash":
+					assert.JSONEq(t, `{"commitment":"confirmed"}`, string(params[0]))
+					return fmt.Sprintf(`{"context":{"slot":1},"value":{"blockhash":"%v","lastValidBlockHeight":200}}`, blockhashes[1])
+				case "sendTransaction":
+					var raw string
+					assert.Nil(t, json.Unmarshal(params[0], &raw))
+					b, err := base64.StdEncoding.DecodeString(raw)
+					assert.Nil(t, err)
+					tx, err := types.TransactionDeserialize(b)
+					assert.Nil(t, err)
+					sent = append(sent, tx)
+					configs = append(configs, string(params[1]))
+					return fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+				case "getBlockHeight":
+					assert.JSONEq(t, `{"commitment":"confirmed"}`, string(params[0]))
+					sentBlockhashes := map[string]bool{}
+					for _, tx := range sent {
+						sentBlockhashes[tx.Message.RecentBlockHash] = true
+					}
+					return tt.blockHeight(len(sentBlockhashes))
+				case "getSignatureStatuses":
+					var sigs []string
+					assert.Nil(t, json.Unmarshal(params[0], &sigs))
+					assert.Equal(t, []string{base58.Encode(sent[len(sent)-1].Signatures[0])}, sigs)
+					if tt.confirmAfter > 0 && lastSends() >= tt.confirmAfter {
+						return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+					}
+					return `{"context":{"slot":1},"value":[null]}`
+				}
+				t.Fatalf("unexpected method: %v", method)
+				return ""
+			})
+			defer server.Close()
+
+			cfg := RebroadcasterConfig{
+				Interval:     time.Millisecond,
+				PollInterval: time.Millisecond,
+				MaxRebuilds:  tt.maxRebuilds,
+			}
+			if tt.rebuild {
+				cfg.Rebuild = func(ctx context.Context, blockhash string) (types.Transaction, error) {
+					return newTx(blockhash), nil
+				}
+			}
+			sig, err := NewClient(server.URL).NewRebroadcaster(cfg).Broadcast(context.Background(), newTx(blockhashes[0]), 100)
+			assert.Equal(t, tt.expectedErr, err)
+
+			sentBlockhashes := []string{}
+			for _, tx := range sent {
+				sentBlockhashes = append(sentBlockhashes, tx.Message.RecentBlockHash)
+			}
+			assert.Equal(t, tt.expectedBlockhashes, sentBlockhashes)
+			assert.Equal(t, base58.Encode(sent[len(sent)-1].Signatures[0]), sig)
+
+			// the first send of a blockhash runs the preflight, the node never retries
+			for i, config := range configs {
+				if i == 0 || sentBlockhashes[i] != sentBlockhashes[i-1] {
+					assert.JSONEq(t, `{"encoding":"base64","maxRetries":0}`, config)
+				} else {
+					assert.JSONEq(t, `{"encoding":"base64","skipPreflight":true,"maxRetries":0}`, config)
+				}
+			}
+		})
+	}
+}
+
+func TestRebroadcaster_Broadcast_LandedBeforeExpiry(t *testing.T) {
+	feePayer := types.NewAccount()
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        feePayer.PublicKey,
+			RecentBlockhash: "9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e",
+			Instructions: []types.Instruction{
+				memo.BuildMemo(memo.BuildMemoParam{Memo: []byte("hello")}),
+			},
+		}),
+		Signers: []types.Account{feePayer},
+	})
+	assert.Nil(t, err)
+
+	sends, polls := 0, 0
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		switch method {
+		case "sendTransaction":
+			sends++
+			return fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+		case "getBlockHeight":
+			// the blockhash is expired
+			return "101"
+		case "getSignatureStatuses":
+			// it stays processed for a few polls after the expiry
+			polls++
+			if polls <= 3 {
+				return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":0,"err":null,"confirmationStatus":"processed"}]}`
+			}
+			return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	})
+	defer server.Close()
+
+	sig, err := NewClient(server.URL).NewRebroadcaster(RebroadcasterConfig{
+		Interval:     time.Millisecond,
+		PollInterval: time.Millisecond,
+		Rebuild: func(ctx context.Context, blockhash string) (types.Transaction, error) {
+			t.Fatalf("a landed transaction is rebuilt")
+			return types.Transaction{}, nil
+		},
+	}).Broadcast(context.Background(), tx, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, base58.Encode(tx.Signatures[0]), sig)
+	assert.Equal(t, 4, polls)
+	// a landed transaction is not rebroadcast
+	assert.Equal(t, 1, sends)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/sysvar"
+)
+
+// RentCache computes the minimum balance for rent exemption from the rent sysvar, which is fetched once,
+// so creating many accounts doesn't call getMinimumBalanceForRentExemption for every one of them.
+// if the sysvar can't be fetched or parsed, it falls back to getMinimumBalanceForRentExemption and caches the results by size.
+//
+//	rent := c.NewRentCache()
+//	lamports, err := rent.MinimumBalanceForRentExemption(ctx, token.TokenAccountSize)
+type RentCache struct {
+	client *Client
+
+	mu       sync.Mutex
+	rent     *sysvar.Rent
+	balances map[uint64]uint64
+}
+
+// NewRentCache returns an empty cache, the sysvar is fetched on the first call
+func (c *Client) NewRentCache() *RentCache {
+	return &RentCache{
+		client:   c,
+		balances: map[uint64]uint64{},
+	}
+}
+
+// MinimumBalanceForRentExemption returns the lamports an account with dataLen bytes of data needs to be rent exempt
+func (r *RentCache) MinimumBalanceForRentExemption(ctx context.Context, dataLen uint64) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.rent == nil {
+		if rent, err := r.client.GetRent(ctx); err == nil {
+			r.rent = &rent
+		}
+	}
+	if r.rent != nil {
+		return r.rent.MinimumBalance(dataLen), nil
+	}
+
+	if balance, ok := r.balances[dataLen]; ok {
+		return balance, nil
+	}
+	balance, err := r.client.GetMinimumBalanceForRentExemption(ctx, dataLen)
+	if err != nil {
+		return 0, err
+	}
+	r.balances[dataLen] = balance
+	return balance, nil
+}
+
+// GetRent fetches and decodes the rent sysvar
+func (c *Client) GetRent(ctx context.Context) (sysvar.Rent, error) {
+	accu, err := c.GetAccountInfo(ctx, common.SysVarRentPubkey.ToBase58())
+	if err != nil {
+		return sysvar.Rent{}, err
+	}
+	return sysvar.DeserializeRent(accu.Data, accu.Owner)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRentCache_MinimumBalanceForRentExemption(t *testing.T) {
+	tests := []struct {
+		name          string
+		rentAccount   string
+		expectedCalls map[string]int
+	}{
+		{
+			name:          "sysvar",
+			rentAccount:   `{"context":{"slot":1},"value":{"data":["mA0AAAAAAAAAAAAAAAAAQDI=","base64"],"executable":false,"lamports":1009200,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}}`,
+			expectedCalls: map[string]int{"getAccountInfo": 1},
+		},
+		{
+			name:          "fallback",
+			rentAccount:   `{"context":{"slot":1},"value":null}`,
+			expectedCalls: map[string]int{"getAccountInfo": 3, "getMinimumBalanceForRentExemption": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := map[string]int{}
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				calls[method]++
+				switch method {
+				case "getAccountInfo":
+					assert.JSONEq(t, `"SysvarRent111111111111111111111111111111111"`, string(params[0]))
+					return tt.rentAccount
+				case "getMinimumBalanceForRentExemption":
+					if string(params[0]) == "165" {
+						return "2039280"
+					}
+					return "890880"
+				}
+				t.Fatalf("unexpected method: %v", method)
+				return ""
+			})
+			defer server.Close()
+
+			rent := NewClient(server.URL).NewRentCache()
+			for _, dataLen := range []uint64{165, 0, 165} {
+				balance, err := rent.MinimumBalanceForRentExemption(context.Background(), dataLen)
+				assert.Nil(t, err)
+				if dataLen == 165 {
+					assert.Equal(t, uint64(2039280), balance)
+				} else {
+					assert.Equal(t, uint64(890880), balance)
+				}
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -47,6 +48,20 @@ func newTokenAmount(amount string, decimals uint8, uiAmountString string) (Token
 	}, nil
 }
 
+// UnmarshalJSON decodes the ui token amount of jsonParsed responses
+func (t *TokenAmount) UnmarshalJSON(data []byte) error {
+	var v rpc.TokenAccountBalance
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	tokenAmount, err := newTokenAmount(v.Amount, v.Decimals, v.UIAmountString)
+	if err != nil {
+		return err
+	}
+	*t = tokenAmount
+	return nil
+}
+
 type ReturnData struct {
 	ProgramId common.PublicKey
 	Data      []byte
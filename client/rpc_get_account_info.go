@@ -4,11 +4,28 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/rpc"
 )
 
+var (
+	zstdDecoderOnce sync.Once
+	// zstdDecoder is only used by DecodeAll, which is safe for concurrent use
+	zstdDecoder    *zstd.Decoder
+	zstdDecoderErr error
+)
+
+// getZstdDecoder creates the decoder on the first base64+zstd account, most programs never need it
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
 type AccountInfo struct {
 	Lamports   uint64
 	Owner      common.PublicKey
@@ -25,12 +42,9 @@ func convertAccountInfo(v rpc.AccountInfo) (AccountInfo, error) {
 	if !ok {
 		return AccountInfo{}, fmt.Errorf("failed to cast raw response to []any")
 	}
-	if data[1] != string(rpc.AccountEncodingBase64) {
-		return AccountInfo{}, fmt.Errorf("return value should be base64 encoded")
-	}
-	rawData, err := base64.StdEncoding.DecodeString(data[0].(string))
+	rawData, err := decodeAccountData(data)
 	if err != nil {
-		return AccountInfo{}, fmt.Errorf("failed to base64 decode data")
+		return AccountInfo{}, err
 	}
 	return AccountInfo{
 		Lamports:   v.Lamports,
@@ -41,54 +55,103 @@ func convertAccountInfo(v rpc.AccountInfo) (AccountInfo, error) {
 	}, nil
 }
 
+// decodeAccountData decodes a ["<data>", "<encoding>"] pair, base64+zstd data is decompressed
+func decodeAccountData(data []any) ([]byte, error) {
+	if len(data) != 2 {
+		return nil, fmt.Errorf("unexpected account data length, got %v", len(data))
+	}
+	encoded, ok := data[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast account data to string")
+	}
+	switch data[1] {
+	case string(rpc.AccountEncodingBase64):
+		rawData, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to base64 decode data")
+		}
+		return rawData, nil
+	case string(rpc.AccountEncodingBase64Zstd):
+		compressed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to base64 decode data")
+		}
+		decoder, err := getZstdDecoder()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd decoder, err: %v", err)
+		}
+		rawData, err := decoder.DecodeAll(compressed, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to zstd decompress data, err: %v", err)
+		}
+		// keep an empty account consistent with the base64 encoding
+		if rawData == nil {
+			rawData = []byte{}
+		}
+		return rawData, nil
+	default:
+		return nil, fmt.Errorf("return value should be base64 or base64+zstd encoded")
+	}
+}
+
 type GetAccountInfoConfig struct {
 	Commitment rpc.Commitment
 	DataSlice  *rpc.DataSlice
+	// Encoding is rpc.AccountEncodingBase64 (default) or rpc.AccountEncodingBase64Zstd,
+	// zstd data is decompressed by the client so it saves bandwidth for large accounts.
+	Encoding rpc.AccountEncoding
 }
 
 func (c GetAccountInfoConfig) toRpc() rpc.GetAccountInfoConfig {
 	return rpc.GetAccountInfoConfig{
-		Encoding:   rpc.AccountEncodingBase64,
+		Encoding:   getAccountEncoding(c.Encoding),
 		Commitment: c.Commitment,
 		DataSlice:  c.DataSlice,
 	}
 }
 
+func getAccountEncoding(encoding rpc.AccountEncoding) rpc.AccountEncoding {
+	if encoding == "" {
+		return rpc.AccountEncodingBase64
+	}
+	return encoding
+}
+
 // GetAccountInfo return account's info
-func (c *Client) GetAccountInfo(ctx context.Context, base58Addr string) (AccountInfo, error) {
+func (c *Client) GetAccountInfo(ctx context.Context, base58Addr string, opts ...rpc.CallOption) (AccountInfo, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
-			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, GetAccountInfoConfig{}.toRpc())
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, GetAccountInfoConfig{}.toRpc(), opts...)
 		},
 		convertGetAccountInfo,
 	)
 }
 
 // GetAccountInfoWithConfig return account's info
-func (c *Client) GetAccountInfoWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig) (AccountInfo, error) {
+func (c *Client) GetAccountInfoWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig, opts ...rpc.CallOption) (AccountInfo, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
-			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, cfg.toRpc())
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, cfg.toRpc(), opts...)
 		},
 		convertGetAccountInfo,
 	)
 }
 
 // GetAccountInfoAndContext return account's info
-func (c *Client) GetAccountInfoAndContext(ctx context.Context, base58Addr string) (rpc.ValueWithContext[AccountInfo], error) {
+func (c *Client) GetAccountInfoAndContext(ctx context.Context, base58Addr string, opts ...rpc.CallOption) (rpc.ValueWithContext[AccountInfo], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
-			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, GetAccountInfoConfig{}.toRpc())
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, GetAccountInfoConfig{}.toRpc(), opts...)
 		},
 		convertGetAccountInfoAndContext,
 	)
 }
 
 // GetAccountInfoAndContextWithConfig return account's info
-func (c *Client) GetAccountInfoAndContextWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig) (rpc.ValueWithContext[AccountInfo], error) {
+func (c *Client) GetAccountInfoAndContextWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[AccountInfo], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
-			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, cfg.toRpc())
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, cfg.toRpc(), opts...)
 		},
 		convertGetAccountInfoAndContext,
 	)
@@ -61,6 +61,29 @@ func TestClient_GetAccountInfoWithConfig(t *testing.T) {
 				},
 				ExpectedError: nil,
 			},
+			{
+				Name:         "with base64+zstd",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["5xtKiHGFfhK6ynJwWrApoVkVHeTJ25czqnezDwJiT86N", {"encoding": "base64+zstd"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":121172974},"value":{"data":["KLUv/QBYIQEAAgAAADLAWbd6nbiA1th1E/yH93WWg4hgfgn3t2BoTE9m2JGH","base64+zstd"],"executable":true,"lamports":1141440,"owner":"BPFLoaderUpgradeab1e11111111111111111111111","rentEpoch":280}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetAccountInfoWithConfig(
+						context.Background(),
+						"5xtKiHGFfhK6ynJwWrApoVkVHeTJ25czqnezDwJiT86N",
+						GetAccountInfoConfig{
+							Encoding: rpc.AccountEncodingBase64Zstd,
+						},
+					)
+				},
+				ExpectedValue: AccountInfo{
+					Lamports:   1141440,
+					Owner:      common.PublicKeyFromString("BPFLoaderUpgradeab1e11111111111111111111111"),
+					Executable: true,
+					RentEpoch:  280,
+					Data:       []byte{2, 0, 0, 0, 50, 192, 89, 183, 122, 157, 184, 128, 214, 216, 117, 19, 252, 135, 247, 117, 150, 131, 136, 96, 126, 9, 247, 183, 96, 104, 76, 79, 102, 216, 145, 135},
+				},
+				ExpectedError: nil,
+			},
 			{
 				Name:         "with commitment",
 				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb", {"commitment": "processed", "encoding": "base64"}]}`,
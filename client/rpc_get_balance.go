@@ -17,40 +17,40 @@ func (c GetBalanceConfig) toRpc() rpc.GetBalanceConfig {
 }
 
 // GetBalance fetch users lamports(SOL) balance
-func (c *Client) GetBalance(ctx context.Context, base58Addr string) (uint64, error) {
+func (c *Client) GetBalance(ctx context.Context, base58Addr string, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[uint64]], error) {
-			return c.RpcClient.GetBalance(ctx, base58Addr)
+			return c.RpcClient.GetBalance(ctx, base58Addr, opts...)
 		},
 		value[uint64],
 	)
 }
 
 // GetBalanceWithConfig fetch users lamports(SOL) balance with specific commitment
-func (c *Client) GetBalanceWithConfig(ctx context.Context, base58Addr string, cfg GetBalanceConfig) (uint64, error) {
+func (c *Client) GetBalanceWithConfig(ctx context.Context, base58Addr string, cfg GetBalanceConfig, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[uint64]], error) {
-			return c.RpcClient.GetBalanceWithConfig(ctx, base58Addr, cfg.toRpc())
+			return c.RpcClient.GetBalanceWithConfig(ctx, base58Addr, cfg.toRpc(), opts...)
 		},
 		value[uint64],
 	)
 }
 
 // GetBalanceAndContext fetch users lamports(SOL) balance
-func (c *Client) GetBalanceAndContext(ctx context.Context, base58Addr string) (rpc.ValueWithContext[uint64], error) {
+func (c *Client) GetBalanceAndContext(ctx context.Context, base58Addr string, opts ...rpc.CallOption) (rpc.ValueWithContext[uint64], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[uint64]], error) {
-			return c.RpcClient.GetBalance(ctx, base58Addr)
+			return c.RpcClient.GetBalance(ctx, base58Addr, opts...)
 		},
 		forward[rpc.ValueWithContext[uint64]],
 	)
 }
 
 // GetBalanceAndContextWithConfig fetch users lamports(SOL) balance with specific commitment
-func (c *Client) GetBalanceAndContextWithConfig(ctx context.Context, base58Addr string, cfg GetBalanceConfig) (rpc.ValueWithContext[uint64], error) {
+func (c *Client) GetBalanceAndContextWithConfig(ctx context.Context, base58Addr string, cfg GetBalanceConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[uint64], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[uint64]], error) {
-			return c.RpcClient.GetBalanceWithConfig(ctx, base58Addr, cfg.toRpc())
+			return c.RpcClient.GetBalanceWithConfig(ctx, base58Addr, cfg.toRpc(), opts...)
 		},
 		forward[rpc.ValueWithContext[uint64]],
 	)
@@ -47,19 +47,19 @@ type BlockTransaction struct {
 	AccountKeys []common.PublicKey
 }
 
-func (c *Client) GetBlock(ctx context.Context, slot uint64) (*Block, error) {
+func (c *Client) GetBlock(ctx context.Context, slot uint64, opts ...rpc.CallOption) (*Block, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[*rpc.GetBlock], error) {
-			return c.RpcClient.GetBlockWithConfig(ctx, slot, GetBlockConfig{}.toRpc())
+			return c.RpcClient.GetBlockWithConfig(ctx, slot, GetBlockConfig{}.toRpc(), opts...)
 		},
 		convertBlock,
 	)
 }
 
-func (c *Client) GetBlockWithConfig(ctx context.Context, slot uint64, cfg GetBlockConfig) (*Block, error) {
+func (c *Client) GetBlockWithConfig(ctx context.Context, slot uint64, cfg GetBlockConfig, opts ...rpc.CallOption) (*Block, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[*rpc.GetBlock], error) {
-			return c.RpcClient.GetBlockWithConfig(ctx, slot, cfg.toRpc())
+			return c.RpcClient.GetBlockWithConfig(ctx, slot, cfg.toRpc(), opts...)
 		},
 		convertBlock,
 	)
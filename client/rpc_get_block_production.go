@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetBlockProductionConfig struct {
+	Commitment rpc.Commitment
+	// Identity only returns the results of this validator identity
+	Identity string
+	// Range default is the current epoch
+	Range *rpc.GetBlockProductionRange
+}
+
+func (c GetBlockProductionConfig) toRpc() rpc.GetBlockProductionConfig {
+	return rpc.GetBlockProductionConfig{
+		Commitment: c.Commitment,
+		Identity:   c.Identity,
+		Range:      c.Range,
+	}
+}
+
+type BlockProduction struct {
+	ByIdentity map[common.PublicKey]BlockProductionStat
+	FirstSlot  uint64
+	LastSlot   uint64
+}
+
+type BlockProductionStat struct {
+	LeaderSlots    uint64
+	BlocksProduced uint64
+}
+
+// SkippedSlots returns the leader slots without a produced block
+func (s BlockProductionStat) SkippedSlots() uint64 {
+	if s.BlocksProduced > s.LeaderSlots {
+		return 0
+	}
+	return s.LeaderSlots - s.BlocksProduced
+}
+
+// GetBlockProduction returns recent block production information from the current epoch
+func (c *Client) GetBlockProduction(ctx context.Context, opts ...rpc.CallOption) (BlockProduction, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetBlockProduction], error) {
+			return c.RpcClient.GetBlockProduction(ctx, opts...)
+		},
+		convertGetBlockProduction,
+	)
+}
+
+// GetBlockProductionWithConfig returns recent block production information
+func (c *Client) GetBlockProductionWithConfig(ctx context.Context, cfg GetBlockProductionConfig, opts ...rpc.CallOption) (BlockProduction, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetBlockProduction], error) {
+			return c.RpcClient.GetBlockProductionWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetBlockProduction,
+	)
+}
+
+func convertGetBlockProduction(v rpc.GetBlockProduction) (BlockProduction, error) {
+	byIdentity := make(map[common.PublicKey]BlockProductionStat, len(v.Value.ByIdentity))
+	for identity, stat := range v.Value.ByIdentity {
+		if len(stat) != 2 {
+			return BlockProduction{}, fmt.Errorf("unexpected block production of %v", identity)
+		}
+		byIdentity[common.PublicKeyFromString(identity)] = BlockProductionStat{
+			LeaderSlots:    stat[0],
+			BlocksProduced: stat[1],
+		}
+	}
+	return BlockProduction{
+		ByIdentity: byIdentity,
+		FirstSlot:  v.Value.Range.FirstSlot,
+		LastSlot:   v.Value.Range.LastSlot,
+	}, nil
+}
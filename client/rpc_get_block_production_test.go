@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetBlockProduction(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlockProduction"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":6850},"value":{"byIdentity":{"8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn":[6851,6849]},"range":{"firstSlot":0,"lastSlot":6850}}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlockProduction(
+						context.Background(),
+					)
+				},
+				ExpectedValue: BlockProduction{
+					ByIdentity: map[common.PublicKey]BlockProductionStat{
+						common.PublicKeyFromString("8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn"): {
+							LeaderSlots:    6851,
+							BlocksProduced: 6849,
+						},
+					},
+					FirstSlot: 0,
+					LastSlot:  6850,
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlockProduction"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":6850},"value":{"byIdentity":{"8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn":[6851]},"range":{"firstSlot":0,"lastSlot":6850}}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlockProduction(
+						context.Background(),
+					)
+				},
+				ExpectedValue: BlockProduction{},
+				ExpectedError: errors.New("unexpected block production of 8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn"),
+			},
+		},
+	)
+}
+
+func TestClient_GetBlockProductionWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlockProduction", "params":[{"identity": "8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn", "range": {"firstSlot": 6000, "lastSlot": 6100}}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":6851},"value":{"byIdentity":{"8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn":[101,101]},"range":{"firstSlot":6000,"lastSlot":6100}}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlockProductionWithConfig(
+						context.Background(),
+						GetBlockProductionConfig{
+							Identity: "8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn",
+							Range: &rpc.GetBlockProductionRange{
+								FirstSlot: 6000,
+								LastSlot:  6100,
+							},
+						},
+					)
+				},
+				ExpectedValue: BlockProduction{
+					ByIdentity: map[common.PublicKey]BlockProductionStat{
+						common.PublicKeyFromString("8gNdbr9dG6oj8bhaQ44icyMYsfG3t1dhXKUJLGVav4tn"): {
+							LeaderSlots:    101,
+							BlocksProduced: 101,
+						},
+					},
+					FirstSlot: 6000,
+					LastSlot:  6100,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestBlockProductionStat_SkippedSlots(t *testing.T) {
+	assert.Equal(t, uint64(2), BlockProductionStat{LeaderSlots: 6851, BlocksProduced: 6849}.SkippedSlots())
+	assert.Equal(t, uint64(0), BlockProductionStat{LeaderSlots: 4, BlocksProduced: 4}.SkippedSlots())
+}
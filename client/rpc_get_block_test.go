@@ -105,8 +105,10 @@ func TestClient_BlockWithConfig(t *testing.T) {
 									1169280,
 									1,
 								},
-								PreTokenBalances:  []rpc.TransactionMetaTokenBalance{},
-								PostTokenBalances: []rpc.TransactionMetaTokenBalance{},
+								PreTokenBalances:       []rpc.TransactionMetaTokenBalance{},
+								TypedPreTokenBalances:  []TransactionTokenBalance{},
+								PostTokenBalances:      []rpc.TransactionMetaTokenBalance{},
+								TypedPostTokenBalances: []TransactionTokenBalance{},
 								LogMessages: []string{
 									"Program Vote111111111111111111111111111111111111111 invoke [1]",
 									"Program Vote111111111111111111111111111111111111111 success",
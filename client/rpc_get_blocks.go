@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetBlocksConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetBlocksConfig) toRpc() rpc.GetBlocksConfig {
+	return rpc.GetBlocksConfig{
+		Commitment: c.Commitment,
+	}
+}
+
+// GetBlocks returns a list of confirmed blocks between two slots, inclusive. Max range allowed is 500,000 slots.
+func (c *Client) GetBlocks(ctx context.Context, startSlot uint64, endSlot uint64, opts ...rpc.CallOption) ([]uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]uint64], error) {
+			return c.RpcClient.GetBlocks(ctx, startSlot, endSlot, opts...)
+		},
+		forward[[]uint64],
+	)
+}
+
+// GetBlocksWithConfig returns a list of confirmed blocks between two slots, inclusive. Max range allowed is 500,000 slots.
+func (c *Client) GetBlocksWithConfig(ctx context.Context, startSlot uint64, endSlot uint64, cfg GetBlocksConfig, opts ...rpc.CallOption) ([]uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]uint64], error) {
+			return c.RpcClient.GetBlocksWithConfig(ctx, startSlot, endSlot, cfg.toRpc(), opts...)
+		},
+		forward[[]uint64],
+	)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetBlocks(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlocks", "params":[86686567, 86686578]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[86686567,86686568,86686572,86686578],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlocks(
+						context.Background(),
+						86686567,
+						86686578,
+					)
+				},
+				ExpectedValue: []uint64{86686567, 86686568, 86686572, 86686578},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetBlocksWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlocks", "params":[86686567, 86686578, {"commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[86686567],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlocksWithConfig(
+						context.Background(),
+						86686567,
+						86686578,
+						GetBlocksConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: []uint64{86686567},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetBlocksWithLimitConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetBlocksWithLimitConfig) toRpc() rpc.GetBlocksWithLimitConfig {
+	return rpc.GetBlocksWithLimitConfig{
+		Commitment: c.Commitment,
+	}
+}
+
+// GetBlocksWithLimit returns a list of confirmed blocks starting at the given slot. Max limit allowed is 500,000 blocks.
+func (c *Client) GetBlocksWithLimit(ctx context.Context, startSlot uint64, limit uint64, opts ...rpc.CallOption) ([]uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]uint64], error) {
+			return c.RpcClient.GetBlocksWithLimit(ctx, startSlot, limit, opts...)
+		},
+		forward[[]uint64],
+	)
+}
+
+// GetBlocksWithLimitWithConfig returns a list of confirmed blocks starting at the given slot. Max limit allowed is 500,000 blocks.
+func (c *Client) GetBlocksWithLimitWithConfig(ctx context.Context, startSlot uint64, limit uint64, cfg GetBlocksWithLimitConfig, opts ...rpc.CallOption) ([]uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]uint64], error) {
+			return c.RpcClient.GetBlocksWithLimitWithConfig(ctx, startSlot, limit, cfg.toRpc(), opts...)
+		},
+		forward[[]uint64],
+	)
+}
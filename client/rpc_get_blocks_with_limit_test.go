@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetBlocksWithLimit(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlocksWithLimit", "params":[86686567, 3]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[86686567,86686568,86686572],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlocksWithLimit(
+						context.Background(),
+						86686567,
+						3,
+					)
+				},
+				ExpectedValue: []uint64{86686567, 86686568, 86686572},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetBlocksWithLimitWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlocksWithLimit", "params":[86686567, 3, {"commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetBlocksWithLimitWithConfig(
+						context.Background(),
+						86686567,
+						3,
+						GetBlocksWithLimitConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: []uint64{},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -10,16 +10,20 @@ import (
 )
 
 type GetFeeForMessageConfig struct {
-	Commitment rpc.Commitment
+	Commitment     rpc.Commitment
+	MinContextSlot *uint64
 }
 
 func (c GetFeeForMessageConfig) toRpc() rpc.GetFeeForMessageConfig {
 	return rpc.GetFeeForMessageConfig{
-		Commitment: c.Commitment,
+		Commitment:     c.Commitment,
+		MinContextSlot: c.MinContextSlot,
 	}
 }
 
-func (c *Client) GetFeeForMessage(ctx context.Context, message types.Message) (*uint64, error) {
+// GetFeeForMessage returns the fee in lamports the network will charge for the message.
+// it returns nil if the recent blockhash of the message has expired.
+func (c *Client) GetFeeForMessage(ctx context.Context, message types.Message, opts ...rpc.CallOption) (*uint64, error) {
 	rawMessage, err := message.Serialize()
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize message, err: %v", err)
@@ -29,13 +33,16 @@ func (c *Client) GetFeeForMessage(ctx context.Context, message types.Message) (*
 			return c.RpcClient.GetFeeForMessage(
 				ctx,
 				base64.StdEncoding.EncodeToString(rawMessage),
+				opts...,
 			)
 		},
 		value[*uint64],
 	)
 }
 
-func (c *Client) GetFeeForMessageWithConfig(ctx context.Context, message types.Message, cfg GetFeeForMessageConfig) (*uint64, error) {
+// GetFeeForMessageWithConfig returns the fee in lamports the network will charge for the message.
+// it returns nil if the recent blockhash of the message has expired.
+func (c *Client) GetFeeForMessageWithConfig(ctx context.Context, message types.Message, cfg GetFeeForMessageConfig, opts ...rpc.CallOption) (*uint64, error) {
 	rawMessage, err := message.Serialize()
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize message, err: %v", err)
@@ -46,13 +53,14 @@ func (c *Client) GetFeeForMessageWithConfig(ctx context.Context, message types.M
 				ctx,
 				base64.StdEncoding.EncodeToString(rawMessage),
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		value[*uint64],
 	)
 }
 
-func (c *Client) GetFeeForMessageAndContext(ctx context.Context, message types.Message) (rpc.ValueWithContext[*uint64], error) {
+func (c *Client) GetFeeForMessageAndContext(ctx context.Context, message types.Message, opts ...rpc.CallOption) (rpc.ValueWithContext[*uint64], error) {
 	rawMessage, err := message.Serialize()
 	if err != nil {
 		return rpc.ValueWithContext[*uint64]{}, fmt.Errorf("failed to serialize message, err: %v", err)
@@ -62,13 +70,14 @@ func (c *Client) GetFeeForMessageAndContext(ctx context.Context, message types.M
 			return c.RpcClient.GetFeeForMessage(
 				ctx,
 				base64.StdEncoding.EncodeToString(rawMessage),
+				opts...,
 			)
 		},
 		forward[rpc.ValueWithContext[*uint64]],
 	)
 }
 
-func (c *Client) GetFeeForMessageAndContextWithConfig(ctx context.Context, message types.Message, cfg GetFeeForMessageConfig) (rpc.ValueWithContext[*uint64], error) {
+func (c *Client) GetFeeForMessageAndContextWithConfig(ctx context.Context, message types.Message, cfg GetFeeForMessageConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[*uint64], error) {
 	rawMessage, err := message.Serialize()
 	if err != nil {
 		return rpc.ValueWithContext[*uint64]{}, fmt.Errorf("failed to serialize message, err: %v", err)
@@ -79,6 +88,7 @@ func (c *Client) GetFeeForMessageAndContextWithConfig(ctx context.Context, messa
 				ctx,
 				base64.StdEncoding.EncodeToString(rawMessage),
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		forward[rpc.ValueWithContext[*uint64]],
@@ -101,6 +101,23 @@ func TestClient_GetFeeForMessageWithConfig(t *testing.T) {
 				ExpectedValue: pointer.Get[uint64](5000),
 				ExpectedError: nil,
 			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getFeeForMessage", "params":["AQABAyRn8Htq2L5KAQiNyByMm5M/q8rDpBu7qahSf2bBSZq4Bj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMqYD+EHyvdHM3SIIuGet5Q6BxOI26dTbdOzaCY8V1mtAQICAAEMAgAAAAEAAAAAAAAA", {"commitment": "confirmed", "minContextSlot": 187830000}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187830352},"value":5000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetFeeForMessageWithConfig(
+						context.TODO(),
+						message,
+						GetFeeForMessageConfig{
+							Commitment:     rpc.CommitmentConfirmed,
+							MinContextSlot: pointer.Get[uint64](187830000),
+						},
+					)
+				},
+				ExpectedValue: pointer.Get[uint64](5000),
+				ExpectedError: nil,
+			},
 		},
 	)
 }
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type HighestSnapshotSlot struct {
+	Full uint64
+	// Incremental is nil if the node has no incremental snapshot based on the full snapshot
+	Incremental *uint64
+}
+
+// GetHighestSnapshotSlot returns the highest slot information that the node has snapshots for
+func (c *Client) GetHighestSnapshotSlot(ctx context.Context) (HighestSnapshotSlot, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetHighestSnapshotSlot], error) {
+			return c.RpcClient.GetHighestSnapshotSlot(ctx)
+		},
+		func(v rpc.GetHighestSnapshotSlot) (HighestSnapshotSlot, error) {
+			return HighestSnapshotSlot(v), nil
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetHighestSnapshotSlot(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getHighestSnapshotSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"full":100,"incremental":null},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetHighestSnapshotSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: HighestSnapshotSlot{
+					Full:        100,
+					Incremental: nil,
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getHighestSnapshotSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","error":{"code":-32008,"message":"No snapshot"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetHighestSnapshotSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: HighestSnapshotSlot{},
+				ExpectedError: &rpc.JsonRpcError{
+					Code:    -32008,
+					Message: "No snapshot",
+				},
+			},
+		},
+	)
+}
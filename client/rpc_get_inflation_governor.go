@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetInflationGovernorConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetInflationGovernorConfig) toRpc() rpc.GetInflationGovernorConfig {
+	return rpc.GetInflationGovernorConfig{
+		Commitment: c.Commitment,
+	}
+}
+
+type InflationGovernor struct {
+	Foundation     float64
+	FoundationTerm float64
+	Initial        float64
+	Taper          float64
+	Terminal       float64
+}
+
+// GetInflationGovernor returns the current inflation governor
+func (c *Client) GetInflationGovernor(ctx context.Context, opts ...rpc.CallOption) (InflationGovernor, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetInflationGovernor], error) {
+			return c.RpcClient.GetInflationGovernor(ctx, opts...)
+		},
+		convertGetInflationGovernor,
+	)
+}
+
+// GetInflationGovernorWithConfig returns the current inflation governor
+func (c *Client) GetInflationGovernorWithConfig(ctx context.Context, cfg GetInflationGovernorConfig, opts ...rpc.CallOption) (InflationGovernor, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetInflationGovernor], error) {
+			return c.RpcClient.GetInflationGovernorWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetInflationGovernor,
+	)
+}
+
+func convertGetInflationGovernor(v rpc.GetInflationGovernor) (InflationGovernor, error) {
+	return InflationGovernor(v), nil
+}
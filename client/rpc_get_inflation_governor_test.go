@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetInflationGovernorWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getInflationGovernor", "params":[{"commitment": "processed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"foundation":0.05,"foundationTerm":7.0,"initial":0.08,"taper":0.15,"terminal":0.015},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetInflationGovernorWithConfig(
+						context.Background(),
+						GetInflationGovernorConfig{
+							Commitment: rpc.CommitmentProcessed,
+						},
+					)
+				},
+				ExpectedValue: InflationGovernor{
+					Foundation:     0.05,
+					FoundationTerm: 7,
+					Initial:        0.08,
+					Taper:          0.15,
+					Terminal:       0.015,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type InflationRate struct {
+	Epoch      uint64
+	Foundation float64
+	Total      float64
+	Validator  float64
+}
+
+// GetInflationRate returns the specific inflation values for the current epoch
+func (c *Client) GetInflationRate(ctx context.Context) (InflationRate, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetInflationRate], error) {
+			return c.RpcClient.GetInflationRate(ctx)
+		},
+		func(v rpc.GetInflationRate) (InflationRate, error) {
+			return InflationRate(v), nil
+		},
+	)
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestClient_GetInflationRate(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getInflationRate"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"epoch":200,"foundation":0.0,"total":0.06956826778571164,"validator":0.06956826778571164},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetInflationRate(
+						context.Background(),
+					)
+				},
+				ExpectedValue: InflationRate{
+					Epoch:      200,
+					Foundation: 0,
+					Total:      0.06956826778571164,
+					Validator:  0.06956826778571164,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetInflationRewardConfig struct {
+	Commitment rpc.Commitment
+	// Epoch default is the previous epoch
+	Epoch uint64
+}
+
+func (c GetInflationRewardConfig) toRpc() rpc.GetInflationRewardConfig {
+	return rpc.GetInflationRewardConfig{
+		Commitment: c.Commitment,
+		Epoch:      c.Epoch,
+	}
+}
+
+type InflationReward struct {
+	Epoch         uint64
+	EffectiveSlot uint64
+	Amount        uint64
+	PostBalance   uint64
+	Commission    *uint8
+}
+
+// GetInflationReward returns the inflation rewards of the addresses in the previous epoch,
+// the reward is nil if the address got no reward.
+func (c *Client) GetInflationReward(ctx context.Context, addrs []string, opts ...rpc.CallOption) ([]*InflationReward, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]*rpc.GetInflationReward], error) {
+			return c.RpcClient.GetInflationReward(ctx, addrs, opts...)
+		},
+		convertGetInflationReward,
+	)
+}
+
+// GetInflationRewardWithConfig returns the inflation rewards of the addresses in an epoch,
+// the reward is nil if the address got no reward.
+func (c *Client) GetInflationRewardWithConfig(ctx context.Context, addrs []string, cfg GetInflationRewardConfig, opts ...rpc.CallOption) ([]*InflationReward, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]*rpc.GetInflationReward], error) {
+			return c.RpcClient.GetInflationRewardWithConfig(ctx, addrs, cfg.toRpc(), opts...)
+		},
+		convertGetInflationReward,
+	)
+}
+
+func convertGetInflationReward(v []*rpc.GetInflationReward) ([]*InflationReward, error) {
+	output := make([]*InflationReward, 0, len(v))
+	for _, r := range v {
+		if r == nil {
+			output = append(output, nil)
+			continue
+		}
+		reward := InflationReward(*r)
+		output = append(output, &reward)
+	}
+	return output, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+)
+
+func TestClient_GetInflationReward(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getInflationReward", "params":[["27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ", "BJhtevCiNKrWsc2pkJP1TFhxAhheZ9FNJ7F567FayhSD"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[null,{"amount":154995,"commission":0,"effectiveSlot":1120,"epoch":34,"postBalance":10003564885}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetInflationReward(
+						context.Background(),
+						[]string{"27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ", "BJhtevCiNKrWsc2pkJP1TFhxAhheZ9FNJ7F567FayhSD"},
+					)
+				},
+				ExpectedValue: []*InflationReward{
+					nil,
+					{
+						Epoch:         34,
+						EffectiveSlot: 1120,
+						Amount:        154995,
+						PostBalance:   10003564885,
+						Commission:    pointer.Get[uint8](0),
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetInflationRewardWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getInflationReward", "params":[["BJhtevCiNKrWsc2pkJP1TFhxAhheZ9FNJ7F567FayhSD"], {"epoch": 31}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"amount":154995,"commission":null,"effectiveSlot":1000,"epoch":31,"postBalance":10003100000}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetInflationRewardWithConfig(
+						context.Background(),
+						[]string{"BJhtevCiNKrWsc2pkJP1TFhxAhheZ9FNJ7F567FayhSD"},
+						GetInflationRewardConfig{
+							Epoch: 31,
+						},
+					)
+				},
+				ExpectedValue: []*InflationReward{
+					{
+						Epoch:         31,
+						EffectiveSlot: 1000,
+						Amount:        154995,
+						PostBalance:   10003100000,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
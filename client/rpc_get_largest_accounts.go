@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetLargestAccountsConfig struct {
+	Commitment rpc.Commitment
+	Filter     rpc.LargestAccountsFilter
+}
+
+func (c GetLargestAccountsConfig) toRpc() rpc.GetLargestAccountsConfig {
+	return rpc.GetLargestAccountsConfig{
+		Commitment: c.Commitment,
+		Filter:     c.Filter,
+	}
+}
+
+type LargestAccount struct {
+	Address  common.PublicKey
+	Lamports uint64
+}
+
+// GetLargestAccounts returns the 20 largest accounts by lamport balance, results may be cached up to two hours
+func (c *Client) GetLargestAccounts(ctx context.Context, opts ...rpc.CallOption) ([]LargestAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetLargestAccounts(ctx, opts...)
+		},
+		convertGetLargestAccounts,
+	)
+}
+
+// GetLargestAccountsWithConfig returns the 20 largest accounts by lamport balance, results may be cached up to two hours
+func (c *Client) GetLargestAccountsWithConfig(ctx context.Context, cfg GetLargestAccountsConfig, opts ...rpc.CallOption) ([]LargestAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetLargestAccountsWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetLargestAccounts,
+	)
+}
+
+func convertGetLargestAccounts(v rpc.ValueWithContext[[]rpc.GetLargestAccountsResultValue]) ([]LargestAccount, error) {
+	output := make([]LargestAccount, 0, len(v.Value))
+	for _, a := range v.Value {
+		output = append(output, LargestAccount{
+			Address:  common.PublicKeyFromString(a.Address),
+			Lamports: a.Lamports,
+		})
+	}
+	return output, nil
+}
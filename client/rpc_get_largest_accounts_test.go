@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetLargestAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLargestAccounts"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":54},"value":[{"lamports":999974,"address":"99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetLargestAccounts(
+						context.Background(),
+					)
+				},
+				ExpectedValue: []LargestAccount{
+					{
+						Address:  common.PublicKeyFromString("99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ"),
+						Lamports: 999974,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetLargestAccountsWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLargestAccounts", "params":[{"commitment":"confirmed","filter":"circulating"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":54},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetLargestAccountsWithConfig(
+						context.Background(),
+						GetLargestAccountsConfig{
+							Commitment: rpc.CommitmentConfirmed,
+							Filter:     rpc.LargestAccountsFilterCirculating,
+						},
+					)
+				},
+				ExpectedValue: []LargestAccount{},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
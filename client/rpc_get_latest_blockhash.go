@@ -21,40 +21,40 @@ func (c GetLatestBlockhashConfig) toRpc() rpc.GetLatestBlockhashConfig {
 }
 
 // GetLatestBlockhash returns the latest blockhash
-func (c *Client) GetLatestBlockhash(ctx context.Context) (rpc.GetLatestBlockhashValue, error) {
+func (c *Client) GetLatestBlockhash(ctx context.Context, opts ...rpc.CallOption) (rpc.GetLatestBlockhashValue, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]], error) {
-			return c.RpcClient.GetLatestBlockhash(ctx)
+			return c.RpcClient.GetLatestBlockhash(ctx, opts...)
 		},
 		convertGetLatestBlockhash,
 	)
 }
 
 // GetLatestBlockhash returns the latest blockhash
-func (c *Client) GetLatestBlockhashWithConfig(ctx context.Context, cfg GetLatestBlockhashConfig) (rpc.GetLatestBlockhashValue, error) {
+func (c *Client) GetLatestBlockhashWithConfig(ctx context.Context, cfg GetLatestBlockhashConfig, opts ...rpc.CallOption) (rpc.GetLatestBlockhashValue, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]], error) {
-			return c.RpcClient.GetLatestBlockhashWithConfig(ctx, cfg.toRpc())
+			return c.RpcClient.GetLatestBlockhashWithConfig(ctx, cfg.toRpc(), opts...)
 		},
 		convertGetLatestBlockhash,
 	)
 }
 
 // GetLatestBlockhashAndContext returns the latest blockhash
-func (c *Client) GetLatestBlockhashAndContext(ctx context.Context) (rpc.ValueWithContext[rpc.GetLatestBlockhashValue], error) {
+func (c *Client) GetLatestBlockhashAndContext(ctx context.Context, opts ...rpc.CallOption) (rpc.ValueWithContext[rpc.GetLatestBlockhashValue], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]], error) {
-			return c.RpcClient.GetLatestBlockhash(ctx)
+			return c.RpcClient.GetLatestBlockhash(ctx, opts...)
 		},
 		forward[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]],
 	)
 }
 
 // GetLatestBlockhashAndContextWithConfig returns the latest blockhash
-func (c *Client) GetLatestBlockhashAndContextWithConfig(ctx context.Context, cfg GetLatestBlockhashConfig) (rpc.ValueWithContext[rpc.GetLatestBlockhashValue], error) {
+func (c *Client) GetLatestBlockhashAndContextWithConfig(ctx context.Context, cfg GetLatestBlockhashConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[rpc.GetLatestBlockhashValue], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]], error) {
-			return c.RpcClient.GetLatestBlockhashWithConfig(ctx, cfg.toRpc())
+			return c.RpcClient.GetLatestBlockhashWithConfig(ctx, cfg.toRpc(), opts...)
 		},
 		forward[rpc.ValueWithContext[rpc.GetLatestBlockhashValue]],
 	)
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetLeaderScheduleConfig struct {
+	Commitment rpc.Commitment
+	Identity   string
+}
+
+func (c GetLeaderScheduleConfig) toRpc() rpc.GetLeaderScheduleConfig {
+	return rpc.GetLeaderScheduleConfig{
+		Commitment: c.Commitment,
+		Identity:   c.Identity,
+	}
+}
+
+// LeaderSchedule maps a validator identity to its leader slot indices, relative to the first slot of the epoch
+type LeaderSchedule map[common.PublicKey][]uint64
+
+// GetLeaderSchedule returns the leader schedule of the current epoch
+func (c *Client) GetLeaderSchedule(ctx context.Context, opts ...rpc.CallOption) (LeaderSchedule, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetLeaderSchedule], error) {
+			return c.RpcClient.GetLeaderSchedule(ctx, opts...)
+		},
+		convertGetLeaderSchedule,
+	)
+}
+
+// GetLeaderScheduleWithConfig returns the leader schedule of the current epoch
+func (c *Client) GetLeaderScheduleWithConfig(ctx context.Context, cfg GetLeaderScheduleConfig, opts ...rpc.CallOption) (LeaderSchedule, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetLeaderSchedule], error) {
+			return c.RpcClient.GetLeaderScheduleWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetLeaderSchedule,
+	)
+}
+
+// GetLeaderScheduleForSlot returns the leader schedule of the epoch which contains the slot,
+// it returns nil if the epoch is not found
+func (c *Client) GetLeaderScheduleForSlot(ctx context.Context, slot uint64, opts ...rpc.CallOption) (LeaderSchedule, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetLeaderSchedule], error) {
+			return c.RpcClient.GetLeaderScheduleForSlot(ctx, slot, opts...)
+		},
+		convertGetLeaderSchedule,
+	)
+}
+
+// GetLeaderScheduleForSlotWithConfig returns the leader schedule of the epoch which contains the slot,
+// it returns nil if the epoch is not found
+func (c *Client) GetLeaderScheduleForSlotWithConfig(ctx context.Context, slot uint64, cfg GetLeaderScheduleConfig, opts ...rpc.CallOption) (LeaderSchedule, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetLeaderSchedule], error) {
+			return c.RpcClient.GetLeaderScheduleForSlotWithConfig(ctx, slot, cfg.toRpc(), opts...)
+		},
+		convertGetLeaderSchedule,
+	)
+}
+
+func convertGetLeaderSchedule(v rpc.GetLeaderSchedule) (LeaderSchedule, error) {
+	if v == nil {
+		return nil, nil
+	}
+	output := make(LeaderSchedule, len(v))
+	for identity, slots := range v {
+		output[common.PublicKeyFromString(identity)] = slots
+	}
+	return output, nil
+}
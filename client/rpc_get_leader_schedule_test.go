@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetLeaderSchedule(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F":[0,1,2,3]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetLeaderSchedule(
+						context.Background(),
+					)
+				},
+				ExpectedValue: LeaderSchedule{
+					common.PublicKeyFromString("4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F"): {0, 1, 2, 3},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetLeaderScheduleForSlotWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule", "params":[100000000000, {"commitment":"confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":null,"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetLeaderScheduleForSlotWithConfig(
+						context.Background(),
+						100000000000,
+						GetLeaderScheduleConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: LeaderSchedule(nil),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// GetMaxRetransmitSlot returns the max slot seen from retransmit stage
+func (c *Client) GetMaxRetransmitSlot(ctx context.Context) (uint64, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[uint64], error) {
+			return c.RpcClient.GetMaxRetransmitSlot(ctx)
+		},
+		forward[uint64],
+	)
+}
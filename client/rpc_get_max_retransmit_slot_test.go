@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestClient_GetMaxRetransmitSlot(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMaxRetransmitSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":1234,"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetMaxRetransmitSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: uint64(1234),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
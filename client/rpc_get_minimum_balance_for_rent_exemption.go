@@ -17,20 +17,20 @@ func (c GetMinimumBalanceForRentExemptionConfig) toRpc() rpc.GetMinimumBalanceFo
 }
 
 // GetMinimumBalanceForRentExemption returns minimum balance required to make account rent exempt
-func (c *Client) GetMinimumBalanceForRentExemption(ctx context.Context, dataLen uint64) (uint64, error) {
+func (c *Client) GetMinimumBalanceForRentExemption(ctx context.Context, dataLen uint64, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetMinimumBalanceForRentExemption(ctx, dataLen)
+			return c.RpcClient.GetMinimumBalanceForRentExemption(ctx, dataLen, opts...)
 		},
 		forward[uint64],
 	)
 }
 
 // GetMinimumBalanceForRentExemption returns minimum balance required to make account rent exempt
-func (c *Client) GetMinimumBalanceForRentExemptionWithConfig(ctx context.Context, dataLen uint64, cfg GetMinimumBalanceForRentExemptionConfig) (uint64, error) {
+func (c *Client) GetMinimumBalanceForRentExemptionWithConfig(ctx context.Context, dataLen uint64, cfg GetMinimumBalanceForRentExemptionConfig, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetMinimumBalanceForRentExemptionWithConfig(ctx, dataLen, cfg.toRpc())
+			return c.RpcClient.GetMinimumBalanceForRentExemptionWithConfig(ctx, dataLen, cfg.toRpc(), opts...)
 		},
 		forward[uint64],
 	)
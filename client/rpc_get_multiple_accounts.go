@@ -9,24 +9,27 @@ import (
 type GetMultipleAccountsConfig struct {
 	Commitment rpc.Commitment
 	DataSlice  *rpc.DataSlice
+	// Encoding is rpc.AccountEncodingBase64 (default) or rpc.AccountEncodingBase64Zstd
+	Encoding rpc.AccountEncoding
 }
 
 func (c GetMultipleAccountsConfig) toRpc() rpc.GetMultipleAccountsConfig {
 	return rpc.GetMultipleAccountsConfig{
-		Encoding:   rpc.AccountEncodingBase64,
+		Encoding:   getAccountEncoding(c.Encoding),
 		Commitment: c.Commitment,
 		DataSlice:  c.DataSlice,
 	}
 }
 
 // GetMultipleAccounts returns multiple accounts info
-func (c *Client) GetMultipleAccounts(ctx context.Context, addrs []string) ([]AccountInfo, error) {
+func (c *Client) GetMultipleAccounts(ctx context.Context, addrs []string, opts ...rpc.CallOption) ([]AccountInfo, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
-			return c.RpcClient.GetMultipleAccountsWithConfig(
+			return c.getMultipleAccounts(
 				ctx,
 				addrs,
 				GetMultipleAccountsConfig{}.toRpc(),
+				opts...,
 			)
 		},
 		convertGetMultipleAccounts,
@@ -34,13 +37,14 @@ func (c *Client) GetMultipleAccounts(ctx context.Context, addrs []string) ([]Acc
 }
 
 // GetMultipleAccountsWithConfig return account's info
-func (c *Client) GetMultipleAccountsWithConfig(ctx context.Context, addrs []string, cfg GetMultipleAccountsConfig) ([]AccountInfo, error) {
+func (c *Client) GetMultipleAccountsWithConfig(ctx context.Context, addrs []string, cfg GetMultipleAccountsConfig, opts ...rpc.CallOption) ([]AccountInfo, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
-			return c.RpcClient.GetMultipleAccountsWithConfig(
+			return c.getMultipleAccounts(
 				ctx,
 				addrs,
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		convertGetMultipleAccounts,
@@ -48,13 +52,14 @@ func (c *Client) GetMultipleAccountsWithConfig(ctx context.Context, addrs []stri
 }
 
 // GetMultipleAccounts returns multiple accounts info
-func (c *Client) GetMultipleAccountsAndContext(ctx context.Context, addrs []string) (rpc.ValueWithContext[[]AccountInfo], error) {
+func (c *Client) GetMultipleAccountsAndContext(ctx context.Context, addrs []string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]AccountInfo], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
-			return c.RpcClient.GetMultipleAccountsWithConfig(
+			return c.getMultipleAccounts(
 				ctx,
 				addrs,
 				GetMultipleAccountsConfig{}.toRpc(),
+				opts...,
 			)
 		},
 		convertGetMultipleAccountsAndContext,
@@ -62,19 +67,68 @@ func (c *Client) GetMultipleAccountsAndContext(ctx context.Context, addrs []stri
 }
 
 // GetMultipleAccountsWithConfig return account's info
-func (c *Client) GetMultipleAccountsAndContextWithConfig(ctx context.Context, addrs []string, cfg GetMultipleAccountsConfig) (rpc.ValueWithContext[[]AccountInfo], error) {
+func (c *Client) GetMultipleAccountsAndContextWithConfig(ctx context.Context, addrs []string, cfg GetMultipleAccountsConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[[]AccountInfo], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
-			return c.RpcClient.GetMultipleAccountsWithConfig(
+			return c.getMultipleAccounts(
 				ctx,
 				addrs,
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		convertGetMultipleAccountsAndContext,
 	)
 }
 
+// getMultipleAccounts splits addrs into chunks of rpc.MaxMultipleAccounts and sends them in one batch request.
+// the accounts are returned in the order of addrs, the context is the one with the lowest slot.
+func (c *Client) getMultipleAccounts(ctx context.Context, addrs []string, cfg rpc.GetMultipleAccountsConfig, opts ...rpc.CallOption) (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
+	if len(addrs) <= rpc.MaxMultipleAccounts {
+		return c.RpcClient.GetMultipleAccountsWithConfig(ctx, addrs, cfg, opts...)
+	}
+
+	var requests []rpc.BatchRequest
+	for i := 0; i < len(addrs); i += rpc.MaxMultipleAccounts {
+		end := i + rpc.MaxMultipleAccounts
+		if end > len(addrs) {
+			end = len(addrs)
+		}
+		requests = append(requests, rpc.BatchRequest{
+			Method:  "getMultipleAccounts",
+			Params:  []any{addrs[i:end]},
+			Config:  cfg,
+			Options: opts,
+		})
+	}
+	responses, err := c.RpcClient.CallBatch(ctx, requests)
+	if err != nil {
+		return rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]]{}, err
+	}
+
+	output := rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]]{
+		JsonRpc: "2.0",
+		Result: rpc.ValueWithContext[[]rpc.AccountInfo]{
+			Value: make([]rpc.AccountInfo, 0, len(addrs)),
+		},
+	}
+	for i, res := range responses {
+		if res.Error != nil {
+			output.Error = res.Error
+			return output, nil
+		}
+		v, err := rpc.BatchResult[rpc.ValueWithContext[[]rpc.AccountInfo]](res)
+		if err != nil {
+			return output, err
+		}
+		if i == 0 || v.Context.Slot < output.Result.Context.Slot {
+			output.Result.Context = v.Context
+		}
+		output.Result.Value = append(output.Result.Value, v.Value...)
+	}
+	return output, nil
+}
+
 func convertGetMultipleAccounts(v rpc.ValueWithContext[[]rpc.AccountInfo]) ([]AccountInfo, error) {
 	output := make([]AccountInfo, 0, len(v.Value))
 	for _, rac := range v.Value {
@@ -2,11 +2,16 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/internal/client_test"
 	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_GetMultipleAccounts(t *testing.T) {
@@ -230,3 +235,60 @@ func TestClient_GetMultipleAccountsAndContextWithConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestClient_GetMultipleAccountsAndContext_Chunks(t *testing.T) {
+	addrs := make([]string, 0, 250)
+	for i := 0; i < 250; i++ {
+		addrs = append(addrs, types.NewAccount().PublicKey.ToBase58())
+	}
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		var requests []struct {
+			Id     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&requests))
+		assert.Len(t, requests, 3)
+
+		var responses []rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]]
+		for i, request := range requests {
+			assert.Equal(t, "getMultipleAccounts", request.Method)
+			assert.JSONEq(t, `{"encoding":"base64","commitment":"confirmed"}`, string(request.Params[1]))
+			var chunk []string
+			assert.Nil(t, json.Unmarshal(request.Params[0], &chunk))
+			end := i*100 + 100
+			if end > len(addrs) {
+				end = len(addrs)
+			}
+			assert.Equal(t, addrs[i*100:end], chunk)
+
+			// each account has the index of its address as lamports
+			infos := make([]rpc.AccountInfo, 0, len(chunk))
+			for j := range chunk {
+				infos = append(infos, rpc.AccountInfo{Lamports: uint64(i*100 + j), Owner: common.SystemProgramID.ToBase58(), Data: []any{"", "base64"}})
+			}
+			responses = append(responses, rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]]{
+				JsonRpc: "2.0",
+				Id:      request.Id,
+				Result:  rpc.ValueWithContext[[]rpc.AccountInfo]{Context: rpc.Context{Slot: uint64(300 - i)}, Value: infos},
+			})
+		}
+		// out of order
+		responses[0], responses[2] = responses[2], responses[0]
+		b, _ := json.Marshal(responses)
+		_, _ = rw.Write(b)
+	}))
+	defer server.Close()
+
+	got, err := NewClient(server.URL).GetMultipleAccountsAndContext(context.Background(), addrs, rpc.WithCommitment(rpc.CommitmentConfirmed))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, uint64(298), got.Context.Slot)
+	assert.Len(t, got.Value, len(addrs))
+	for i, v := range got.Value {
+		assert.Equal(t, uint64(i), v.Lamports)
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetParsedAccountInfoConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetParsedAccountInfoConfig) toRpc() rpc.GetAccountInfoConfig {
+	return rpc.GetAccountInfoConfig{
+		Encoding:   rpc.AccountEncodingJsonParsed,
+		Commitment: c.Commitment,
+	}
+}
+
+// GetParsedAccountInfo returns account's info with jsonParsed encoding,
+// nonce, token, stake and vote accounts are decoded into typed structs
+func (c *Client) GetParsedAccountInfo(ctx context.Context, base58Addr string, opts ...rpc.CallOption) (ParsedAccountInfo, error) {
+	return c.GetParsedAccountInfoWithConfig(ctx, base58Addr, GetParsedAccountInfoConfig{}, opts...)
+}
+
+// GetParsedAccountInfoWithConfig returns account's info with jsonParsed encoding
+func (c *Client) GetParsedAccountInfoWithConfig(ctx context.Context, base58Addr string, cfg GetParsedAccountInfoConfig, opts ...rpc.CallOption) (ParsedAccountInfo, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, cfg.toRpc(), opts...)
+		},
+		convertGetParsedAccountInfo,
+	)
+}
+
+func convertGetParsedAccountInfo(v rpc.ValueWithContext[rpc.AccountInfo]) (ParsedAccountInfo, error) {
+	return convertParsedAccountInfo(v.Value)
+}
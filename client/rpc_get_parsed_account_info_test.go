@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetParsedAccountInfo(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				Name:         "token account",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"isNative":false,"mint":"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb","owner":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","state":"initialized","tokenAmount":{"amount":"1000000000","decimals":9,"uiAmount":1.0,"uiAmountString":"1"}},"type":"account"},"program":"spl-token","space":165},"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 2039280,
+					Owner:    common.TokenProgramID,
+					Data: ParsedAccountData{
+						Program: ParsedProgramSplToken,
+						Space:   165,
+						Type:    "account",
+						TokenAccount: &ParsedTokenAccount{
+							Mint:  common.PublicKeyFromString("F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb"),
+							Owner: common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+							TokenAmount: TokenAmount{
+								Amount:         1000000000,
+								Decimals:       9,
+								UIAmountString: "1",
+							},
+							State: "initialized",
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "mint",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"decimals":9,"freezeAuthority":null,"isInitialized":true,"mintAuthority":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","supply":"1000000000000"},"type":"mint"},"program":"spl-token","space":82},"executable":false,"lamports":1461600,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 1461600,
+					Owner:    common.TokenProgramID,
+					Data: ParsedAccountData{
+						Program: ParsedProgramSplToken,
+						Space:   82,
+						Type:    "mint",
+						Mint: &ParsedMint{
+							MintAuthority: pointer.Get(common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")),
+							Supply:        1000000000000,
+							Decimals:      9,
+							IsInitialized: true,
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "nonce",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["DJyNpXgggw1WGgjTVzFsNjb3fuQZVXYhXEhUb3VXoXL4", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"authority":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","blockhash":"8wDrJu3A9ukG3hFy98fy5J7HQ7qPufhBBEMhyFVrHAYL","feeCalculator":{"lamportsPerSignature":"5000"}},"type":"initialized"},"program":"nonce","space":80},"executable":false,"lamports":1447680,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"DJyNpXgggw1WGgjTVzFsNjb3fuQZVXYhXEhUb3VXoXL4",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 1447680,
+					Owner:    common.SystemProgramID,
+					Data: ParsedAccountData{
+						Program: ParsedProgramNonce,
+						Space:   80,
+						Type:    "initialized",
+						Nonce: &ParsedNonceAccount{
+							Authority:     common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+							Blockhash:     "8wDrJu3A9ukG3hFy98fy5J7HQ7qPufhBBEMhyFVrHAYL",
+							FeeCalculator: ParsedFeeCalculator{LamportsPerSignature: 5000},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "stake",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"meta":{"authorized":{"staker":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","withdrawer":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},"lockup":{"custodian":"11111111111111111111111111111111","epoch":0,"unixTimestamp":0},"rentExemptReserve":"2282880"},"stake":{"creditsObserved":1234,"delegation":{"activationEpoch":"490","deactivationEpoch":"18446744073709551615","stake":"997717120","voter":"FwR3PbjS5iyqzLiLugrBqKSa5EKZ4vK9SKs7eQXtT59f","warmupCooldownRate":0.25}}},"type":"delegated"},"program":"stake","space":200},"executable":false,"lamports":1000000000,"owner":"Stake11111111111111111111111111111111111111","rentEpoch":18446744073709551615}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports:  1000000000,
+					Owner:     common.StakeProgramID,
+					RentEpoch: 18446744073709551615,
+					Data: ParsedAccountData{
+						Program: ParsedProgramStake,
+						Space:   200,
+						Type:    "delegated",
+						Stake: &ParsedStakeAccount{
+							Meta: ParsedStakeMeta{
+								RentExemptReserve: 2282880,
+								Authorized: ParsedStakeAuthorized{
+									Staker:     common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+									Withdrawer: common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								},
+								Lockup: ParsedStakeLockup{
+									Custodian: common.SystemProgramID,
+								},
+							},
+							Stake: &ParsedStakeStake{
+								Delegation: ParsedStakeDelegation{
+									Voter:              common.PublicKeyFromString("FwR3PbjS5iyqzLiLugrBqKSa5EKZ4vK9SKs7eQXtT59f"),
+									Stake:              997717120,
+									ActivationEpoch:    490,
+									DeactivationEpoch:  18446744073709551615,
+									WarmupCooldownRate: 0.25,
+								},
+								CreditsObserved: 1234,
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "vote",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["FwR3PbjS5iyqzLiLugrBqKSa5EKZ4vK9SKs7eQXtT59f", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"authorizedVoters":[{"authorizedVoter":"9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF","epoch":490}],"authorizedWithdrawer":"9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF","commission":10,"epochCredits":[{"credits":"5000","epoch":490,"previousCredits":"4000"}],"lastTimestamp":{"slot":213071380,"timestamp":1689999999},"nodePubkey":"7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2","priorVoters":[],"rootSlot":213071349,"votes":[{"confirmationCount":2,"slot":213071379},{"confirmationCount":1,"slot":213071380}]},"type":"vote"},"program":"vote","space":3731},"executable":false,"lamports":27074400,"owner":"Vote111111111111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"FwR3PbjS5iyqzLiLugrBqKSa5EKZ4vK9SKs7eQXtT59f",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 27074400,
+					Owner:    common.VoteProgramID,
+					Data: ParsedAccountData{
+						Program: ParsedProgramVote,
+						Space:   3731,
+						Type:    "vote",
+						Vote: &ParsedVoteAccount{
+							NodePubkey:           common.PublicKeyFromString("7Np41oeYqPefeNQEHSv1UDhYrehxin3NStELsSKCT4K2"),
+							AuthorizedWithdrawer: common.PublicKeyFromString("9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF"),
+							Commission:           10,
+							Votes: []ParsedVoteLockout{
+								{Slot: 213071379, ConfirmationCount: 2},
+								{Slot: 213071380, ConfirmationCount: 1},
+							},
+							RootSlot: pointer.Get[uint64](213071349),
+							AuthorizedVoters: []ParsedVoteAuthorizedVoter{
+								{Epoch: 490, AuthorizedVoter: common.PublicKeyFromString("9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF")},
+							},
+							PriorVoters: []ParsedVotePriorVoter{},
+							EpochCredits: []ParsedVoteEpochCredits{
+								{Epoch: 490, Credits: 5000, PreviousCredits: 4000},
+							},
+							LastTimestamp: ParsedVoteTimestamp{Slot: 213071380, Timestamp: 1689999999},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "untyped program",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["SysvarC1ock11111111111111111111111111111111", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":{"parsed":{"info":{"epoch":493,"slot":213071383},"type":"clock"},"program":"sysvar","space":40},"executable":false,"lamports":1169280,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"SysvarC1ock11111111111111111111111111111111",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 1169280,
+					Owner:    common.PublicKeyFromString("Sysvar1111111111111111111111111111111111111"),
+					Data: ParsedAccountData{
+						Program: "sysvar",
+						Space:   40,
+						Type:    "clock",
+						Info:    json.RawMessage(`{"epoch":493,"slot":213071383}`),
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "not parsable",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":["AQID","base64"],"executable":false,"lamports":1000000,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 1000000,
+					Owner:    common.SystemProgramID,
+					Data: ParsedAccountData{
+						Raw: []byte{1, 2, 3},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "not found",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":null},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfo(
+						context.Background(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetParsedAccountInfoWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"encoding": "jsonParsed", "commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":{"data":["","base64"],"executable":false,"lamports":1000000,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedAccountInfoWithConfig(
+						context.Background(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+						GetParsedAccountInfoConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: ParsedAccountInfo{
+					Lamports: 1000000,
+					Owner:    common.SystemProgramID,
+					Data: ParsedAccountData{
+						Raw: []byte{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetParsedMultipleAccountsConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetParsedMultipleAccountsConfig) toRpc() rpc.GetMultipleAccountsConfig {
+	return rpc.GetMultipleAccountsConfig{
+		Encoding:   rpc.AccountEncodingJsonParsed,
+		Commitment: c.Commitment,
+	}
+}
+
+// GetParsedMultipleAccounts returns multiple accounts info with jsonParsed encoding
+func (c *Client) GetParsedMultipleAccounts(ctx context.Context, addrs []string, opts ...rpc.CallOption) ([]ParsedAccountInfo, error) {
+	return c.GetParsedMultipleAccountsWithConfig(ctx, addrs, GetParsedMultipleAccountsConfig{}, opts...)
+}
+
+// GetParsedMultipleAccountsWithConfig returns multiple accounts info with jsonParsed encoding
+func (c *Client) GetParsedMultipleAccountsWithConfig(ctx context.Context, addrs []string, cfg GetParsedMultipleAccountsConfig, opts ...rpc.CallOption) ([]ParsedAccountInfo, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
+			return c.getMultipleAccounts(ctx, addrs, cfg.toRpc(), opts...)
+		},
+		convertGetParsedMultipleAccounts,
+	)
+}
+
+func convertGetParsedMultipleAccounts(v rpc.ValueWithContext[[]rpc.AccountInfo]) ([]ParsedAccountInfo, error) {
+	output := make([]ParsedAccountInfo, 0, len(v.Value))
+	for _, rac := range v.Value {
+		ac, err := convertParsedAccountInfo(rac)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, ac)
+	}
+	return output, nil
+}
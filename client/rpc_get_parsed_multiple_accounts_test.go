@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetParsedMultipleAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", "9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"], {"encoding": "jsonParsed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":[{"data":{"parsed":{"info":{"decimals":9,"freezeAuthority":null,"isInitialized":true,"mintAuthority":null,"supply":"1000000000000"},"type":"mint"},"program":"spl-token","space":82},"executable":false,"lamports":1461600,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":0},{"data":["","base64"],"executable":false,"lamports":1000000,"owner":"11111111111111111111111111111111","rentEpoch":0},null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedMultipleAccounts(
+						context.Background(),
+						[]string{
+							"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb",
+							"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+							"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D",
+						},
+					)
+				},
+				ExpectedValue: []ParsedAccountInfo{
+					{
+						Lamports: 1461600,
+						Owner:    common.TokenProgramID,
+						Data: ParsedAccountData{
+							Program: ParsedProgramSplToken,
+							Space:   82,
+							Type:    "mint",
+							Mint: &ParsedMint{
+								Supply:        1000000000000,
+								Decimals:      9,
+								IsInitialized: true,
+							},
+						},
+					},
+					{
+						Lamports: 1000000,
+						Owner:    common.SystemProgramID,
+						Data: ParsedAccountData{
+							Raw: []byte{},
+						},
+					},
+					{},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetParsedMultipleAccountsWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"], {"encoding": "jsonParsed", "commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.16.7","slot":213071383},"value":[null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedMultipleAccountsWithConfig(
+						context.Background(),
+						[]string{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},
+						GetParsedMultipleAccountsConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: []ParsedAccountInfo{{}},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetParsedTransactionConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetParsedTransactionConfig) toRpc() rpc.GetTransactionConfig {
+	return rpc.GetTransactionConfig{
+		Commitment:                     c.Commitment,
+		Encoding:                       rpc.TransactionEncodingJsonParsed,
+		MaxSupportedTransactionVersion: pointer.Get[uint8](0),
+	}
+}
+
+// GetParsedTransaction returns a confirmed transaction with jsonParsed encoding,
+// the instructions of the known programs carry their parsed type and info
+func (c *Client) GetParsedTransaction(ctx context.Context, txhash string, opts ...rpc.CallOption) (*ParsedTransaction, error) {
+	return c.GetParsedTransactionWithConfig(ctx, txhash, GetParsedTransactionConfig{}, opts...)
+}
+
+// GetParsedTransactionWithConfig returns a confirmed transaction with jsonParsed encoding
+func (c *Client) GetParsedTransactionWithConfig(ctx context.Context, txhash string, cfg GetParsedTransactionConfig, opts ...rpc.CallOption) (*ParsedTransaction, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[*rpc.GetTransaction], error) {
+			return c.RpcClient.GetTransactionWithConfig(ctx, txhash, cfg.toRpc(), opts...)
+		},
+		convertParsedTransaction,
+	)
+}
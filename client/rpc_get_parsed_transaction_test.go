@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetParsedTransaction(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTransaction", "params":["4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq", {"encoding": "jsonParsed", "maxSupportedTransactionVersion": 0}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"blockTime":1689999999,"meta":{"computeUnitsConsumed":3000,"err":null,"fee":5000,"innerInstructions":[{"index":1,"instructions":[{"parsed":{"info":{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":1,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111","stackHeight":2}]}],"logMessages":["Program 11111111111111111111111111111111 invoke [1]","Program 11111111111111111111111111111111 success"],"postBalances":[989995000,10000000,1],"postTokenBalances":[],"preBalances":[1000000000,0,1],"preTokenBalances":[],"rewards":[],"status":{"Ok":null}},"slot":213071383,"transaction":{"message":{"accountKeys":[{"pubkey":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","signer":true,"source":"transaction","writable":true},{"pubkey":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","signer":false,"source":"transaction","writable":true},{"pubkey":"11111111111111111111111111111111","signer":false,"source":"transaction","writable":false}],"instructions":[{"parsed":{"info":{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":10000000,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111","stackHeight":null},{"accounts":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"],"data":"3Bxs4Bc3VYuGVB19","programId":"CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r","stackHeight":null},{"parsed":"hello","program":"spl-memo","programId":"MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr","stackHeight":null}],"recentBlockhash":"8wDrJu3A9ukG3hFy98fy5J7HQ7qPufhBBEMhyFVrHAYL"},"signatures":["4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq"]},"version":0},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedTransaction(
+						context.Background(),
+						"4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq",
+					)
+				},
+				ExpectedValue: &ParsedTransaction{
+					Slot:       213071383,
+					BlockTime:  pointer.Get[int64](1689999999),
+					Version:    types.MessageVersionV0,
+					Signatures: []string{"4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq"},
+					AccountKeys: []ParsedAccountKey{
+						{PublicKey: common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"), Signer: true, Writable: true, Source: "transaction"},
+						{PublicKey: common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"), Signer: false, Writable: true, Source: "transaction"},
+						{PublicKey: common.SystemProgramID, Signer: false, Writable: false, Source: "transaction"},
+					},
+					RecentBlockhash: "8wDrJu3A9ukG3hFy98fy5J7HQ7qPufhBBEMhyFVrHAYL",
+					Instructions: []ParsedInstruction{
+						{
+							Program:   "system",
+							ProgramID: common.SystemProgramID,
+							Type:      "transfer",
+							Info:      json.RawMessage(`{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":10000000,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}`),
+						},
+						{
+							ProgramID: common.PublicKeyFromString("CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r"),
+							Accounts: []common.PublicKey{
+								common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"),
+							},
+							Data: []byte{2, 0, 0, 0, 64, 66, 15, 0, 0, 0, 0, 0},
+						},
+						{
+							Program:   "spl-memo",
+							ProgramID: common.MemoProgramID,
+							Info:      json.RawMessage(`"hello"`),
+						},
+					},
+					Meta: &ParsedTransactionMeta{
+						Fee:                    5000,
+						PreBalances:            []int64{1000000000, 0, 1},
+						PostBalances:           []int64{989995000, 10000000, 1},
+						PreTokenBalances:       []rpc.TransactionMetaTokenBalance{},
+						TypedPreTokenBalances:  []TransactionTokenBalance{},
+						PostTokenBalances:      []rpc.TransactionMetaTokenBalance{},
+						TypedPostTokenBalances: []TransactionTokenBalance{},
+						LogMessages: []string{
+							"Program 11111111111111111111111111111111 invoke [1]",
+							"Program 11111111111111111111111111111111 success",
+						},
+						InnerInstructions: []ParsedInnerInstruction{
+							{
+								Index: 1,
+								Instructions: []ParsedInstruction{
+									{
+										Program:     "system",
+										ProgramID:   common.SystemProgramID,
+										Type:        "transfer",
+										Info:        json.RawMessage(`{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":1,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}`),
+										StackHeight: pointer.Get[uint64](2),
+									},
+								},
+							},
+						},
+						ComputeUnitsConsumed: pointer.Get[uint64](3000),
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "not found",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTransaction", "params":["4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq", {"encoding": "jsonParsed", "maxSupportedTransactionVersion": 0}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":null,"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetParsedTransaction(
+						context.Background(),
+						"4Dj8Xbs7L6z7pbNp5eGZXLgYFFZ3EYeRCm4hVFXHB9oPz4AgWHaTvBxZS6bcdCgGBxjWrHDHWVRNUYqPzLmDVXYq",
+					)
+				},
+				ExpectedValue: (*ParsedTransaction)(nil),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestParsedInstruction_DecodeInfo(t *testing.T) {
+	instruction := ParsedInstruction{
+		Program:   "spl-token",
+		ProgramID: common.TokenProgramID,
+		Type:      "transferChecked",
+		Info:      json.RawMessage(`{"authority":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","mint":"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb","source":"CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r","tokenAmount":{"amount":"1500","decimals":3,"uiAmount":1.5,"uiAmountString":"1.5"}}`),
+	}
+	var info ParsedTokenTransferChecked
+	assert.Nil(t, instruction.DecodeInfo(&info))
+	assert.Equal(t, ParsedTokenTransferChecked{
+		Source:      common.PublicKeyFromString("CYdUt2yCpzGGw9jmxLzAQw8dkQ43ZnZgNmo5sWnrGg6r"),
+		Mint:        common.PublicKeyFromString("F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb"),
+		Destination: common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"),
+		TokenAmount: TokenAmount{Amount: 1500, Decimals: 3, UIAmountString: "1.5"},
+		Authority:   pointer.Get(common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")),
+	}, info)
+
+	assert.EqualError(t, ParsedInstruction{Data: []byte{1}}.DecodeInfo(&info), "instruction is not parsed")
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type ProgramAccount struct {
+	PublicKey   common.PublicKey
+	AccountInfo AccountInfo
+}
+
+type GetProgramAccountsConfig struct {
+	Commitment     rpc.Commitment
+	DataSlice      *rpc.DataSlice
+	Filters        []rpc.GetProgramAccountsConfigFilter
+	MinContextSlot *uint64
+	// Encoding is rpc.AccountEncodingBase64 (default) or rpc.AccountEncodingBase64Zstd
+	Encoding rpc.AccountEncoding
+}
+
+func (c GetProgramAccountsConfig) toRpc() rpc.GetProgramAccountsConfig {
+	return rpc.GetProgramAccountsConfig{
+		Encoding:       getAccountEncoding(c.Encoding),
+		Commitment:     c.Commitment,
+		DataSlice:      c.DataSlice,
+		Filters:        c.Filters,
+		MinContextSlot: c.MinContextSlot,
+	}
+}
+
+// GetProgramAccounts returns all accounts owned by the program
+func (c *Client) GetProgramAccounts(ctx context.Context, programId string, opts ...rpc.CallOption) ([]ProgramAccount, error) {
+	return c.GetProgramAccountsWithConfig(ctx, programId, GetProgramAccountsConfig{}, opts...)
+}
+
+// GetProgramAccountsWithConfig returns the accounts owned by the program which match all filters
+func (c *Client) GetProgramAccountsWithConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig, opts ...rpc.CallOption) ([]ProgramAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetProgramAccounts], error) {
+			return c.RpcClient.GetProgramAccountsWithConfig(ctx, programId, cfg.toRpc(), opts...)
+		},
+		convertGetProgramAccounts,
+	)
+}
+
+// GetProgramAccountsAndContext returns all accounts owned by the program
+func (c *Client) GetProgramAccountsAndContext(ctx context.Context, programId string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]ProgramAccount], error) {
+	return c.GetProgramAccountsAndContextWithConfig(ctx, programId, GetProgramAccountsConfig{}, opts...)
+}
+
+// GetProgramAccountsAndContextWithConfig returns the accounts owned by the program which match all filters
+func (c *Client) GetProgramAccountsAndContextWithConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[[]ProgramAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetProgramAccountsWithContext], error) {
+			return c.RpcClient.GetProgramAccountsWithContextAndConfig(ctx, programId, cfg.toRpc(), opts...)
+		},
+		convertGetProgramAccountsAndContext,
+	)
+}
+
+func convertGetProgramAccounts(v rpc.GetProgramAccounts) ([]ProgramAccount, error) {
+	output := make([]ProgramAccount, 0, len(v))
+	for _, v := range v {
+		accountInfo, err := convertAccountInfo(v.Account)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, ProgramAccount{
+			PublicKey:   common.PublicKeyFromString(v.Pubkey),
+			AccountInfo: accountInfo,
+		})
+	}
+	return output, nil
+}
+
+func convertGetProgramAccountsAndContext(v rpc.GetProgramAccountsWithContext) (rpc.ValueWithContext[[]ProgramAccount], error) {
+	programAccounts, err := convertGetProgramAccounts(v.Value)
+	if err != nil {
+		return rpc.ValueWithContext[[]ProgramAccount]{}, err
+	}
+	return rpc.ValueWithContext[[]ProgramAccount]{
+		Context: v.Context,
+		Value:   programAccounts,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetProgramAccountsWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getProgramAccounts", "params":["TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", {"encoding":"base64", "commitment":"confirmed", "dataSlice":{"offset":0,"length":3}, "filters":[{"dataSize":165},{"memcmp":{"offset":32,"bytes":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}}]}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"account":{"data":["AQID","base64"],"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":181},"pubkey":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetProgramAccountsWithConfig(
+						context.Background(),
+						"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+						GetProgramAccountsConfig{
+							Commitment: rpc.CommitmentConfirmed,
+							DataSlice:  &rpc.DataSlice{Offset: 0, Length: 3},
+							Filters: []rpc.GetProgramAccountsConfigFilter{
+								rpc.NewDataSizeFilter(165),
+								rpc.NewMemCmpFilter(32, common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7").Bytes()),
+							},
+						},
+					)
+				},
+				ExpectedValue: []ProgramAccount{
+					{
+						PublicKey: common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"),
+						AccountInfo: AccountInfo{
+							Lamports:  2039280,
+							Owner:     common.TokenProgramID,
+							RentEpoch: 181,
+							Data:      []byte{1, 2, 3},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "with base64+zstd",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getProgramAccounts", "params":["TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", {"encoding": "base64+zstd", "filters":[{"dataSize": 165}]}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"account":{"data":["KLUv/QBYbQIANATs5kvExA7d9DwekfxJB78GIvCdh5i2DIdwRkIs0WfLghCWWRdefGQzIaXtRkKgJ7Cr2XuN2XrRvMbcZHE4bM3cAAEAAgAEJwaY4Aw=","base64+zstd"],"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":181},"pubkey":"Dh4w3Pn6HqCDbEDhZdcDY8bHydeqNAhYY6EktLiWxFf6"}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetProgramAccountsWithConfig(
+						context.Background(),
+						"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+						GetProgramAccountsConfig{
+							Encoding: rpc.AccountEncodingBase64Zstd,
+							Filters: []rpc.GetProgramAccountsConfigFilter{
+								rpc.NewDataSizeFilter(165),
+							},
+						},
+					)
+				},
+				ExpectedValue: []ProgramAccount{
+					{
+						PublicKey: common.PublicKeyFromString("Dh4w3Pn6HqCDbEDhZdcDY8bHydeqNAhYY6EktLiWxFf6"),
+						AccountInfo: AccountInfo{
+							Lamports:  2039280,
+							Owner:     common.TokenProgramID,
+							RentEpoch: 181,
+							Data:      []byte{236, 230, 75, 196, 196, 14, 221, 244, 60, 30, 145, 252, 73, 7, 191, 6, 34, 240, 157, 135, 152, 182, 12, 135, 112, 70, 66, 44, 209, 103, 203, 130, 16, 150, 89, 23, 94, 124, 100, 51, 33, 165, 237, 70, 66, 160, 39, 176, 171, 217, 123, 141, 217, 122, 209, 188, 198, 220, 100, 113, 56, 108, 205, 220, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "unsupported encoding",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getProgramAccounts", "params":["TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", {"encoding": "base58"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"account":{"data":["Ldp","base58"],"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":181},"pubkey":"Dh4w3Pn6HqCDbEDhZdcDY8bHydeqNAhYY6EktLiWxFf6"}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetProgramAccountsWithConfig(
+						context.Background(),
+						"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+						GetProgramAccountsConfig{
+							Encoding: rpc.AccountEncodingBase58,
+						},
+					)
+				},
+				ExpectedValue: []ProgramAccount(nil),
+				ExpectedError: fmt.Errorf("return value should be base64 or base64+zstd encoded"),
+			},
+		},
+	)
+}
+
+func TestClient_GetProgramAccountsAndContext(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getProgramAccounts", "params":["TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", {"encoding":"base64", "withContext":true}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":100},"value":[{"account":{"data":["AQID","base64"],"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":181},"pubkey":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetProgramAccountsAndContext(
+						context.Background(),
+						"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+					)
+				},
+				ExpectedValue: rpc.ValueWithContext[[]ProgramAccount]{
+					Context: rpc.Context{Slot: 100},
+					Value: []ProgramAccount{
+						{
+							PublicKey: common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"),
+							AccountInfo: AccountInfo{
+								Lamports:  2039280,
+								Owner:     common.TokenProgramID,
+								RentEpoch: 181,
+								Data:      []byte{1, 2, 3},
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
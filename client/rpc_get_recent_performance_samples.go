@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type PerformanceSample struct {
+	Slot            uint64
+	NumTransactions uint64
+	// NumNonVoteTransactions is nil if the node doesn't report it
+	NumNonVoteTransactions *uint64
+	NumSlots               uint64
+	SamplePeriodSecs       uint16
+}
+
+// TransactionsPerSecond returns the average tps in the sample period
+func (s PerformanceSample) TransactionsPerSecond() float64 {
+	if s.SamplePeriodSecs == 0 {
+		return 0
+	}
+	return float64(s.NumTransactions) / float64(s.SamplePeriodSecs)
+}
+
+// GetRecentPerformanceSamples returns a list of recent performance samples, in reverse slot order.
+// Performance samples are taken every 60 seconds.
+func (c *Client) GetRecentPerformanceSamples(ctx context.Context) ([]PerformanceSample, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]rpc.GetRecentPerformanceSample], error) {
+			return c.RpcClient.GetRecentPerformanceSamples(ctx)
+		},
+		convertGetRecentPerformanceSamples,
+	)
+}
+
+// GetRecentPerformanceSamplesWithLimit returns at most limit recent performance samples, max limit is 720
+func (c *Client) GetRecentPerformanceSamplesWithLimit(ctx context.Context, limit uint64, opts ...rpc.CallOption) ([]PerformanceSample, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]rpc.GetRecentPerformanceSample], error) {
+			return c.RpcClient.GetRecentPerformanceSamplesWithLimit(ctx, limit, opts...)
+		},
+		convertGetRecentPerformanceSamples,
+	)
+}
+
+func convertGetRecentPerformanceSamples(v []rpc.GetRecentPerformanceSample) ([]PerformanceSample, error) {
+	output := make([]PerformanceSample, 0, len(v))
+	for _, s := range v {
+		output = append(output, PerformanceSample(s))
+	}
+	return output, nil
+}
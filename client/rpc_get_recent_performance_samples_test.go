@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetRecentPerformanceSamples(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPerformanceSamples"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"numSlots":126,"numTransactions":126,"numNonVoteTransactions":1,"samplePeriodSecs":60,"slot":348125}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetRecentPerformanceSamples(
+						context.Background(),
+					)
+				},
+				ExpectedValue: []PerformanceSample{
+					{
+						Slot:                   348125,
+						NumTransactions:        126,
+						NumNonVoteTransactions: pointer.Get[uint64](1),
+						NumSlots:               126,
+						SamplePeriodSecs:       60,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetRecentPerformanceSamplesWithLimit(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPerformanceSamples", "params":[2]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetRecentPerformanceSamplesWithLimit(
+						context.Background(),
+						2,
+					)
+				},
+				ExpectedValue: []PerformanceSample{},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPerformanceSamples", "params":[2, {"minContextSlot":100}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetRecentPerformanceSamplesWithLimit(
+						context.Background(),
+						2,
+						rpc.WithMinContextSlot(100),
+					)
+				},
+				ExpectedValue: []PerformanceSample{},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestPerformanceSample_TransactionsPerSecond(t *testing.T) {
+	assert.Equal(t, 2.5, PerformanceSample{NumTransactions: 150, SamplePeriodSecs: 60}.TransactionsPerSecond())
+	assert.Equal(t, 0.0, PerformanceSample{NumTransactions: 150}.TransactionsPerSecond())
+}
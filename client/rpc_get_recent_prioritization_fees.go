@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// GetRecentPrioritizationFees returns the prioritization fees of recent slots, see rpc.GetRecentPrioritizationFees
+func (c *Client) GetRecentPrioritizationFees(ctx context.Context, addresses []string) (rpc.GetRecentPrioritizationFees, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetRecentPrioritizationFees], error) {
+			return c.RpcClient.GetRecentPrioritizationFees(ctx, addresses)
+		},
+		forward[rpc.GetRecentPrioritizationFees],
+	)
+}
+
+// EstimatePriorityFee returns the compute unit price in micro-lamports at the percentile (0~100) of the recent
+// prioritization fees of the writable accounts. the price can be set by compute_budget.SetComputeUnitPrice.
+func (c *Client) EstimatePriorityFee(ctx context.Context, writableAccounts []string, percentile uint8) (uint64, error) {
+	if percentile > 100 {
+		return 0, fmt.Errorf("percentile should be between 0 and 100, got: %v", percentile)
+	}
+	fees, err := c.GetRecentPrioritizationFees(ctx, writableAccounts)
+	if err != nil {
+		return 0, err
+	}
+	return prioritizationFeePercentile(fees, percentile), nil
+}
+
+// prioritizationFeePercentile picks the fee by the nearest-rank method
+func prioritizationFeePercentile(fees rpc.GetRecentPrioritizationFees, percentile uint8) uint64 {
+	if len(fees) == 0 {
+		return 0
+	}
+	values := make([]uint64, 0, len(fees))
+	for _, fee := range fees {
+		values = append(values, fee.PrioritizationFee)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	rank := (int(percentile)*len(values) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return values[rank-1]
+}
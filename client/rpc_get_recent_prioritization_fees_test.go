@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_EstimatePriorityFee(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPrioritizationFees", "params":[["CxELquR1gPP8wHe33gZ4QxqGB3sZ9RSwsJ2KshVewkFY"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"prioritizationFee":0,"slot":1},{"prioritizationFee":400,"slot":2},{"prioritizationFee":100,"slot":3},{"prioritizationFee":300,"slot":4},{"prioritizationFee":200,"slot":5}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.EstimatePriorityFee(context.Background(), []string{"CxELquR1gPP8wHe33gZ4QxqGB3sZ9RSwsJ2KshVewkFY"}, 75)
+				},
+				ExpectedValue: uint64(300),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestPrioritizationFeePercentile(t *testing.T) {
+	fees := rpc.GetRecentPrioritizationFees{}
+	assert.Equal(t, uint64(0), prioritizationFeePercentile(fees, 50))
+
+	for i := 10; i >= 1; i-- {
+		fees = append(fees, rpc.PrioritizationFee{Slot: uint64(i), PrioritizationFee: uint64(i * 10)})
+	}
+	tests := []struct {
+		percentile uint8
+		expected   uint64
+	}{
+		{0, 10},
+		{1, 10},
+		{50, 50},
+		{51, 60},
+		{90, 90},
+		{100, 100},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, prioritizationFeePercentile(fees, tt.percentile), tt.percentile)
+	}
+}
@@ -2,11 +2,16 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/liangjies/solana-go-sdk/rpc"
 )
 
+// MaxSignatureStatusesLength is the max number of signatures in a `getSignatureStatuses` request
+const MaxSignatureStatusesLength = 256
+
 type GetSignatureStatusesConfig struct {
+	// SearchTransactionHistory searches the ledger for signatures which are not in the recent status cache
 	SearchTransactionHistory bool
 }
 
@@ -16,42 +21,64 @@ func (c GetSignatureStatusesConfig) toRpc() rpc.GetSignatureStatusesConfig {
 	}
 }
 
-func (c *Client) GetSignatureStatus(ctx context.Context, signature string) (*rpc.SignatureStatus, error) {
+// GetSignatureStatus returns the status of a signature, the status is nil if the signature is not found
+func (c *Client) GetSignatureStatus(ctx context.Context, signature string, opts ...rpc.CallOption) (*rpc.SignatureStatus, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.SignatureStatuses]], error) {
-			return c.RpcClient.GetSignatureStatuses(ctx, []string{signature})
-		},
-		func(v rpc.ValueWithContext[rpc.SignatureStatuses]) (*rpc.SignatureStatus, error) {
-			return v.Value[0], nil
+			return c.RpcClient.GetSignatureStatuses(ctx, []string{signature}, opts...)
 		},
+		convertGetSignatureStatus,
 	)
 }
 
-func (c *Client) GetSignatureStatusWithConfig(ctx context.Context, signature string, cfg GetSignatureStatusesConfig) (*rpc.SignatureStatus, error) {
+// GetSignatureStatusWithConfig returns the status of a signature, the status is nil if the signature is not found
+func (c *Client) GetSignatureStatusWithConfig(ctx context.Context, signature string, cfg GetSignatureStatusesConfig, opts ...rpc.CallOption) (*rpc.SignatureStatus, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.SignatureStatuses]], error) {
-			return c.RpcClient.GetSignatureStatusesWithConfig(ctx, []string{signature}, cfg.toRpc())
-		},
-		func(v rpc.ValueWithContext[rpc.SignatureStatuses]) (*rpc.SignatureStatus, error) {
-			return v.Value[0], nil
+			return c.RpcClient.GetSignatureStatusesWithConfig(ctx, []string{signature}, cfg.toRpc(), opts...)
 		},
+		convertGetSignatureStatus,
 	)
 }
 
-func (c *Client) GetSignatureStatuses(ctx context.Context, signatures []string) (rpc.SignatureStatuses, error) {
+// GetSignatureStatuses returns the statuses of at most MaxSignatureStatusesLength signatures in the same order,
+// the status is nil if the signature is not found
+func (c *Client) GetSignatureStatuses(ctx context.Context, signatures []string, opts ...rpc.CallOption) (rpc.SignatureStatuses, error) {
+	if err := checkSignatureStatusesLength(signatures); err != nil {
+		return nil, err
+	}
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.SignatureStatuses]], error) {
-			return c.RpcClient.GetSignatureStatuses(ctx, signatures)
+			return c.RpcClient.GetSignatureStatuses(ctx, signatures, opts...)
 		},
 		value[rpc.SignatureStatuses],
 	)
 }
 
-func (c *Client) GetSignatureStatusesWithConfig(ctx context.Context, signatures []string, cfg GetSignatureStatusesConfig) (rpc.SignatureStatuses, error) {
+// GetSignatureStatusesWithConfig returns the statuses of at most MaxSignatureStatusesLength signatures in the same order,
+// the status is nil if the signature is not found
+func (c *Client) GetSignatureStatusesWithConfig(ctx context.Context, signatures []string, cfg GetSignatureStatusesConfig, opts ...rpc.CallOption) (rpc.SignatureStatuses, error) {
+	if err := checkSignatureStatusesLength(signatures); err != nil {
+		return nil, err
+	}
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.SignatureStatuses]], error) {
-			return c.RpcClient.GetSignatureStatusesWithConfig(ctx, signatures, cfg.toRpc())
+			return c.RpcClient.GetSignatureStatusesWithConfig(ctx, signatures, cfg.toRpc(), opts...)
 		},
 		value[rpc.SignatureStatuses],
 	)
 }
+
+func checkSignatureStatusesLength(signatures []string) error {
+	if len(signatures) > MaxSignatureStatusesLength {
+		return fmt.Errorf("too many signatures, got %v, max is %v", len(signatures), MaxSignatureStatusesLength)
+	}
+	return nil
+}
+
+func convertGetSignatureStatus(v rpc.ValueWithContext[rpc.SignatureStatuses]) (*rpc.SignatureStatus, error) {
+	if len(v.Value) != 1 {
+		return nil, fmt.Errorf("unexpected signature statuses length, got %v", len(v.Value))
+	}
+	return v.Value[0], nil
+}
@@ -2,11 +2,13 @@ package client
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/liangjies/solana-go-sdk/internal/client_test"
 	"github.com/liangjies/solana-go-sdk/pkg/pointer"
 	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_GetSignatureStatus(t *testing.T) {
@@ -271,3 +273,30 @@ func TestClient_GetSignatureStatusesWithConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestClient_GetSignatureStatus_UnexpectedLength(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSignatureStatuses", "params":[["3E6jD48LnMeNDs1QTXXunXGaqYybZKHXYdriDwqXGJbCXzVkMZNexuiGnTtUSba7PcmbKcsxKsAcBKLSmqjUKDRg"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetSignatureStatus(
+						context.Background(),
+						"3E6jD48LnMeNDs1QTXXunXGaqYybZKHXYdriDwqXGJbCXzVkMZNexuiGnTtUSba7PcmbKcsxKsAcBKLSmqjUKDRg",
+					)
+				},
+				ExpectedValue: (*rpc.SignatureStatus)(nil),
+				ExpectedError: errors.New("unexpected signature statuses length, got 0"),
+			},
+		},
+	)
+}
+
+func TestClient_GetSignatureStatuses_TooManySignatures(t *testing.T) {
+	c := NewClient("http://127.0.0.1:0")
+	_, err := c.GetSignatureStatuses(context.Background(), make([]string, MaxSignatureStatusesLength+1))
+	assert.EqualError(t, err, "too many signatures, got 257, max is 256")
+}
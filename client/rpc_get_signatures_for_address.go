@@ -22,19 +22,19 @@ func (c GetSignaturesForAddressConfig) toRpc() rpc.GetSignaturesForAddressConfig
 	}
 }
 
-func (c *Client) GetSignaturesForAddress(ctx context.Context, addr string) (rpc.GetSignaturesForAddress, error) {
+func (c *Client) GetSignaturesForAddress(ctx context.Context, addr string, opts ...rpc.CallOption) (rpc.GetSignaturesForAddress, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.GetSignaturesForAddress], error) {
-			return c.RpcClient.GetSignaturesForAddress(ctx, addr)
+			return c.RpcClient.GetSignaturesForAddress(ctx, addr, opts...)
 		},
 		forward[rpc.GetSignaturesForAddress],
 	)
 }
 
-func (c *Client) GetSignaturesForAddressWithConfig(ctx context.Context, addr string, cfg GetSignaturesForAddressConfig) (rpc.GetSignaturesForAddress, error) {
+func (c *Client) GetSignaturesForAddressWithConfig(ctx context.Context, addr string, cfg GetSignaturesForAddressConfig, opts ...rpc.CallOption) (rpc.GetSignaturesForAddress, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.GetSignaturesForAddress], error) {
-			return c.RpcClient.GetSignaturesForAddressWithConfig(ctx, addr, cfg.toRpc())
+			return c.RpcClient.GetSignaturesForAddressWithConfig(ctx, addr, cfg.toRpc(), opts...)
 		},
 		forward[rpc.GetSignaturesForAddress],
 	)
@@ -17,20 +17,20 @@ func (c GetSlotConfig) toRpc() rpc.GetSlotConfig {
 }
 
 // GetSlot get current slot (finalized)
-func (c *Client) GetSlot(ctx context.Context) (uint64, error) {
+func (c *Client) GetSlot(ctx context.Context, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetSlot(ctx)
+			return c.RpcClient.GetSlot(ctx, opts...)
 		},
 		forward[uint64],
 	)
 }
 
 // GetSlotWithConfig get slot by commitment
-func (c *Client) GetSlotWithConfig(ctx context.Context, cfg GetSlotConfig) (uint64, error) {
+func (c *Client) GetSlotWithConfig(ctx context.Context, cfg GetSlotConfig, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetSlotWithConfig(ctx, cfg.toRpc())
+			return c.RpcClient.GetSlotWithConfig(ctx, cfg.toRpc(), opts...)
 		},
 		forward[uint64],
 	)
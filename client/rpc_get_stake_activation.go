@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetStakeActivationConfig struct {
+	Commitment rpc.Commitment
+	// Epoch default is the current epoch
+	Epoch *uint64
+}
+
+func (c GetStakeActivationConfig) toRpc() rpc.GetStakeActivationConfig {
+	return rpc.GetStakeActivationConfig{
+		Commitment: c.Commitment,
+		Epoch:      c.Epoch,
+	}
+}
+
+type StakeActivation struct {
+	State rpc.StakeActivationState
+	// Active is the stake active during the epoch, in lamports
+	Active uint64
+	// Inactive is the stake inactive during the epoch, in lamports
+	Inactive uint64
+}
+
+// GetStakeActivation returns epoch activation information for a stake account.
+// The method is deprecated by the node since v1.18, and is not available after v2.0.
+func (c *Client) GetStakeActivation(ctx context.Context, stakeAccountAddr string, opts ...rpc.CallOption) (StakeActivation, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetStakeActivation], error) {
+			return c.RpcClient.GetStakeActivation(ctx, stakeAccountAddr, opts...)
+		},
+		convertGetStakeActivation,
+	)
+}
+
+// GetStakeActivationWithConfig returns epoch activation information for a stake account.
+// The method is deprecated by the node since v1.18, and is not available after v2.0.
+func (c *Client) GetStakeActivationWithConfig(ctx context.Context, stakeAccountAddr string, cfg GetStakeActivationConfig, opts ...rpc.CallOption) (StakeActivation, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetStakeActivation], error) {
+			return c.RpcClient.GetStakeActivationWithConfig(ctx, stakeAccountAddr, cfg.toRpc(), opts...)
+		},
+		convertGetStakeActivation,
+	)
+}
+
+func convertGetStakeActivation(v rpc.GetStakeActivation) (StakeActivation, error) {
+	return StakeActivation(v), nil
+}
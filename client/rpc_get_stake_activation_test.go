@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetStakeActivation(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeActivation", "params":["CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"active":197717120,"inactive":0,"state":"active"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetStakeActivation(
+						context.Background(),
+						"CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT",
+					)
+				},
+				ExpectedValue: StakeActivation{
+					State:    rpc.StakeActivationStateActive,
+					Active:   197717120,
+					Inactive: 0,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetStakeActivationWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeActivation", "params":["CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT", {"commitment":"confirmed","epoch":4}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"active":124429280,"inactive":73287840,"state":"activating"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetStakeActivationWithConfig(
+						context.Background(),
+						"CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT",
+						GetStakeActivationConfig{
+							Commitment: rpc.CommitmentConfirmed,
+							Epoch:      pointer.Get[uint64](4),
+						},
+					)
+				},
+				ExpectedValue: StakeActivation{
+					State:    rpc.StakeActivationStateActivating,
+					Active:   124429280,
+					Inactive: 73287840,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetStakeMinimumDelegation(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeMinimumDelegation"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":501},"value":1000000000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetStakeMinimumDelegation(
+						context.Background(),
+					)
+				},
+				ExpectedValue: uint64(1000000000),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetStakeMinimumDelegationWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeMinimumDelegation", "params":[{"commitment":"finalized"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":501},"value":1},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetStakeMinimumDelegationWithConfig(
+						context.Background(),
+						GetStakeMinimumDelegationConfig{
+							Commitment: rpc.CommitmentFinalized,
+						},
+					)
+				},
+				ExpectedValue: uint64(1),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
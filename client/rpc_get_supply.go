@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetSupplyConfig struct {
+	Commitment                        rpc.Commitment
+	ExcludeNonCirculatingAccountsList bool
+}
+
+func (c GetSupplyConfig) toRpc() rpc.GetSupplyConfig {
+	return rpc.GetSupplyConfig{
+		Commitment:                        c.Commitment,
+		ExcludeNonCirculatingAccountsList: c.ExcludeNonCirculatingAccountsList,
+	}
+}
+
+type Supply struct {
+	Total                  uint64
+	Circulating            uint64
+	NonCirculating         uint64
+	NonCirculatingAccounts []common.PublicKey
+}
+
+// GetSupply returns information about the current supply
+func (c *Client) GetSupply(ctx context.Context, opts ...rpc.CallOption) (Supply, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetSupplyResultValue]], error) {
+			return c.RpcClient.GetSupply(ctx, opts...)
+		},
+		convertGetSupply,
+	)
+}
+
+// GetSupplyWithConfig returns information about the current supply
+func (c *Client) GetSupplyWithConfig(ctx context.Context, cfg GetSupplyConfig, opts ...rpc.CallOption) (Supply, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetSupplyResultValue]], error) {
+			return c.RpcClient.GetSupplyWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetSupply,
+	)
+}
+
+func convertGetSupply(v rpc.ValueWithContext[rpc.GetSupplyResultValue]) (Supply, error) {
+	nonCirculatingAccounts := make([]common.PublicKey, 0, len(v.Value.NonCirculatingAccounts))
+	for _, addr := range v.Value.NonCirculatingAccounts {
+		nonCirculatingAccounts = append(nonCirculatingAccounts, common.PublicKeyFromString(addr))
+	}
+	return Supply{
+		Total:                  v.Value.Total,
+		Circulating:            v.Value.Circulating,
+		NonCirculating:         v.Value.NonCirculating,
+		NonCirculatingAccounts: nonCirculatingAccounts,
+	}, nil
+}
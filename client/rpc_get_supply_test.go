@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestClient_GetSupply(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSupply"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":1114},"value":{"circulating":16000,"nonCirculating":1000000,"nonCirculatingAccounts":["FEy8pTbP5fEoqMV1GdTz83byuJ8EbYCNYg6gj8RmKhRx"],"total":1016000}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetSupply(
+						context.Background(),
+					)
+				},
+				ExpectedValue: Supply{
+					Total:          1016000,
+					Circulating:    16000,
+					NonCirculating: 1000000,
+					NonCirculatingAccounts: []common.PublicKey{
+						common.PublicKeyFromString("FEy8pTbP5fEoqMV1GdTz83byuJ8EbYCNYg6gj8RmKhRx"),
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetSupplyWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSupply", "params":[{"excludeNonCirculatingAccountsList":true}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":1114},"value":{"circulating":16000,"nonCirculating":1000000,"nonCirculatingAccounts":[],"total":1016000}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetSupplyWithConfig(
+						context.Background(),
+						GetSupplyConfig{
+							ExcludeNonCirculatingAccountsList: true,
+						},
+					)
+				},
+				ExpectedValue: Supply{
+					Total:                  1016000,
+					Circulating:            16000,
+					NonCirculating:         1000000,
+					NonCirculatingAccounts: []common.PublicKey{},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
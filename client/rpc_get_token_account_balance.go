@@ -16,37 +16,37 @@ func (c GetTokenAccountBalanceConfig) toRpc() rpc.GetTokenAccountBalanceConfig {
 	}
 }
 
-func (c *Client) GetTokenAccountBalance(ctx context.Context, addr string) (TokenAmount, error) {
+func (c *Client) GetTokenAccountBalance(ctx context.Context, addr string, opts ...rpc.CallOption) (TokenAmount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.TokenAccountBalance]], error) {
-			return c.RpcClient.GetTokenAccountBalance(ctx, addr)
+			return c.RpcClient.GetTokenAccountBalance(ctx, addr, opts...)
 		},
 		convertGetTokenAccountBalance,
 	)
 }
 
-func (c *Client) GetTokenAccountBalanceWithConfig(ctx context.Context, addr string, cfg GetTokenAccountBalanceConfig) (TokenAmount, error) {
+func (c *Client) GetTokenAccountBalanceWithConfig(ctx context.Context, addr string, cfg GetTokenAccountBalanceConfig, opts ...rpc.CallOption) (TokenAmount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.TokenAccountBalance]], error) {
-			return c.RpcClient.GetTokenAccountBalanceWithConfig(ctx, addr, cfg.toRpc())
+			return c.RpcClient.GetTokenAccountBalanceWithConfig(ctx, addr, cfg.toRpc(), opts...)
 		},
 		convertGetTokenAccountBalance,
 	)
 }
 
-func (c *Client) GetTokenAccountBalanceAndContext(ctx context.Context, addr string) (rpc.ValueWithContext[TokenAmount], error) {
+func (c *Client) GetTokenAccountBalanceAndContext(ctx context.Context, addr string, opts ...rpc.CallOption) (rpc.ValueWithContext[TokenAmount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.TokenAccountBalance]], error) {
-			return c.RpcClient.GetTokenAccountBalance(ctx, addr)
+			return c.RpcClient.GetTokenAccountBalance(ctx, addr, opts...)
 		},
 		convertGetTokenAccountBalanceAndContext,
 	)
 }
 
-func (c *Client) GetTokenAccountBalanceAndContextWithConfig(ctx context.Context, addr string, cfg GetTokenAccountBalanceConfig) (rpc.ValueWithContext[TokenAmount], error) {
+func (c *Client) GetTokenAccountBalanceAndContextWithConfig(ctx context.Context, addr string, cfg GetTokenAccountBalanceConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[TokenAmount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.TokenAccountBalance]], error) {
-			return c.RpcClient.GetTokenAccountBalanceWithConfig(ctx, addr, cfg.toRpc())
+			return c.RpcClient.GetTokenAccountBalanceWithConfig(ctx, addr, cfg.toRpc(), opts...)
 		},
 		convertGetTokenAccountBalanceAndContext,
 	)
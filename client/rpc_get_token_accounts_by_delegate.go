@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// GetTokenAccountsByDelegateByMint returns the token accounts of the mint approved to the delegate
+func (c *Client) GetTokenAccountsByDelegateByMint(ctx context.Context, delegate, mintAddr string, opts ...rpc.CallOption) ([]TokenAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
+			return c.RpcClient.GetTokenAccountsByDelegateWithConfig(
+				ctx,
+				delegate,
+				rpc.GetTokenAccountsByDelegateConfigFilter{
+					Mint: mintAddr,
+				},
+				rpc.GetTokenAccountsByDelegateConfig{
+					Encoding: rpc.AccountEncodingBase64,
+				},
+				opts...,
+			)
+		},
+		convertGetTokenAccountsByOwner,
+	)
+}
+
+// GetTokenAccountsByDelegateByProgram returns the token accounts of the token program approved to the delegate
+func (c *Client) GetTokenAccountsByDelegateByProgram(ctx context.Context, delegate, programId string, opts ...rpc.CallOption) ([]TokenAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
+			return c.RpcClient.GetTokenAccountsByDelegateWithConfig(
+				ctx,
+				delegate,
+				rpc.GetTokenAccountsByDelegateConfigFilter{
+					ProgramId: programId,
+				},
+				rpc.GetTokenAccountsByDelegateConfig{
+					Encoding: rpc.AccountEncodingBase64,
+				},
+				opts...,
+			)
+		},
+		convertGetTokenAccountsByOwner,
+	)
+}
+
+// GetTokenAccountsByDelegateWithContextByMint returns the token accounts of the mint approved to the delegate
+func (c *Client) GetTokenAccountsByDelegateWithContextByMint(ctx context.Context, delegate, mintAddr string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
+			return c.RpcClient.GetTokenAccountsByDelegateWithConfig(
+				ctx,
+				delegate,
+				rpc.GetTokenAccountsByDelegateConfigFilter{
+					Mint: mintAddr,
+				},
+				rpc.GetTokenAccountsByDelegateConfig{
+					Encoding: rpc.AccountEncodingBase64,
+				},
+				opts...,
+			)
+		},
+		convertGetTokenAccountsByOwnerAndContext,
+	)
+}
+
+// GetTokenAccountsByDelegateWithContextByProgram returns the token accounts of the token program approved to the delegate
+func (c *Client) GetTokenAccountsByDelegateWithContextByProgram(ctx context.Context, delegate, programId string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
+			return c.RpcClient.GetTokenAccountsByDelegateWithConfig(
+				ctx,
+				delegate,
+				rpc.GetTokenAccountsByDelegateConfigFilter{
+					ProgramId: programId,
+				},
+				rpc.GetTokenAccountsByDelegateConfig{
+					Encoding: rpc.AccountEncodingBase64,
+				},
+				opts...,
+			)
+		},
+		convertGetTokenAccountsByOwnerAndContext,
+	)
+}
@@ -13,7 +13,7 @@ type TokenAccount struct {
 	PublicKey common.PublicKey
 }
 
-func (c *Client) GetTokenAccountsByOwnerByMint(ctx context.Context, owner, mintAddr string) ([]TokenAccount, error) {
+func (c *Client) GetTokenAccountsByOwnerByMint(ctx context.Context, owner, mintAddr string, opts ...rpc.CallOption) ([]TokenAccount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
 			return c.RpcClient.GetTokenAccountsByOwnerWithConfig(
@@ -25,13 +25,14 @@ func (c *Client) GetTokenAccountsByOwnerByMint(ctx context.Context, owner, mintA
 				rpc.GetTokenAccountsByOwnerConfig{
 					Encoding: rpc.AccountEncodingBase64,
 				},
+				opts...,
 			)
 		},
 		convertGetTokenAccountsByOwner,
 	)
 }
 
-func (c *Client) GetTokenAccountsByOwnerByProgram(ctx context.Context, owner, programId string) ([]TokenAccount, error) {
+func (c *Client) GetTokenAccountsByOwnerByProgram(ctx context.Context, owner, programId string, opts ...rpc.CallOption) ([]TokenAccount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
 			return c.RpcClient.GetTokenAccountsByOwnerWithConfig(
@@ -43,13 +44,14 @@ func (c *Client) GetTokenAccountsByOwnerByProgram(ctx context.Context, owner, pr
 				rpc.GetTokenAccountsByOwnerConfig{
 					Encoding: rpc.AccountEncodingBase64,
 				},
+				opts...,
 			)
 		},
 		convertGetTokenAccountsByOwner,
 	)
 }
 
-func (c *Client) GetTokenAccountsByOwnerWithContextByMint(ctx context.Context, owner, mintAddr string) (rpc.ValueWithContext[[]TokenAccount], error) {
+func (c *Client) GetTokenAccountsByOwnerWithContextByMint(ctx context.Context, owner, mintAddr string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenAccount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
 			return c.RpcClient.GetTokenAccountsByOwnerWithConfig(
@@ -61,13 +63,14 @@ func (c *Client) GetTokenAccountsByOwnerWithContextByMint(ctx context.Context, o
 				rpc.GetTokenAccountsByOwnerConfig{
 					Encoding: rpc.AccountEncodingBase64,
 				},
+				opts...,
 			)
 		},
 		convertGetTokenAccountsByOwnerAndContext,
 	)
 }
 
-func (c *Client) GetTokenAccountsByOwnerWithContextByProgram(ctx context.Context, owner, programId string) (rpc.ValueWithContext[[]TokenAccount], error) {
+func (c *Client) GetTokenAccountsByOwnerWithContextByProgram(ctx context.Context, owner, programId string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenAccount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetProgramAccounts]], error) {
 			return c.RpcClient.GetTokenAccountsByOwnerWithConfig(
@@ -79,6 +82,7 @@ func (c *Client) GetTokenAccountsByOwnerWithContextByProgram(ctx context.Context
 				rpc.GetTokenAccountsByOwnerConfig{
 					Encoding: rpc.AccountEncodingBase64,
 				},
+				opts...,
 			)
 		},
 		convertGetTokenAccountsByOwnerAndContext,
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetTokenLargestAccountsConfig struct {
+	Commitment rpc.Commitment
+}
+
+func (c GetTokenLargestAccountsConfig) toRpc() rpc.GetTokenLargestAccountsConfig {
+	return rpc.GetTokenLargestAccountsConfig{
+		Commitment: c.Commitment,
+	}
+}
+
+type TokenLargestAccount struct {
+	Address common.PublicKey
+	TokenAmount
+}
+
+func (c *Client) GetTokenLargestAccounts(ctx context.Context, mintAddr string, opts ...rpc.CallOption) ([]TokenLargestAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetTokenLargestAccounts(ctx, mintAddr, opts...)
+		},
+		convertGetTokenLargestAccounts,
+	)
+}
+
+func (c *Client) GetTokenLargestAccountsWithConfig(ctx context.Context, mintAddr string, cfg GetTokenLargestAccountsConfig, opts ...rpc.CallOption) ([]TokenLargestAccount, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetTokenLargestAccountsWithConfig(ctx, mintAddr, cfg.toRpc(), opts...)
+		},
+		convertGetTokenLargestAccounts,
+	)
+}
+
+func (c *Client) GetTokenLargestAccountsAndContext(ctx context.Context, mintAddr string, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenLargestAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetTokenLargestAccounts(ctx, mintAddr, opts...)
+		},
+		convertGetTokenLargestAccountsAndContext,
+	)
+}
+
+func (c *Client) GetTokenLargestAccountsAndContextWithConfig(ctx context.Context, mintAddr string, cfg GetTokenLargestAccountsConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[[]TokenLargestAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]], error) {
+			return c.RpcClient.GetTokenLargestAccountsWithConfig(ctx, mintAddr, cfg.toRpc(), opts...)
+		},
+		convertGetTokenLargestAccountsAndContext,
+	)
+}
+
+func convertGetTokenLargestAccounts(v rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]) ([]TokenLargestAccount, error) {
+	output := make([]TokenLargestAccount, 0, len(v.Value))
+	for _, a := range v.Value {
+		tokenAmount, err := newTokenAmount(a.Amount, a.Decimals, a.UIAmountString)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, TokenLargestAccount{
+			Address:     common.PublicKeyFromString(a.Address),
+			TokenAmount: tokenAmount,
+		})
+	}
+	return output, nil
+}
+
+func convertGetTokenLargestAccountsAndContext(v rpc.ValueWithContext[[]rpc.GetTokenLargestAccountsResultValue]) (rpc.ValueWithContext[[]TokenLargestAccount], error) {
+	accounts, err := convertGetTokenLargestAccounts(v)
+	if err != nil {
+		return rpc.ValueWithContext[[]TokenLargestAccount]{}, err
+	}
+	return rpc.ValueWithContext[[]TokenLargestAccount]{
+		Context: v.Context,
+		Value:   accounts,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+func TestClient_GetTokenLargestAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenLargestAccounts", "params":["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187574619},"value":[{"address":"FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH","amount":"771","decimals":2,"uiAmount":7.71,"uiAmountString":"7.71"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetTokenLargestAccounts(
+						context.Background(),
+						"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb",
+					)
+				},
+				ExpectedValue: []TokenLargestAccount{
+					{
+						Address: common.PublicKeyFromString("FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH"),
+						TokenAmount: TokenAmount{
+							Amount:         771,
+							Decimals:       2,
+							UIAmountString: "7.71",
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetTokenLargestAccountsAndContextWithConfig(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenLargestAccounts", "params":["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb", {"commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187574619},"value":[{"address":"FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH","amount":"771","decimals":2,"uiAmount":7.71,"uiAmountString":"7.71"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetTokenLargestAccountsAndContextWithConfig(
+						context.Background(),
+						"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb",
+						GetTokenLargestAccountsConfig{
+							Commitment: rpc.CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: rpc.ValueWithContext[[]TokenLargestAccount]{
+					Context: rpc.Context{
+						Slot:       187574619,
+						ApiVersion: "1.14.10",
+					},
+					Value: []TokenLargestAccount{
+						{
+							Address: common.PublicKeyFromString("FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH"),
+							TokenAmount: TokenAmount{
+								Amount:         771,
+								Decimals:       2,
+								UIAmountString: "7.71",
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -16,37 +16,37 @@ func (c GetTokenSupplyConfig) toRpc() rpc.GetTokenSupplyConfig {
 	}
 }
 
-func (c *Client) GetTokenSupply(ctx context.Context, mintAddr string) (TokenAmount, error) {
+func (c *Client) GetTokenSupply(ctx context.Context, mintAddr string, opts ...rpc.CallOption) (TokenAmount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetTokenSupplyResultValue]], error) {
-			return c.RpcClient.GetTokenSupply(ctx, mintAddr)
+			return c.RpcClient.GetTokenSupply(ctx, mintAddr, opts...)
 		},
 		convertGetTokenSupply,
 	)
 }
 
-func (c *Client) GetTokenSupplyWithConfig(ctx context.Context, mintAddr string, cfg GetTokenSupplyConfig) (TokenAmount, error) {
+func (c *Client) GetTokenSupplyWithConfig(ctx context.Context, mintAddr string, cfg GetTokenSupplyConfig, opts ...rpc.CallOption) (TokenAmount, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetTokenSupplyResultValue]], error) {
-			return c.RpcClient.GetTokenSupplyWithConfig(ctx, mintAddr, cfg.toRpc())
+			return c.RpcClient.GetTokenSupplyWithConfig(ctx, mintAddr, cfg.toRpc(), opts...)
 		},
 		convertGetTokenSupply,
 	)
 }
 
-func (c *Client) GetTokenSupplyAndContext(ctx context.Context, mintAddr string) (rpc.ValueWithContext[TokenAmount], error) {
+func (c *Client) GetTokenSupplyAndContext(ctx context.Context, mintAddr string, opts ...rpc.CallOption) (rpc.ValueWithContext[TokenAmount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetTokenSupplyResultValue]], error) {
-			return c.RpcClient.GetTokenSupply(ctx, mintAddr)
+			return c.RpcClient.GetTokenSupply(ctx, mintAddr, opts...)
 		},
 		convertGetTokenSupplyAndContext,
 	)
 }
 
-func (c *Client) GetTokenSupplyAndContextWithConfig(ctx context.Context, mintAddr string, cfg GetTokenSupplyConfig) (rpc.ValueWithContext[TokenAmount], error) {
+func (c *Client) GetTokenSupplyAndContextWithConfig(ctx context.Context, mintAddr string, cfg GetTokenSupplyConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[TokenAmount], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.GetTokenSupplyResultValue]], error) {
-			return c.RpcClient.GetTokenSupplyWithConfig(ctx, mintAddr, cfg.toRpc())
+			return c.RpcClient.GetTokenSupplyWithConfig(ctx, mintAddr, cfg.toRpc(), opts...)
 		},
 		convertGetTokenSupplyAndContext,
 	)
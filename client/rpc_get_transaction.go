@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 
 	"github.com/liangjies/solana-go-sdk/common"
@@ -40,39 +41,68 @@ func (t Transaction) Version() types.MessageVersion {
 }
 
 type TransactionMeta struct {
+	// rpc
 	Err                  any
 	Fee                  uint64
 	PreBalances          []int64
 	PostBalances         []int64
 	PreTokenBalances     []rpc.TransactionMetaTokenBalance
 	PostTokenBalances    []rpc.TransactionMetaTokenBalance
+	Rewards              []Reward
 	LogMessages          []string
 	InnerInstructions    []InnerInstruction
 	LoadedAddresses      rpc.TransactionLoadedAddresses
 	ReturnData           *ReturnData
 	ComputeUnitsConsumed *uint64
+
+	// custom
+	// TypedPreTokenBalances and TypedPostTokenBalances are PreTokenBalances and PostTokenBalances with
+	// public keys and a parsed TokenAmount
+	TypedPreTokenBalances  []TransactionTokenBalance
+	TypedPostTokenBalances []TransactionTokenBalance
+	// TypedLoadedAddresses is LoadedAddresses with public keys
+	TypedLoadedAddresses LoadedAddresses
 }
 
 type InnerInstruction struct {
+	// Index is the index of the instruction in the message which invokes them
 	Index        uint64
 	Instructions []types.CompiledInstruction
 }
 
+type TransactionTokenBalance struct {
+	// AccountIndex is the index of the token account in the account keys
+	AccountIndex uint64
+	Mint         common.PublicKey
+	// Owner and ProgramID are empty in the responses of old nodes
+	Owner       common.PublicKey
+	ProgramID   common.PublicKey
+	TokenAmount TokenAmount
+	// Err is set if the amount of the balance can't be parsed, TokenAmount is empty then
+	Err error
+}
+
+// LoadedAddresses are the addresses loaded from the address lookup tables of a v0 transaction
+type LoadedAddresses struct {
+	Writable []common.PublicKey
+	Readonly []common.PublicKey
+}
+
 // GetTransaction returns transaction details for a confirmed transaction
-func (c *Client) GetTransaction(ctx context.Context, txhash string) (*Transaction, error) {
+func (c *Client) GetTransaction(ctx context.Context, txhash string, opts ...rpc.CallOption) (*Transaction, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[*rpc.GetTransaction], error) {
-			return c.RpcClient.GetTransactionWithConfig(ctx, txhash, GetTransactionConfig{}.toRpc())
+			return c.RpcClient.GetTransactionWithConfig(ctx, txhash, GetTransactionConfig{}.toRpc(), opts...)
 		},
 		convertTransaction,
 	)
 }
 
 // GetTransaction returns transaction details for a confirmed transaction
-func (c *Client) GetTransactionWithConfig(ctx context.Context, txhash string, cfg GetTransactionConfig) (*Transaction, error) {
+func (c *Client) GetTransactionWithConfig(ctx context.Context, txhash string, cfg GetTransactionConfig, opts ...rpc.CallOption) (*Transaction, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[*rpc.GetTransaction], error) {
-			return c.RpcClient.GetTransactionWithConfig(ctx, txhash, cfg.toRpc())
+			return c.RpcClient.GetTransactionWithConfig(ctx, txhash, cfg.toRpc(), opts...)
 		},
 		convertTransaction,
 	)
@@ -108,35 +138,71 @@ func convertTransactionMeta(meta *rpc.TransactionMeta) (*TransactionMeta, error)
 		return nil, nil
 	}
 
-	innerInstructions := make([]InnerInstruction, 0, len(meta.InnerInstructions))
-	for _, metaInnerInstruction := range meta.InnerInstructions {
+	innerInstructions, err := convertInnerInstructions(meta.InnerInstructions)
+	if err != nil {
+		return nil, err
+	}
+
+	var rewards []Reward
+	if len(meta.Rewards) > 0 {
+		rewards = convertRewards(meta.Rewards)
+	}
+
+	var returnData *ReturnData
+	if v := meta.ReturnData; v != nil {
+		d, err := convertReturnData(*v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process return data, err: %v", err)
+		}
+		returnData = &d
+	}
+
+	return &TransactionMeta{
+		Err:                  meta.Err,
+		Fee:                  meta.Fee,
+		PreBalances:          meta.PreBalances,
+		PostBalances:         meta.PostBalances,
+		PreTokenBalances:     meta.PreTokenBalances,
+		PostTokenBalances:    meta.PostTokenBalances,
+		Rewards:              rewards,
+		LogMessages:          meta.LogMessages,
+		InnerInstructions:    innerInstructions,
+		LoadedAddresses:      meta.LoadedAddresses,
+		ReturnData:           returnData,
+		ComputeUnitsConsumed: meta.ComputeUnitsConsumed,
+
+		TypedPreTokenBalances:  convertTokenBalances(meta.PreTokenBalances),
+		TypedPostTokenBalances: convertTokenBalances(meta.PostTokenBalances),
+		TypedLoadedAddresses:   convertLoadedAddresses(meta.LoadedAddresses),
+	}, nil
+}
+
+func convertInnerInstructions(metaInnerInstructions []rpc.TransactionMetaInnerInstruction) ([]InnerInstruction, error) {
+	innerInstructions := make([]InnerInstruction, 0, len(metaInnerInstructions))
+	for _, metaInnerInstruction := range metaInnerInstructions {
 		compiledInstructions := make([]types.CompiledInstruction, 0, len(metaInnerInstruction.Instructions))
 		for _, innerInstruction := range metaInnerInstruction.Instructions {
-			parsedInstruction, ok := innerInstruction.(map[string]any)
-			if !ok {
-				return nil, fmt.Errorf("failed to convert inner instruction type. value: %v", innerInstruction)
-			}
-
-			rawAccounts, ok := parsedInstruction["accounts"].([]any)
-			if !ok {
-				return nil, fmt.Errorf("failed to parse instruction accounts")
+			b, err := json.Marshal(innerInstruction)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal inner instruction, err: %v", err)
 			}
-			accounts := make([]int, 0, len(rawAccounts))
-			for _, v := range rawAccounts {
-				accounts = append(accounts, int(v.(float64)))
+			var instruction rpc.Instruction
+			if err := json.Unmarshal(b, &instruction); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal inner instruction, value: %v, err: %v", innerInstruction, err)
 			}
 
 			var data []byte
-			var err error
-			if dataString := parsedInstruction["data"].(string); len(dataString) > 0 {
-				data, err = base58.Decode(dataString)
+			if len(instruction.Data) > 0 {
+				data, err = base58.Decode(instruction.Data)
 				if err != nil {
-					return nil, fmt.Errorf("failed to base58 decode data, data: %v, err: %v", parsedInstruction["data"], err)
+					return nil, fmt.Errorf("failed to base58 decode data, data: %v, err: %v", instruction.Data, err)
 				}
 			}
+			accounts := make([]int, 0, len(instruction.Accounts))
+			accounts = append(accounts, instruction.Accounts...)
 
 			compiledInstructions = append(compiledInstructions, types.CompiledInstruction{
-				ProgramIDIndex: int(parsedInstruction["programIdIndex"].(float64)),
+				ProgramIDIndex: instruction.ProgramIDIndex,
 				Accounts:       accounts,
 				Data:           data,
 			})
@@ -148,28 +214,43 @@ func convertTransactionMeta(meta *rpc.TransactionMeta) (*TransactionMeta, error)
 		})
 	}
 
-	var returnData *ReturnData
-	if v := meta.ReturnData; v != nil {
-		d, err := convertReturnData(*v)
+	return innerInstructions, nil
+}
+
+// convertTokenBalances keeps a balance whose amount can't be parsed with its Err set, so the others are still returned
+func convertTokenBalances(metaTokenBalances []rpc.TransactionMetaTokenBalance) []TransactionTokenBalance {
+	tokenBalances := make([]TransactionTokenBalance, 0, len(metaTokenBalances))
+	for _, v := range metaTokenBalances {
+		tokenBalance := TransactionTokenBalance{
+			AccountIndex: v.AccountIndex,
+			Mint:         common.PublicKeyFromString(v.Mint),
+		}
+		tokenAmount, err := newTokenAmount(v.UITokenAmount.Amount, v.UITokenAmount.Decimals, v.UITokenAmount.UIAmountString)
 		if err != nil {
-			return nil, fmt.Errorf("failed to process return data, err: %v", err)
+			tokenBalance.Err = fmt.Errorf("failed to convert token balance, account index: %v, amount: %v, err: %v", v.AccountIndex, v.UITokenAmount.Amount, err)
+		} else {
+			tokenBalance.TokenAmount = tokenAmount
 		}
-		returnData = &d
+		if v.Owner != "" {
+			tokenBalance.Owner = common.PublicKeyFromString(v.Owner)
+		}
+		if v.ProgramId != "" {
+			tokenBalance.ProgramID = common.PublicKeyFromString(v.ProgramId)
+		}
+		tokenBalances = append(tokenBalances, tokenBalance)
 	}
+	return tokenBalances
+}
 
-	return &TransactionMeta{
-		Err:                  meta.Err,
-		Fee:                  meta.Fee,
-		PreBalances:          meta.PreBalances,
-		PostBalances:         meta.PostBalances,
-		PreTokenBalances:     meta.PreTokenBalances,
-		PostTokenBalances:    meta.PostTokenBalances,
-		LogMessages:          meta.LogMessages,
-		InnerInstructions:    innerInstructions,
-		LoadedAddresses:      meta.LoadedAddresses,
-		ReturnData:           returnData,
-		ComputeUnitsConsumed: meta.ComputeUnitsConsumed,
-	}, nil
+func convertLoadedAddresses(v rpc.TransactionLoadedAddresses) LoadedAddresses {
+	var loadedAddresses LoadedAddresses
+	for _, s := range v.Writable {
+		loadedAddresses.Writable = append(loadedAddresses.Writable, common.PublicKeyFromString(s))
+	}
+	for _, s := range v.Readonly {
+		loadedAddresses.Readonly = append(loadedAddresses.Readonly, common.PublicKeyFromString(s))
+	}
+	return loadedAddresses
 }
 
 func parseBase64Tx(raw any, transactionMeta *TransactionMeta) (types.Transaction, []common.PublicKey, error) {
@@ -198,12 +279,8 @@ func parseBase64Tx(raw any, transactionMeta *TransactionMeta) (types.Transaction
 	accountKeys := make([]common.PublicKey, 0, l)
 	accountKeys = append(accountKeys, tx.Message.Accounts...)
 	if transactionMeta != nil {
-		for _, s := range transactionMeta.LoadedAddresses.Writable {
-			accountKeys = append(accountKeys, common.PublicKeyFromString(s))
-		}
-		for _, s := range transactionMeta.LoadedAddresses.Readonly {
-			accountKeys = append(accountKeys, common.PublicKeyFromString(s))
-		}
+		accountKeys = append(accountKeys, transactionMeta.TypedLoadedAddresses.Writable...)
+		accountKeys = append(accountKeys, transactionMeta.TypedLoadedAddresses.Readonly...)
 	}
 
 	return tx, accountKeys, nil
@@ -17,20 +17,20 @@ func (c GetTransactionCountConfig) toRpc() rpc.GetTransactionCountConfig {
 }
 
 // GetTransactionCount returns the current Transaction count from the ledger
-func (c *Client) GetTransactionCount(ctx context.Context) (uint64, error) {
+func (c *Client) GetTransactionCount(ctx context.Context, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetTransactionCount(ctx)
+			return c.RpcClient.GetTransactionCount(ctx, opts...)
 		},
 		forward[uint64],
 	)
 }
 
 // GetTransactionCount returns the current Transaction count from the ledger
-func (c *Client) GetTransactionCountWithConfig(ctx context.Context, cfg GetTransactionCountConfig) (uint64, error) {
+func (c *Client) GetTransactionCountWithConfig(ctx context.Context, cfg GetTransactionCountConfig, opts ...rpc.CallOption) (uint64, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[uint64], error) {
-			return c.RpcClient.GetTransactionCountWithConfig(ctx, cfg.toRpc())
+			return c.RpcClient.GetTransactionCountWithConfig(ctx, cfg.toRpc(), opts...)
 		},
 		forward[uint64],
 	)
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/liangjies/solana-go-sdk/common"
@@ -10,6 +11,7 @@ import (
 	"github.com/liangjies/solana-go-sdk/rpc"
 	"github.com/liangjies/solana-go-sdk/types"
 	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_GetTransaction(t *testing.T) {
@@ -108,7 +110,8 @@ func TestClient_GetTransaction(t *testing.T) {
 							1,
 							898174080,
 						},
-						PreTokenBalances: []rpc.TransactionMetaTokenBalance{},
+						PreTokenBalances:      []rpc.TransactionMetaTokenBalance{},
+						TypedPreTokenBalances: []TransactionTokenBalance{},
 						PostTokenBalances: []rpc.TransactionMetaTokenBalance{
 							{
 								AccountIndex: 1,
@@ -120,6 +123,17 @@ func TestClient_GetTransaction(t *testing.T) {
 								},
 							},
 						},
+						TypedPostTokenBalances: []TransactionTokenBalance{
+							{
+								AccountIndex: 1,
+								Mint:         common.PublicKeyFromString("4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3"),
+								TokenAmount: TokenAmount{
+									Amount:         0,
+									Decimals:       9,
+									UIAmountString: "0",
+								},
+							},
+						},
 					},
 					Transaction: types.Transaction{
 						Signatures: []types.Signature{[]byte{0xa1, 0x6, 0x96, 0xca, 0xe3, 0xc0, 0x73, 0xa3, 0x5c, 0xe0, 0xc4, 0xbc, 0x41, 0x9b, 0xe5, 0x96, 0x9d, 0x7b, 0xc4, 0x1e, 0x96, 0x45, 0xb1, 0xda, 0x5f, 0x55, 0xbe, 0xc7, 0x8f, 0xfe, 0xd, 0x68, 0x95, 0xec, 0x5, 0xc4, 0xa9, 0x9, 0x13, 0x4, 0x43, 0x27, 0x26, 0x76, 0xc1, 0xe9, 0x7c, 0xa7, 0x60, 0xe2, 0x96, 0x9d, 0xf0, 0x9c, 0x1b, 0xba, 0x0, 0x46, 0xd1, 0x7, 0x82, 0xed, 0x87, 0x0}},
@@ -201,8 +215,10 @@ func TestClient_GetTransaction(t *testing.T) {
 							1,
 							1141440,
 						},
-						PreTokenBalances:  []rpc.TransactionMetaTokenBalance{},
-						PostTokenBalances: []rpc.TransactionMetaTokenBalance{},
+						PreTokenBalances:       []rpc.TransactionMetaTokenBalance{},
+						TypedPreTokenBalances:  []TransactionTokenBalance{},
+						PostTokenBalances:      []rpc.TransactionMetaTokenBalance{},
+						TypedPostTokenBalances: []TransactionTokenBalance{},
 					},
 					Transaction: types.Transaction{
 						Signatures: []types.Signature{[]byte{0x35, 0xa5, 0xa6, 0x33, 0xdd, 0x9b, 0xef, 0x26, 0xba, 0x3d, 0x86, 0xc3, 0x97, 0xad, 0x4, 0x90, 0x1, 0x1e, 0x8, 0x6, 0xb6, 0x1c, 0xc8, 0x89, 0xc, 0x5c, 0x14, 0xef, 0x93, 0x8b, 0x3b, 0x38, 0x92, 0xba, 0xc5, 0x7, 0x6a, 0xd6, 0xba, 0x2d, 0x83, 0x4, 0xdf, 0x99, 0xcf, 0xf3, 0x74, 0xc7, 0xcb, 0x4a, 0xa7, 0xae, 0xf7, 0xd6, 0x5e, 0x59, 0x5a, 0x78, 0xa0, 0x40, 0x3b, 0x8c, 0x41, 0xd}},
@@ -294,6 +310,17 @@ func TestClient_GetTransaction(t *testing.T) {
 								"GAXzq8BWdAWaS1kWFiL5tzV2h3AbRBtYGP5psNTWrM9g",
 							},
 						},
+						TypedLoadedAddresses: LoadedAddresses{
+							Readonly: []common.PublicKey{
+								common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"),
+								common.PublicKeyFromString("5jHeQFBSNxFqqkMF9YCYwtJbkzGarSGwGsmi2ZuPG6yw"),
+							},
+							Writable: []common.PublicKey{
+								common.PublicKeyFromString("3Yvq7e9UXLoFK4PKyxrpEA3y3TKmFK2Wb1f5tVFUgwPu"),
+								common.PublicKeyFromString("5McxjaxNKYLHtv9DqbMfoi6GNs7ZEMHGkJDrouPib4sW"),
+								common.PublicKeyFromString("GAXzq8BWdAWaS1kWFiL5tzV2h3AbRBtYGP5psNTWrM9g"),
+							},
+						},
 						PreTokenBalances: []rpc.TransactionMetaTokenBalance{
 							{
 								AccountIndex: 1,
@@ -340,6 +367,52 @@ func TestClient_GetTransaction(t *testing.T) {
 								},
 							},
 						},
+						TypedPreTokenBalances: []TransactionTokenBalance{
+							{
+								AccountIndex: 1,
+								Mint:         common.PublicKeyFromString("5jHeQFBSNxFqqkMF9YCYwtJbkzGarSGwGsmi2ZuPG6yw"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         100,
+									Decimals:       0,
+									UIAmountString: "100",
+								},
+							},
+							{
+								AccountIndex: 3,
+								Mint:         common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         100,
+									Decimals:       0,
+									UIAmountString: "100",
+								},
+							},
+							{
+								AccountIndex: 4,
+								Mint:         common.PublicKeyFromString("5jHeQFBSNxFqqkMF9YCYwtJbkzGarSGwGsmi2ZuPG6yw"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         100,
+									Decimals:       0,
+									UIAmountString: "100",
+								},
+							},
+							{
+								AccountIndex: 5,
+								Mint:         common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         100,
+									Decimals:       0,
+									UIAmountString: "100",
+								},
+							},
+						},
 						PostTokenBalances: []rpc.TransactionMetaTokenBalance{
 							{
 								AccountIndex: 1,
@@ -386,6 +459,52 @@ func TestClient_GetTransaction(t *testing.T) {
 								},
 							},
 						},
+						TypedPostTokenBalances: []TransactionTokenBalance{
+							{
+								AccountIndex: 1,
+								Mint:         common.PublicKeyFromString("5jHeQFBSNxFqqkMF9YCYwtJbkzGarSGwGsmi2ZuPG6yw"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         101,
+									Decimals:       0,
+									UIAmountString: "101",
+								},
+							},
+							{
+								AccountIndex: 3,
+								Mint:         common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         99,
+									Decimals:       0,
+									UIAmountString: "99",
+								},
+							},
+							{
+								AccountIndex: 4,
+								Mint:         common.PublicKeyFromString("5jHeQFBSNxFqqkMF9YCYwtJbkzGarSGwGsmi2ZuPG6yw"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         99,
+									Decimals:       0,
+									UIAmountString: "99",
+								},
+							},
+							{
+								AccountIndex: 5,
+								Mint:         common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"),
+								Owner:        common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+								ProgramID:    common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
+								TokenAmount: TokenAmount{
+									Amount:         101,
+									Decimals:       0,
+									UIAmountString: "101",
+								},
+							},
+						},
 						InnerInstructions:    []InnerInstruction{},
 						ComputeUnitsConsumed: pointer.Get[uint64](12344),
 					},
@@ -456,3 +575,81 @@ func mustBase58Decode(t *testing.T, s string) []byte {
 	}
 	return b
 }
+
+func Test_convertTransactionMeta(t *testing.T) {
+	var meta rpc.TransactionMeta
+	err := json.Unmarshal([]byte(`{"err":null,"fee":5000,"preBalances":[10000],"postBalances":[5000],"preTokenBalances":[{"accountIndex":1,"mint":"So11111111111111111111111111111111111111112","uiTokenAmount":{"amount":"10","decimals":9,"uiAmount":0.00000001,"uiAmountString":"0.00000001"}}],"postTokenBalances":[],"rewards":[{"pubkey":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","lamports":-5000,"postBalance":5000,"rewardType":"Rent","commission":null}],"logMessages":[],"innerInstructions":[{"index":0,"instructions":[{"programIdIndex":2,"accounts":[0,1],"data":"3Bxs412MvVNQj175","stackHeight":2}]}],"loadedAddresses":{"writable":[],"readonly":["F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"]},"computeUnitsConsumed":150}`), &meta)
+	assert.Nil(t, err)
+
+	got, err := convertTransactionMeta(&meta)
+	assert.Nil(t, err)
+	rewardType := rpc.RewardType("Rent")
+	assert.Equal(t, &TransactionMeta{
+		Fee:          5000,
+		PreBalances:  []int64{10000},
+		PostBalances: []int64{5000},
+		PreTokenBalances: []rpc.TransactionMetaTokenBalance{
+			{
+				AccountIndex: 1,
+				Mint:         "So11111111111111111111111111111111111111112",
+				UITokenAmount: rpc.TokenAccountBalance{
+					Amount:         "10",
+					Decimals:       9,
+					UIAmountString: "0.00000001",
+				},
+			},
+		},
+		PostTokenBalances: []rpc.TransactionMetaTokenBalance{},
+		Rewards: []Reward{
+			{
+				Pubkey:       common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+				Lamports:     -5000,
+				PostBalances: 5000,
+				RewardType:   &rewardType,
+			},
+		},
+		LogMessages: []string{},
+		InnerInstructions: []InnerInstruction{
+			{
+				Index: 0,
+				Instructions: []types.CompiledInstruction{
+					{ProgramIDIndex: 2, Accounts: []int{0, 1}, Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}},
+				},
+			},
+		},
+		LoadedAddresses: rpc.TransactionLoadedAddresses{
+			Writable: []string{},
+			Readonly: []string{"F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8"},
+		},
+		ComputeUnitsConsumed: pointer.Get[uint64](150),
+		TypedPreTokenBalances: []TransactionTokenBalance{
+			{
+				AccountIndex: 1,
+				Mint:         common.PublicKeyFromString("So11111111111111111111111111111111111111112"),
+				TokenAmount:  TokenAmount{Amount: 10, Decimals: 9, UIAmountString: "0.00000001"},
+			},
+		},
+		TypedPostTokenBalances: []TransactionTokenBalance{},
+		TypedLoadedAddresses: LoadedAddresses{
+			Readonly: []common.PublicKey{common.PublicKeyFromString("F1rcBbZB6tQZUTR2z8jKQxaAwUUkxnghSh941Q62hMi8")},
+		},
+	}, got)
+
+	// a balance whose amount can't be parsed is reported and doesn't drop the others
+	meta.PostTokenBalances = []rpc.TransactionMetaTokenBalance{
+		{AccountIndex: 1, Mint: "So11111111111111111111111111111111111111112", UITokenAmount: rpc.TokenAccountBalance{Amount: "-1"}},
+		{AccountIndex: 2, Mint: "So11111111111111111111111111111111111111112", UITokenAmount: rpc.TokenAccountBalance{Amount: "5"}},
+	}
+	got, err = convertTransactionMeta(&meta)
+	assert.Nil(t, err)
+	assert.Len(t, got.TypedPostTokenBalances, 2)
+	assert.NotNil(t, got.TypedPostTokenBalances[0].Err)
+	assert.Equal(t, TokenAmount{}, got.TypedPostTokenBalances[0].TokenAmount)
+	assert.Nil(t, got.TypedPostTokenBalances[1].Err)
+	assert.Equal(t, uint64(5), got.TypedPostTokenBalances[1].TokenAmount.Amount)
+
+	// a malformed inner instruction returns an error instead of a panic
+	meta.InnerInstructions[0].Instructions[0] = map[string]any{"programIdIndex": "2", "accounts": []any{}, "data": ""}
+	_, err = convertTransactionMeta(&meta)
+	assert.NotNil(t, err)
+}
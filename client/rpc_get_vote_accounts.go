@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type GetVoteAccountsConfig struct {
+	Commitment              rpc.Commitment
+	VotePubkey              string
+	KeepUnstakedDelinquents bool
+	DelinquentSlotDistance  uint64
+}
+
+func (c GetVoteAccountsConfig) toRpc() rpc.GetVoteAccountsConfig {
+	return rpc.GetVoteAccountsConfig{
+		Commitment:              c.Commitment,
+		VotePubkey:              c.VotePubkey,
+		KeepUnstakedDelinquents: c.KeepUnstakedDelinquents,
+		DelinquentSlotDistance:  c.DelinquentSlotDistance,
+	}
+}
+
+type VoteAccountStatus struct {
+	Current    []VoteAccount
+	Delinquent []VoteAccount
+}
+
+type VoteAccount struct {
+	VotePubkey       common.PublicKey
+	NodePubkey       common.PublicKey
+	ActivatedStake   uint64
+	Commission       uint8
+	EpochVoteAccount bool
+	LastVote         uint64
+	EpochCredits     []EpochCredits
+	RootSlot         uint64
+}
+
+type EpochCredits struct {
+	Epoch           uint64
+	Credits         uint64
+	PreviousCredits uint64
+}
+
+// GetVoteAccounts returns the account info and associated stake for all the voting accounts in the current bank.
+func (c *Client) GetVoteAccounts(ctx context.Context, opts ...rpc.CallOption) (VoteAccountStatus, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetVoteAccounts], error) {
+			return c.RpcClient.GetVoteAccounts(ctx, opts...)
+		},
+		convertGetVoteAccounts,
+	)
+}
+
+// GetVoteAccountsWithConfig returns the account info and associated stake for all the voting accounts in the current bank.
+func (c *Client) GetVoteAccountsWithConfig(ctx context.Context, cfg GetVoteAccountsConfig, opts ...rpc.CallOption) (VoteAccountStatus, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.GetVoteAccounts], error) {
+			return c.RpcClient.GetVoteAccountsWithConfig(ctx, cfg.toRpc(), opts...)
+		},
+		convertGetVoteAccounts,
+	)
+}
+
+func convertGetVoteAccounts(v rpc.GetVoteAccounts) (VoteAccountStatus, error) {
+	return VoteAccountStatus{
+		Current:    convertVoteAccounts(v.Current),
+		Delinquent: convertVoteAccounts(v.Deliquent),
+	}, nil
+}
+
+func convertVoteAccounts(v rpc.VoteAccounts) []VoteAccount {
+	output := make([]VoteAccount, 0, len(v))
+	for _, a := range v {
+		epochCredits := make([]EpochCredits, 0, len(a.EpochCredits))
+		for _, e := range a.EpochCredits {
+			epochCredits = append(epochCredits, EpochCredits{
+				Epoch:           e[0],
+				Credits:         e[1],
+				PreviousCredits: e[2],
+			})
+		}
+		output = append(output, VoteAccount{
+			VotePubkey:       common.PublicKeyFromString(a.VotePubkey),
+			NodePubkey:       common.PublicKeyFromString(a.NodePubkey),
+			ActivatedStake:   a.ActivatedStake,
+			Commission:       a.Commission,
+			EpochVoteAccount: a.EpochVoteAccount,
+			LastVote:         a.LastVote,
+			EpochCredits:     epochCredits,
+			RootSlot:         a.RootSlot,
+		})
+	}
+	return output
+}
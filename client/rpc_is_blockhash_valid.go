@@ -16,37 +16,42 @@ func (c IsBlockhashValidConfig) toRpc() rpc.IsBlockhashValidConfig {
 	}
 }
 
-func (c *Client) IsBlockhashValid(ctx context.Context, blockhash string) (bool, error) {
+// IsBlockhashValid returns whether a blockhash is still valid or not. A transaction with an invalid
+// blockhash can't land anymore, so it is safe to re-sign it with a new blockhash.
+func (c *Client) IsBlockhashValid(ctx context.Context, blockhash string, opts ...rpc.CallOption) (bool, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[bool]], error) {
-			return c.RpcClient.IsBlockhashValid(ctx, blockhash)
+			return c.RpcClient.IsBlockhashValid(ctx, blockhash, opts...)
 		},
 		value[bool],
 	)
 }
 
-func (c *Client) IsBlockhashValidWithConfig(ctx context.Context, blockhash string, cfg IsBlockhashValidConfig) (bool, error) {
+// IsBlockhashValidWithConfig returns whether a blockhash is still valid or not
+func (c *Client) IsBlockhashValidWithConfig(ctx context.Context, blockhash string, cfg IsBlockhashValidConfig, opts ...rpc.CallOption) (bool, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[bool]], error) {
-			return c.RpcClient.IsBlockhashValidWithConfig(ctx, blockhash, cfg.toRpc())
+			return c.RpcClient.IsBlockhashValidWithConfig(ctx, blockhash, cfg.toRpc(), opts...)
 		},
 		value[bool],
 	)
 }
 
-func (c *Client) IsBlockhashValidAndContext(ctx context.Context, blockhash string) (rpc.ValueWithContext[bool], error) {
+// IsBlockhashValidAndContext returns whether a blockhash is still valid or not with the context
+func (c *Client) IsBlockhashValidAndContext(ctx context.Context, blockhash string, opts ...rpc.CallOption) (rpc.ValueWithContext[bool], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[bool]], error) {
-			return c.RpcClient.IsBlockhashValid(ctx, blockhash)
+			return c.RpcClient.IsBlockhashValid(ctx, blockhash, opts...)
 		},
 		forward[rpc.ValueWithContext[bool]],
 	)
 }
 
-func (c *Client) IsBlockhashValidAndContextWithConfig(ctx context.Context, blockhash string, cfg IsBlockhashValidConfig) (rpc.ValueWithContext[bool], error) {
+// IsBlockhashValidAndContextWithConfig returns whether a blockhash is still valid or not with the context
+func (c *Client) IsBlockhashValidAndContextWithConfig(ctx context.Context, blockhash string, cfg IsBlockhashValidConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[bool], error) {
 	return process(
 		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[bool]], error) {
-			return c.RpcClient.IsBlockhashValidWithConfig(ctx, blockhash, cfg.toRpc())
+			return c.RpcClient.IsBlockhashValidWithConfig(ctx, blockhash, cfg.toRpc(), opts...)
 		},
 		forward[rpc.ValueWithContext[bool]],
 	)
@@ -17,17 +17,17 @@ func (c RequestAirdropConfig) toRpc() rpc.RequestAirdropConfig {
 }
 
 // RequestAirdrop requests an airdrop of lamports to a Pubkey
-func (c *Client) RequestAirdrop(ctx context.Context, base58Addr string, lamports uint64) (string, error) {
+func (c *Client) RequestAirdrop(ctx context.Context, base58Addr string, lamports uint64, opts ...rpc.CallOption) (string, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[string], error) {
-			return c.RpcClient.RequestAirdrop(ctx, base58Addr, lamports)
+			return c.RpcClient.RequestAirdrop(ctx, base58Addr, lamports, opts...)
 		},
 		forward[string],
 	)
 }
 
-// RequestAirdrop requests an airdrop of lamports to a Pubkey
-func (c *Client) RequestAirdropWithConfig(ctx context.Context, base58Addr string, lamports uint64, cfg RequestAirdropConfig) (string, error) {
+// RequestAirdropWithConfig requests an airdrop of lamports to a Pubkey
+func (c *Client) RequestAirdropWithConfig(ctx context.Context, base58Addr string, lamports uint64, cfg RequestAirdropConfig, opts ...rpc.CallOption) (string, error) {
 	return process(
 		func() (rpc.JsonRpcResponse[string], error) {
 			return c.RpcClient.RequestAirdropWithConfig(
@@ -35,6 +35,7 @@ func (c *Client) RequestAirdropWithConfig(ctx context.Context, base58Addr string
 				base58Addr,
 				lamports,
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		forward[string],
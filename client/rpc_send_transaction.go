@@ -12,7 +12,9 @@ import (
 type SendTransactionConfig struct {
 	SkipPreflight       bool
 	PreflightCommitment rpc.Commitment
-	MaxRetries          uint64
+	// MaxRetries 0 means the node default, pass rpc.WithMaxRetries(0) to stop the node from retrying
+	MaxRetries     uint64
+	MinContextSlot *uint64
 }
 
 func (c SendTransactionConfig) toRpc() rpc.SendTransactionConfig {
@@ -21,41 +23,51 @@ func (c SendTransactionConfig) toRpc() rpc.SendTransactionConfig {
 		PreflightCommitment: c.PreflightCommitment,
 		MaxRetries:          c.MaxRetries,
 		SkipPreflight:       c.SkipPreflight,
+		MinContextSlot:      c.MinContextSlot,
 	}
 }
 
 // SendTransaction send transaction struct directly
-func (c *Client) SendTransaction(ctx context.Context, tx types.Transaction) (string, error) {
+func (c *Client) SendTransaction(ctx context.Context, tx types.Transaction, opts ...rpc.CallOption) (string, error) {
+	return c.SendTransactionWithConfig(ctx, tx, SendTransactionConfig{}, opts...)
+}
+
+// SendTransactionWithConfig send transaction struct directly
+func (c *Client) SendTransactionWithConfig(ctx context.Context, tx types.Transaction, cfg SendTransactionConfig, opts ...rpc.CallOption) (string, error) {
+	if err := tx.ValidateSize(); err != nil {
+		return "", err
+	}
 	rawTx, err := tx.Serialize()
 	if err != nil {
 		return "", fmt.Errorf("failed to serialize tx, err: %v", err)
 	}
-	return process(
-		func() (rpc.JsonRpcResponse[string], error) {
-			return c.RpcClient.SendTransactionWithConfig(
-				ctx,
-				base64.StdEncoding.EncodeToString(rawTx),
-				SendTransactionConfig{}.toRpc(),
-			)
-		},
-		forward[string],
-	)
+	return c.SendRawTransactionWithConfig(ctx, rawTx, cfg, opts...)
 }
 
-// SendTransaction send transaction struct directly
-func (c *Client) SendTransactionWithConfig(ctx context.Context, tx types.Transaction, cfg SendTransactionConfig) (string, error) {
-	rawTx, err := tx.Serialize()
-	if err != nil {
-		return "", fmt.Errorf("failed to serialize tx, err: %v", err)
+// SendRawTransaction sends a serialized transaction, e.g. one signed outside of this sdk
+func (c *Client) SendRawTransaction(ctx context.Context, rawTx []byte, opts ...rpc.CallOption) (string, error) {
+	return c.SendRawTransactionWithConfig(ctx, rawTx, SendTransactionConfig{}, opts...)
+}
+
+// SendRawTransactionWithConfig sends a serialized transaction, e.g. one signed outside of this sdk.
+// a *PreflightError returns if the simulation before sending fails.
+func (c *Client) SendRawTransactionWithConfig(ctx context.Context, rawTx []byte, cfg SendTransactionConfig, opts ...rpc.CallOption) (string, error) {
+	if len(rawTx) > types.PacketDataSize {
+		return "", fmt.Errorf("%w, size: %v, max: %v", types.ErrTransactionTooLarge, len(rawTx), types.PacketDataSize)
 	}
-	return process(
+	sig, err := process(
 		func() (rpc.JsonRpcResponse[string], error) {
 			return c.RpcClient.SendTransactionWithConfig(
 				ctx,
 				base64.StdEncoding.EncodeToString(rawTx),
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		forward[string],
 	)
+	if err != nil {
+		return "", newPreflightError(err)
+	}
+	return sig, nil
 }
@@ -3,11 +3,17 @@ package client
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
 	"github.com/liangjies/solana-go-sdk/rpc"
 	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_SendTransaction(t *testing.T) {
@@ -104,6 +110,135 @@ func TestClient_SendTransactionWithConfig(t *testing.T) {
 				ExpectedValue: "uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV",
 				ExpectedError: nil,
 			},
+			{
+				Name:         "with min context slot",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=", {"encoding":"base64", "minContextSlot": 100}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SendTransactionWithConfig(
+						context.Background(),
+						tx,
+						SendTransactionConfig{
+							MinContextSlot: pointer.Get[uint64](100),
+						},
+					)
+				},
+				ExpectedValue: "uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV",
+				ExpectedError: nil,
+			},
+			{
+				Name:         "disable node retries",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=", {"encoding":"base64", "maxRetries": 0}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SendTransactionWithConfig(
+						context.Background(),
+						tx,
+						SendTransactionConfig{},
+						rpc.WithMaxRetries(0),
+					)
+				},
+				ExpectedValue: "uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV",
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_SendRawTransaction(t *testing.T) {
+	b, _ := base64.StdEncoding.DecodeString("AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=")
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=", {"encoding":"base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SendRawTransaction(
+						context.Background(),
+						b,
+					)
+				},
+				ExpectedValue: "uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV",
+				ExpectedError: nil,
+			},
 		},
 	)
 }
+
+func TestClient_SendRawTransactionWithConfig(t *testing.T) {
+	b, _ := base64.StdEncoding.DecodeString("AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=")
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["AS0vVCOi6XOkuufPHS3HyoJPInhwLzT11XpPBYBC9gp/bK9yC94aoeyiuZHZBF7MdddUJ2TPhKZiVyuJuaKp1QQBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4FSlNamSkhBk0k6HFg2jh8fDW13bySu4HkH6hAQQVEjYoKT69yJM5QhVyj/TbbwW+0VbubU5Ssg4cY/m97ik7YAQEABPCfkbs=", {"encoding":"base64", "skipPreflight": true, "maxRetries": 3, "minContextSlot": 100}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SendRawTransactionWithConfig(
+						context.Background(),
+						b,
+						SendTransactionConfig{
+							SkipPreflight:  true,
+							MaxRetries:     3,
+							MinContextSlot: pointer.Get[uint64](100),
+						},
+					)
+				},
+				ExpectedValue: "uQ1KB2ZS7WDN5Jf4nFxDCC75reGMdUW8S7mybWfZPzMPo4TULPE8NCkJAaQ5ifCoDmreCnzdPmFjLrDTRJ6QLbV",
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_SendTransaction_TooLarge(t *testing.T) {
+	feePayer := types.NewAccount()
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        feePayer.PublicKey,
+			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+			Instructions: []types.Instruction{
+				{ProgramID: common.MemoProgramID, Data: make([]byte, types.PacketDataSize)},
+			},
+		}),
+		Signers: []types.Account{feePayer},
+	})
+	assert.Nil(t, err)
+
+	// the rpc node is not called
+	c := NewClient("http://127.0.0.1:0")
+	_, err = c.SendTransaction(context.Background(), tx)
+	assert.ErrorIs(t, err, types.ErrTransactionTooLarge)
+	_, err = c.SendRawTransaction(context.Background(), make([]byte, types.PacketDataSize+1))
+	assert.EqualError(t, err, "transaction too large, size: 1233, max: 1232")
+}
+
+func TestClient_SendTransaction_PreflightFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32002,"message":"Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1","data":{"accounts":null,"err":{"InstructionError":[0,{"Custom":1}]},"logs":["Program 11111111111111111111111111111111 invoke [1]","Transfer: insufficient lamports 10, need 100","Program 11111111111111111111111111111111 failed: custom program error: 0x1"],"returnData":null,"unitsConsumed":150}},"id":1}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).SendRawTransaction(context.Background(), []byte{1})
+
+	var preflightErr *PreflightError
+	assert.True(t, errors.As(err, &preflightErr))
+	assert.Equal(t, "Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1", preflightErr.Message)
+	assert.Equal(t, map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}}, preflightErr.Err)
+	assert.Len(t, preflightErr.Logs, 3)
+	assert.Equal(t, pointer.Get[uint64](150), preflightErr.UnitsConsumed)
+	assert.Equal(t, pointer.Get(0), preflightErr.InstructionIndex)
+	assert.Equal(t, map[string]any{"Custom": float64(1)}, preflightErr.InstructionErr)
+	assert.Equal(t, common.SystemProgramID, preflightErr.ProgramError.ProgramID)
+	assert.Equal(t, "ResultWithNegativeLamports", preflightErr.ProgramError.Name)
+	assert.Contains(t, err.Error(), "Transfer: insufficient lamports 10, need 100")
+
+	var rpcErr *rpc.JsonRpcError
+	assert.True(t, errors.As(err, &rpcErr))
+	assert.True(t, errors.Is(err, &rpc.JsonRpcError{Code: rpc.ErrorCodeSendTransactionPreflightFailure}))
+}
@@ -10,17 +10,23 @@ import (
 )
 
 type SimulateTransaction struct {
-	Err        any
-	Logs       []string
-	Accounts   []*AccountInfo
-	ReturnData *ReturnData
+	Err           any
+	Logs          []string
+	Accounts      []*AccountInfo
+	ReturnData    *ReturnData
+	UnitsConsumed *uint64
+	// InnerInstructions are set if SimulateTransactionConfig.InnerInstructions is, the node returns them
+	// parsed or partially decoded, see ParsedInstruction
+	InnerInstructions []ParsedInnerInstruction
 }
 
 type SimulateTransactionConfig struct {
 	SigVerify              bool
 	Commitment             rpc.Commitment
 	ReplaceRecentBlockhash bool
-	Addresses              []string
+	// Addresses are the accounts whose post-simulation states are returned in SimulateTransaction.Accounts
+	Addresses         []string
+	InnerInstructions bool
 }
 
 func (c SimulateTransactionConfig) toRpc() rpc.SimulateTransactionConfig {
@@ -37,10 +43,11 @@ func (c SimulateTransactionConfig) toRpc() rpc.SimulateTransactionConfig {
 		Commitment:             c.Commitment,
 		ReplaceRecentBlockhash: c.ReplaceRecentBlockhash,
 		Accounts:               accounts,
+		InnerInstructions:      c.InnerInstructions,
 	}
 }
 
-func (c *Client) SimulateTransaction(ctx context.Context, tx types.Transaction) (SimulateTransaction, error) {
+func (c *Client) SimulateTransaction(ctx context.Context, tx types.Transaction, opts ...rpc.CallOption) (SimulateTransaction, error) {
 	rawTx, err := tx.Serialize()
 	if err != nil {
 		return SimulateTransaction{}, fmt.Errorf("failed to serialize tx, err: %v", err)
@@ -51,13 +58,14 @@ func (c *Client) SimulateTransaction(ctx context.Context, tx types.Transaction)
 				ctx,
 				base64.StdEncoding.EncodeToString(rawTx),
 				SimulateTransactionConfig{}.toRpc(),
+				opts...,
 			)
 		},
 		convertSimulateTransaction,
 	)
 }
 
-func (c *Client) SimulateTransactionWithConfig(ctx context.Context, tx types.Transaction, cfg SimulateTransactionConfig) (SimulateTransaction, error) {
+func (c *Client) SimulateTransactionWithConfig(ctx context.Context, tx types.Transaction, cfg SimulateTransactionConfig, opts ...rpc.CallOption) (SimulateTransaction, error) {
 	rawTx, err := tx.Serialize()
 	if err != nil {
 		return SimulateTransaction{}, fmt.Errorf("failed to serialize tx, err: %v", err)
@@ -68,13 +76,14 @@ func (c *Client) SimulateTransactionWithConfig(ctx context.Context, tx types.Tra
 				ctx,
 				base64.StdEncoding.EncodeToString(rawTx),
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		convertSimulateTransaction,
 	)
 }
 
-func (c *Client) SimulateTransactionAndContext(ctx context.Context, tx types.Transaction) (rpc.ValueWithContext[SimulateTransaction], error) {
+func (c *Client) SimulateTransactionAndContext(ctx context.Context, tx types.Transaction, opts ...rpc.CallOption) (rpc.ValueWithContext[SimulateTransaction], error) {
 	rawTx, err := tx.Serialize()
 	if err != nil {
 		return rpc.ValueWithContext[SimulateTransaction]{}, fmt.Errorf("failed to serialize tx, err: %v", err)
@@ -85,13 +94,14 @@ func (c *Client) SimulateTransactionAndContext(ctx context.Context, tx types.Tra
 				ctx,
 				base64.StdEncoding.EncodeToString(rawTx),
 				SimulateTransactionConfig{}.toRpc(),
+				opts...,
 			)
 		},
 		convertSimulateTransactionAndContext,
 	)
 }
 
-func (c *Client) SimulateTransactionAndContextWithConfig(ctx context.Context, tx types.Transaction, cfg SimulateTransactionConfig) (rpc.ValueWithContext[SimulateTransaction], error) {
+func (c *Client) SimulateTransactionAndContextWithConfig(ctx context.Context, tx types.Transaction, cfg SimulateTransactionConfig, opts ...rpc.CallOption) (rpc.ValueWithContext[SimulateTransaction], error) {
 	rawTx, err := tx.Serialize()
 	if err != nil {
 		return rpc.ValueWithContext[SimulateTransaction]{}, fmt.Errorf("failed to serialize tx, err: %v", err)
@@ -102,6 +112,7 @@ func (c *Client) SimulateTransactionAndContextWithConfig(ctx context.Context, tx
 				ctx,
 				base64.StdEncoding.EncodeToString(rawTx),
 				cfg.toRpc(),
+				opts...,
 			)
 		},
 		convertSimulateTransactionAndContext,
@@ -134,11 +145,22 @@ func convertSimulateTransaction(v rpc.ValueWithContext[rpc.SimulateTransactionVa
 		returnData = &d
 	}
 
+	var innerInstructions []ParsedInnerInstruction
+	if v.Value.InnerInstructions != nil {
+		var err error
+		innerInstructions, err = convertParsedInnerInstructions(v.Value.InnerInstructions)
+		if err != nil {
+			return SimulateTransaction{}, fmt.Errorf("failed to process inner instructions, err: %v", err)
+		}
+	}
+
 	return SimulateTransaction{
-		Err:        v.Value.Err,
-		Logs:       v.Value.Logs,
-		Accounts:   accountInfos,
-		ReturnData: returnData,
+		Err:               v.Value.Err,
+		Logs:              v.Value.Logs,
+		Accounts:          accountInfos,
+		ReturnData:        returnData,
+		UnitsConsumed:     v.Value.UnitsConsumed,
+		InnerInstructions: innerInstructions,
 	}, nil
 }
 
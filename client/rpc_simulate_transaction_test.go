@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
 	"github.com/liangjies/solana-go-sdk/rpc"
 )
 
@@ -36,6 +38,7 @@ func TestClient_SimulateTransaction(t *testing.T) {
 						ProgramId: common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
 						Data:      []byte{1, 2, 3, 4, 5},
 					},
+					UnitsConsumed: pointer.Get[uint64](185),
 				},
 				ExpectedError: nil,
 			},
@@ -75,6 +78,68 @@ func TestClient_SimulateTransactionAndContext(t *testing.T) {
 							ProgramId: common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
 							Data:      []byte{1, 2, 3, 4, 5},
 						},
+						UnitsConsumed: pointer.Get[uint64](185),
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_SimulateTransactionWithConfig(t *testing.T) {
+	tx := mustDeserializeBase64Tx(t, "Ab/yMEK7qNgGxaPMg2XaVnwwLMqnY8FTeJrA9qJ1nOBFX08BHycnp3/9WOxOY53+eZnbkT2/+6Mx7w+DsuVN8ggBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4e0EmQh0otX6HS7HumAryrMtxCzacgpjtG6MY9cJWYYEsGZsdWhvaw9ENEPFBEi4eBna4CphPQWWcgU4yARSnVAQEAAA==")
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"simulateTransaction","params":["Ab/yMEK7qNgGxaPMg2XaVnwwLMqnY8FTeJrA9qJ1nOBFX08BHycnp3/9WOxOY53+eZnbkT2/+6Mx7w+DsuVN8ggBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4e0EmQh0otX6HS7HumAryrMtxCzacgpjtG6MY9cJWYYEsGZsdWhvaw9ENEPFBEi4eBna4CphPQWWcgU4yARSnVAQEAAA==", {"encoding": "base64", "replaceRecentBlockhash": true, "innerInstructions": true, "accounts": {"encoding": "base64", "addresses": ["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", "9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"]}}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.17.3","slot":159776096},"value":{"accounts":[{"data":["AQID","base64"],"executable":false,"lamports":1000,"owner":"11111111111111111111111111111111","rentEpoch":0},null],"err":null,"innerInstructions":[{"index":0,"instructions":[{"parsed":{"info":{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":1000,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111","stackHeight":2},{"accounts":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7","9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"],"data":"Ldp","programId":"35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP","stackHeight":2}]}],"logs":[],"replacementBlockhash":{"blockhash":"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e","lastValidBlockHeight":159776246},"returnData":null,"unitsConsumed":1500}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SimulateTransactionWithConfig(
+						context.Background(),
+						tx,
+						SimulateTransactionConfig{
+							ReplaceRecentBlockhash: true,
+							Addresses:              []string{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", "9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"},
+							InnerInstructions:      true,
+						},
+					)
+				},
+				ExpectedValue: SimulateTransaction{
+					Logs: []string{},
+					Accounts: []*AccountInfo{
+						{
+							Lamports: 1000,
+							Owner:    common.SystemProgramID,
+							Data:     []byte{1, 2, 3},
+						},
+						nil,
+					},
+					UnitsConsumed: pointer.Get[uint64](1500),
+					InnerInstructions: []ParsedInnerInstruction{
+						{
+							Index: 0,
+							Instructions: []ParsedInstruction{
+								{
+									Program:     "system",
+									ProgramID:   common.SystemProgramID,
+									Type:        "transfer",
+									Info:        json.RawMessage(`{"destination":"9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D","lamports":1000,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}`),
+									StackHeight: pointer.Get[uint64](2),
+								},
+								{
+									ProgramID: common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
+									Accounts: []common.PublicKey{
+										common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+										common.PublicKeyFromString("9ywX3U33UZC1HThhoBR2Ys7SiouXDkkDoH6brJApFh5D"),
+									},
+									Data:        []byte{1, 2, 3},
+									StackHeight: pointer.Get[uint64](2),
+								},
+							},
+						},
 					},
 				},
 				ExpectedError: nil,
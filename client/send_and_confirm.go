@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+type SendAndConfirmTransactionParam struct {
+	Instructions []types.Instruction
+	Signers      []types.Account
+	// ExternalSigners are signers without a local private key, e.g. a ledger
+	ExternalSigners []types.Signer
+	FeePayer        common.PublicKey
+	// v0 transaction
+	AddressLookupTableAccounts []types.AddressLookupTableAccount
+}
+
+type SendAndConfirmTransactionConfig struct {
+	// Commitment is used to fetch the blockhash and to wait for, default is the client default commitment or confirmed
+	Commitment rpc.Commitment
+	// MaxRetries is the number of times to rebuild the transaction with a new blockhash after the previous one expired
+	// or the node didn't find it
+	MaxRetries int
+	// PollInterval is the first interval between status polls, see WaitForConfirmationConfig
+	PollInterval time.Duration
+	// ComputeBudget prepends the compute budget instructions if it is set, see BuildTransactionConfig
+	ComputeBudget         *ComputeBudgetConfig
+	SendTransactionConfig SendTransactionConfig
+}
+
+// SendAndConfirmTransaction fetches a blockhash, signs, sends and waits for the transaction with the confirmed commitment
+func (c *Client) SendAndConfirmTransaction(ctx context.Context, param SendAndConfirmTransactionParam) (string, error) {
+	return c.SendAndConfirmTransactionWithConfig(ctx, param, SendAndConfirmTransactionConfig{})
+}
+
+// SendAndConfirmTransactionWithConfig fetches a blockhash, signs, sends and waits for the transaction.
+// if the blockhash expires before the transaction is confirmed, or the preflight doesn't find it, the transaction is
+// rebuilt with a new blockhash up to cfg.MaxRetries times.
+// a *TransactionError returns if the transaction failed, ErrTransactionExpired returns if all attempts expired.
+func (c *Client) SendAndConfirmTransactionWithConfig(ctx context.Context, param SendAndConfirmTransactionParam, cfg SendAndConfirmTransactionConfig) (string, error) {
+	commitment := c.commitmentOrDefault(cfg.Commitment, rpc.CommitmentConfirmed)
+
+	for attempt := 0; ; attempt++ {
+		built, err := c.BuildTransactionWithConfig(ctx, BuildTransactionParam{
+			Instructions:               param.Instructions,
+			Signers:                    param.Signers,
+			ExternalSigners:            param.ExternalSigners,
+			FeePayer:                   param.FeePayer,
+			AddressLookupTableAccounts: param.AddressLookupTableAccounts,
+		}, BuildTransactionConfig{Commitment: commitment, ComputeBudget: cfg.ComputeBudget})
+		if err != nil {
+			return "", err
+		}
+
+		sig, err := c.SendTransactionWithConfig(ctx, built.Transaction, cfg.SendTransactionConfig)
+		if isBlockhashNotFound(err) && attempt < cfg.MaxRetries {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		_, err = c.WaitForConfirmationWithConfig(ctx, sig, commitment, WaitForConfirmationConfig{
+			LastValidBlockHeight: built.LastValidBlockHeight,
+			PollInterval:         cfg.PollInterval,
+		})
+		if errors.Is(err, ErrTransactionExpired) && attempt < cfg.MaxRetries {
+			continue
+		}
+		return sig, err
+	}
+}
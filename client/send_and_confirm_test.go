@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/program/memo"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_SendAndConfirmTransactionWithConfig(t *testing.T) {
+	feePayer := types.NewAccount()
+	blockhashes := []string{
+		"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e",
+		"FBVKaoAJUiFiDHLoKaPTDHwUHwLSjPV5NXT3VsPhSvDi",
+	}
+
+	tests := []struct {
+		name          string
+		maxRetries    int
+		expectedSends int
+		expectedErr   error
+	}{
+		{
+			name:          "retry after expiry",
+			maxRetries:    1,
+			expectedSends: 2,
+			expectedErr:   nil,
+		},
+		{
+			name:          "no retry",
+			maxRetries:    0,
+			expectedSends: 1,
+			expectedErr:   ErrTransactionExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []types.Transaction
+			server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+				attempt := len(sent)
+				switch method {
+				case "getLatestBlockhash":
+					assert.JSONEq(t, `{"commitment":"confirmed"}`, string(params[0]))
+					return fmt.Sprintf(`{"context":{"slot":1},"value":{"blockhash":"%v","lastValidBlockHeight":%v}}`, blockhashes[attempt], (attempt+1)*100)
+				case "sendTransaction":
+					var raw string
+					assert.Nil(t, json.Unmarshal(params[0], &raw))
+					b, err := base64.StdEncoding.DecodeString(raw)
+					assert.Nil(t, err)
+					tx, err := types.TransactionDeserialize(b)
+					assert.Nil(t, err)
+					sent = append(sent, tx)
+					return fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+				case "getBlockHeight":
+					// the first blockhash is expired
+					if attempt == 1 {
+						return "101"
+					}
+					return "150"
+				case "getSignatureStatuses":
+					if attempt == 1 {
+						return `{"context":{"slot":1},"value":[null]}`
+					}
+					return `{"context":{"slot":1},"value":[{"slot":1,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+				}
+				t.Fatalf("unexpected method: %v", method)
+				return ""
+			})
+			defer server.Close()
+
+			sig, err := NewClient(server.URL).SendAndConfirmTransactionWithConfig(
+				context.Background(),
+				SendAndConfirmTransactionParam{
+					Instructions: []types.Instruction{
+						memo.BuildMemo(memo.BuildMemoParam{Memo: []byte("hello")}),
+					},
+					Signers:  []types.Account{feePayer},
+					FeePayer: feePayer.PublicKey,
+				},
+				SendAndConfirmTransactionConfig{
+					MaxRetries:   tt.maxRetries,
+					PollInterval: time.Millisecond,
+				},
+			)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Len(t, sent, tt.expectedSends)
+			for i, tx := range sent {
+				assert.Equal(t, blockhashes[i], tx.Message.RecentBlockHash)
+				assert.Equal(t, feePayer.PublicKey, tx.Message.Accounts[0])
+			}
+			last := sent[len(sent)-1]
+			assert.Equal(t, base58.Encode(last.Signatures[0]), sig)
+		})
+	}
+}
+
+func TestClient_SendAndConfirmTransactionWithConfig_BlockhashNotFound(t *testing.T) {
+	feePayer := types.NewAccount()
+	blockhashes := []string{
+		"9Y4C9Xt7mT4Ji2Lm7QQUu6zrXQT1wA7SaMxszRvQbn7e",
+		"FBVKaoAJUiFiDHLoKaPTDHwUHwLSjPV5NXT3VsPhSvDi",
+	}
+
+	var mu sync.Mutex
+	var sent []types.Transaction
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var body struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+		var result string
+		switch body.Method {
+		case "getLatestBlockhash":
+			result = fmt.Sprintf(`{"context":{"slot":1},"value":{"blockhash":"%v","lastValidBlockHeight":200}}`, blockhashes[len(sent)])
+		case "sendTransaction":
+			var raw string
+			assert.Nil(t, json.Unmarshal(body.Params[0], &raw))
+			b, err := base64.StdEncoding.DecodeString(raw)
+			assert.Nil(t, err)
+			tx, err := types.TransactionDeserialize(b)
+			assert.Nil(t, err)
+			sent = append(sent, tx)
+			// the node is behind and doesn't know the first blockhash yet
+			if len(sent) == 1 {
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32002,"message":"Transaction simulation failed: Blockhash not found","data":{"accounts":null,"err":"BlockhashNotFound","logs":[],"returnData":null,"unitsConsumed":0}}}`))
+				return
+			}
+			result = fmt.Sprintf(`"%v"`, base58.Encode(tx.Signatures[0]))
+		case "getBlockHeight":
+			result = "150"
+		case "getSignatureStatuses":
+			result = `{"context":{"slot":1},"value":[{"slot":1,"confirmations":1,"err":null,"confirmationStatus":"confirmed"}]}`
+		default:
+			t.Fatalf("unexpected method: %v", body.Method)
+		}
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":%v}`, result)))
+	}))
+	defer server.Close()
+
+	param := SendAndConfirmTransactionParam{
+		Instructions: []types.Instruction{
+			memo.BuildMemo(memo.BuildMemoParam{Memo: []byte("hello")}),
+		},
+		Signers:  []types.Account{feePayer},
+		FeePayer: feePayer.PublicKey,
+	}
+
+	sig, err := NewClient(server.URL).SendAndConfirmTransactionWithConfig(
+		context.Background(),
+		param,
+		SendAndConfirmTransactionConfig{MaxRetries: 1, PollInterval: time.Millisecond},
+	)
+	assert.Nil(t, err)
+	assert.Len(t, sent, 2)
+	assert.Equal(t, blockhashes[1], sent[1].Message.RecentBlockHash)
+	assert.Equal(t, base58.Encode(sent[1].Signatures[0]), sig)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+// maxSignaturesPageSize is the max limit of getSignaturesForAddress, it is also the default page size
+const maxSignaturesPageSize = 1000
+
+// SignatureIterator pages through the signatures of an address from the newest to the oldest.
+//
+//	it := c.NewSignatureIterator(addr, client.GetSignaturesForAddressConfig{})
+//	for it.Next(ctx) {
+//		sig := it.Signature()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type SignatureIterator struct {
+	client *Client
+	addr   string
+	cfg    GetSignaturesForAddressConfig
+	opts   []rpc.CallOption
+
+	page []rpc.SignatureWithStatus
+	idx  int
+	cur  rpc.SignatureWithStatus
+	done bool
+	err  error
+}
+
+// NewSignatureIterator returns an iterator over the signatures of addr. cfg.Before and cfg.Until bound the range,
+// cfg.Limit is the page size of each request, it is at most 1000, which is the default too.
+func (c *Client) NewSignatureIterator(addr string, cfg GetSignaturesForAddressConfig, opts ...rpc.CallOption) *SignatureIterator {
+	// a larger limit is rejected or cut by the node, the latter would look like the last page
+	if cfg.Limit <= 0 || cfg.Limit > maxSignaturesPageSize {
+		cfg.Limit = maxSignaturesPageSize
+	}
+	return &SignatureIterator{
+		client: c,
+		addr:   addr,
+		cfg:    cfg,
+		opts:   opts,
+	}
+}
+
+// Next advances to the next signature, it fetches the next page when the current one is consumed.
+// it returns false when there are no more signatures or an error occurs.
+func (it *SignatureIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	if it.idx >= len(it.page) {
+		if it.done {
+			return false
+		}
+		page, err := it.client.GetSignaturesForAddressWithConfig(ctx, it.addr, it.cfg, it.opts...)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		// a short page means there is nothing left before it
+		if len(page) < it.cfg.Limit {
+			it.done = true
+		}
+		if len(page) == 0 {
+			return false
+		}
+		it.page, it.idx = page, 0
+		it.cfg.Before = page[len(page)-1].Signature
+	}
+	it.cur = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// Signature returns the current signature
+func (it *SignatureIterator) Signature() rpc.SignatureWithStatus {
+	return it.cur
+}
+
+// Err returns the error which stopped the iteration
+func (it *SignatureIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureIterator(t *testing.T) {
+	// sig9 is the newest, sig0 is the oldest
+	var all []rpc.SignatureWithStatus
+	for i := 9; i >= 0; i-- {
+		all = append(all, rpc.SignatureWithStatus{Signature: fmt.Sprintf("sig%d", i), Slot: uint64(i)})
+	}
+
+	tests := []struct {
+		name          string
+		cfg           GetSignaturesForAddressConfig
+		expected      []string
+		expectedCalls int
+	}{
+		{
+			name:          "all",
+			cfg:           GetSignaturesForAddressConfig{Limit: 4},
+			expected:      []string{"sig9", "sig8", "sig7", "sig6", "sig5", "sig4", "sig3", "sig2", "sig1", "sig0"},
+			expectedCalls: 3,
+		},
+		{
+			name:          "exact pages",
+			cfg:           GetSignaturesForAddressConfig{Limit: 5},
+			expected:      []string{"sig9", "sig8", "sig7", "sig6", "sig5", "sig4", "sig3", "sig2", "sig1", "sig0"},
+			expectedCalls: 3,
+		},
+		{
+			name:          "before and until",
+			cfg:           GetSignaturesForAddressConfig{Limit: 2, Before: "sig8", Until: "sig3"},
+			expected:      []string{"sig7", "sig6", "sig5", "sig4"},
+			expectedCalls: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				calls++
+				var body struct {
+					Params []json.RawMessage `json:"params"`
+				}
+				assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+				var cfg rpc.GetSignaturesForAddressConfig
+				assert.Nil(t, json.Unmarshal(body.Params[1], &cfg))
+
+				var page []rpc.SignatureWithStatus
+				started := cfg.Before == ""
+				for _, s := range all {
+					if s.Signature == cfg.Until || len(page) == cfg.Limit {
+						break
+					}
+					if started {
+						page = append(page, s)
+					}
+					if s.Signature == cfg.Before {
+						started = true
+					}
+				}
+				b, _ := json.Marshal(rpc.JsonRpcResponse[[]rpc.SignatureWithStatus]{JsonRpc: "2.0", Id: 1, Result: page})
+				_, _ = rw.Write(b)
+			}))
+			defer server.Close()
+
+			it := NewClient(server.URL).NewSignatureIterator("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", tt.cfg)
+			var got []string
+			for it.Next(context.Background()) {
+				got = append(got, it.Signature().Signature)
+			}
+			assert.Nil(t, it.Err())
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.False(t, it.Next(context.Background()))
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestSignatureIteratorError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param: WrongSize"},"id":1}`))
+	}))
+	defer server.Close()
+
+	it := NewClient(server.URL).NewSignatureIterator("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", GetSignaturesForAddressConfig{})
+	assert.False(t, it.Next(context.Background()))
+	assert.Equal(t, &rpc.JsonRpcError{Code: -32602, Message: "Invalid param: WrongSize"}, it.Err())
+}
+
+func TestNewSignatureIterator_Limit(t *testing.T) {
+	c := NewClient("")
+	assert.Equal(t, 1000, c.NewSignatureIterator("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", GetSignaturesForAddressConfig{}).cfg.Limit)
+	assert.Equal(t, 10, c.NewSignatureIterator("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", GetSignaturesForAddressConfig{Limit: 10}).cfg.Limit)
+	// the node never returns more than 1000 signatures in a page
+	assert.Equal(t, 1000, c.NewSignatureIterator("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", GetSignaturesForAddressConfig{Limit: 5000}).cfg.Limit)
+}
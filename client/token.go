@@ -2,7 +2,11 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/program/token"
 )
 
@@ -13,3 +17,96 @@ func (c *Client) GetTokenAccount(ctx context.Context, base58Addr string) (token.
 	}
 	return token.DeserializeTokenAccount(accountInfo.Data, accountInfo.Owner)
 }
+
+// GetMultisigAccount returns the M-of-N multisig which can be used as a token authority
+func (c *Client) GetMultisigAccount(ctx context.Context, base58Addr string) (token.MultisigAccount, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return token.MultisigAccount{}, err
+	}
+	return token.DeserializeMultisigAccount(accountInfo.Data, accountInfo.Owner)
+}
+
+type TokenBalance struct {
+	TokenAmount
+	// PublicKey is the address of the token account
+	PublicKey common.PublicKey
+	Mint      common.PublicKey
+	// ProgramID is the token program or the token-2022 program
+	ProgramID common.PublicKey
+	// Err is set if the mint is closed or can't be deserialized, only the Amount of TokenAmount is set then
+	Err error
+}
+
+// GetAllTokenBalances returns the balances of all token accounts of owner, of both the token program and the token-2022 program.
+// the decimals are read from the mints, which are fetched with getMultipleAccounts. a mint which is closed or
+// can't be deserialized doesn't fail the others, the Err of its balances is set instead.
+func (c *Client) GetAllTokenBalances(ctx context.Context, owner string) ([]TokenBalance, error) {
+	balances := []TokenBalance{}
+	mints := []string{}
+	seen := map[common.PublicKey]bool{}
+	for _, programID := range []common.PublicKey{common.TokenProgramID, common.Token2022ProgramID} {
+		tokenAccounts, err := c.GetTokenAccountsByOwnerByProgram(ctx, owner, programID.ToBase58())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token accounts, program: %v, err: %v", programID.ToBase58(), err)
+		}
+		for _, tokenAccount := range tokenAccounts {
+			balances = append(balances, TokenBalance{
+				TokenAmount: TokenAmount{Amount: tokenAccount.Amount},
+				PublicKey:   tokenAccount.PublicKey,
+				Mint:        tokenAccount.Mint,
+				ProgramID:   programID,
+			})
+			if !seen[tokenAccount.Mint] {
+				seen[tokenAccount.Mint] = true
+				mints = append(mints, tokenAccount.Mint.ToBase58())
+			}
+		}
+	}
+	if len(mints) == 0 {
+		return balances, nil
+	}
+
+	mintAccounts, err := c.GetMultipleAccounts(ctx, mints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mints, err: %w", err)
+	}
+	decimals := make(map[common.PublicKey]uint8, len(mints))
+	mintErrs := map[common.PublicKey]error{}
+	for i, mintAccount := range mintAccounts {
+		mintPubkey := common.PublicKeyFromString(mints[i])
+		if mintAccount.Owner == (common.PublicKey{}) {
+			mintErrs[mintPubkey] = fmt.Errorf("mint %v not found", mints[i])
+			continue
+		}
+		mint, err := token.DeserializeMintAccount(mintAccount.Data, mintAccount.Owner)
+		if err != nil {
+			mintErrs[mintPubkey] = fmt.Errorf("failed to deserialize mint %v, err: %v", mints[i], err)
+			continue
+		}
+		decimals[mintPubkey] = mint.Decimals
+	}
+
+	for i := range balances {
+		if err := mintErrs[balances[i].Mint]; err != nil {
+			balances[i].Err = err
+			continue
+		}
+		balances[i].Decimals = decimals[balances[i].Mint]
+		balances[i].UIAmountString = formatUIAmount(balances[i].Amount, balances[i].Decimals)
+	}
+	return balances, nil
+}
+
+// formatUIAmount formats amount like uiAmountString, e.g. 1500000 with 6 decimals is "1.5"
+func formatUIAmount(amount uint64, decimals uint8) string {
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(decimals)] + "." + s[len(s)-int(decimals):]
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
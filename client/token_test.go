@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func tokenAccountData(mint, owner common.PublicKey, amount uint64) string {
+	data := make([]byte, token.TokenAccountSize)
+	copy(data[0:32], mint.Bytes())
+	copy(data[32:64], owner.Bytes())
+	binary.LittleEndian.PutUint64(data[64:72], amount)
+	data[108] = byte(token.TokenAccountStateInitialized)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func mintAccountData(decimals uint8, extensionLen int) string {
+	data := make([]byte, token.MintAccountSize+extensionLen)
+	data[44], data[45] = decimals, 1
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func TestClient_GetAllTokenBalances(t *testing.T) {
+	owner := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	usdc := common.PublicKeyFromString("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	pyusd := common.PublicKeyFromString("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo")
+	closed := common.PublicKeyFromString("So11111111111111111111111111111111111111112")
+	accounts := []common.PublicKey{
+		common.PublicKeyFromString("DJyNpXgggw1WGgjTVzFsNjb3fuQZVMqhoakvSBfX9LYx"),
+		common.PublicKeyFromString("8wx8PoVMibdYTrfweG2wCFuYz7EhwkaZLm8hutyFgh8T"),
+		common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
+		common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+	}
+
+	server := newFakeRpcServer(t, func(method string, params []json.RawMessage) string {
+		switch method {
+		case "getTokenAccountsByOwner":
+			assert.JSONEq(t, `"27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"`, string(params[0]))
+			var filter struct {
+				ProgramId string `json:"programId"`
+			}
+			assert.Nil(t, json.Unmarshal(params[1], &filter))
+			account := `{"pubkey":"%v","account":{"data":["%v","base64"],"executable":false,"lamports":2039280,"owner":"%v","rentEpoch":0}}`
+			switch filter.ProgramId {
+			case common.TokenProgramID.ToBase58():
+				return fmt.Sprintf(`{"context":{"slot":1},"value":[%v,%v,%v]}`,
+					fmt.Sprintf(account, accounts[0], tokenAccountData(usdc, owner, 1500000), filter.ProgramId),
+					fmt.Sprintf(account, accounts[1], tokenAccountData(usdc, owner, 0), filter.ProgramId),
+					fmt.Sprintf(account, accounts[3], tokenAccountData(closed, owner, 7), filter.ProgramId),
+				)
+			case common.Token2022ProgramID.ToBase58():
+				return fmt.Sprintf(`{"context":{"slot":1},"value":[%v]}`,
+					fmt.Sprintf(account, accounts[2], tokenAccountData(pyusd, owner, 123), filter.ProgramId),
+				)
+			}
+		case "getMultipleAccounts":
+			assert.JSONEq(t, `["EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v","So11111111111111111111111111111111111111112","2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo"]`, string(params[0]))
+			// the mint of the third account is closed
+			return fmt.Sprintf(`{"context":{"slot":1},"value":[{"data":["%v","base64"],"executable":false,"lamports":1461600,"owner":"%v","rentEpoch":0},null,{"data":["%v","base64"],"executable":false,"lamports":1461600,"owner":"%v","rentEpoch":0}]}`,
+				mintAccountData(6, 0), common.TokenProgramID, mintAccountData(6, 200), common.Token2022ProgramID,
+			)
+		}
+		t.Fatalf("unexpected method: %v", method)
+		return ""
+	})
+	defer server.Close()
+
+	balances, err := NewClient(server.URL).GetAllTokenBalances(context.Background(), owner.ToBase58())
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenBalance{
+		{
+			TokenAmount: TokenAmount{Amount: 1500000, Decimals: 6, UIAmountString: "1.5"},
+			PublicKey:   accounts[0],
+			Mint:        usdc,
+			ProgramID:   common.TokenProgramID,
+		},
+		{
+			TokenAmount: TokenAmount{Amount: 0, Decimals: 6, UIAmountString: "0"},
+			PublicKey:   accounts[1],
+			Mint:        usdc,
+			ProgramID:   common.TokenProgramID,
+		},
+		{
+			TokenAmount: TokenAmount{Amount: 7},
+			PublicKey:   accounts[3],
+			Mint:        closed,
+			ProgramID:   common.TokenProgramID,
+			Err:         errors.New("mint So11111111111111111111111111111111111111112 not found"),
+		},
+		{
+			TokenAmount: TokenAmount{Amount: 123, Decimals: 6, UIAmountString: "0.000123"},
+			PublicKey:   accounts[2],
+			Mint:        pyusd,
+			ProgramID:   common.Token2022ProgramID,
+		},
+	}, balances)
+}
+
+func TestFormatUIAmount(t *testing.T) {
+	tests := []struct {
+		amount   uint64
+		decimals uint8
+		want     string
+	}{
+		{amount: 0, decimals: 0, want: "0"},
+		{amount: 0, decimals: 9, want: "0"},
+		{amount: 100, decimals: 0, want: "100"},
+		{amount: 1, decimals: 9, want: "0.000000001"},
+		{amount: 1000000000, decimals: 9, want: "1"},
+		{amount: 1234500000, decimals: 9, want: "1.2345"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatUIAmount(tt.amount, tt.decimals))
+	}
+}
@@ -0,0 +1,25 @@
+package ws
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type AccountNotification rpc.ValueWithContext[rpc.AccountInfo]
+
+// AccountSubscribeConfig is a option config for `accountSubscribe`
+type AccountSubscribeConfig struct {
+	Commitment rpc.Commitment      `json:"commitment,omitempty"`
+	Encoding   rpc.AccountEncoding `json:"encoding,omitempty"`
+}
+
+// AccountSubscribe subscribes to an account to receive notifications when the lamports or data changes
+func (c *Client) AccountSubscribe(ctx context.Context, base58Addr string) (*Subscription[AccountNotification], error) {
+	return subscribe[AccountNotification](ctx, c, "accountSubscribe", "accountUnsubscribe", nil, base58Addr)
+}
+
+// AccountSubscribeWithConfig subscribes to an account to receive notifications when the lamports or data changes
+func (c *Client) AccountSubscribeWithConfig(ctx context.Context, base58Addr string, cfg AccountSubscribeConfig) (*Subscription[AccountNotification], error) {
+	return subscribe[AccountNotification](ctx, c, "accountSubscribe", "accountUnsubscribe", nil, base58Addr, cfg)
+}
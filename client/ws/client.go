@@ -0,0 +1,228 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+const (
+	LocalnetWSEndpoint = "ws://localhost:8900"
+	DevnetWSEndpoint   = "wss://api.devnet.solana.com"
+	TestnetWSEndpoint  = "wss://api.testnet.solana.com"
+	MainnetWSEndpoint  = "wss://api.mainnet-beta.solana.com"
+)
+
+var (
+	ErrClientClosed = errors.New("ws client closed")
+)
+
+type request struct {
+	JsonRpc string `json:"jsonrpc"`
+	Id      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params,omitempty"`
+}
+
+type message struct {
+	Id     *uint64           `json:"id"`
+	Result json.RawMessage   `json:"result"`
+	Error  *rpc.JsonRpcError `json:"error"`
+	Method string            `json:"method"`
+	Params *struct {
+		Result       json.RawMessage `json:"result"`
+		Subscription uint64          `json:"subscription"`
+	} `json:"params"`
+}
+
+type response struct {
+	result json.RawMessage
+	err    error
+}
+
+type pendingCall struct {
+	ch chan response
+	// onResult is called by the read loop before any following message is
+	// handled, it is used to register a subscription without missing notifications
+	onResult func(json.RawMessage)
+}
+
+type subscriber interface {
+	notify(json.RawMessage)
+	close()
+}
+
+// Client is a pubsub client which talks to the solana websocket endpoint
+type Client struct {
+	conn *websocket.Conn
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextId  uint64
+	pending map[uint64]*pendingCall
+	subs    map[uint64]subscriber
+	closed  bool
+	err     error
+
+	done chan struct{}
+}
+
+// Connect dials the websocket endpoint and starts to receive messages
+func Connect(ctx context.Context, endpoint string) (*Client, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %v, err: %v", endpoint, err)
+	}
+	c := &Client{
+		conn:    conn,
+		nextId:  1,
+		pending: map[uint64]*pendingCall{},
+		subs:    map[uint64]subscriber{},
+		done:    make(chan struct{}),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// Close closes the connection. all subscriptions will be closed.
+func (c *Client) Close() error {
+	err := c.conn.Close()
+	<-c.done
+	return err
+}
+
+// Done returns a channel which is closed when the connection is gone
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the reason why the connection is gone
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *Client) readLoop() {
+	var err error
+	defer func() {
+		c.mu.Lock()
+		c.closed = true
+		c.err = err
+		for id, p := range c.pending {
+			p.ch <- response{err: ErrClientClosed}
+			delete(c.pending, id)
+		}
+		subs := c.subs
+		c.subs = map[uint64]subscriber{}
+		c.mu.Unlock()
+		for _, sub := range subs {
+			sub.close()
+		}
+		close(c.done)
+	}()
+
+	for {
+		var data []byte
+		_, data, err = c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg message
+		if json.Unmarshal(data, &msg) != nil {
+			continue
+		}
+
+		// a response of a request
+		if msg.Id != nil {
+			c.mu.Lock()
+			p, ok := c.pending[*msg.Id]
+			delete(c.pending, *msg.Id)
+			c.mu.Unlock()
+			if !ok {
+				continue
+			}
+			if msg.Error != nil {
+				p.ch <- response{err: msg.Error}
+				continue
+			}
+			if p.onResult != nil {
+				p.onResult(msg.Result)
+			}
+			p.ch <- response{result: msg.Result}
+			continue
+		}
+
+		// a notification of a subscription
+		if msg.Params != nil {
+			c.mu.Lock()
+			sub, ok := c.subs[msg.Params.Subscription]
+			c.mu.Unlock()
+			if ok {
+				sub.notify(msg.Params.Result)
+			}
+		}
+	}
+}
+
+func (c *Client) call(ctx context.Context, method string, params ...any) (json.RawMessage, error) {
+	return c.callWithHook(ctx, nil, method, params...)
+}
+
+func (c *Client) callWithHook(ctx context.Context, onResult func(json.RawMessage), method string, params ...any) (json.RawMessage, error) {
+	ch := make(chan response, 1)
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, ErrClientClosed
+	}
+	id := c.nextId
+	c.nextId++
+	c.pending[id] = &pendingCall{ch: ch, onResult: onResult}
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	err := c.conn.WriteJSON(request{
+		JsonRpc: "2.0",
+		Id:      id,
+		Method:  method,
+		Params:  params,
+	})
+	c.writeMu.Unlock()
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, fmt.Errorf("failed to send request, err: %v", err)
+	}
+
+	select {
+	case res := <-ch:
+		return res.result, res.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) addSubscriber(id uint64, sub subscriber) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subs[id] = sub
+}
+
+func (c *Client) removeSubscriber(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.subs, id)
+}
@@ -0,0 +1,242 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestServer starts a websocket server. handler receives each request and returns messages to reply
+func newTestServer(t *testing.T, handler func(req map[string]any) []string) (string, func()) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade, err: %v", err)
+			return
+		}
+		defer conn.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var req map[string]any
+			assert.Nil(t, json.Unmarshal(data, &req))
+			for _, msg := range handler(req) {
+				assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+			}
+		}
+	}))
+	return "ws" + strings.TrimPrefix(server.URL, "http"), server.Close
+}
+
+func receive[T any](t *testing.T, ch <-chan T) (T, bool) {
+	select {
+	case v, ok := <-ch:
+		return v, ok
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout")
+	}
+	var v T
+	return v, false
+}
+
+func TestAccountSubscribe(t *testing.T) {
+	unsubscribed := make(chan struct{})
+	endpoint, shutdown := newTestServer(t, func(req map[string]any) []string {
+		switch req["method"] {
+		case "accountSubscribe":
+			assert.Equal(t, []any{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", map[string]any{"commitment": "confirmed", "encoding": "base64"}}, req["params"])
+			return []string{
+				`{"jsonrpc":"2.0","result":23784,"id":1}`,
+				`{"jsonrpc":"2.0","method":"accountNotification","params":{"result":{"context":{"slot":5199307},"value":{"data":["","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}},"subscription":23784}}`,
+			}
+		case "accountUnsubscribe":
+			assert.Equal(t, []any{float64(23784)}, req["params"])
+			close(unsubscribed)
+			return []string{`{"jsonrpc":"2.0","result":true,"id":2}`}
+		}
+		t.Errorf("unexpected method: %v", req["method"])
+		return nil
+	})
+	defer shutdown()
+
+	c, err := Connect(context.Background(), endpoint)
+	assert.Nil(t, err)
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := c.AccountSubscribeWithConfig(ctx, "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", AccountSubscribeConfig{
+		Commitment: rpc.CommitmentConfirmed,
+		Encoding:   rpc.AccountEncodingBase64,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(23784), sub.Id())
+
+	v, ok := receive(t, sub.C())
+	assert.True(t, ok)
+	assert.Equal(t, AccountNotification{
+		Context: rpc.Context{Slot: 5199307},
+		Value: rpc.AccountInfo{
+			Lamports:  33594,
+			Owner:     "11111111111111111111111111111111",
+			RentEpoch: 635,
+			Data:      []any{"", "base64"},
+		},
+	}, v)
+
+	// cancel the context should unsubscribe
+	cancel()
+	_, ok = receive(t, sub.C())
+	assert.False(t, ok)
+	select {
+	case <-unsubscribed:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestSignatureSubscribe(t *testing.T) {
+	endpoint, shutdown := newTestServer(t, func(req map[string]any) []string {
+		assert.Equal(t, "signatureSubscribe", req["method"])
+		return []string{
+			`{"jsonrpc":"2.0","result":0,"id":1}`,
+			`{"jsonrpc":"2.0","method":"signatureNotification","params":{"result":{"context":{"slot":5207624},"value":"receivedSignature"},"subscription":0}}`,
+			`{"jsonrpc":"2.0","method":"signatureNotification","params":{"result":{"context":{"slot":5207625},"value":{"err":null}},"subscription":0}}`,
+		}
+	})
+	defer shutdown()
+
+	c, err := Connect(context.Background(), endpoint)
+	assert.Nil(t, err)
+	defer c.Close()
+
+	sub, err := c.SignatureSubscribeWithConfig(
+		context.Background(),
+		"2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b",
+		SignatureSubscribeConfig{EnableReceivedNotification: true},
+	)
+	assert.Nil(t, err)
+
+	v, ok := receive(t, sub.C())
+	assert.True(t, ok)
+	assert.Equal(t, SignatureNotification{Context: rpc.Context{Slot: 5207624}, Value: SignatureNotificationValue{Received: true}}, v)
+
+	v, ok = receive(t, sub.C())
+	assert.True(t, ok)
+	assert.Equal(t, SignatureNotification{Context: rpc.Context{Slot: 5207625}, Value: SignatureNotificationValue{}}, v)
+
+	// the server cancels it automatically
+	_, ok = receive(t, sub.C())
+	assert.False(t, ok)
+}
+
+func TestSlotSubscribe(t *testing.T) {
+	endpoint, shutdown := newTestServer(t, func(req map[string]any) []string {
+		assert.Equal(t, "slotSubscribe", req["method"])
+		return []string{
+			`{"jsonrpc":"2.0","result":3,"id":1}`,
+			`{"jsonrpc":"2.0","method":"slotNotification","params":{"result":{"parent":75,"root":44,"slot":76},"subscription":3}}`,
+		}
+	})
+	defer shutdown()
+
+	c, err := Connect(context.Background(), endpoint)
+	assert.Nil(t, err)
+
+	sub, err := c.SlotSubscribe(context.Background())
+	assert.Nil(t, err)
+
+	v, ok := receive(t, sub.C())
+	assert.True(t, ok)
+	assert.Equal(t, SlotNotification{Parent: 75, Root: 44, Slot: 76}, v)
+
+	// close the client should close all subscriptions
+	assert.Nil(t, c.Close())
+	_, ok = receive(t, sub.C())
+	assert.False(t, ok)
+
+	_, err = c.RootSubscribe(context.Background())
+	assert.Equal(t, ErrClientClosed, err)
+}
+
+func TestSlotSubscribe_Lagging(t *testing.T) {
+	unsubscribed := make(chan struct{})
+	endpoint, shutdown := newTestServer(t, func(req map[string]any) []string {
+		switch req["method"] {
+		case "slotSubscribe":
+			msgs := []string{`{"jsonrpc":"2.0","result":3,"id":1}`}
+			for i := 0; i <= subscriptionBufferSize; i++ {
+				msgs = append(msgs, fmt.Sprintf(`{"jsonrpc":"2.0","method":"slotNotification","params":{"result":{"parent":%v,"root":0,"slot":%v},"subscription":3}}`, i, i+1))
+			}
+			return msgs
+		case "slotUnsubscribe":
+			assert.Equal(t, []any{float64(3)}, req["params"])
+			close(unsubscribed)
+			return []string{fmt.Sprintf(`{"jsonrpc":"2.0","result":true,"id":%v}`, req["id"])}
+		case "rootSubscribe":
+			return []string{
+				fmt.Sprintf(`{"jsonrpc":"2.0","result":4,"id":%v}`, req["id"]),
+				`{"jsonrpc":"2.0","method":"rootNotification","params":{"result":42,"subscription":4}}`,
+			}
+		}
+		t.Errorf("unexpected method: %v", req["method"])
+		return nil
+	})
+	defer shutdown()
+
+	c, err := Connect(context.Background(), endpoint)
+	assert.Nil(t, err)
+	defer c.Close()
+
+	// no one reads the slots
+	slotSub, err := c.SlotSubscribe(context.Background())
+	assert.Nil(t, err)
+	select {
+	case <-unsubscribed:
+	case <-time.After(3 * time.Second):
+		t.Fatal("the lagging subscription is not unsubscribed")
+	}
+
+	// the buffered notifications are still delivered before the channel is closed
+	for i := 0; i < subscriptionBufferSize; i++ {
+		v, ok := receive(t, slotSub.C())
+		assert.True(t, ok)
+		assert.Equal(t, uint64(i+1), v.Slot)
+	}
+	_, ok := receive(t, slotSub.C())
+	assert.False(t, ok)
+	assert.Equal(t, ErrSubscriptionLagging, slotSub.Err())
+
+	// the other subscriptions are not blocked
+	rootSub, err := c.RootSubscribe(context.Background())
+	assert.Nil(t, err)
+	root, ok := receive(t, rootSub.C())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(42), root)
+	assert.Nil(t, rootSub.Err())
+}
+
+func TestSubscribeError(t *testing.T) {
+	endpoint, shutdown := newTestServer(t, func(req map[string]any) []string {
+		return []string{`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":1}`}
+	})
+	defer shutdown()
+
+	c, err := Connect(context.Background(), endpoint)
+	assert.Nil(t, err)
+	defer c.Close()
+
+	_, err = c.LogsSubscribeMentions(context.Background(), "invalid")
+	assert.Equal(t, &rpc.JsonRpcError{Code: -32602, Message: "Invalid params"}, err)
+}
@@ -0,0 +1,52 @@
+package ws
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type LogsNotification rpc.ValueWithContext[LogsNotificationValue]
+
+// LogsNotificationValue is a part of LogsNotification
+type LogsNotificationValue struct {
+	Signature string   `json:"signature"`
+	Err       any      `json:"err"`
+	Logs      []string `json:"logs"`
+}
+
+type LogsSubscribeFilter string
+
+const (
+	LogsSubscribeFilterAll          LogsSubscribeFilter = "all"
+	LogsSubscribeFilterAllWithVotes LogsSubscribeFilter = "allWithVotes"
+)
+
+type logsSubscribeFilterMentions struct {
+	Mentions []string `json:"mentions"`
+}
+
+// LogsSubscribeConfig is a option config for `logsSubscribe`
+type LogsSubscribeConfig struct {
+	Commitment rpc.Commitment `json:"commitment,omitempty"`
+}
+
+// LogsSubscribe subscribes to transaction logging
+func (c *Client) LogsSubscribe(ctx context.Context, filter LogsSubscribeFilter) (*Subscription[LogsNotification], error) {
+	return subscribe[LogsNotification](ctx, c, "logsSubscribe", "logsUnsubscribe", nil, filter)
+}
+
+// LogsSubscribeWithConfig subscribes to transaction logging
+func (c *Client) LogsSubscribeWithConfig(ctx context.Context, filter LogsSubscribeFilter, cfg LogsSubscribeConfig) (*Subscription[LogsNotification], error) {
+	return subscribe[LogsNotification](ctx, c, "logsSubscribe", "logsUnsubscribe", nil, filter, cfg)
+}
+
+// LogsSubscribeMentions subscribes to all transactions that mention the provided pubkey
+func (c *Client) LogsSubscribeMentions(ctx context.Context, base58Addr string) (*Subscription[LogsNotification], error) {
+	return subscribe[LogsNotification](ctx, c, "logsSubscribe", "logsUnsubscribe", nil, logsSubscribeFilterMentions{Mentions: []string{base58Addr}})
+}
+
+// LogsSubscribeMentionsWithConfig subscribes to all transactions that mention the provided pubkey
+func (c *Client) LogsSubscribeMentionsWithConfig(ctx context.Context, base58Addr string, cfg LogsSubscribeConfig) (*Subscription[LogsNotification], error) {
+	return subscribe[LogsNotification](ctx, c, "logsSubscribe", "logsUnsubscribe", nil, logsSubscribeFilterMentions{Mentions: []string{base58Addr}}, cfg)
+}
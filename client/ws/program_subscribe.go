@@ -0,0 +1,26 @@
+package ws
+
+import (
+	"context"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type ProgramNotification rpc.ValueWithContext[rpc.GetProgramAccount]
+
+// ProgramSubscribeConfig is a option config for `programSubscribe`
+type ProgramSubscribeConfig struct {
+	Commitment rpc.Commitment                       `json:"commitment,omitempty"`
+	Encoding   rpc.AccountEncoding                  `json:"encoding,omitempty"`
+	Filters    []rpc.GetProgramAccountsConfigFilter `json:"filters,omitempty"`
+}
+
+// ProgramSubscribe subscribes to a program to receive notifications when the lamports or data for an account owned by the given program changes
+func (c *Client) ProgramSubscribe(ctx context.Context, programId string) (*Subscription[ProgramNotification], error) {
+	return subscribe[ProgramNotification](ctx, c, "programSubscribe", "programUnsubscribe", nil, programId)
+}
+
+// ProgramSubscribeWithConfig subscribes to a program to receive notifications when the lamports or data for an account owned by the given program changes
+func (c *Client) ProgramSubscribeWithConfig(ctx context.Context, programId string, cfg ProgramSubscribeConfig) (*Subscription[ProgramNotification], error) {
+	return subscribe[ProgramNotification](ctx, c, "programSubscribe", "programUnsubscribe", nil, programId, cfg)
+}
@@ -0,0 +1,10 @@
+package ws
+
+import (
+	"context"
+)
+
+// RootSubscribe subscribes to receive notification anytime a new root is set by the validator
+func (c *Client) RootSubscribe(ctx context.Context) (*Subscription[uint64], error) {
+	return subscribe[uint64](ctx, c, "rootSubscribe", "rootUnsubscribe", nil)
+}
@@ -0,0 +1,56 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+)
+
+type SignatureNotification rpc.ValueWithContext[SignatureNotificationValue]
+
+// SignatureNotificationValue is a part of SignatureNotification.
+// the value is the string "receivedSignature" when enableReceivedNotification is set and the signature is received
+type SignatureNotificationValue struct {
+	Err      any
+	Received bool
+}
+
+func (v *SignatureNotificationValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		*v = SignatureNotificationValue{Received: s == "receivedSignature"}
+		return nil
+	}
+	var value struct {
+		Err any `json:"err"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*v = SignatureNotificationValue{Err: value.Err}
+	return nil
+}
+
+// SignatureSubscribeConfig is a option config for `signatureSubscribe`
+type SignatureSubscribeConfig struct {
+	Commitment                 rpc.Commitment `json:"commitment,omitempty"`
+	EnableReceivedNotification bool           `json:"enableReceivedNotification,omitempty"`
+}
+
+// SignatureSubscribe subscribes to a transaction signature to receive notification when the transaction is confirmed.
+// the subscription is cancelled by the server after the notification is sent.
+func (c *Client) SignatureSubscribe(ctx context.Context, signature string) (*Subscription[SignatureNotification], error) {
+	return subscribe[SignatureNotification](ctx, c, "signatureSubscribe", "signatureUnsubscribe", isLastSignatureNotification, signature)
+}
+
+// SignatureSubscribeWithConfig subscribes to a transaction signature to receive notification when the transaction is confirmed.
+// the subscription is cancelled by the server after the notification is sent.
+func (c *Client) SignatureSubscribeWithConfig(ctx context.Context, signature string, cfg SignatureSubscribeConfig) (*Subscription[SignatureNotification], error) {
+	return subscribe[SignatureNotification](ctx, c, "signatureSubscribe", "signatureUnsubscribe", isLastSignatureNotification, signature, cfg)
+}
+
+// the server cancels the subscription after the transaction is processed
+func isLastSignatureNotification(n SignatureNotification) bool {
+	return !n.Value.Received
+}
@@ -0,0 +1,16 @@
+package ws
+
+import (
+	"context"
+)
+
+type SlotNotification struct {
+	Parent uint64 `json:"parent"`
+	Root   uint64 `json:"root"`
+	Slot   uint64 `json:"slot"`
+}
+
+// SlotSubscribe subscribes to receive notification anytime a slot is processed by the validator
+func (c *Client) SlotSubscribe(ctx context.Context) (*Subscription[SlotNotification], error) {
+	return subscribe[SlotNotification](ctx, c, "slotSubscribe", "slotUnsubscribe", nil)
+}
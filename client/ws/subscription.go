@@ -0,0 +1,179 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// subscriptionBufferSize is the number of notifications a subscription buffers for a slow consumer
+const subscriptionBufferSize = 16
+
+// ErrSubscriptionLagging is the Err of a subscription which is closed because its consumer doesn't keep up
+var ErrSubscriptionLagging = errors.New("ws subscription is lagging")
+
+// Subscription delivers notifications of a subscription through a channel.
+// the channel is closed after the subscription is over. the notifications of all subscriptions are
+// read by one loop, so a subscription whose channel is full is closed with ErrSubscriptionLagging
+// instead of blocking the others.
+type Subscription[T any] struct {
+	client            *Client
+	id                uint64
+	unsubscribeMethod string
+	// isLast reports whether the server cancels the subscription after the notification
+	isLast func(T) bool
+
+	ch     chan T
+	closed chan struct{}
+
+	mu        sync.Mutex
+	finished  bool
+	err       error
+	closeOnce sync.Once
+	unsubOnce sync.Once
+}
+
+// Id returns the subscription id which is assigned by the server
+func (s *Subscription[T]) Id() uint64 {
+	return s.id
+}
+
+// C returns the channel which receives notifications
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// Err returns ErrSubscriptionLagging if the subscription is closed because the channel is full,
+// it is nil if the subscription is unsubscribed or the connection is gone, see Client.Err for the latter.
+func (s *Subscription[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Unsubscribe stops receiving notifications and closes the channel
+func (s *Subscription[T]) Unsubscribe() error {
+	var err error
+	s.unsubOnce.Do(func() {
+		s.close()
+		s.client.removeSubscriber(s.id)
+		var res json.RawMessage
+		res, err = s.client.call(context.Background(), s.unsubscribeMethod, s.id)
+		if err != nil {
+			return
+		}
+		var ok bool
+		if err = json.Unmarshal(res, &ok); err != nil {
+			err = fmt.Errorf("failed to json decode unsubscribe result, err: %v", err)
+			return
+		}
+		if !ok {
+			err = fmt.Errorf("failed to unsubscribe %v", s.id)
+		}
+	})
+	return err
+}
+
+func (s *Subscription[T]) notify(raw json.RawMessage) {
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	lagging := false
+	select {
+	case s.ch <- v:
+	default:
+		lagging = true
+		s.err = ErrSubscriptionLagging
+	}
+	s.mu.Unlock()
+
+	if lagging {
+		// the read loop can't wait for the unsubscribe result, it is the one to read it
+		s.close()
+		s.client.removeSubscriber(s.id)
+		go s.Unsubscribe()
+		return
+	}
+
+	if s.isLast != nil && s.isLast(v) {
+		s.unsubOnce.Do(func() {
+			s.close()
+			s.client.removeSubscriber(s.id)
+		})
+	}
+}
+
+func (s *Subscription[T]) close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		s.mu.Lock()
+		s.finished = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+func subscribe[T any](ctx context.Context, c *Client, subscribeMethod, unsubscribeMethod string, isLast func(T) bool, params ...any) (*Subscription[T], error) {
+	sub := &Subscription[T]{
+		client:            c,
+		unsubscribeMethod: unsubscribeMethod,
+		isLast:            isLast,
+		ch:                make(chan T, subscriptionBufferSize),
+		closed:            make(chan struct{}),
+	}
+
+	var mu sync.Mutex
+	var registered, abandoned bool
+	_, err := c.callWithHook(
+		ctx,
+		func(res json.RawMessage) {
+			var id uint64
+			if json.Unmarshal(res, &id) != nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			sub.id = id
+			registered = true
+			c.addSubscriber(id, sub)
+			// the caller has gone, no one will consume it
+			if abandoned {
+				go sub.Unsubscribe()
+			}
+		},
+		subscribeMethod,
+		params...,
+	)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err != nil {
+		abandoned = true
+		if registered {
+			go sub.Unsubscribe()
+		}
+		return nil, err
+	}
+	if !registered {
+		return nil, fmt.Errorf("failed to parse subscription id")
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Unsubscribe()
+		case <-sub.closed:
+		}
+	}()
+
+	return sub, nil
+}
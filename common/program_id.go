@@ -6,13 +6,26 @@ var (
 	StakeProgramID                     = PublicKeyFromString("Stake11111111111111111111111111111111111111")
 	VoteProgramID                      = PublicKeyFromString("Vote111111111111111111111111111111111111111")
 	BPFLoaderProgramID                 = PublicKeyFromString("BPFLoader1111111111111111111111111111111111")
+	BPFLoaderUpgradeableProgramID      = PublicKeyFromString("BPFLoaderUpgradeab1e11111111111111111111111")
 	Secp256k1ProgramID                 = PublicKeyFromString("KeccakSecp256k11111111111111111111111111111")
+	Ed25519ProgramID                   = PublicKeyFromString("Ed25519SigVerify111111111111111111111111111")
 	TokenProgramID                     = PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
 	MemoProgramID                      = PublicKeyFromString("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr")
 	SPLAssociatedTokenAccountProgramID = PublicKeyFromString("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
 	SPLNameServiceProgramID            = PublicKeyFromString("namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX")
 	MetaplexTokenMetaProgramID         = PublicKeyFromString("metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s")
+	MetaplexCandyMachineV3ProgramID    = PublicKeyFromString("CndyV3LdqHUfDLmE5naZjVN8rBZz4tqhdefbAnjHG3JR")
+	MetaplexCandyGuardProgramID        = PublicKeyFromString("Guard1JwRhJkVH6XZhzoYxeBVQe872VH6QggF4BWmS9g")
+	MetaplexTokenAuthRulesProgramID    = PublicKeyFromString("auth9SigNpDKz4sJJ1DfCTuZrZNSAgh9sFD3rboVmgg")
+	MetaplexBubblegumProgramID         = PublicKeyFromString("BGUMAp9Gq7iTEuizy4pqaxsTyUCBK68MDfK752saRPUY")
+	SPLAccountCompressionProgramID     = PublicKeyFromString("cmtDvXumGCrqC1Age74AVPhSRVXJMd8PJS91L8KbNCK")
+	SPLNoopProgramID                   = PublicKeyFromString("noopb9bkMVfRPU8AsbpTUg8AQkHtKwMYZiFUjNRtMmV")
 	ComputeBudgetProgramID             = PublicKeyFromString("ComputeBudget111111111111111111111111111111")
 	AddressLookupTableProgramID        = PublicKeyFromString("AddressLookupTab1e1111111111111111111111111")
 	Token2022ProgramID                 = PublicKeyFromString("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+	SPLGovernanceProgramID             = PublicKeyFromString("GovER5Lthms3bLBqWub97yVrMmEogzX7xNjdXpPPCVZw")
+	SPLStakePoolProgramID              = PublicKeyFromString("SPoo1Ku8WFXoNDMHPsrGSTSG1Y47rzgn41SLUNakuHy")
+	SPLTokenSwapProgramID              = PublicKeyFromString("SwaPpA9LAaLfeLi3a68M4DjnLqgtticKg6CnyNwgAC8")
+	OpenBookDexProgramID               = PublicKeyFromString("srmqPvymJeFKQ4zGQed1GFppgkRHL9kaELCbyksJtPX")
+	SerumDexV3ProgramID                = PublicKeyFromString("9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin")
 )
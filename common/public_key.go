@@ -106,9 +106,14 @@ func CreateWithSeed(from PublicKey, seed string, programID PublicKey) PublicKey
 }
 
 func FindAssociatedTokenAddress(walletAddress, tokenMintAddress PublicKey) (PublicKey, uint8, error) {
+	return FindAssociatedTokenAddressWithProgramID(walletAddress, tokenMintAddress, TokenProgramID)
+}
+
+// FindAssociatedTokenAddressWithProgramID derives the associated token address for a mint owned by tokenProgramID, e.g. Token2022ProgramID
+func FindAssociatedTokenAddressWithProgramID(walletAddress, tokenMintAddress, tokenProgramID PublicKey) (PublicKey, uint8, error) {
 	seeds := [][]byte{}
 	seeds = append(seeds, walletAddress.Bytes())
-	seeds = append(seeds, TokenProgramID.Bytes())
+	seeds = append(seeds, tokenProgramID.Bytes())
 	seeds = append(seeds, tokenMintAddress.Bytes())
 
 	return FindProgramAddress(seeds, SPLAssociatedTokenAccountProgramID)
@@ -176,6 +176,57 @@ func TestFindAssociatedTokenAddress(t *testing.T) {
 	}
 }
 
+func TestFindAssociatedTokenAddressWithProgramID(t *testing.T) {
+	type args struct {
+		walletAddress    PublicKey
+		tokenMintAddress PublicKey
+		tokenProgramID   PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    PublicKey
+		want1   uint8
+		wantErr bool
+	}{
+		{
+			args: args{
+				walletAddress:    PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				tokenMintAddress: PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH"),
+				tokenProgramID:   TokenProgramID,
+			},
+			want:    PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"),
+			want1:   254,
+			wantErr: false,
+		},
+		{
+			args: args{
+				walletAddress:    PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				tokenMintAddress: PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH"),
+				tokenProgramID:   Token2022ProgramID,
+			},
+			want:    PublicKeyFromString("Zt9aHhoLTH4d4MBacxVEkXscdXrA4pof2vvsnmDaWRL"),
+			want1:   254,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := FindAssociatedTokenAddressWithProgramID(tt.args.walletAddress, tt.args.tokenMintAddress, tt.args.tokenProgramID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindAssociatedTokenAddressWithProgramID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAssociatedTokenAddressWithProgramID() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("FindAssociatedTokenAddressWithProgramID() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
 func TestCreateWithSeed(t *testing.T) {
 	type args struct {
 		from      PublicKey
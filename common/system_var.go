@@ -9,5 +9,6 @@ var (
 	SysVarStakeHistoryPubkey     = PublicKeyFromString("SysvarStakeHistory1111111111111111111111111")
 	SysVarInstructionsPubkey     = PublicKeyFromString("Sysvar1nstructions1111111111111111111111111")
 	SysVarSlotHashesPubkey       = PublicKeyFromString("SysvarS1otHashes111111111111111111111111111")
+	SysVarEpochSchedulePubkey    = PublicKeyFromString("SysvarEpochSchedu1e111111111111111111111111")
 	StakeConfigPubkey            = PublicKeyFromString("StakeConfig11111111111111111111111111111111")
 )
@@ -0,0 +1,367 @@
+package anchor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/borsh"
+	"github.com/mr-tron/base58"
+)
+
+// encoder borsh-encodes values by the types of an idl.
+//
+// values are given as:
+//
+//	integers    any go integer, *big.Int for u128 and i128
+//	pubkey      common.PublicKey or a base58 string
+//	bytes       []byte
+//	option      nil for none
+//	vec, array  a slice or an array
+//	struct      map[string]any by field names, []any for a tuple struct
+//	enum        the variant name for a unit variant, map[string]any{variant: fields} for the others
+//
+// a defined type which is not a map, a slice or a string is encoded by borsh.Serialize, so a go struct
+// declared in the same layout can be used directly.
+type encoder struct {
+	types map[string]IDLTypeDef
+}
+
+func newEncoder(typeDefs []IDLTypeDef) encoder {
+	types := make(map[string]IDLTypeDef, len(typeDefs))
+	for _, t := range typeDefs {
+		types[t.Name] = t
+	}
+	return encoder{types: types}
+}
+
+func (e encoder) encode(t IDLType, v any) ([]byte, error) {
+	switch {
+	case t.Primitive != "":
+		return encodePrimitive(t.Primitive, v)
+	case t.Option != nil:
+		if isNil(v) {
+			return []byte{0}, nil
+		}
+		b, err := e.encode(*t.Option, deref(v))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1}, b...), nil
+	case t.COption != nil:
+		if isNil(v) {
+			return []byte{0, 0, 0, 0}, nil
+		}
+		b, err := e.encode(*t.COption, deref(v))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1, 0, 0, 0}, b...), nil
+	case t.Vec != nil:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, fmt.Errorf("expected a slice for vec, got %T", v)
+		}
+		return e.encodeElems(binary.LittleEndian.AppendUint32(nil, uint32(rv.Len())), *t.Vec, rv)
+	case t.Array != nil:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, fmt.Errorf("expected a slice for array, got %T", v)
+		}
+		if rv.Len() != t.ArrayLen {
+			return nil, fmt.Errorf("expected %v elements, got %v", t.ArrayLen, rv.Len())
+		}
+		return e.encodeElems(nil, *t.Array, rv)
+	case t.Defined != "":
+		return e.encodeDefined(t.Defined, v)
+	}
+	return nil, fmt.Errorf("invalid type")
+}
+
+func (e encoder) encodeElems(b []byte, t IDLType, rv reflect.Value) ([]byte, error) {
+	for i := 0; i < rv.Len(); i++ {
+		d, err := e.encode(t, rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("index %v: %w", i, err)
+		}
+		b = append(b, d...)
+	}
+	return b, nil
+}
+
+func (e encoder) encodeDefined(name string, v any) ([]byte, error) {
+	typeDef, ok := e.types[name]
+	if !ok {
+		return nil, fmt.Errorf("undefined type: %v", name)
+	}
+	switch typeDef.Type.Kind {
+	case IDLTypeDefKindStruct:
+		switch v := v.(type) {
+		case map[string]any:
+			return e.encodeFields(typeDef.Type.Fields, v)
+		case []any:
+			return e.encodeFields(typeDef.Type.Fields, v)
+		}
+	case IDLTypeDefKindEnum:
+		switch v := v.(type) {
+		case string:
+			return e.encodeEnum(name, typeDef.Type.Variants, v, nil)
+		case map[string]any:
+			if len(v) != 1 {
+				return nil, fmt.Errorf("expected one variant of %v, got %v", name, len(v))
+			}
+			for variant, fields := range v {
+				return e.encodeEnum(name, typeDef.Type.Variants, variant, fields)
+			}
+		}
+	case IDLTypeDefKindAlias:
+		if typeDef.Type.Alias == nil {
+			return nil, fmt.Errorf("type %v has no alias", name)
+		}
+		return e.encode(*typeDef.Type.Alias, v)
+	default:
+		return nil, fmt.Errorf("unsupported kind %v of type %v", typeDef.Type.Kind, name)
+	}
+	return borsh.Serialize(v)
+}
+
+func (e encoder) encodeEnum(name string, variants []IDLEnumVariant, variant string, fields any) ([]byte, error) {
+	for i, v := range variants {
+		if v.Name != variant {
+			continue
+		}
+		b := []byte{uint8(i)}
+		if len(v.Fields.Named) == 0 && len(v.Fields.Tuple) == 0 {
+			return b, nil
+		}
+		d, err := e.encodeFields(v.Fields, fields)
+		if err != nil {
+			return nil, fmt.Errorf("variant %v: %w", variant, err)
+		}
+		return append(b, d...), nil
+	}
+	return nil, fmt.Errorf("unknown variant %v of %v", variant, name)
+}
+
+func (e encoder) encodeFields(fields IDLFields, v any) ([]byte, error) {
+	var b []byte
+	if len(fields.Tuple) > 0 {
+		values, ok := v.([]any)
+		if !ok || len(values) != len(fields.Tuple) {
+			return nil, fmt.Errorf("expected %v values of a tuple", len(fields.Tuple))
+		}
+		for i, t := range fields.Tuple {
+			d, err := e.encode(t, values[i])
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %w", i, err)
+			}
+			b = append(b, d...)
+		}
+		return b, nil
+	}
+
+	values, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a map of fields, got %T", v)
+	}
+	for _, field := range fields.Named {
+		value, ok := values[field.Name]
+		if !ok && field.Type.Option == nil && field.Type.COption == nil {
+			return nil, fmt.Errorf("missing field: %v", field.Name)
+		}
+		d, err := e.encode(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("field %v: %w", field.Name, err)
+		}
+		b = append(b, d...)
+	}
+	return b, nil
+}
+
+func encodePrimitive(primitive string, v any) ([]byte, error) {
+	switch primitive {
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", v)
+		}
+		if b {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case "u8", "u16", "u32", "u64":
+		n, err := toUint64(v)
+		if err != nil {
+			return nil, err
+		}
+		if bits := intBits(primitive); bits < 64 && n >= 1<<bits {
+			return nil, fmt.Errorf("integer overflow: %v doesn't fit in %v", n, primitive)
+		}
+		return appendInt(nil, primitive, n)
+	case "i8", "i16", "i32", "i64":
+		n, err := toInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		if bits := intBits(primitive); bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+			return nil, fmt.Errorf("integer overflow: %v doesn't fit in %v", n, primitive)
+		}
+		return appendInt(nil, primitive, uint64(n))
+	case "u128", "i128":
+		return encodeInt128(primitive, v)
+	case "f32":
+		f, err := toFloat64(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint32(nil, math.Float32bits(float32(f))), nil
+	case "f64":
+		f, err := toFloat64(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint64(nil, math.Float64bits(f)), nil
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", v)
+		}
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(s))), s...), nil
+	case "bytes":
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte, got %T", v)
+		}
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(b))), b...), nil
+	case "pubkey", "publicKey":
+		switch v := v.(type) {
+		case common.PublicKey:
+			return v.Bytes(), nil
+		case string:
+			b, err := base58.Decode(v)
+			if err != nil || len(b) != common.PublicKeyLength {
+				return nil, fmt.Errorf("invalid pubkey: %v", v)
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("expected common.PublicKey, got %T", v)
+	}
+	return nil, fmt.Errorf("unsupported type: %v", primitive)
+}
+
+func intBits(primitive string) int {
+	switch primitive {
+	case "u8", "i8":
+		return 8
+	case "u16", "i16":
+		return 16
+	case "u32", "i32":
+		return 32
+	}
+	return 64
+}
+
+func appendInt(b []byte, primitive string, n uint64) ([]byte, error) {
+	switch primitive {
+	case "u8", "i8":
+		return append(b, uint8(n)), nil
+	case "u16", "i16":
+		return binary.LittleEndian.AppendUint16(b, uint16(n)), nil
+	case "u32", "i32":
+		return binary.LittleEndian.AppendUint32(b, uint32(n)), nil
+	}
+	return binary.LittleEndian.AppendUint64(b, n), nil
+}
+
+func encodeInt128(primitive string, v any) ([]byte, error) {
+	var n *big.Int
+	switch v := v.(type) {
+	case *big.Int:
+		n = v
+	case big.Int:
+		n = &v
+	default:
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = big.NewInt(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n = new(big.Int).SetUint64(rv.Uint())
+		default:
+			return nil, fmt.Errorf("expected an integer, got %T", v)
+		}
+	}
+	lo, hi := new(big.Int), new(big.Int).Lsh(big.NewInt(1), 128)
+	if primitive == "i128" {
+		lo.Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+		hi.Lsh(big.NewInt(1), 127)
+	}
+	if n.Cmp(lo) < 0 || n.Cmp(hi) >= 0 {
+		return nil, fmt.Errorf("integer overflow: %v doesn't fit in %v", n, primitive)
+	}
+
+	// two's complement in 128 bits
+	m := new(big.Int).And(n, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)))
+	be := m.FillBytes(make([]byte, 16))
+	b := make([]byte, 16)
+	for i := range be {
+		b[i] = be[15-i]
+	}
+	return b, nil
+}
+
+func toUint64(v any) (uint64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return 0, fmt.Errorf("expected an unsigned integer, got %v", rv.Int())
+		}
+		return uint64(rv.Int()), nil
+	}
+	return 0, fmt.Errorf("expected an integer, got %T", v)
+}
+
+func toInt64(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("integer overflow: %v", rv.Uint())
+		}
+		return int64(rv.Uint()), nil
+	}
+	return 0, fmt.Errorf("expected an integer, got %T", v)
+}
+
+func toFloat64(v any) (float64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+	return 0, fmt.Errorf("expected a float, got %T", v)
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func deref(v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		return rv.Elem().Interface()
+	}
+	return v
+}
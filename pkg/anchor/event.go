@@ -0,0 +1,71 @@
+package anchor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/pkg/borsh"
+	"github.com/liangjies/solana-go-sdk/pkg/programlog"
+)
+
+// Event is an event emitted by `emit!`
+type Event struct {
+	Name string
+	// Data is the borsh-encoded event without the discriminator
+	Data []byte
+}
+
+// Decode decodes the event into v, a pointer to a go struct declared in the same layout as the event
+func (e Event) Decode(v any) error {
+	return borsh.Deserialize(e.Data, v)
+}
+
+// ParseEvents returns the events emitted by the program in the log messages of a transaction.
+// only `Program data:` lines logged by the program itself are decoded, so events with the same name
+// from other programs are ignored. unknown discriminators are skipped.
+// the events are in the order of the invocations, the ones of an invocation come before the ones of the
+// invocations it makes, which only differs from the log order if the program invokes itself.
+func (p *Program) ParseEvents(logs []string) ([]Event, error) {
+	type eventDef struct {
+		name          string
+		discriminator []byte
+	}
+	defs := make([]eventDef, 0, len(p.IDL.Events))
+	for _, e := range p.IDL.Events {
+		discriminator := e.Discriminator
+		if len(discriminator) == 0 {
+			discriminator = EventDiscriminator(e.Name)
+		}
+		defs = append(defs, eventDef{name: e.Name, discriminator: discriminator})
+	}
+
+	invocations, err := programlog.Parse(logs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse logs, err: %v", err)
+	}
+
+	var events []Event
+	var walk func(invocations []*programlog.Invocation)
+	walk = func(invocations []*programlog.Invocation) {
+		for _, invocation := range invocations {
+			if invocation.ProgramID == p.ProgramID {
+				for _, line := range invocation.Data {
+					// anchor logs an event as a single slice
+					if len(line) != 1 || len(line[0]) < DiscriminatorSize {
+						continue
+					}
+					data := line[0]
+					for _, def := range defs {
+						if bytes.Equal(def.discriminator, data[:DiscriminatorSize]) {
+							events = append(events, Event{Name: def.name, Data: data[DiscriminatorSize:]})
+							break
+						}
+					}
+				}
+			}
+			walk(invocation.Invocations)
+		}
+	}
+	walk(invocations)
+	return events, nil
+}
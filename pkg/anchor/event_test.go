@@ -0,0 +1,72 @@
+package anchor
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/borsh"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgram_ParseEvents(t *testing.T) {
+	type transferEvent struct {
+		Amount uint64
+		To     common.PublicKey
+	}
+	to := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	eventData := func(discriminator []byte, amount uint64) string {
+		b := append(append([]byte{}, discriminator...), borsh.MustSerialize(transferEvent{Amount: amount, To: to})...)
+		return "Program data: " + base64.StdEncoding.EncodeToString(b)
+	}
+
+	idl, err := ParseIDL([]byte(`{
+		"address": "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS",
+		"instructions": [],
+		"events": [
+			{"name": "TransferEvent", "discriminator": [1, 1, 1, 1, 1, 1, 1, 1]},
+			{"name": "LegacyEvent", "fields": [{"name": "amount", "type": "u64"}, {"name": "to", "type": "publicKey"}]}
+		]
+	}`))
+	assert.Nil(t, err)
+
+	events, err := NewProgram(idl).ParseEvents([]string{
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+		"Program log: Instruction: Transfer",
+		// msg! lines don't change the invocation
+		"Program log: success",
+		"Program log: x invoke [2]",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+		eventData([]byte{1, 1, 1, 1, 1, 1, 1, 1}, 1),
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA success",
+		eventData([]byte{1, 1, 1, 1, 1, 1, 1, 1}, 2),
+		eventData(EventDiscriminator("LegacyEvent"), 3),
+		eventData([]byte{9, 9, 9, 9, 9, 9, 9, 9}, 4),
+		"Program data: AQID BAU=",
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS consumed 5000 of 200000 compute units",
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS success",
+		eventData([]byte{1, 1, 1, 1, 1, 1, 1, 1}, 5),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, "TransferEvent", events[0].Name)
+	var transfer transferEvent
+	assert.Nil(t, events[0].Decode(&transfer))
+	assert.Equal(t, transferEvent{Amount: 2, To: to}, transfer)
+
+	assert.Equal(t, "LegacyEvent", events[1].Name)
+	assert.Nil(t, events[1].Decode(&transfer))
+	assert.Equal(t, transferEvent{Amount: 3, To: to}, transfer)
+}
+
+func TestProgram_ParseEvents_InvalidLogs(t *testing.T) {
+	idl, err := ParseIDL([]byte(`{"address": "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS", "instructions": [], "events": []}`))
+	assert.Nil(t, err)
+
+	_, err = NewProgram(idl).ParseEvents([]string{
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+		"Program data: not base64",
+	})
+	assert.NotNil(t, err)
+}
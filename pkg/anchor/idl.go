@@ -0,0 +1,261 @@
+package anchor
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// IDL is an Anchor IDL. both the current format (anchor >= 0.30) and the legacy one are accepted.
+type IDL struct {
+	// Address is the program id, only in the current format
+	Address      string           `json:"address"`
+	Metadata     IDLMetadata      `json:"metadata"`
+	Name         string           `json:"name"`
+	Version      string           `json:"version"`
+	Instructions []IDLInstruction `json:"instructions"`
+	Accounts     []IDLAccount     `json:"accounts"`
+	Events       []IDLEvent       `json:"events"`
+	Errors       []IDLErrorCode   `json:"errors"`
+	Types        []IDLTypeDef     `json:"types"`
+}
+
+type IDLMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Spec    string `json:"spec"`
+	// Address is the program id in the legacy format
+	Address string `json:"address"`
+}
+
+// ParseIDL parses the json of an IDL
+func ParseIDL(b []byte) (IDL, error) {
+	var idl IDL
+	if err := json.Unmarshal(b, &idl); err != nil {
+		return IDL{}, fmt.Errorf("failed to parse idl, err: %v", err)
+	}
+	return idl, nil
+}
+
+// ProgramID returns the program address recorded in the idl
+func (idl IDL) ProgramID() string {
+	if idl.Address != "" {
+		return idl.Address
+	}
+	return idl.Metadata.Address
+}
+
+type IDLInstruction struct {
+	Name string `json:"name"`
+	// Discriminator is only in the current format, it is derived from the name if it is empty
+	Discriminator Discriminator           `json:"discriminator"`
+	Accounts      []IDLInstructionAccount `json:"accounts"`
+	Args          []IDLField              `json:"args"`
+}
+
+type IDLInstructionAccount struct {
+	Name     string
+	Writable bool
+	Signer   bool
+	Optional bool
+	// Address is set if the account is a fixed address, e.g. the system program
+	Address string
+	// Accounts is set if it is a group of accounts
+	Accounts []IDLInstructionAccount
+}
+
+func (a *IDLInstructionAccount) UnmarshalJSON(b []byte) error {
+	var v struct {
+		Name       string                  `json:"name"`
+		Writable   bool                    `json:"writable"`
+		Signer     bool                    `json:"signer"`
+		Optional   bool                    `json:"optional"`
+		IsMut      bool                    `json:"isMut"`
+		IsSigner   bool                    `json:"isSigner"`
+		IsOptional bool                    `json:"isOptional"`
+		Address    string                  `json:"address"`
+		Accounts   []IDLInstructionAccount `json:"accounts"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*a = IDLInstructionAccount{
+		Name:     v.Name,
+		Writable: v.Writable || v.IsMut,
+		Signer:   v.Signer || v.IsSigner,
+		Optional: v.Optional || v.IsOptional,
+		Address:  v.Address,
+		Accounts: v.Accounts,
+	}
+	return nil
+}
+
+type IDLAccount struct {
+	Name          string        `json:"name"`
+	Discriminator Discriminator `json:"discriminator"`
+	// Type is only in the legacy format, the current one puts it in IDL.Types
+	Type *IDLTypeDefTy `json:"type"`
+}
+
+type IDLEvent struct {
+	Name          string        `json:"name"`
+	Discriminator Discriminator `json:"discriminator"`
+	// Fields is only in the legacy format, the current one puts the type in IDL.Types
+	Fields []IDLField `json:"fields"`
+}
+
+type IDLErrorCode struct {
+	Code uint32 `json:"code"`
+	Name string `json:"name"`
+	Msg  string `json:"msg"`
+}
+
+type IDLTypeDef struct {
+	Name string       `json:"name"`
+	Type IDLTypeDefTy `json:"type"`
+}
+
+type IDLTypeDefKind string
+
+const (
+	IDLTypeDefKindStruct IDLTypeDefKind = "struct"
+	IDLTypeDefKindEnum   IDLTypeDefKind = "enum"
+	IDLTypeDefKindAlias  IDLTypeDefKind = "type"
+)
+
+type IDLTypeDefTy struct {
+	Kind     IDLTypeDefKind   `json:"kind"`
+	Fields   IDLFields        `json:"fields"`
+	Variants []IDLEnumVariant `json:"variants"`
+	// Alias is the aliased type of a `type` kind
+	Alias *IDLType `json:"alias"`
+}
+
+type IDLEnumVariant struct {
+	Name   string    `json:"name"`
+	Fields IDLFields `json:"fields"`
+}
+
+// IDLFields are the named fields of a struct, or the types of a tuple struct
+type IDLFields struct {
+	Named []IDLField
+	Tuple []IDLType
+}
+
+func (f *IDLFields) UnmarshalJSON(b []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil {
+		return err
+	}
+	*f = IDLFields{}
+	for _, raw := range raws {
+		var named struct {
+			Name string `json:"name"`
+		}
+		// a named field is an object with a name, a tuple field is a type
+		if err := json.Unmarshal(raw, &named); err == nil && named.Name != "" {
+			var field IDLField
+			if err := json.Unmarshal(raw, &field); err != nil {
+				return err
+			}
+			f.Named = append(f.Named, field)
+			continue
+		}
+		var t IDLType
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+		f.Tuple = append(f.Tuple, t)
+	}
+	return nil
+}
+
+type IDLField struct {
+	Name string  `json:"name"`
+	Type IDLType `json:"type"`
+}
+
+// IDLType is one of a primitive, e.g. "u64", "pubkey", "string", or a compound type.
+type IDLType struct {
+	Primitive string
+	Option    *IDLType
+	// COption is encoded with a 4 bytes tag, e.g. the authorities of a token mint
+	COption  *IDLType
+	Vec      *IDLType
+	Array    *IDLType
+	ArrayLen int
+	// Defined is the name of a type in IDL.Types
+	Defined string
+}
+
+func (t *IDLType) UnmarshalJSON(b []byte) error {
+	*t = IDLType{}
+	var primitive string
+	if err := json.Unmarshal(b, &primitive); err == nil {
+		t.Primitive = primitive
+		return nil
+	}
+
+	var v struct {
+		Option  *IDLType          `json:"option"`
+		COption *IDLType          `json:"coption"`
+		Vec     *IDLType          `json:"vec"`
+		Array   []json.RawMessage `json:"array"`
+		Defined json.RawMessage   `json:"defined"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.Option, t.COption, t.Vec = v.Option, v.COption, v.Vec
+
+	if v.Array != nil {
+		if len(v.Array) != 2 {
+			return fmt.Errorf("invalid array type: %s", b)
+		}
+		var elem IDLType
+		if err := json.Unmarshal(v.Array[0], &elem); err != nil {
+			return err
+		}
+		// the length can be a generic const which is not supported
+		if err := json.Unmarshal(v.Array[1], &t.ArrayLen); err != nil {
+			return fmt.Errorf("unsupported array length: %s", v.Array[1])
+		}
+		t.Array = &elem
+	}
+
+	if v.Defined != nil {
+		// legacy: {"defined":"Name"}, current: {"defined":{"name":"Name"}}
+		if err := json.Unmarshal(v.Defined, &t.Defined); err != nil {
+			var defined struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(v.Defined, &defined); err != nil {
+				return err
+			}
+			t.Defined = defined.Name
+		}
+	}
+
+	if t.Option == nil && t.COption == nil && t.Vec == nil && t.Array == nil && t.Defined == "" {
+		return fmt.Errorf("unsupported type: %s", b)
+	}
+	return nil
+}
+
+// Discriminator is the 8 bytes prefix of instruction data, account data and events
+type Discriminator []byte
+
+// UnmarshalJSON decodes a discriminator from an array of numbers
+func (d *Discriminator) UnmarshalJSON(b []byte) error {
+	var v []uint16
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*d = make(Discriminator, 0, len(v))
+	for _, n := range v {
+		if n > 255 {
+			return fmt.Errorf("invalid discriminator: %s", b)
+		}
+		*d = append(*d, byte(n))
+	}
+	return nil
+}
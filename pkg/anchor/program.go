@@ -0,0 +1,182 @@
+package anchor
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const DiscriminatorSize = 8
+
+// Program builds instructions of an anchor program from its idl
+type Program struct {
+	// ProgramID defaults to the address in the idl
+	ProgramID common.PublicKey
+	IDL       IDL
+
+	encoder encoder
+}
+
+func NewProgram(idl IDL) *Program {
+	var programID common.PublicKey
+	if address := idl.ProgramID(); address != "" {
+		programID = common.PublicKeyFromString(address)
+	}
+	return &Program{
+		ProgramID: programID,
+		IDL:       idl,
+		encoder:   newEncoder(idl.Types),
+	}
+}
+
+type BuildInstructionParam struct {
+	// Name is the instruction name, either camelCase or snake_case
+	Name string
+	// Accounts are keyed by the account names in the idl, accounts in a group are keyed by "group.name".
+	// an account with a fixed address in the idl can be omitted, an omitted optional account is replaced by the program id.
+	Accounts map[string]common.PublicKey
+	// Args are keyed by the arg names in the idl, see encoder for the accepted values
+	Args              map[string]any
+	RemainingAccounts []types.AccountMeta
+}
+
+// BuildInstruction builds an instruction with its discriminator, account metas and borsh-encoded args
+func (p *Program) BuildInstruction(param BuildInstructionParam) (types.Instruction, error) {
+	if p.ProgramID == (common.PublicKey{}) {
+		return types.Instruction{}, fmt.Errorf("program id is not set")
+	}
+	instruction, err := p.instruction(param.Name)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	data, err := p.encodeInstructionData(instruction, param.Args)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	accounts, err := p.accountMetas(instruction.Accounts, "", param.Accounts)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	accounts = append(accounts, param.RemainingAccounts...)
+
+	return types.Instruction{
+		ProgramID: p.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+// EncodeInstructionData returns the discriminator followed by the borsh-encoded args
+func (p *Program) EncodeInstructionData(name string, args map[string]any) ([]byte, error) {
+	instruction, err := p.instruction(name)
+	if err != nil {
+		return nil, err
+	}
+	return p.encodeInstructionData(instruction, args)
+}
+
+func (p *Program) encodeInstructionData(instruction IDLInstruction, args map[string]any) ([]byte, error) {
+	discriminator := instruction.Discriminator
+	if len(discriminator) == 0 {
+		discriminator = InstructionDiscriminator(instruction.Name)
+	}
+	data := append([]byte{}, discriminator...)
+	for _, arg := range instruction.Args {
+		v, ok := args[arg.Name]
+		if !ok && arg.Type.Option == nil && arg.Type.COption == nil {
+			return nil, fmt.Errorf("missing arg: %v", arg.Name)
+		}
+		b, err := p.encoder.encode(arg.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("arg %v: %w", arg.Name, err)
+		}
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+func (p *Program) instruction(name string) (IDLInstruction, error) {
+	for _, instruction := range p.IDL.Instructions {
+		if instruction.Name == name || toSnakeCase(instruction.Name) == toSnakeCase(name) {
+			return instruction, nil
+		}
+	}
+	return IDLInstruction{}, fmt.Errorf("unknown instruction: %v", name)
+}
+
+func (p *Program) accountMetas(idlAccounts []IDLInstructionAccount, prefix string, accounts map[string]common.PublicKey) ([]types.AccountMeta, error) {
+	metas := make([]types.AccountMeta, 0, len(idlAccounts))
+	for _, account := range idlAccounts {
+		name := prefix + account.Name
+		if len(account.Accounts) > 0 {
+			group, err := p.accountMetas(account.Accounts, name+".", accounts)
+			if err != nil {
+				return nil, err
+			}
+			metas = append(metas, group...)
+			continue
+		}
+
+		pubkey, ok := accounts[name]
+		if !ok {
+			switch {
+			case account.Address != "":
+				pubkey = common.PublicKeyFromString(account.Address)
+			case account.Optional:
+				// anchor treats the program id as a missing optional account
+				metas = append(metas, types.AccountMeta{PubKey: p.ProgramID})
+				continue
+			default:
+				return nil, fmt.Errorf("missing account: %v", name)
+			}
+		}
+		metas = append(metas, types.AccountMeta{
+			PubKey:     pubkey,
+			IsSigner:   account.Signer,
+			IsWritable: account.Writable,
+		})
+	}
+	return metas, nil
+}
+
+// InstructionDiscriminator returns the discriminator of an instruction, the name is converted to snake_case
+func InstructionDiscriminator(name string) Discriminator {
+	return sighash("global", toSnakeCase(name))
+}
+
+// AccountDiscriminator returns the discriminator of an account type, e.g. AccountDiscriminator("Counter")
+func AccountDiscriminator(name string) Discriminator {
+	return sighash("account", name)
+}
+
+// EventDiscriminator returns the discriminator of an event, e.g. EventDiscriminator("TransferEvent")
+func EventDiscriminator(name string) Discriminator {
+	return sighash("event", name)
+}
+
+func sighash(namespace, name string) Discriminator {
+	h := sha256.Sum256([]byte(namespace + ":" + name))
+	return Discriminator(h[:DiscriminatorSize])
+}
+
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
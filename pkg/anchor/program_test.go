@@ -0,0 +1,287 @@
+package anchor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testIDL = `{
+	"address": "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS",
+	"metadata": {"name": "counter", "version": "0.1.0", "spec": "0.1.0"},
+	"instructions": [
+		{
+			"name": "initialize",
+			"discriminator": [175, 175, 109, 31, 13, 152, 155, 237],
+			"accounts": [
+				{"name": "counter", "writable": true, "signer": true},
+				{"name": "authority", "writable": true, "signer": true},
+				{"name": "system_program", "address": "11111111111111111111111111111111"}
+			],
+			"args": [{"name": "start", "type": "u64"}]
+		},
+		{
+			"name": "configure",
+			"discriminator": [1, 2, 3, 4, 5, 6, 7, 8],
+			"accounts": [
+				{"name": "counter", "writable": true},
+				{"name": "auth", "accounts": [{"name": "authority", "signer": true}]},
+				{"name": "delegate", "optional": true}
+			],
+			"args": [
+				{"name": "config", "type": {"defined": {"name": "Config"}}},
+				{"name": "mode", "type": {"defined": {"name": "Mode"}}},
+				{"name": "limit", "type": {"option": "u128"}},
+				{"name": "tags", "type": {"vec": "string"}},
+				{"name": "seed", "type": {"array": ["u8", 2]}}
+			]
+		}
+	],
+	"types": [
+		{
+			"name": "Config",
+			"type": {"kind": "struct", "fields": [{"name": "admin", "type": "pubkey"}, {"name": "fee", "type": "i16"}, {"name": "paused", "type": "bool"}]}
+		},
+		{
+			"name": "Mode",
+			"type": {"kind": "enum", "variants": [{"name": "Off"}, {"name": "Fixed", "fields": ["u32"]}, {"name": "Range", "fields": [{"name": "min", "type": "u8"}, {"name": "max", "type": "u8"}]}]}
+		}
+	]
+}`
+
+func TestProgram_BuildInstruction(t *testing.T) {
+	idl, err := ParseIDL([]byte(testIDL))
+	assert.Nil(t, err)
+	p := NewProgram(idl)
+	programID := common.PublicKeyFromString("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS")
+	counter := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	authority := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+	admin := common.PublicKeyFromString("CUQwQyNDPdGM2KfC7B4NJhrSwDwRjdqKetpwBHe9CvEk")
+
+	tests := []struct {
+		name    string
+		param   BuildInstructionParam
+		want    types.Instruction
+		wantErr string
+	}{
+		{
+			name: "fixed address",
+			param: BuildInstructionParam{
+				Name:     "initialize",
+				Accounts: map[string]common.PublicKey{"counter": counter, "authority": authority},
+				Args:     map[string]any{"start": uint64(10)},
+			},
+			want: types.Instruction{
+				ProgramID: programID,
+				Accounts: []types.AccountMeta{
+					{PubKey: counter, IsSigner: true, IsWritable: true},
+					{PubKey: authority, IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{175, 175, 109, 31, 13, 152, 155, 237, 10, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			name: "defined types",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args: map[string]any{
+					"config": map[string]any{"admin": admin.ToBase58(), "fee": -2, "paused": true},
+					"mode":   map[string]any{"Range": map[string]any{"min": 1, "max": 9}},
+					"limit":  big.NewInt(258),
+					"tags":   []string{"a"},
+					"seed":   [2]uint8{7, 8},
+				},
+				RemainingAccounts: []types.AccountMeta{{PubKey: admin}},
+			},
+			want: types.Instruction{
+				ProgramID: programID,
+				Accounts: []types.AccountMeta{
+					{PubKey: counter, IsSigner: false, IsWritable: true},
+					{PubKey: authority, IsSigner: true, IsWritable: false},
+					{PubKey: programID, IsSigner: false, IsWritable: false},
+					{PubKey: admin, IsSigner: false, IsWritable: false},
+				},
+				Data: append(append([]byte{1, 2, 3, 4, 5, 6, 7, 8}, admin.Bytes()...),
+					254, 255, 1, // config
+					2, 1, 9, // mode
+					1, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // limit
+					1, 0, 0, 0, 1, 0, 0, 0, 'a', // tags
+					7, 8, // seed
+				),
+			},
+		},
+		{
+			name: "unit variant and none",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority, "delegate": admin},
+				Args: map[string]any{
+					"config": map[string]any{"admin": admin, "fee": int16(0), "paused": false},
+					"mode":   "Off",
+					"tags":   []string{},
+					"seed":   []uint8{0, 0},
+				},
+			},
+			want: types.Instruction{
+				ProgramID: programID,
+				Accounts: []types.AccountMeta{
+					{PubKey: counter, IsSigner: false, IsWritable: true},
+					{PubKey: authority, IsSigner: true, IsWritable: false},
+					{PubKey: admin, IsSigner: false, IsWritable: false},
+				},
+				Data: append(append([]byte{1, 2, 3, 4, 5, 6, 7, 8}, admin.Bytes()...),
+					0, 0, 0, // config
+					0,          // mode
+					0,          // limit
+					0, 0, 0, 0, // tags
+					0, 0, // seed
+				),
+			},
+		},
+		{
+			name: "invalid value",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args:     map[string]any{"config": []any{}},
+			},
+			wantErr: "arg config: expected a map of fields, got []interface {}",
+		},
+		{
+			name: "u8 overflow",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args: map[string]any{
+					"config": map[string]any{"admin": admin, "fee": 0, "paused": false},
+					"mode":   map[string]any{"Range": map[string]any{"min": 300, "max": 9}},
+				},
+			},
+			wantErr: "arg mode: variant Range: field min: integer overflow: 300 doesn't fit in u8",
+		},
+		{
+			name: "i16 overflow",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args:     map[string]any{"config": map[string]any{"admin": admin, "fee": -40000, "paused": false}},
+			},
+			wantErr: "arg config: field fee: integer overflow: -40000 doesn't fit in i16",
+		},
+		{
+			name: "negative u128",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args: map[string]any{
+					"config": map[string]any{"admin": admin, "fee": 0, "paused": false},
+					"mode":   "Off",
+					"limit":  big.NewInt(-1),
+				},
+			},
+			wantErr: "arg limit: integer overflow: -1 doesn't fit in u128",
+		},
+		{
+			name: "invalid pubkey",
+			param: BuildInstructionParam{
+				Name:     "configure",
+				Accounts: map[string]common.PublicKey{"counter": counter, "auth.authority": authority},
+				Args:     map[string]any{"config": map[string]any{"admin": "abc", "fee": 0, "paused": false}},
+			},
+			wantErr: "arg config: field admin: invalid pubkey: abc",
+		},
+		{
+			name: "missing account",
+			param: BuildInstructionParam{
+				Name:     "initialize",
+				Accounts: map[string]common.PublicKey{"counter": counter},
+				Args:     map[string]any{"start": uint64(10)},
+			},
+			wantErr: "missing account: authority",
+		},
+		{
+			name:    "missing arg",
+			param:   BuildInstructionParam{Name: "initialize"},
+			wantErr: "missing arg: start",
+		},
+		{
+			name:    "unknown instruction",
+			param:   BuildInstructionParam{Name: "close"},
+			wantErr: "unknown instruction: close",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.BuildInstruction(tt.param)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProgram_BuildInstructionLegacyIDL(t *testing.T) {
+	idl, err := ParseIDL([]byte(`{
+		"version": "0.1.0",
+		"name": "counter",
+		"instructions": [
+			{
+				"name": "setData",
+				"accounts": [{"name": "counter", "isMut": true, "isSigner": false}, {"name": "authority", "isMut": false, "isSigner": true}],
+				"args": [{"name": "data", "type": {"defined": "Data"}}, {"name": "owner", "type": "publicKey"}]
+			}
+		],
+		"types": [{"name": "Data", "type": {"kind": "struct", "fields": [{"name": "value", "type": "u32"}]}}],
+		"metadata": {"address": "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS"}
+	}`))
+	assert.Nil(t, err)
+	counter := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	authority := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+
+	// a go struct in the same layout can be used for a defined type
+	type data struct {
+		Value uint32
+	}
+	got, err := NewProgram(idl).BuildInstruction(BuildInstructionParam{
+		Name:     "set_data",
+		Accounts: map[string]common.PublicKey{"counter": counter, "authority": authority},
+		Args:     map[string]any{"data": data{Value: 5}, "owner": authority},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.PublicKeyFromString("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS"),
+		Accounts: []types.AccountMeta{
+			{PubKey: counter, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: true, IsWritable: false},
+		},
+		Data: append(append([]byte{}, InstructionDiscriminator("set_data")...), append([]byte{5, 0, 0, 0}, authority.Bytes()...)...),
+	}, got)
+}
+
+func TestDiscriminator(t *testing.T) {
+	assert.Equal(t, Discriminator{175, 175, 109, 31, 13, 152, 155, 237}, InstructionDiscriminator("initialize"))
+	assert.Equal(t, InstructionDiscriminator("set_data"), InstructionDiscriminator("setData"))
+	assert.Len(t, AccountDiscriminator("Counter"), DiscriminatorSize)
+	assert.NotEqual(t, AccountDiscriminator("Counter"), EventDiscriminator("Counter"))
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"initialize":      "initialize",
+		"setData":         "set_data",
+		"set_data":        "set_data",
+		"updateV2Config":  "update_v2_config",
+		"createATAIfNeed": "create_ata_if_need",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, toSnakeCase(in), in)
+	}
+}
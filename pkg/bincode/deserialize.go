@@ -0,0 +1,168 @@
+package bincode
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var ErrInsufficientData = errors.New("insufficient data length")
+
+// DeserializeData decodes data into v which must be a non-nil pointer.
+// it supports the same types as SerializeData. trailing bytes are ignored.
+func DeserializeData(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("v should be a non-nil pointer")
+	}
+	_, err := deserializeData(data, rv.Elem())
+	return err
+}
+
+func deserializeData(data []byte, v reflect.Value) (int, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if len(data) < 1 {
+			return 0, ErrInsufficientData
+		}
+		switch data[0] {
+		case 0:
+			v.SetBool(false)
+		case 1:
+			v.SetBool(true)
+		default:
+			return 0, fmt.Errorf("invalid bool value: %v", data[0])
+		}
+		return 1, nil
+	case reflect.Uint8:
+		if len(data) < 1 {
+			return 0, ErrInsufficientData
+		}
+		v.SetUint(uint64(data[0]))
+		return 1, nil
+	case reflect.Int16:
+		if len(data) < 2 {
+			return 0, ErrInsufficientData
+		}
+		v.SetInt(int64(int16(binary.LittleEndian.Uint16(data))))
+		return 2, nil
+	case reflect.Uint16:
+		if len(data) < 2 {
+			return 0, ErrInsufficientData
+		}
+		v.SetUint(uint64(binary.LittleEndian.Uint16(data)))
+		return 2, nil
+	case reflect.Int32:
+		if len(data) < 4 {
+			return 0, ErrInsufficientData
+		}
+		v.SetInt(int64(int32(binary.LittleEndian.Uint32(data))))
+		return 4, nil
+	case reflect.Uint32:
+		if len(data) < 4 {
+			return 0, ErrInsufficientData
+		}
+		v.SetUint(uint64(binary.LittleEndian.Uint32(data)))
+		return 4, nil
+	case reflect.Int64:
+		if len(data) < 8 {
+			return 0, ErrInsufficientData
+		}
+		v.SetInt(int64(binary.LittleEndian.Uint64(data)))
+		return 8, nil
+	case reflect.Uint64:
+		if len(data) < 8 {
+			return 0, ErrInsufficientData
+		}
+		v.SetUint(binary.LittleEndian.Uint64(data))
+		return 8, nil
+	case reflect.Slice:
+		switch v.Type().Elem().Kind() {
+		case reflect.Uint8:
+			if len(data) < 8 {
+				return 0, ErrInsufficientData
+			}
+			l := binary.LittleEndian.Uint64(data)
+			if l > uint64(len(data)-8) {
+				return 0, ErrInsufficientData
+			}
+			b := make([]byte, l)
+			copy(b, data[8:8+l])
+			v.SetBytes(b)
+			return 8 + int(l), nil
+		case reflect.Array:
+			if len(data) < 8 {
+				return 0, ErrInsufficientData
+			}
+			l := binary.LittleEndian.Uint64(data)
+			current := 8
+			// each element takes at least one byte, reject a length which can't be satisfied before allocating
+			if l > uint64(len(data)-current) {
+				return 0, ErrInsufficientData
+			}
+			s := reflect.MakeSlice(v.Type(), int(l), int(l))
+			for i := 0; i < int(l); i++ {
+				n, err := deserializeData(data[current:], s.Index(i))
+				if err != nil {
+					return 0, err
+				}
+				current += n
+			}
+			v.Set(s)
+			return current, nil
+		}
+		return 0, fmt.Errorf("unsupport type: %v, elem: %v", v.Kind(), v.Type().Elem().Kind())
+	case reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Uint8:
+			if len(data) < v.Len() {
+				return 0, ErrInsufficientData
+			}
+			for i := 0; i < v.Len(); i++ {
+				v.Index(i).SetUint(uint64(data[i]))
+			}
+			return v.Len(), nil
+		}
+		return 0, fmt.Errorf("unsupport type: %v, elem: %v", v.Kind(), v.Type().Elem().Kind())
+	case reflect.String:
+		if len(data) < 8 {
+			return 0, ErrInsufficientData
+		}
+		l := binary.LittleEndian.Uint64(data)
+		if l > uint64(len(data)-8) {
+			return 0, ErrInsufficientData
+		}
+		v.SetString(string(data[8 : 8+l]))
+		return 8 + int(l), nil
+	case reflect.Ptr:
+		if len(data) < 1 {
+			return 0, ErrInsufficientData
+		}
+		switch data[0] {
+		case 0:
+			v.Set(reflect.Zero(v.Type()))
+			return 1, nil
+		case 1:
+			e := reflect.New(v.Type().Elem())
+			n, err := deserializeData(data[1:], e.Elem())
+			if err != nil {
+				return 0, err
+			}
+			v.Set(e)
+			return 1 + n, nil
+		}
+		return 0, fmt.Errorf("invalid option value: %v", data[0])
+	case reflect.Struct:
+		current := 0
+		for i := 0; i < v.NumField(); i++ {
+			n, err := deserializeData(data[current:], v.Field(i))
+			if err != nil {
+				return 0, err
+			}
+			current += n
+		}
+		return current, nil
+	}
+	return 0, fmt.Errorf("unsupport type: %v", v.Kind())
+}
@@ -0,0 +1,92 @@
+package bincode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStruct struct {
+	Bool   bool
+	U8     uint8
+	I16    int16
+	U32    uint32
+	I64    int64
+	Key    [32]byte
+	Keys   [][2]byte
+	Str    string
+	Bytes  []byte
+	Option *uint64
+	Empty  *uint64
+}
+
+func TestDeserializeData(t *testing.T) {
+	v := uint64(7)
+	in := testStruct{
+		Bool:   true,
+		U8:     1,
+		I16:    -2,
+		U32:    3,
+		I64:    -4,
+		Key:    [32]byte{5},
+		Keys:   [][2]byte{{6, 7}, {8, 9}},
+		Str:    "solana",
+		Bytes:  []byte{10, 11},
+		Option: &v,
+	}
+	data, err := SerializeData(in)
+	assert.Nil(t, err)
+
+	var out testStruct
+	assert.Nil(t, DeserializeData(data, &out))
+	assert.Equal(t, in, out)
+
+	// trailing bytes are ignored
+	out = testStruct{}
+	assert.Nil(t, DeserializeData(append(data, 1, 2, 3), &out))
+	assert.Equal(t, in, out)
+}
+
+func TestDeserializeDataError(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		v    any
+		err  error
+	}{
+		{
+			name: "not pointer",
+			data: []byte{1},
+			v:    uint8(0),
+			err:  assert.AnError,
+		},
+		{
+			name: "insufficient",
+			data: []byte{1, 0},
+			v:    new(uint32),
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "string length overflow",
+			data: []byte{255, 255, 255, 255, 255, 255, 255, 255, 1},
+			v:    new(string),
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "invalid bool",
+			data: []byte{2},
+			v:    new(bool),
+			err:  assert.AnError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DeserializeData(tt.data, tt.v)
+			if tt.err == assert.AnError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -53,6 +53,10 @@ func serializeData(v reflect.Value) ([]byte, error) {
 		return b, nil
 	case reflect.Slice:
 		switch v.Type().Elem().Kind() {
+		case reflect.Uint8:
+			output := make([]byte, 8, 8+v.Len())
+			binary.LittleEndian.PutUint64(output, uint64(v.Len()))
+			return append(output, v.Bytes()...), nil
 		case reflect.Array:
 			l := v.Len()
 			output := make([]byte, 0, 8+l*v.Type().Elem().Len())
@@ -0,0 +1,170 @@
+package borsh
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSimpleEnum Enum
+
+const (
+	testSimpleEnumA testSimpleEnum = iota
+	testSimpleEnumB
+)
+
+type testVariantA struct {
+	Value uint16
+}
+
+type testVariantB struct {
+	Name string
+}
+
+type testComplexEnum struct {
+	Enum Enum `borsh_enum:"true"`
+	A    testVariantA
+	B    testVariantB
+}
+
+type testStruct struct {
+	Bool    bool
+	U8      uint8
+	I16     int16
+	U32     uint32
+	I64     int64
+	F64     float64
+	Key     [4]byte
+	Bytes   []byte
+	Vec     []uint16
+	Str     string
+	Some    *uint32
+	None    *uint32
+	Simple  testSimpleEnum
+	Complex testComplexEnum
+	Ignored uint64 `borsh_skip:"true"`
+}
+
+func TestSerialize(t *testing.T) {
+	v := uint32(7)
+	tests := []struct {
+		name string
+		in   any
+		want []byte
+	}{
+		{
+			name: "ints",
+			in: struct {
+				A uint8
+				B int16
+				C uint32
+				D int64
+			}{1, -2, 3, -4},
+			want: []byte{1, 254, 255, 3, 0, 0, 0, 252, 255, 255, 255, 255, 255, 255, 255},
+		},
+		{
+			name: "string and vec",
+			in: struct {
+				S string
+				V []uint16
+				B []byte
+				A [2]uint8
+			}{"ab", []uint16{1, 2}, []byte{9}, [2]uint8{3, 4}},
+			want: []byte{2, 0, 0, 0, 97, 98, 2, 0, 0, 0, 1, 0, 2, 0, 1, 0, 0, 0, 9, 3, 4},
+		},
+		{
+			name: "option",
+			in: struct {
+				Some *uint32
+				None *uint32
+			}{&v, nil},
+			want: []byte{1, 7, 0, 0, 0, 0},
+		},
+		{
+			name: "enum",
+			in: struct {
+				Simple  testSimpleEnum
+				Complex testComplexEnum
+			}{testSimpleEnumB, testComplexEnum{Enum: 1, B: testVariantB{Name: "x"}}},
+			want: []byte{1, 1, 1, 0, 0, 0, 120},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Serialize(tt.in)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	v := uint32(7)
+	in := testStruct{
+		Bool:    true,
+		U8:      1,
+		I16:     -2,
+		U32:     3,
+		I64:     -4,
+		F64:     1.5,
+		Key:     [4]byte{1, 2, 3, 4},
+		Bytes:   []byte{5, 6},
+		Vec:     []uint16{7, 8},
+		Str:     "solana",
+		Some:    &v,
+		Simple:  testSimpleEnumA,
+		Complex: testComplexEnum{Enum: 0, A: testVariantA{Value: 10}},
+		Ignored: 100,
+	}
+	data, err := Serialize(in)
+	assert.Nil(t, err)
+
+	var out testStruct
+	assert.Nil(t, Deserialize(data, &out))
+	in.Ignored = 0
+	assert.Equal(t, in, out)
+}
+
+func TestDeserializeError(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		v    any
+	}{
+		{
+			name: "not pointer",
+			data: []byte{1},
+			v:    uint8(0),
+		},
+		{
+			name: "insufficient",
+			data: []byte{1, 0},
+			v:    new(uint32),
+		},
+		{
+			name: "string length overflow",
+			data: []byte{255, 255, 255, 255, 1},
+			v:    new(string),
+		},
+		{
+			name: "invalid option",
+			data: []byte{2, 0},
+			v:    new(*uint8),
+		},
+		{
+			name: "invalid enum variant",
+			data: []byte{5, 0, 0},
+			v:    new(testComplexEnum),
+		},
+		{
+			name: "trailing data",
+			data: []byte{1, 2},
+			v:    new(uint8),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NotNil(t, Deserialize(tt.data, tt.v))
+		})
+	}
+}
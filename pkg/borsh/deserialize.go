@@ -0,0 +1,193 @@
+package borsh
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var ErrInsufficientData = errors.New("insufficient data length")
+
+// Deserialize decodes data into v which must be a non-nil pointer. all data need to be consumed.
+func Deserialize(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("v should be a non-nil pointer")
+	}
+	n, err := deserialize(data, rv.Elem())
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("%v bytes remain after deserializing", len(data)-n)
+	}
+	return nil
+}
+
+func deserialize(data []byte, v reflect.Value) (int, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if len(data) < 1 {
+			return 0, ErrInsufficientData
+		}
+		switch data[0] {
+		case 0:
+			v.SetBool(false)
+		case 1:
+			v.SetBool(true)
+		default:
+			return 0, fmt.Errorf("invalid bool value: %v", data[0])
+		}
+		return 1, nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size := int(v.Type().Size())
+		if len(data) < size {
+			return 0, ErrInsufficientData
+		}
+		v.SetUint(readUint(data, size))
+		return size, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size := int(v.Type().Size())
+		if len(data) < size {
+			return 0, ErrInsufficientData
+		}
+		u := readUint(data, size)
+		// sign extend
+		shift := 64 - 8*size
+		v.SetInt(int64(u<<shift) >> shift)
+		return size, nil
+	case reflect.Float32:
+		if len(data) < 4 {
+			return 0, ErrInsufficientData
+		}
+		v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(data))))
+		return 4, nil
+	case reflect.Float64:
+		if len(data) < 8 {
+			return 0, ErrInsufficientData
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+		return 8, nil
+	case reflect.String:
+		l, err := readLen(data)
+		if err != nil {
+			return 0, err
+		}
+		if l > len(data)-4 {
+			return 0, ErrInsufficientData
+		}
+		v.SetString(string(data[4 : 4+l]))
+		return 4 + l, nil
+	case reflect.Slice:
+		l, err := readLen(data)
+		if err != nil {
+			return 0, err
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if l > len(data)-4 {
+				return 0, ErrInsufficientData
+			}
+			b := make([]byte, l)
+			copy(b, data[4:4+l])
+			v.SetBytes(b)
+			return 4 + l, nil
+		}
+		// each element takes at least one byte except zero-sized ones, reject a length which can't be satisfied before allocating
+		if v.Type().Elem().Size() > 0 && l > len(data)-4 {
+			return 0, ErrInsufficientData
+		}
+		s := reflect.MakeSlice(v.Type(), l, l)
+		n, err := deserializeElems(data[4:], s)
+		if err != nil {
+			return 0, err
+		}
+		v.Set(s)
+		return 4 + n, nil
+	case reflect.Array:
+		return deserializeElems(data, v)
+	case reflect.Ptr:
+		if len(data) < 1 {
+			return 0, ErrInsufficientData
+		}
+		switch data[0] {
+		case 0:
+			v.Set(reflect.Zero(v.Type()))
+			return 1, nil
+		case 1:
+			e := reflect.New(v.Type().Elem())
+			n, err := deserialize(data[1:], e.Elem())
+			if err != nil {
+				return 0, err
+			}
+			v.Set(e)
+			return 1 + n, nil
+		}
+		return 0, fmt.Errorf("invalid option value: %v", data[0])
+	case reflect.Struct:
+		if isEnumStruct(v.Type()) {
+			return deserializeEnumStruct(data, v)
+		}
+		current := 0
+		for i := 0; i < v.NumField(); i++ {
+			if skip(v.Type().Field(i)) {
+				continue
+			}
+			n, err := deserialize(data[current:], v.Field(i))
+			if err != nil {
+				return 0, fmt.Errorf("field %v: %w", v.Type().Field(i).Name, err)
+			}
+			current += n
+		}
+		return current, nil
+	}
+	return 0, fmt.Errorf("unsupport type: %v", v.Type())
+}
+
+func deserializeElems(data []byte, v reflect.Value) (int, error) {
+	current := 0
+	for i := 0; i < v.Len(); i++ {
+		n, err := deserialize(data[current:], v.Index(i))
+		if err != nil {
+			return 0, err
+		}
+		current += n
+	}
+	return current, nil
+}
+
+func deserializeEnumStruct(data []byte, v reflect.Value) (int, error) {
+	if len(data) < 1 {
+		return 0, ErrInsufficientData
+	}
+	variant := int(data[0])
+	if variant+1 >= v.NumField() {
+		return 0, fmt.Errorf("invalid enum variant: %v of %v", variant, v.Type())
+	}
+	v.Field(0).SetUint(uint64(variant))
+	n, err := deserialize(data[1:], v.Field(variant+1))
+	if err != nil {
+		return 0, err
+	}
+	return 1 + n, nil
+}
+
+func readUint(data []byte, size int) uint64 {
+	switch size {
+	case 1:
+		return uint64(data[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(data))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(data))
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+func readLen(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, ErrInsufficientData
+	}
+	return int(binary.LittleEndian.Uint32(data)), nil
+}
@@ -0,0 +1,130 @@
+// Package borsh encodes and decodes borsh, it is used by pkg/anchor to decode accounts and events of any program.
+// the program builders use github.com/near/borsh-go, which is fine for the fixed layouts they serialize,
+// but decoding untrusted data needs what it doesn't do: a length prefix is checked against the remaining data
+// before anything is allocated, data left after the value is an error, and an unsupported type is an error
+// instead of a zero value.
+package borsh
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Enum is the variant index of an enum. a simple enum can be declared as a named Enum type.
+// an enum which carries data is a struct tagged with `borsh_enum:"true"`,
+// the first field is the Enum and the field at position index+1 is the payload of the variant.
+type Enum uint8
+
+var enumType = reflect.TypeOf(Enum(0))
+
+func Serialize(data any) ([]byte, error) {
+	return serialize(reflect.ValueOf(data))
+}
+
+func MustSerialize(data any) []byte {
+	b, err := Serialize(data)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func serialize(v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case reflect.Uint8:
+		return []byte{uint8(v.Uint())}, nil
+	case reflect.Int8:
+		return []byte{uint8(v.Int())}, nil
+	case reflect.Uint16:
+		return binary.LittleEndian.AppendUint16(nil, uint16(v.Uint())), nil
+	case reflect.Int16:
+		return binary.LittleEndian.AppendUint16(nil, uint16(v.Int())), nil
+	case reflect.Uint32:
+		return binary.LittleEndian.AppendUint32(nil, uint32(v.Uint())), nil
+	case reflect.Int32:
+		return binary.LittleEndian.AppendUint32(nil, uint32(v.Int())), nil
+	case reflect.Uint64:
+		return binary.LittleEndian.AppendUint64(nil, v.Uint()), nil
+	case reflect.Int64:
+		return binary.LittleEndian.AppendUint64(nil, uint64(v.Int())), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(nil, math.Float32bits(float32(v.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(nil, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		b := binary.LittleEndian.AppendUint32(nil, uint32(v.Len()))
+		return append(b, v.String()...), nil
+	case reflect.Slice:
+		b := binary.LittleEndian.AppendUint32(nil, uint32(v.Len()))
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append(b, v.Bytes()...), nil
+		}
+		return serializeElems(b, v)
+	case reflect.Array:
+		return serializeElems(nil, v)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return []byte{0}, nil
+		}
+		d, err := serialize(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1}, d...), nil
+	case reflect.Struct:
+		if isEnumStruct(v.Type()) {
+			return serializeEnumStruct(v)
+		}
+		b := make([]byte, 0, 64)
+		for i := 0; i < v.NumField(); i++ {
+			if skip(v.Type().Field(i)) {
+				continue
+			}
+			d, err := serialize(v.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %w", v.Type().Field(i).Name, err)
+			}
+			b = append(b, d...)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unsupport type: %v", v.Type())
+}
+
+func serializeElems(b []byte, v reflect.Value) ([]byte, error) {
+	for i := 0; i < v.Len(); i++ {
+		d, err := serialize(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, d...)
+	}
+	return b, nil
+}
+
+func serializeEnumStruct(v reflect.Value) ([]byte, error) {
+	variant := int(v.Field(0).Uint())
+	if variant+1 >= v.NumField() {
+		return nil, fmt.Errorf("invalid enum variant: %v of %v", variant, v.Type())
+	}
+	d, err := serialize(v.Field(variant + 1))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{uint8(variant)}, d...), nil
+}
+
+func isEnumStruct(t reflect.Type) bool {
+	return t.NumField() > 0 && t.Field(0).Type == enumType && t.Field(0).Tag.Get("borsh_enum") == "true"
+}
+
+func skip(f reflect.StructField) bool {
+	return f.Tag.Get("borsh_skip") == "true"
+}
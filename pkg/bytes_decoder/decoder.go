@@ -3,6 +3,7 @@ package bytes_decoder
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 )
 
 func GetUint64(curr *int, data []byte) (uint64, error) {
@@ -40,3 +41,28 @@ func GetBytes32(curr *int, data []byte) ([32]byte, error) {
 
 	return v, nil
 }
+
+func GetUint8(curr *int, data []byte) (uint8, error) {
+	if curr == nil {
+		return 0, fmt.Errorf("index is nil")
+	}
+	if data == nil {
+		return 0, fmt.Errorf("data is nil")
+	}
+	if len(data[*curr:]) < 1 {
+		return 0, fmt.Errorf("insufficient data length")
+	}
+
+	v := data[*curr]
+	*curr += 1
+
+	return v, nil
+}
+
+func GetFloat64(curr *int, data []byte) (float64, error) {
+	v, err := GetUint64(curr, data)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(v), nil
+}
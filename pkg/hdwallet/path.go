@@ -60,7 +60,11 @@ func Derived(path string, seed []byte) (Key, error) {
 	for _, s := range strings.Split(path, "/")[1:] {
 		v, err := strconv.ParseUint(s[:len(s)-1], 10, 32)
 		if err != nil {
-			return Key{}, fmt.Errorf("failed to parse %v as a uint, err: %v", v, err)
+			return Key{}, fmt.Errorf("failed to parse %v as a uint, err: %v", s, err)
+		}
+		// the index will be hardened, a bigger one overflows into another index
+		if v >= 1<<31 {
+			return Key{}, fmt.Errorf("index %v is out of range", v)
 		}
 
 		key = CKDPriv(key, uint32(v)+1<<31)
@@ -68,3 +72,9 @@ func Derived(path string, seed []byte) (Key, error) {
 
 	return key, nil
 }
+
+// SolanaPath returns the bip44 path of the nth account, m/44'/501'/{index}'/0'.
+// it is the path which wallets like phantom use to derive multiple accounts from one seed.
+func SolanaPath(index uint32) string {
+	return fmt.Sprintf("m/44'/501'/%d'/0'", index)
+}
@@ -166,6 +166,14 @@ func TestDerived(t *testing.T) {
 				PrivateKey: mustDecodeHex("551d333177df541ad876a60ea71f00447931c0a9da16f227c11ea080d7391b8d"),
 			},
 		},
+		{
+			args: args{
+				seed: mustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+				path: "m/2147483648'",
+			},
+			want:    Key{},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -181,6 +189,27 @@ func TestDerived(t *testing.T) {
 	}
 }
 
+func TestSolanaPath(t *testing.T) {
+	tests := []struct {
+		index uint32
+		want  string
+	}{
+		{index: 0, want: "m/44'/501'/0'/0'"},
+		{index: 10, want: "m/44'/501'/10'/0'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := SolanaPath(tt.index)
+			if got != tt.want {
+				t.Errorf("SolanaPath() = %v, want %v", got, tt.want)
+			}
+			if !isValidPath(got) {
+				t.Errorf("SolanaPath() = %v is not a valid path", got)
+			}
+		})
+	}
+}
+
 func mustDecodeHex(s string) []byte {
 	h, err := hex.DecodeString(s)
 	if err != nil {
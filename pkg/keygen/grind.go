@@ -0,0 +1,127 @@
+package keygen
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+type GrindConfig struct {
+	Prefix     string
+	Suffix     string
+	IgnoreCase bool
+	// Count is the number of accounts to find, default is 1
+	Count int
+	// Workers is the number of goroutines, default is runtime.NumCPU()
+	Workers int
+	// OnProgress is called every ProgressInterval with the number of keys tried so far
+	OnProgress       func(attempts uint64)
+	ProgressInterval time.Duration
+}
+
+// Grind generates keypairs until Count of them match the prefix and the suffix, like `solana-keygen grind`.
+// if ctx is done before that, the accounts found so far are returned with ctx.Err().
+func Grind(ctx context.Context, config GrindConfig) ([]types.Account, error) {
+	if config.Prefix == "" && config.Suffix == "" {
+		return nil, fmt.Errorf("prefix or suffix is required")
+	}
+	for _, s := range []string{config.Prefix, config.Suffix} {
+		if err := validate(s, config.IgnoreCase); err != nil {
+			return nil, err
+		}
+	}
+	if config.Count <= 0 {
+		config.Count = 1
+	}
+	if config.Workers <= 0 {
+		config.Workers = runtime.NumCPU()
+	}
+	if config.ProgressInterval <= 0 {
+		config.ProgressInterval = time.Second
+	}
+
+	prefix, suffix := config.Prefix, config.Suffix
+	if config.IgnoreCase {
+		prefix, suffix = strings.ToLower(prefix), strings.ToLower(suffix)
+	}
+	match := func(account types.Account) bool {
+		s := account.PublicKey.ToBase58()
+		if config.IgnoreCase {
+			s = strings.ToLower(s)
+		}
+		return strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var attempts uint64
+	found := make(chan types.Account)
+	var wg sync.WaitGroup
+	for i := 0; i < config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				account := types.NewAccount()
+				atomic.AddUint64(&attempts, 1)
+				if !match(account) {
+					continue
+				}
+				select {
+				case found <- account:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	// stop the workers before returning
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	var tick <-chan time.Time
+	if config.OnProgress != nil {
+		ticker := time.NewTicker(config.ProgressInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	accounts := make([]types.Account, 0, config.Count)
+	for {
+		select {
+		case account := <-found:
+			accounts = append(accounts, account)
+			if len(accounts) == config.Count {
+				return accounts, nil
+			}
+		case <-tick:
+			config.OnProgress(atomic.LoadUint64(&attempts))
+		case <-ctx.Done():
+			return accounts, ctx.Err()
+		}
+	}
+}
+
+func validate(s string, ignoreCase bool) error {
+	for _, c := range s {
+		if strings.ContainsRune(base58Alphabet, c) {
+			continue
+		}
+		if ignoreCase && (strings.ContainsRune(base58Alphabet, unicode.ToUpper(c)) || strings.ContainsRune(base58Alphabet, unicode.ToLower(c))) {
+			continue
+		}
+		return fmt.Errorf("%q is not a base58 character", c)
+	}
+	return nil
+}
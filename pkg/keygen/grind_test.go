@@ -0,0 +1,80 @@
+package keygen
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrind(t *testing.T) {
+	accounts, err := Grind(context.Background(), GrindConfig{
+		Prefix:  "A",
+		Suffix:  "b",
+		Count:   2,
+		Workers: 2,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, accounts, 2)
+	for _, account := range accounts {
+		s := account.PublicKey.ToBase58()
+		assert.True(t, strings.HasPrefix(s, "A"), s)
+		assert.True(t, strings.HasSuffix(s, "b"), s)
+	}
+}
+
+func TestGrindIgnoreCase(t *testing.T) {
+	accounts, err := Grind(context.Background(), GrindConfig{
+		Prefix:     "o",
+		IgnoreCase: true,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, accounts, 1)
+	assert.True(t, strings.HasPrefix(strings.ToLower(accounts[0].PublicKey.ToBase58()), "o"))
+}
+
+func TestGrindCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var last uint64
+	accounts, err := Grind(ctx, GrindConfig{
+		Prefix:           "zzzzzzzzzz",
+		ProgressInterval: 5 * time.Millisecond,
+		OnProgress: func(attempts uint64) {
+			assert.GreaterOrEqual(t, attempts, atomic.LoadUint64(&last))
+			atomic.StoreUint64(&last, attempts)
+		},
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Empty(t, accounts)
+	assert.NotZero(t, atomic.LoadUint64(&last))
+}
+
+func TestGrindInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config GrindConfig
+	}{
+		{
+			name:   "empty",
+			config: GrindConfig{},
+		},
+		{
+			name:   "invalid prefix",
+			config: GrindConfig{Prefix: "0"},
+		},
+		{
+			name:   "invalid suffix",
+			config: GrindConfig{Suffix: "O"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Grind(context.Background(), tt.config)
+			assert.NotNil(t, err)
+		})
+	}
+}
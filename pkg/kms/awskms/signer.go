@@ -0,0 +1,127 @@
+// Package awskms signs with ed25519 keys held in AWS KMS, the private key never leaves KMS.
+//
+// the signer calls KMS through Client, so this package doesn't depend on the aws sdk.
+// a *kms.Client of aws-sdk-go-v2 can be adapted as:
+//
+//	type kmsClient struct{ *kms.Client }
+//
+//	func (c kmsClient) Sign(ctx context.Context, keyID string, message []byte) ([]byte, error) {
+//		out, err := c.Client.Sign(ctx, &kms.SignInput{
+//			KeyId:            aws.String(keyID),
+//			Message:          message,
+//			MessageType:      kmstypes.MessageTypeRaw,
+//			SigningAlgorithm: kmstypes.SigningAlgorithmSpec(awskms.SigningAlgorithm),
+//		})
+//		if err != nil {
+//			return nil, err
+//		}
+//		return out.Signature, nil
+//	}
+//
+//	func (c kmsClient) GetPublicKey(ctx context.Context, keyID string) ([]byte, error) {
+//		out, err := c.Client.GetPublicKey(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(keyID)})
+//		if err != nil {
+//			return nil, err
+//		}
+//		return out.PublicKey, nil
+//	}
+package awskms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const (
+	// KeySpec is the key spec of an ed25519 key
+	KeySpec = "ECC_NIST_EDWARDS25519"
+	// SigningAlgorithm is the pure ed25519 algorithm which solana uses, the message type must be RAW
+	SigningAlgorithm = "ED25519_SHA_512"
+
+	defaultTimeout = 10 * time.Second
+)
+
+var ErrInvalidSignature = errors.New("kms returned an invalid signature")
+
+// Client calls the KMS api
+type Client interface {
+	// Sign calls kms:Sign with MessageType RAW and SigningAlgorithm ED25519_SHA_512, it returns the signature
+	Sign(ctx context.Context, keyID string, message []byte) ([]byte, error)
+	// GetPublicKey calls kms:GetPublicKey, it returns the DER-encoded public key
+	GetPublicKey(ctx context.Context, keyID string) ([]byte, error)
+}
+
+var _ types.Signer = (*Signer)(nil)
+
+// Signer is a types.Signer backed by a KMS key
+type Signer struct {
+	client    Client
+	keyID     string
+	publicKey common.PublicKey
+	// Timeout bounds a Sign call which has no context, default is 10s
+	Timeout time.Duration
+}
+
+// NewSigner fetches the public key of keyID which is a key id, a key arn or an alias
+func NewSigner(ctx context.Context, client Client, keyID string) (*Signer, error) {
+	der, err := client.GetPublicKey(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key, err: %v", err)
+	}
+	publicKey, err := parsePublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		client:    client,
+		keyID:     keyID,
+		publicKey: publicKey,
+		Timeout:   defaultTimeout,
+	}, nil
+}
+
+func parsePublicKey(der []byte) (common.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return common.PublicKey{}, fmt.Errorf("failed to parse public key, err: %v", err)
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return common.PublicKey{}, fmt.Errorf("key is not an ed25519 key, got %T", key)
+	}
+	return common.PublicKeyFromBytes(publicKey), nil
+}
+
+func (s *Signer) PublicKey() common.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs a serialized message
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return s.SignWithContext(ctx, message)
+}
+
+// SignWithContext signs a serialized message, the signature is verified before it returns
+func (s *Signer) SignWithContext(ctx context.Context, message []byte) ([]byte, error) {
+	sig, err := s.client.Sign(ctx, s.keyID, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign, err: %v", err)
+	}
+	if len(sig) != ed25519.SignatureSize || !ed25519.Verify(s.publicKey.Bytes(), message, sig) {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
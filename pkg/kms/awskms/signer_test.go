@@ -0,0 +1,91 @@
+package awskms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	keyID      string
+	publicKey  []byte
+	privateKey ed25519.PrivateKey
+	signErr    error
+}
+
+func (c *fakeClient) Sign(ctx context.Context, keyID string, message []byte) ([]byte, error) {
+	if keyID != c.keyID {
+		return nil, errors.New("NotFoundException")
+	}
+	if c.signErr != nil {
+		return nil, c.signErr
+	}
+	return ed25519.Sign(c.privateKey, message), nil
+}
+
+func (c *fakeClient) GetPublicKey(ctx context.Context, keyID string) ([]byte, error) {
+	if keyID != c.keyID {
+		return nil, errors.New("NotFoundException")
+	}
+	return c.publicKey, nil
+}
+
+func newFakeClient(t *testing.T, keyID string) (*fakeClient, ed25519.PublicKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.Nil(t, err)
+	return &fakeClient{keyID: keyID, publicKey: der, privateKey: privateKey}, publicKey
+}
+
+func TestSigner(t *testing.T) {
+	client, publicKey := newFakeClient(t, "alias/hot-wallet")
+	signer, err := NewSigner(context.Background(), client, "alias/hot-wallet")
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromBytes(publicKey), signer.PublicKey())
+
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer:        signer.PublicKey(),
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+	})
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message:         message,
+		ExternalSigners: []types.Signer{signer},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, tx.VerifySignatures())
+
+	// a signature of another key is rejected
+	other, _ := newFakeClient(t, "alias/hot-wallet")
+	signer.client = other
+	_, err = signer.Sign([]byte("hello"))
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	client.signErr = errors.New("ThrottlingException")
+	signer.client = client
+	_, err = signer.Sign([]byte("hello"))
+	assert.EqualError(t, err, "failed to sign, err: ThrottlingException")
+}
+
+func TestNewSigner_Error(t *testing.T) {
+	client, _ := newFakeClient(t, "alias/hot-wallet")
+	_, err := NewSigner(context.Background(), client, "alias/unknown")
+	assert.EqualError(t, err, "failed to get public key, err: NotFoundException")
+
+	// not an ed25519 key
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	client.publicKey, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.Nil(t, err)
+	_, err = NewSigner(context.Background(), client, "alias/hot-wallet")
+	assert.EqualError(t, err, "key is not an ed25519 key, got *ecdsa.PublicKey")
+}
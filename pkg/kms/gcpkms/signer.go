@@ -0,0 +1,201 @@
+// Package gcpkms signs with ed25519 keys held in Google Cloud KMS, the private key never leaves KMS.
+//
+// the signer calls KMS through Client, so this package doesn't depend on the google cloud sdk.
+// a *kms.KeyManagementClient of cloud.google.com/go/kms/apiv1 can be adapted as:
+//
+//	type kmsClient struct{ *kms.KeyManagementClient }
+//
+//	func (c kmsClient) AsymmetricSign(ctx context.Context, keyVersionName string, message []byte) ([]byte, error) {
+//		resp, err := c.KeyManagementClient.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+//			Name: keyVersionName,
+//			Data: message,
+//		})
+//		if err != nil {
+//			return nil, err
+//		}
+//		return resp.Signature, nil
+//	}
+//
+//	func (c kmsClient) GetPublicKey(ctx context.Context, keyVersionName string) (string, error) {
+//		resp, err := c.KeyManagementClient.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: keyVersionName})
+//		if err != nil {
+//			return "", err
+//		}
+//		return resp.Pem, nil
+//	}
+package gcpkms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// Algorithm is the algorithm of an ed25519 key version
+const Algorithm = "EC_SIGN_ED25519"
+
+const (
+	defaultTimeout        = 10 * time.Second
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+)
+
+var ErrInvalidSignature = errors.New("kms returned an invalid signature")
+
+var keyVersionNameRegexp = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+/cryptoKeyVersions/[^/]+$`)
+
+// Client calls the Cloud KMS api
+type Client interface {
+	// AsymmetricSign calls AsymmetricSign with the message as `data`, it returns the signature
+	AsymmetricSign(ctx context.Context, keyVersionName string, message []byte) ([]byte, error)
+	// GetPublicKey calls GetPublicKey, it returns the PEM-encoded public key
+	GetPublicKey(ctx context.Context, keyVersionName string) (string, error)
+}
+
+type Config struct {
+	// Timeout bounds a Sign call which has no context, including retries, default is 10s
+	Timeout time.Duration
+	// MaxRetries is the number of retries after a failed call, default is 3. a negative value disables retry.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, it doubles on each retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// IsRetryable decides whether an error is retried, default retries all errors
+	IsRetryable func(err error) bool
+}
+
+var _ types.Signer = (*Signer)(nil)
+
+// Signer is a types.Signer backed by a Cloud KMS key version
+type Signer struct {
+	client         Client
+	keyVersionName string
+	publicKey      common.PublicKey
+	cfg            Config
+}
+
+// NewSigner fetches the public key of the key version
+func NewSigner(ctx context.Context, client Client, keyVersionName string) (*Signer, error) {
+	return NewSignerWithConfig(ctx, client, keyVersionName, Config{})
+}
+
+// NewSignerWithConfig fetches the public key of the key version.
+// keyVersionName must be a full key version name, so a rotation of the primary version doesn't change the address.
+func NewSignerWithConfig(ctx context.Context, client Client, keyVersionName string, cfg Config) (*Signer, error) {
+	if !keyVersionNameRegexp.MatchString(keyVersionName) {
+		return nil, fmt.Errorf("invalid key version name: %v, expected projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*", keyVersionName)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = defaultInitialBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+
+	s := &Signer{
+		client:         client,
+		keyVersionName: keyVersionName,
+		cfg:            cfg,
+	}
+
+	var pemKey string
+	err := s.retry(ctx, func() error {
+		var err error
+		pemKey, err = client.GetPublicKey(ctx, keyVersionName)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key, err: %v", err)
+	}
+	s.publicKey, err = parsePublicKey(pemKey)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func parsePublicKey(pemKey string) (common.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return common.PublicKey{}, errors.New("failed to decode pem")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return common.PublicKey{}, fmt.Errorf("failed to parse public key, err: %v", err)
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return common.PublicKey{}, fmt.Errorf("key is not an ed25519 key, got %T", key)
+	}
+	return common.PublicKeyFromBytes(publicKey), nil
+}
+
+func (s *Signer) PublicKey() common.PublicKey {
+	return s.publicKey
+}
+
+// KeyVersionName returns the pinned key version
+func (s *Signer) KeyVersionName() string {
+	return s.keyVersionName
+}
+
+// Sign signs a serialized message
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	return s.SignWithContext(ctx, message)
+}
+
+// SignWithContext signs a serialized message, the signature is verified before it returns
+func (s *Signer) SignWithContext(ctx context.Context, message []byte) ([]byte, error) {
+	var sig []byte
+	err := s.retry(ctx, func() error {
+		var err error
+		sig, err = s.client.AsymmetricSign(ctx, s.keyVersionName, message)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign, err: %v", err)
+	}
+	if len(sig) != ed25519.SignatureSize || !ed25519.Verify(s.publicKey.Bytes(), message, sig) {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+func (s *Signer) retry(ctx context.Context, f func() error) error {
+	backoff := s.cfg.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if attempt >= s.cfg.MaxRetries || ctx.Err() != nil || (s.cfg.IsRetryable != nil && !s.cfg.IsRetryable(err)) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > s.cfg.MaxBackoff {
+			backoff = s.cfg.MaxBackoff
+		}
+	}
+}
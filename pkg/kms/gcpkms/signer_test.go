@@ -0,0 +1,118 @@
+package gcpkms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKeyVersionName = "projects/p/locations/global/keyRings/r/cryptoKeys/hot-wallet/cryptoKeyVersions/1"
+
+type fakeClient struct {
+	publicKey  string
+	privateKey ed25519.PrivateKey
+	// errs are returned by the first calls
+	errs  []error
+	calls int
+}
+
+func (c *fakeClient) next() error {
+	c.calls++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return err
+	}
+	return nil
+}
+
+func (c *fakeClient) AsymmetricSign(ctx context.Context, keyVersionName string, message []byte) ([]byte, error) {
+	if err := c.next(); err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(c.privateKey, message), nil
+}
+
+func (c *fakeClient) GetPublicKey(ctx context.Context, keyVersionName string) (string, error) {
+	if err := c.next(); err != nil {
+		return "", err
+	}
+	return c.publicKey, nil
+}
+
+func newFakeClient(t *testing.T) (*fakeClient, ed25519.PublicKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.Nil(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return &fakeClient{publicKey: string(pemKey), privateKey: privateKey}, publicKey
+}
+
+func TestSigner(t *testing.T) {
+	client, publicKey := newFakeClient(t)
+	client.errs = []error{errors.New("Unavailable")}
+	signer, err := NewSignerWithConfig(context.Background(), client, testKeyVersionName, Config{InitialBackoff: time.Millisecond})
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromBytes(publicKey), signer.PublicKey())
+	assert.Equal(t, testKeyVersionName, signer.KeyVersionName())
+	assert.Equal(t, 2, client.calls)
+
+	client.errs = []error{errors.New("Unavailable"), errors.New("ResourceExhausted")}
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        signer.PublicKey(),
+			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		}),
+		ExternalSigners: []types.Signer{signer},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, tx.VerifySignatures())
+	assert.Equal(t, 5, client.calls)
+}
+
+func TestSigner_Retry(t *testing.T) {
+	client, _ := newFakeClient(t)
+	permissionDenied := errors.New("PermissionDenied")
+	signer, err := NewSignerWithConfig(context.Background(), client, testKeyVersionName, Config{
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+		IsRetryable: func(err error) bool {
+			return err != permissionDenied
+		},
+	})
+	assert.Nil(t, err)
+
+	client.calls = 0
+	client.errs = []error{errors.New("Unavailable"), errors.New("Unavailable"), errors.New("Unavailable")}
+	_, err = signer.Sign([]byte("hello"))
+	assert.EqualError(t, err, "failed to sign, err: Unavailable")
+	assert.Equal(t, 3, client.calls)
+
+	client.calls = 0
+	client.errs = []error{permissionDenied}
+	_, err = signer.Sign([]byte("hello"))
+	assert.EqualError(t, err, "failed to sign, err: PermissionDenied")
+	assert.Equal(t, 1, client.calls)
+
+	// a signature of another key is rejected
+	other, _ := newFakeClient(t)
+	signer.client = other
+	_, err = signer.Sign([]byte("hello"))
+	assert.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestNewSigner_KeyVersionPinning(t *testing.T) {
+	client, _ := newFakeClient(t)
+	_, err := NewSigner(context.Background(), client, "projects/p/locations/global/keyRings/r/cryptoKeys/hot-wallet")
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, client.calls)
+}
@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	hidPacketSize = 64
+	// channel(2) + tag(1) + sequence(2)
+	transportHeaderSize = 5
+	apduTag             = 0x05
+	apduCLA             = 0xe0
+	// the max size of the data in an apdu
+	maxChunkSize = 255
+)
+
+var ErrInvalidResponse = errors.New("invalid response from device")
+
+// StatusError is returned when the device responds with a status other than 0x9000
+type StatusError struct {
+	Code uint16
+}
+
+func (e *StatusError) Error() string {
+	switch e.Code {
+	case 0x6700:
+		return "ledger: incorrect length"
+	case 0x6982:
+		return "ledger: security status not satisfied, the device may be locked"
+	case 0x6985:
+		return "ledger: user rejected the request"
+	case 0x6a80:
+		return "ledger: invalid data"
+	case 0x6b00:
+		return "ledger: incorrect parameters"
+	case 0x6d00, 0x6e00:
+		return "ledger: the solana app is not open"
+	}
+	return fmt.Sprintf("ledger: unknown status 0x%04x", e.Code)
+}
+
+// exchange sends an apdu through the hid framing of ledger and returns the response without the status
+func exchange(device io.ReadWriter, ins, p1, p2 byte, data []byte) ([]byte, error) {
+	if len(data) > maxChunkSize {
+		return nil, fmt.Errorf("apdu data too long, max: %v, got: %v", maxChunkSize, len(data))
+	}
+	if err := write(device, append([]byte{apduCLA, ins, p1, p2, byte(len(data))}, data...)); err != nil {
+		return nil, err
+	}
+	resp, err := read(device)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) < 2 {
+		return nil, ErrInvalidResponse
+	}
+	status := uint16(resp[len(resp)-2])<<8 | uint16(resp[len(resp)-1])
+	if status != 0x9000 {
+		return nil, &StatusError{Code: status}
+	}
+	return resp[:len(resp)-2], nil
+}
+
+func write(device io.Writer, apdu []byte) error {
+	// the first packet carries the length of the whole apdu
+	payload := append([]byte{byte(len(apdu) >> 8), byte(len(apdu))}, apdu...)
+	for seq := 0; len(payload) > 0; seq++ {
+		packet := make([]byte, hidPacketSize)
+		copy(packet, []byte{0x01, 0x01, apduTag, byte(seq >> 8), byte(seq)})
+		n := copy(packet[transportHeaderSize:], payload)
+		payload = payload[n:]
+		if _, err := device.Write(packet); err != nil {
+			return fmt.Errorf("failed to write to device, err: %v", err)
+		}
+	}
+	return nil
+}
+
+func read(device io.Reader) ([]byte, error) {
+	var message []byte
+	size := 0
+	for seq := 0; seq <= 0xffff; seq++ {
+		packet := make([]byte, hidPacketSize)
+		n, err := device.Read(packet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read from device, err: %v", err)
+		}
+		packet = packet[:n]
+		if n < transportHeaderSize || packet[0] != 0x01 || packet[1] != 0x01 || packet[2] != apduTag {
+			return nil, ErrInvalidResponse
+		}
+		if int(packet[3])<<8|int(packet[4]) != seq {
+			return nil, ErrInvalidResponse
+		}
+		offset := transportHeaderSize
+		if seq == 0 {
+			if n < transportHeaderSize+2 {
+				return nil, ErrInvalidResponse
+			}
+			size = int(packet[5])<<8 | int(packet[6])
+			offset += 2
+		}
+		message = append(message, packet[offset:]...)
+		if len(message) >= size {
+			return message[:size], nil
+		}
+	}
+	return nil, ErrInvalidResponse
+}
@@ -0,0 +1,179 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const (
+	insGetAppConfiguration = 0x04
+	insGetPubkey           = 0x05
+	insSignMessage         = 0x06
+
+	p1NonConfirm = 0x00
+	p1Confirm    = 0x01
+
+	p2Extend = 0x01
+	p2More   = 0x02
+)
+
+// DerivationPath is the bip44 path of a key, all indexes are hardened. e.g. {44, 501, 0, 0} is m/44'/501'/0'/0'
+type DerivationPath []uint32
+
+// DefaultDerivationPath is the path solana cli uses for `usb://ledger`
+var DefaultDerivationPath = DerivationPath{44, 501}
+
+// NewDerivationPath returns m/44'/501'/{account}'/{change}'
+func NewDerivationPath(account, change uint32) DerivationPath {
+	return DerivationPath{44, 501, account, change}
+}
+
+func (p DerivationPath) serialize() []byte {
+	b := make([]byte, 1, 1+4*len(p))
+	b[0] = byte(len(p))
+	for _, index := range p {
+		b = binary.BigEndian.AppendUint32(b, index|1<<31)
+	}
+	return b
+}
+
+// Wallet talks to the solana app on a ledger device.
+// device is an opened hid device which reads and writes 64 bytes reports, e.g. a device from github.com/karalabe/hid
+type Wallet struct {
+	mu     sync.Mutex
+	device io.ReadWriter
+}
+
+func NewWallet(device io.ReadWriter) *Wallet {
+	return &Wallet{device: device}
+}
+
+type AppConfiguration struct {
+	BlindSigningEnabled bool
+	PubkeyDisplayMode   uint8
+	Version             string
+}
+
+// GetAppConfiguration returns the settings and the version of the solana app
+func (w *Wallet) GetAppConfiguration() (AppConfiguration, error) {
+	resp, err := w.exchange(insGetAppConfiguration, p1NonConfirm, 0, nil)
+	if err != nil {
+		return AppConfiguration{}, err
+	}
+	if len(resp) < 5 {
+		return AppConfiguration{}, ErrInvalidResponse
+	}
+	return AppConfiguration{
+		BlindSigningEnabled: resp[0] != 0,
+		PubkeyDisplayMode:   resp[1],
+		Version:             fmt.Sprintf("%d.%d.%d", resp[2], resp[3], resp[4]),
+	}, nil
+}
+
+// GetPublicKey returns the public key of the path. if confirm is true, the user needs to approve it on the device.
+func (w *Wallet) GetPublicKey(path DerivationPath, confirm bool) (common.PublicKey, error) {
+	p1 := byte(p1NonConfirm)
+	if confirm {
+		p1 = p1Confirm
+	}
+	resp, err := w.exchange(insGetPubkey, p1, 0, path.serialize())
+	if err != nil {
+		return common.PublicKey{}, err
+	}
+	if len(resp) != common.PublicKeyLength {
+		return common.PublicKey{}, ErrInvalidResponse
+	}
+	return common.PublicKeyFromBytes(resp), nil
+}
+
+// SignMessage signs a serialized transaction message with the key of the path, the user needs to approve it on the device
+func (w *Wallet) SignMessage(path DerivationPath, message []byte) ([]byte, error) {
+	if len(message) > 0xffff {
+		return nil, fmt.Errorf("message too long, max: %v, got: %v", 0xffff, len(message))
+	}
+
+	// the app accepts multiple paths, only one signer is used here
+	payload := append([]byte{1}, path.serialize()...)
+	n := maxChunkSize - len(payload)
+	if n > len(message) {
+		n = len(message)
+	}
+	payload = append(payload, message[:n]...)
+	remaining := message[n:]
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	p2 := byte(0)
+	if len(remaining) > 0 {
+		p2 = p2More
+	}
+	resp, err := exchange(w.device, insSignMessage, p1Confirm, p2, payload)
+	if err != nil {
+		return nil, err
+	}
+	for len(remaining) > 0 {
+		n := maxChunkSize
+		if n > len(remaining) {
+			n = len(remaining)
+		}
+		chunk := remaining[:n]
+		remaining = remaining[n:]
+
+		p2 := byte(p2Extend)
+		if len(remaining) > 0 {
+			p2 |= p2More
+		}
+		resp, err = exchange(w.device, insSignMessage, p1Confirm, p2, chunk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(resp) != 64 {
+		return nil, ErrInvalidResponse
+	}
+	return resp, nil
+}
+
+func (w *Wallet) exchange(ins, p1, p2 byte, data []byte) ([]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return exchange(w.device, ins, p1, p2, data)
+}
+
+var _ types.Signer = (*Signer)(nil)
+
+// Signer signs with a key on the ledger device, it can be used as a types.Signer
+type Signer struct {
+	wallet    *Wallet
+	path      DerivationPath
+	publicKey common.PublicKey
+}
+
+// NewSigner fetches the public key of the path and returns a signer of it
+func NewSigner(wallet *Wallet, path DerivationPath) (*Signer, error) {
+	publicKey, err := wallet.GetPublicKey(path, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		wallet:    wallet,
+		path:      path,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (s *Signer) PublicKey() common.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs a serialized message
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	return s.wallet.SignMessage(s.path, message)
+}
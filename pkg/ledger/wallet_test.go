@@ -0,0 +1,146 @@
+package ledger
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDevice reassembles apdus from hid packets and replies by handler
+type fakeDevice struct {
+	t       *testing.T
+	handler func(apdu []byte) []byte
+
+	buf     []byte
+	size    int
+	seq     int
+	replies [][]byte
+}
+
+func (d *fakeDevice) Write(p []byte) (int, error) {
+	assert.Equal(d.t, hidPacketSize, len(p))
+	assert.Equal(d.t, []byte{0x01, 0x01, apduTag, byte(d.seq >> 8), byte(d.seq)}, p[:5])
+	data := p[5:]
+	if d.seq == 0 {
+		d.size = int(data[0])<<8 | int(data[1])
+		data = data[2:]
+	}
+	d.seq++
+	d.buf = append(d.buf, data...)
+	if len(d.buf) >= d.size {
+		resp := d.handler(d.buf[:d.size])
+		d.buf, d.size, d.seq = nil, 0, 0
+
+		payload := append([]byte{byte(len(resp) >> 8), byte(len(resp))}, resp...)
+		for seq := 0; len(payload) > 0; seq++ {
+			packet := make([]byte, hidPacketSize)
+			copy(packet, []byte{0x01, 0x01, apduTag, byte(seq >> 8), byte(seq)})
+			n := copy(packet[5:], payload)
+			payload = payload[n:]
+			d.replies = append(d.replies, packet)
+		}
+	}
+	return len(p), nil
+}
+
+func (d *fakeDevice) Read(p []byte) (int, error) {
+	packet := d.replies[0]
+	d.replies = d.replies[1:]
+	return copy(p, packet), nil
+}
+
+func TestGetPublicKey(t *testing.T) {
+	pubkey := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	device := &fakeDevice{
+		t: t,
+		handler: func(apdu []byte) []byte {
+			assert.Equal(t, []byte{
+				apduCLA, insGetPubkey, p1NonConfirm, 0, 17,
+				4,
+				0x80, 0, 0, 44,
+				0x80, 0, 0x01, 0xf5,
+				0x80, 0, 0, 1,
+				0x80, 0, 0, 0,
+			}, apdu)
+			return append(pubkey.Bytes(), 0x90, 0x00)
+		},
+	}
+	got, err := NewWallet(device).GetPublicKey(NewDerivationPath(1, 0), false)
+	assert.Nil(t, err)
+	assert.Equal(t, pubkey, got)
+}
+
+func TestGetAppConfiguration(t *testing.T) {
+	device := &fakeDevice{
+		t: t,
+		handler: func(apdu []byte) []byte {
+			assert.Equal(t, []byte{apduCLA, insGetAppConfiguration, 0, 0, 0}, apdu)
+			return []byte{1, 0, 1, 3, 17, 0x90, 0x00}
+		},
+	}
+	got, err := NewWallet(device).GetAppConfiguration()
+	assert.Nil(t, err)
+	assert.Equal(t, AppConfiguration{BlindSigningEnabled: true, PubkeyDisplayMode: 0, Version: "1.3.17"}, got)
+}
+
+func TestStatusError(t *testing.T) {
+	device := &fakeDevice{
+		t: t,
+		handler: func(apdu []byte) []byte {
+			return []byte{0x69, 0x85}
+		},
+	}
+	_, err := NewWallet(device).GetPublicKey(DefaultDerivationPath, true)
+	assert.Equal(t, &StatusError{Code: 0x6985}, err)
+	assert.Equal(t, "ledger: user rejected the request", err.Error())
+}
+
+func TestSigner(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	assert.Nil(t, err)
+
+	// a message which needs 3 apdus
+	message := bytes.Repeat([]byte{1, 2, 3}, 200)
+
+	var received []byte
+	var p2s []byte
+	device := &fakeDevice{
+		t: t,
+		handler: func(apdu []byte) []byte {
+			switch apdu[1] {
+			case insGetPubkey:
+				return append(append([]byte{}, pub...), 0x90, 0x00)
+			case insSignMessage:
+				assert.Equal(t, byte(p1Confirm), apdu[2])
+				p2s = append(p2s, apdu[3])
+				data := apdu[5:]
+				assert.Equal(t, int(apdu[4]), len(data))
+				if apdu[3]&p2Extend == 0 {
+					// signer count + path
+					assert.Equal(t, []byte{1, 2, 0x80, 0, 0, 44, 0x80, 0, 0x01, 0xf5}, data[:10])
+					data = data[10:]
+				}
+				received = append(received, data...)
+				if apdu[3]&p2More != 0 {
+					return []byte{0x90, 0x00}
+				}
+				return append(ed25519.Sign(priv, received), 0x90, 0x00)
+			}
+			t.Fatalf("unexpected ins: %v", apdu[1])
+			return nil
+		},
+	}
+
+	signer, err := NewSigner(NewWallet(device), DefaultDerivationPath)
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromBytes(pub), signer.PublicKey())
+
+	sig, err := signer.Sign(message)
+	assert.Nil(t, err)
+	assert.Equal(t, message, received)
+	assert.Equal(t, []byte{p2More, p2Extend | p2More, p2Extend}, p2s)
+	assert.True(t, ed25519.Verify(pub, message, sig))
+}
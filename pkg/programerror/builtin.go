@@ -0,0 +1,99 @@
+package programerror
+
+var systemErrors = map[uint32]string{
+	0: "AccountAlreadyInUse",
+	1: "ResultWithNegativeLamports",
+	2: "InvalidProgramId",
+	3: "InvalidAccountDataLength",
+	4: "MaxSeedLengthExceeded",
+	5: "AddressWithSeedMismatch",
+	6: "NonceNoRecentBlockhashes",
+	7: "NonceBlockhashNotExpired",
+	8: "NonceUnexpectedBlockhashValue",
+}
+
+var stakeErrors = map[uint32]string{
+	0:  "NoCreditsToRedeem",
+	1:  "LockupInForce",
+	2:  "AlreadyDeactivated",
+	3:  "TooSoonToRedelegate",
+	4:  "InsufficientStake",
+	5:  "MergeTransientStake",
+	6:  "MergeMismatch",
+	7:  "CustodianMissing",
+	8:  "CustodianSignatureMissing",
+	9:  "InsufficientReferenceVotes",
+	10: "VoteAddressMismatch",
+	11: "MinimumDelinquentEpochsForDeactivationNotMet",
+	12: "InsufficientDelegation",
+	13: "RedelegateTransientOrInactiveStake",
+	14: "RedelegateToSameVoteAccount",
+	15: "RedelegatedStakeMustFullyActivateBeforeDeactivationIsPermitted",
+	16: "EpochRewardsActive",
+}
+
+var tokenErrors = map[uint32]string{
+	0:  "NotRentExempt",
+	1:  "InsufficientFunds",
+	2:  "InvalidMint",
+	3:  "MintMismatch",
+	4:  "OwnerMismatch",
+	5:  "FixedSupply",
+	6:  "AlreadyInUse",
+	7:  "InvalidNumberOfProvidedSigners",
+	8:  "InvalidNumberOfRequiredSigners",
+	9:  "UninitializedState",
+	10: "NativeNotSupported",
+	11: "NonNativeHasBalance",
+	12: "InvalidInstruction",
+	13: "InvalidState",
+	14: "Overflow",
+	15: "AuthorityTypeNotSupported",
+	16: "MintCannotFreeze",
+	17: "AccountFrozen",
+	18: "MintDecimalsMismatch",
+	19: "NonNativeNotSupported",
+}
+
+// token2022Errors are the errors of the token program followed by the ones of the extensions
+var token2022Errors = func() map[uint32]string {
+	errors := map[uint32]string{
+		20: "ExtensionTypeMismatch",
+		21: "ExtensionBaseMismatch",
+		22: "ExtensionAlreadyInitialized",
+		23: "ConfidentialTransferAccountHasBalance",
+		24: "ConfidentialTransferAccountNotApproved",
+		25: "ConfidentialTransferDepositsAndTransfersDisabled",
+		26: "ConfidentialTransferElGamalPubkeyMismatch",
+		27: "ConfidentialTransferBalanceMismatch",
+		28: "MintHasSupply",
+		29: "NoAuthorityExists",
+		30: "TransferFeeExceedsMaximum",
+		31: "MintRequiredForTransfer",
+		32: "FeeMismatch",
+		33: "FeeParametersMismatch",
+		34: "ImmutableOwner",
+		35: "AccountHasWithheldTransferFees",
+		36: "NoMemo",
+		37: "NonTransferable",
+		38: "NonTransferableNeedsImmutableOwnership",
+		39: "MaximumPendingBalanceCreditCounterExceeded",
+		40: "MaximumDepositAmountExceeded",
+		41: "CpiGuardSettingsLocked",
+		42: "CpiGuardTransferBlocked",
+		43: "CpiGuardBurnBlocked",
+		44: "CpiGuardCloseAccountBlocked",
+		45: "CpiGuardApproveBlocked",
+		46: "CpiGuardSetAuthorityBlocked",
+		47: "CpiGuardOwnerChangeBlocked",
+		48: "ExtensionNotFound",
+	}
+	for code, name := range tokenErrors {
+		errors[code] = name
+	}
+	return errors
+}()
+
+var associatedTokenAccountErrors = map[uint32]string{
+	0: "InvalidOwner",
+}
@@ -0,0 +1,119 @@
+// Package programerror names the custom program errors, e.g. `custom program error: 0x1` of the token program
+// is InsufficientFunds. the errors of the system, stake, token, token-2022 and associated token account programs
+// are registered, register the other programs with Register.
+//
+//	programerror.Register(programID, map[uint32]string{6000: "Unauthorized"})
+//	if err := programerror.FromLogs(simulation.Logs); err != nil { ... }
+package programerror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/programlog"
+)
+
+const customErrorPrefix = "custom program error: "
+
+// Error is a custom program error, compare it with errors.Is and an *Error of the same program and code
+type Error struct {
+	ProgramID common.PublicKey
+	Code      uint32
+	// Name is empty if the program or the code isn't registered
+	Name string
+}
+
+func (e *Error) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("program %v failed: custom program error: %#x", e.ProgramID, e.Code)
+	}
+	return fmt.Sprintf("program %v failed: %v (%#x)", e.ProgramID, e.Name, e.Code)
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.ProgramID == e.ProgramID && t.Code == e.Code
+}
+
+// Registry maps programs to the names of their error codes, it is safe for concurrent use
+type Registry struct {
+	mu       sync.RWMutex
+	programs map[common.PublicKey]map[uint32]string
+}
+
+// NewRegistry returns a registry with the errors of the builtin programs
+func NewRegistry() *Registry {
+	r := &Registry{programs: map[common.PublicKey]map[uint32]string{}}
+	r.Register(common.SystemProgramID, systemErrors)
+	r.Register(common.StakeProgramID, stakeErrors)
+	r.Register(common.TokenProgramID, tokenErrors)
+	r.Register(common.Token2022ProgramID, token2022Errors)
+	r.Register(common.SPLAssociatedTokenAccountProgramID, associatedTokenAccountErrors)
+	return r
+}
+
+// Register adds the names of the error codes of the program, a registered code is overwritten
+func (r *Registry) Register(programID common.PublicKey, errors map[uint32]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names, ok := r.programs[programID]
+	if !ok {
+		names = make(map[uint32]string, len(errors))
+		r.programs[programID] = names
+	}
+	for code, name := range errors {
+		names[code] = name
+	}
+}
+
+// Decode returns the error of the code, the name is empty if it isn't registered
+func (r *Registry) Decode(programID common.PublicKey, code uint32) *Error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return &Error{
+		ProgramID: programID,
+		Code:      code,
+		Name:      r.programs[programID][code],
+	}
+}
+
+// FromLogs returns the custom program error which fails the transaction as an *Error, the program is the one
+// which returns the error even if it is invoked by another program. nil returns if the transaction
+// doesn't fail by a custom program error or the logs can't be parsed. it returns an error rather than an *Error,
+// so a nil result compares equal to nil once it is stored as an error.
+func (r *Registry) FromLogs(logs []string) error {
+	invocations, err := programlog.Parse(logs)
+	if err != nil {
+		return nil
+	}
+	failed := programlog.FindFailed(invocations)
+	if failed == nil || !strings.HasPrefix(failed.Err, customErrorPrefix) {
+		return nil
+	}
+	code, err := strconv.ParseUint(strings.TrimPrefix(failed.Err, customErrorPrefix), 0, 32)
+	if err != nil {
+		return nil
+	}
+	return r.Decode(failed.ProgramID, uint32(code))
+}
+
+// DefaultRegistry is the registry used by the package level functions
+var DefaultRegistry = NewRegistry()
+
+// Register adds the names of the error codes of the program to the DefaultRegistry
+func Register(programID common.PublicKey, errors map[uint32]string) {
+	DefaultRegistry.Register(programID, errors)
+}
+
+// Decode returns the error of the code with the DefaultRegistry
+func Decode(programID common.PublicKey, code uint32) *Error {
+	return DefaultRegistry.Decode(programID, code)
+}
+
+// FromLogs returns the custom program error which fails the transaction with the DefaultRegistry, see Registry.FromLogs
+func FromLogs(logs []string) error {
+	return DefaultRegistry.FromLogs(logs)
+}
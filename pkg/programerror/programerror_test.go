@@ -0,0 +1,79 @@
+package programerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_Decode(t *testing.T) {
+	program := common.PublicKeyFromString("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS")
+	r := NewRegistry()
+
+	assert.Equal(t, &Error{ProgramID: common.TokenProgramID, Code: 1, Name: "InsufficientFunds"}, r.Decode(common.TokenProgramID, 1))
+	assert.Equal(t, "InsufficientFunds", r.Decode(common.Token2022ProgramID, 1).Name)
+	assert.Equal(t, "ImmutableOwner", r.Decode(common.Token2022ProgramID, 34).Name)
+	assert.Equal(t, "", r.Decode(common.TokenProgramID, 34).Name)
+	assert.Equal(t, "", r.Decode(program, 6000).Name)
+
+	r.Register(program, map[uint32]string{6000: "Unauthorized"})
+	assert.Equal(t, "Unauthorized", r.Decode(program, 6000).Name)
+	// the default registry is untouched
+	assert.Equal(t, "", Decode(program, 6000).Name)
+}
+
+func TestError(t *testing.T) {
+	err := fmt.Errorf("failed to send, err: %w", Decode(common.SystemProgramID, 1))
+	assert.True(t, errors.Is(err, &Error{ProgramID: common.SystemProgramID, Code: 1}))
+	assert.False(t, errors.Is(err, &Error{ProgramID: common.TokenProgramID, Code: 1}))
+
+	var programErr *Error
+	assert.True(t, errors.As(err, &programErr))
+	assert.Equal(t, "program 11111111111111111111111111111111 failed: ResultWithNegativeLamports (0x1)", programErr.Error())
+	assert.Equal(t, "program 11111111111111111111111111111111 failed: custom program error: 0x64", Decode(common.SystemProgramID, 100).Error())
+}
+
+func TestFromLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		logs     []string
+		expected error
+	}{
+		{
+			name: "cpi",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+				"Program log: Error: insufficient funds",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA failed: custom program error: 0x1",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS failed: custom program error: 0x1",
+			},
+			expected: &Error{ProgramID: common.TokenProgramID, Code: 1, Name: "InsufficientFunds"},
+		},
+		{
+			name: "not a custom error",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS failed: invalid account data for instruction",
+			},
+		},
+		{
+			name: "success",
+			logs: []string{
+				"Program 11111111111111111111111111111111 invoke [1]",
+				"Program 11111111111111111111111111111111 success",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromLogs(tt.logs)
+			assert.Equal(t, tt.expected, err)
+			// not a typed nil
+			assert.Equal(t, tt.expected == nil, err == nil)
+		})
+	}
+}
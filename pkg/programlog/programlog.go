@@ -0,0 +1,199 @@
+// Package programlog parses the log messages of a transaction into the tree of the program invocations.
+// it is the log parser of the sdk, e.g. pkg/anchor and pkg/programerror build on it.
+//
+//	invocations, err := programlog.Parse(tx.Meta.LogMessages)
+//	failed := programlog.FindFailed(invocations)
+package programlog
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	programPrefix       = "Program "
+	programLogPrefix    = "Program log: "
+	programDataPrefix   = "Program data: "
+	programReturnPrefix = "Program return: "
+	logTruncated        = "Log truncated"
+)
+
+type Status uint8
+
+const (
+	// StatusUnknown means the logs end before the invocation does, e.g. they are truncated
+	StatusUnknown Status = iota
+	StatusSuccess
+	StatusFailed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusSuccess:
+		return "success"
+	case StatusFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Invocation is a program invoked by a transaction or by another program
+type Invocation struct {
+	ProgramID common.PublicKey
+	// Depth is 1 for the instructions of the transaction and goes up by one per cpi
+	Depth  int
+	Status Status
+	// Err is the reason of a failed invocation, e.g. "custom program error: 0x1"
+	Err string
+	// Logs are the messages of `msg!` without the "Program log: " prefix,
+	// and the other lines of the invocation, e.g. the ones of the native programs, as they are
+	Logs []string
+	// Data are the `Program data:` lines, a line carries every slice passed to `sol_log_data`
+	Data [][][]byte
+	// ReturnData is the data set by `set_return_data`, nil if it is not set
+	ReturnData []byte
+	// ComputeUnitsConsumed and ComputeUnitsLimit are 0 if the node doesn't log them
+	ComputeUnitsConsumed uint64
+	ComputeUnitsLimit    uint64
+	// Invocations are the programs it invokes, in order
+	Invocations []*Invocation
+}
+
+// Parse returns the invocations of the instructions of a transaction, in order.
+// the logs after "Log truncated" are dropped and the invocations still open are StatusUnknown.
+func Parse(logs []string) ([]*Invocation, error) {
+	var roots []*Invocation
+	var stack []*Invocation
+	for i, log := range logs {
+		if log == logTruncated {
+			break
+		}
+
+		var current *Invocation
+		if len(stack) > 0 {
+			current = stack[len(stack)-1]
+		}
+
+		switch {
+		case strings.HasPrefix(log, programLogPrefix):
+			if current != nil {
+				current.Logs = append(current.Logs, strings.TrimPrefix(log, programLogPrefix))
+			}
+			continue
+		case strings.HasPrefix(log, programDataPrefix):
+			if current == nil {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(log, programDataPrefix))
+			data := make([][]byte, 0, len(fields))
+			for _, field := range fields {
+				b, err := base64.StdEncoding.DecodeString(field)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode program data, line: %v, err: %v", i, err)
+				}
+				data = append(data, b)
+			}
+			current.Data = append(current.Data, data)
+			continue
+		case strings.HasPrefix(log, programReturnPrefix):
+			// Program return: <id> <base64>
+			fields := strings.Fields(strings.TrimPrefix(log, programReturnPrefix))
+			if current == nil || len(fields) == 0 {
+				continue
+			}
+			data := []byte{}
+			if len(fields) > 1 {
+				b, err := base64.StdEncoding.DecodeString(fields[1])
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode return data, line: %v, err: %v", i, err)
+				}
+				data = b
+			}
+			current.ReturnData = data
+			continue
+		}
+
+		// Program <id> invoke [n]
+		// Program <id> consumed <n> of <m> compute units
+		// Program <id> success
+		// Program <id> failed: <err>
+		fields := strings.Fields(strings.TrimPrefix(log, programPrefix))
+		if !strings.HasPrefix(log, programPrefix) || len(fields) < 2 || !isPublicKey(fields[0]) {
+			if current != nil {
+				current.Logs = append(current.Logs, log)
+			}
+			continue
+		}
+		programID := common.PublicKeyFromString(fields[0])
+
+		switch {
+		case fields[1] == "invoke" && len(fields) == 3:
+			depth, err := strconv.Atoi(strings.Trim(fields[2], "[]"))
+			if err != nil || depth != len(stack)+1 {
+				return nil, fmt.Errorf("unexpected invoke, line: %v, log: %v", i, log)
+			}
+			invocation := &Invocation{ProgramID: programID, Depth: depth}
+			if current == nil {
+				roots = append(roots, invocation)
+			} else {
+				current.Invocations = append(current.Invocations, invocation)
+			}
+			stack = append(stack, invocation)
+		case fields[1] == "consumed" && len(fields) == 7:
+			if current == nil || current.ProgramID != programID {
+				return nil, fmt.Errorf("unexpected compute units, line: %v, log: %v", i, log)
+			}
+			consumed, err := strconv.ParseUint(fields[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse compute units, line: %v, err: %v", i, err)
+			}
+			limit, err := strconv.ParseUint(fields[4], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse compute units, line: %v, err: %v", i, err)
+			}
+			current.ComputeUnitsConsumed = consumed
+			current.ComputeUnitsLimit = limit
+		case fields[1] == "success" || fields[1] == "failed:":
+			if current == nil || current.ProgramID != programID {
+				return nil, fmt.Errorf("unexpected result, line: %v, log: %v", i, log)
+			}
+			if fields[1] == "success" {
+				current.Status = StatusSuccess
+			} else {
+				current.Status = StatusFailed
+				current.Err = strings.TrimPrefix(log, fmt.Sprintf("%v%v failed: ", programPrefix, fields[0]))
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if current != nil {
+				current.Logs = append(current.Logs, log)
+			}
+		}
+	}
+	return roots, nil
+}
+
+// FindFailed returns the deepest failed invocation, it is the one which fails the transaction.
+// nil returns if every invocation succeeds.
+func FindFailed(invocations []*Invocation) *Invocation {
+	for _, invocation := range invocations {
+		if invocation.Status != StatusFailed {
+			continue
+		}
+		if inner := FindFailed(invocation.Invocations); inner != nil {
+			return inner
+		}
+		return invocation
+	}
+	return nil
+}
+
+func isPublicKey(s string) bool {
+	b, err := base58.Decode(s)
+	return err == nil && len(b) == common.PublicKeyLength
+}
@@ -0,0 +1,171 @@
+package programlog
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	program := common.PublicKeyFromString("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS")
+
+	tests := []struct {
+		name     string
+		logs     []string
+		expected []*Invocation
+		err      bool
+	}{
+		{
+			name: "cpi",
+			logs: []string{
+				"Program ComputeBudget111111111111111111111111111111 invoke [1]",
+				"Program ComputeBudget111111111111111111111111111111 success",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program log: Instruction: Transfer",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+				"Program log: Instruction: TransferChecked",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA consumed 6172 of 193000 compute units",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA success",
+				"Program data: AQID BAU=",
+				"Program return: Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS Bgc=",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS consumed 12000 of 199850 compute units",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS success",
+			},
+			expected: []*Invocation{
+				{
+					ProgramID: common.ComputeBudgetProgramID,
+					Depth:     1,
+					Status:    StatusSuccess,
+				},
+				{
+					ProgramID:            program,
+					Depth:                1,
+					Status:               StatusSuccess,
+					Logs:                 []string{"Instruction: Transfer"},
+					Data:                 [][][]byte{{{1, 2, 3}, {4, 5}}},
+					ReturnData:           []byte{6, 7},
+					ComputeUnitsConsumed: 12000,
+					ComputeUnitsLimit:    199850,
+					Invocations: []*Invocation{
+						{
+							ProgramID:            common.TokenProgramID,
+							Depth:                2,
+							Status:               StatusSuccess,
+							Logs:                 []string{"Instruction: TransferChecked"},
+							ComputeUnitsConsumed: 6172,
+							ComputeUnitsLimit:    193000,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failed",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program 11111111111111111111111111111111 invoke [2]",
+				"Transfer: insufficient lamports 10, need 100",
+				"Program 11111111111111111111111111111111 failed: custom program error: 0x1",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS consumed 3000 of 200000 compute units",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS failed: custom program error: 0x1",
+			},
+			expected: []*Invocation{
+				{
+					ProgramID:            program,
+					Depth:                1,
+					Status:               StatusFailed,
+					Err:                  "custom program error: 0x1",
+					ComputeUnitsConsumed: 3000,
+					ComputeUnitsLimit:    200000,
+					Invocations: []*Invocation{
+						{
+							ProgramID: common.SystemProgramID,
+							Depth:     2,
+							Status:    StatusFailed,
+							Err:       "custom program error: 0x1",
+							Logs:      []string{"Transfer: insufficient lamports 10, need 100"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "truncated",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program log: a",
+				"Log truncated",
+			},
+			expected: []*Invocation{
+				{
+					ProgramID: program,
+					Depth:     1,
+					Status:    StatusUnknown,
+					Logs:      []string{"a"},
+				},
+			},
+		},
+		{
+			name: "lines like the ones of an invocation",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program log: x invoke [2]",
+				"Program log: success",
+				"Program is not deployed",
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS success",
+			},
+			expected: []*Invocation{
+				{
+					ProgramID: program,
+					Depth:     1,
+					Status:    StatusSuccess,
+					Logs:      []string{"x invoke [2]", "success", "Program is not deployed"},
+				},
+			},
+		},
+		{
+			name: "unexpected depth",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [2]",
+			},
+			err: true,
+		},
+		{
+			name: "result of another program",
+			logs: []string{
+				"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+				"Program 11111111111111111111111111111111 success",
+			},
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.logs)
+			if tt.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestFindFailed(t *testing.T) {
+	invocations, err := Parse([]string{
+		"Program ComputeBudget111111111111111111111111111111 invoke [1]",
+		"Program ComputeBudget111111111111111111111111111111 success",
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS invoke [1]",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA success",
+		"Program 11111111111111111111111111111111 invoke [2]",
+		"Program 11111111111111111111111111111111 failed: custom program error: 0x1",
+		"Program Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS failed: custom program error: 0x1",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, invocations[1].Invocations[1], FindFailed(invocations))
+
+	assert.Nil(t, FindFailed(invocations[:1]))
+}
@@ -0,0 +1,129 @@
+// Package rpcmetrics records prometheus metrics of the rpc calls of a client.
+//
+//	m := rpcmetrics.New(rpcmetrics.Config{})
+//	prometheus.MustRegister(m)
+//	c := client.New(append(m.Options(), rpc.WithEndpoint(rpc.MainnetRPCEndpoint))...)
+package rpcmetrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	defaultNamespace = "solana"
+	defaultSubsystem = "rpc"
+
+	// batchMethod is the method label of the http requests of a batch
+	batchMethod = "batch"
+)
+
+type Config struct {
+	// Namespace defaults to "solana"
+	Namespace string
+	// Subsystem defaults to "rpc"
+	Subsystem string
+	// Buckets of the latency histogram in seconds, it defaults to prometheus.DefBuckets
+	Buckets []float64
+	// ConstLabels are added to every metric, e.g. the name of the rpc provider
+	ConstLabels prometheus.Labels
+}
+
+// Metrics is a prometheus.Collector which records the calls of the clients built with its Options.
+// all metrics are labeled with the rpc method.
+type Metrics struct {
+	requests    *prometheus.CounterVec
+	errors      *prometheus.CounterVec
+	latency     *prometheus.HistogramVec
+	rateLimited *prometheus.CounterVec
+}
+
+func New(cfg Config) *Metrics {
+	if cfg.Namespace == "" {
+		cfg.Namespace = defaultNamespace
+	}
+	if cfg.Subsystem == "" {
+		cfg.Subsystem = defaultSubsystem
+	}
+	if len(cfg.Buckets) == 0 {
+		cfg.Buckets = prometheus.DefBuckets
+	}
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   cfg.Namespace,
+			Subsystem:   cfg.Subsystem,
+			Name:        "requests_total",
+			Help:        "Number of rpc calls.",
+			ConstLabels: cfg.ConstLabels,
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   cfg.Namespace,
+			Subsystem:   cfg.Subsystem,
+			Name:        "errors_total",
+			Help:        "Number of failed rpc calls, including the calls which the node returned an error object.",
+			ConstLabels: cfg.ConstLabels,
+		}, []string{"method"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   cfg.Namespace,
+			Subsystem:   cfg.Subsystem,
+			Name:        "request_duration_seconds",
+			Help:        "Latency of rpc calls, retries are included.",
+			Buckets:     cfg.Buckets,
+			ConstLabels: cfg.ConstLabels,
+		}, []string{"method"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   cfg.Namespace,
+			Subsystem:   cfg.Subsystem,
+			Name:        "rate_limited_total",
+			Help:        "Number of http requests rejected with status 429, every retry is counted.",
+			ConstLabels: cfg.ConstLabels,
+		}, []string{"method"}),
+	}
+}
+
+// Options returns the options which hook the metrics into a client
+func (m *Metrics) Options() []rpc.Option {
+	return []rpc.Option{
+		rpc.WithCallHook(rpc.CallHookFunc(m.afterCall)),
+		rpc.WithMiddleware(m.middleware),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.errors.Describe(ch)
+	m.latency.Describe(ch)
+	m.rateLimited.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.errors.Collect(ch)
+	m.latency.Collect(ch)
+	m.rateLimited.Collect(ch)
+}
+
+func (m *Metrics) afterCall(_ context.Context, info rpc.CallInfo) {
+	m.requests.WithLabelValues(info.Method).Inc()
+	m.latency.WithLabelValues(info.Method).Observe(info.Latency.Seconds())
+	if info.Err != nil {
+		m.errors.WithLabelValues(info.Method).Inc()
+	}
+}
+
+func (m *Metrics) middleware(next rpc.RequestHandler) rpc.RequestHandler {
+	return func(req *http.Request) (*http.Response, error) {
+		res, err := next(req)
+		if err == nil && res.StatusCode == http.StatusTooManyRequests {
+			method := rpc.MethodFromContext(req.Context())
+			if method == "" {
+				method = batchMethod
+			}
+			m.rateLimited.WithLabelValues(method).Inc()
+		}
+		return res, err
+	}
+}
@@ -0,0 +1,85 @@
+package rpcmetrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			rw.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":100,"id":1}`))
+		case 3:
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":1}`))
+		case 4:
+			rw.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	m := New(Config{ConstLabels: prometheus.Labels{"provider": "test"}})
+	registry := prometheus.NewPedanticRegistry()
+	assert.Nil(t, registry.Register(m))
+
+	c := rpc.New(append(m.Options(),
+		rpc.WithEndpoint(server.URL),
+		rpc.WithRetry(rpc.RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}),
+		rpc.WithMethodRetry("getBalance", rpc.RetryPolicy{}),
+	)...)
+
+	_, err := c.GetSlot(context.Background())
+	assert.Nil(t, err)
+	res, err := c.GetBalance(context.Background(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	assert.Nil(t, err)
+	assert.NotNil(t, res.Error)
+	_, err = c.CallBatch(context.Background(), []rpc.BatchRequest{rpc.NewBatchRequest("getSlot")})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP solana_rpc_requests_total Number of rpc calls.
+# TYPE solana_rpc_requests_total counter
+solana_rpc_requests_total{method="getBalance",provider="test"} 1
+solana_rpc_requests_total{method="getSlot",provider="test"} 2
+# HELP solana_rpc_errors_total Number of failed rpc calls, including the calls which the node returned an error object.
+# TYPE solana_rpc_errors_total counter
+solana_rpc_errors_total{method="getBalance",provider="test"} 1
+solana_rpc_errors_total{method="getSlot",provider="test"} 1
+# HELP solana_rpc_rate_limited_total Number of http requests rejected with status 429, every retry is counted.
+# TYPE solana_rpc_rate_limited_total counter
+solana_rpc_rate_limited_total{method="batch",provider="test"} 1
+solana_rpc_rate_limited_total{method="getSlot",provider="test"} 1
+`),
+		"solana_rpc_requests_total",
+		"solana_rpc_errors_total",
+		"solana_rpc_rate_limited_total",
+	))
+	assert.Equal(t, 2, testutil.CollectAndCount(m, "solana_rpc_request_duration_seconds"))
+}
+
+func TestNew(t *testing.T) {
+	m := New(Config{Namespace: "app", Subsystem: "solana", Buckets: []float64{0.1, 1}})
+	m.latency.WithLabelValues("getSlot").Observe(0.5)
+	assert.Nil(t, testutil.CollectAndCompare(m, strings.NewReader(`
+# HELP app_solana_request_duration_seconds Latency of rpc calls, retries are included.
+# TYPE app_solana_request_duration_seconds histogram
+app_solana_request_duration_seconds_bucket{method="getSlot",le="0.1"} 0
+app_solana_request_duration_seconds_bucket{method="getSlot",le="1"} 1
+app_solana_request_duration_seconds_bucket{method="getSlot",le="+Inf"} 1
+app_solana_request_duration_seconds_sum{method="getSlot"} 0.5
+app_solana_request_duration_seconds_count{method="getSlot"} 1
+`), "app_solana_request_duration_seconds"))
+}
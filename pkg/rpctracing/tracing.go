@@ -0,0 +1,232 @@
+// Package rpctracing records an opentelemetry span of every http request of a client.
+//
+//	t := rpctracing.New(rpctracing.Config{})
+//	c := client.New(append(t.Options(), rpc.WithEndpoint(rpc.MainnetRPCEndpoint))...)
+//
+// the span is a child of the span in the ctx which is passed to the call, it ends when the body of the response
+// is closed. a request retried by the retry policy has a span per attempt.
+package rpctracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/liangjies/solana-go-sdk/pkg/rpctracing"
+
+	// batchSpanName is the span name of a batch request
+	batchSpanName = "batch"
+
+	// maxRecordedBodySize bounds the head of a response which is decoded for the attributes of a span,
+	// the error object and the context come before the value in the responses of the nodes
+	maxRecordedBodySize = 4 << 10
+)
+
+// the attributes of a span
+const (
+	AttributeRpcSystem         = attribute.Key("rpc.system")
+	AttributeRpcMethod         = attribute.Key("rpc.method")
+	AttributeServerAddress     = attribute.Key("server.address")
+	AttributeHttpStatusCode    = attribute.Key("http.status_code")
+	AttributeJsonRpcVersion    = attribute.Key("rpc.jsonrpc.version")
+	AttributeJsonRpcErrCode    = attribute.Key("rpc.jsonrpc.error_code")
+	AttributeJsonRpcErrMsg     = attribute.Key("rpc.jsonrpc.error_message")
+	AttributeSolanaContextSlot = attribute.Key("solana.context.slot")
+)
+
+type Config struct {
+	// TracerProvider defaults to otel.GetTracerProvider()
+	TracerProvider trace.TracerProvider
+	// Propagator injects the trace context into the request headers, nothing is injected if it is nil.
+	// it only makes sense if the rpc node or a proxy in front of it is traced.
+	Propagator propagation.TextMapPropagator
+}
+
+// Tracer records the requests of the clients built with its Options
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func New(cfg Config) *Tracer {
+	if cfg.TracerProvider == nil {
+		cfg.TracerProvider = otel.GetTracerProvider()
+	}
+	return &Tracer{
+		tracer:     cfg.TracerProvider.Tracer(instrumentationName),
+		propagator: cfg.Propagator,
+	}
+}
+
+// Options returns the options which hook the tracer into a client
+func (t *Tracer) Options() []rpc.Option {
+	return []rpc.Option{
+		rpc.WithMiddleware(t.middleware),
+	}
+}
+
+func (t *Tracer) middleware(next rpc.RequestHandler) rpc.RequestHandler {
+	return func(req *http.Request) (*http.Response, error) {
+		method := rpc.MethodFromContext(req.Context())
+		name := method
+		if name == "" {
+			name = batchSpanName
+		}
+		ctx, span := t.tracer.Start(
+			req.Context(),
+			name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				AttributeRpcSystem.String("jsonrpc"),
+				AttributeJsonRpcVersion.String("2.0"),
+				// only the host is recorded, the path and the query of an endpoint may contain an api key
+				AttributeServerAddress.String(req.URL.Host),
+			),
+		)
+		if method != "" {
+			span.SetAttributes(AttributeRpcMethod.String(method))
+		}
+		req = req.WithContext(ctx)
+		if t.propagator != nil {
+			t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+		}
+		res, err := next(req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			span.End()
+			return res, err
+		}
+		span.SetAttributes(AttributeHttpStatusCode.Int(res.StatusCode))
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			span.SetStatus(codes.Error, fmt.Sprintf("get status code: %v", res.StatusCode))
+			span.End()
+			return res, nil
+		}
+		// the head of the body is recorded while the client reads it, so the body is neither read twice nor copied
+		res.Body = &tracedBody{ReadCloser: res.Body, span: span}
+		return res, nil
+	}
+}
+
+// tracedBody keeps the head of the body which is read and ends the span when it is closed
+type tracedBody struct {
+	io.ReadCloser
+	span trace.Span
+
+	head    []byte
+	readErr error
+	once    sync.Once
+}
+
+func (b *tracedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if room := maxRecordedBodySize - len(b.head); room > 0 {
+		if room > n {
+			room = n
+		}
+		b.head = append(b.head, p[:room]...)
+	}
+	if err != nil && err != io.EOF {
+		b.readErr = err
+	}
+	return n, err
+}
+
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		if b.readErr != nil {
+			b.span.RecordError(b.readErr)
+			b.span.SetStatus(codes.Error, b.readErr.Error())
+		} else {
+			recordResponse(b.span, b.head)
+		}
+		b.span.End()
+	})
+	return err
+}
+
+// recordResponse records the error object or the context slot of a single call, a batch response is skipped.
+// head is decoded as a stream and the decoding stops at the first field it can't read, e.g. a value which is cut.
+func recordResponse(span trace.Span, head []byte) {
+	d := json.NewDecoder(bytes.NewReader(head))
+	decodeObject(d, func(key string) bool {
+		switch key {
+		case "error":
+			var attrs []attribute.KeyValue
+			var message string
+			decodeObject(d, func(key string) bool {
+				switch key {
+				case "code":
+					var code int
+					if d.Decode(&code) != nil {
+						return false
+					}
+					attrs = append(attrs, AttributeJsonRpcErrCode.Int(code))
+					return true
+				case "message":
+					if d.Decode(&message) != nil {
+						return false
+					}
+					attrs = append(attrs, AttributeJsonRpcErrMsg.String(message))
+					return true
+				}
+				return skipValue(d)
+			})
+			span.SetAttributes(attrs...)
+			span.SetStatus(codes.Error, message)
+		case "result":
+			decodeObject(d, func(key string) bool {
+				if key != "context" {
+					return skipValue(d)
+				}
+				var context struct {
+					Slot *uint64 `json:"slot"`
+				}
+				if d.Decode(&context) == nil && context.Slot != nil {
+					span.SetAttributes(AttributeSolanaContextSlot.Int64(int64(*context.Slot)))
+				}
+				return false
+			})
+		default:
+			return skipValue(d)
+		}
+		return false
+	})
+}
+
+// decodeObject calls field with the key of every field of the object at d, field decodes or skips the value.
+// it stops if the value isn't an object, at the first error or when field returns false.
+func decodeObject(d *json.Decoder, field func(key string) bool) {
+	if tok, err := d.Token(); err != nil || tok != json.Delim('{') {
+		return
+	}
+	for d.More() {
+		tok, err := d.Token()
+		if err != nil {
+			return
+		}
+		key, _ := tok.(string)
+		if !field(key) {
+			return
+		}
+	}
+}
+
+func skipValue(d *json.Decoder) bool {
+	var v json.RawMessage
+	return d.Decode(&v) == nil
+}
@@ -0,0 +1,188 @@
+package rpctracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	var calls int32
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		traceparents = append(traceparents, req.Header.Get("traceparent"))
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			rw.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{"context":{"slot":100},"value":5},"id":1}`))
+		case 3:
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":1}`))
+		case 4:
+			_, _ = rw.Write([]byte(`[{"jsonrpc":"2.0","result":100,"id":1}]`))
+		}
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	parentCtx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+	tracer := New(Config{TracerProvider: provider, Propagator: propagation.TraceContext{}})
+	c := rpc.New(append(tracer.Options(),
+		rpc.WithEndpoint(server.URL+"/api-key"),
+		rpc.WithRetry(rpc.RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}),
+	)...)
+
+	res, err := c.GetBalance(parentCtx, "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), res.Result.Value)
+	_, err = c.GetSlot(parentCtx)
+	assert.Nil(t, err)
+	_, err = c.CallBatch(parentCtx, []rpc.BatchRequest{rpc.NewBatchRequest("getSlot")})
+	assert.Nil(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 5)
+	spans = spans[:4]
+
+	type span struct {
+		Name       string
+		Status     codes.Code
+		Attributes []attribute.KeyValue
+	}
+	got := make([]span, 0, len(spans))
+	for i, s := range spans {
+		assert.Equal(t, parent.SpanContext().SpanID(), s.Parent().SpanID())
+		assert.Contains(t, traceparents[i], s.SpanContext().SpanID().String())
+		got = append(got, span{Name: s.Name(), Status: s.Status().Code, Attributes: s.Attributes()})
+	}
+	base := []attribute.KeyValue{
+		AttributeRpcSystem.String("jsonrpc"),
+		AttributeJsonRpcVersion.String("2.0"),
+		AttributeServerAddress.String(u.Host),
+	}
+	with := func(kvs ...attribute.KeyValue) []attribute.KeyValue {
+		return append(append([]attribute.KeyValue{}, base...), kvs...)
+	}
+	assert.Equal(t, []span{
+		{
+			Name:       "getBalance",
+			Status:     codes.Error,
+			Attributes: with(AttributeRpcMethod.String("getBalance"), AttributeHttpStatusCode.Int(429)),
+		},
+		{
+			Name:       "getBalance",
+			Status:     codes.Unset,
+			Attributes: with(AttributeRpcMethod.String("getBalance"), AttributeHttpStatusCode.Int(200), AttributeSolanaContextSlot.Int64(100)),
+		},
+		{
+			Name:   "getSlot",
+			Status: codes.Error,
+			Attributes: with(
+				AttributeRpcMethod.String("getSlot"),
+				AttributeHttpStatusCode.Int(200),
+				AttributeJsonRpcErrCode.Int(-32602),
+				AttributeJsonRpcErrMsg.String("Invalid params"),
+			),
+		},
+		{
+			Name:       "batch",
+			Status:     codes.Unset,
+			Attributes: with(AttributeHttpStatusCode.Int(200)),
+		},
+	}, got)
+}
+
+func TestTracer_Response(t *testing.T) {
+	largeValue := `"` + strings.Repeat("A", 2*maxRecordedBodySize) + `"`
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		expectedStatus     codes.Code
+		expectedAttributes []attribute.KeyValue
+	}{
+		{
+			name: "3xx",
+			handler: func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(http.StatusMultipleChoices)
+			},
+			expectedStatus:     codes.Error,
+			expectedAttributes: []attribute.KeyValue{AttributeHttpStatusCode.Int(300)},
+		},
+		{
+			name: "context before a large value",
+			handler: func(rw http.ResponseWriter, req *http.Request) {
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{"context":{"apiVersion":"2.0.0","slot":100},"value":` + largeValue + `},"id":1}`))
+			},
+			expectedStatus:     codes.Unset,
+			expectedAttributes: []attribute.KeyValue{AttributeHttpStatusCode.Int(200), AttributeSolanaContextSlot.Int64(100)},
+		},
+		{
+			name: "context after a large value",
+			handler: func(rw http.ResponseWriter, req *http.Request) {
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{"value":` + largeValue + `,"context":{"slot":100}},"id":1}`))
+			},
+			expectedStatus:     codes.Unset,
+			expectedAttributes: []attribute.KeyValue{AttributeHttpStatusCode.Int(200)},
+		},
+		{
+			name: "error with large data",
+			handler: func(rw http.ResponseWriter, req *http.Request) {
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32002,"message":"Transaction simulation failed","data":{"logs":[` + largeValue + `]}},"id":1}`))
+			},
+			expectedStatus: codes.Error,
+			expectedAttributes: []attribute.KeyValue{
+				AttributeHttpStatusCode.Int(200),
+				AttributeJsonRpcErrCode.Int(-32002),
+				AttributeJsonRpcErrMsg.String("Transaction simulation failed"),
+			},
+		},
+		{
+			name: "body is cut",
+			handler: func(rw http.ResponseWriter, req *http.Request) {
+				rw.Header().Set("Content-Length", "100")
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0",`))
+			},
+			expectedStatus:     codes.Error,
+			expectedAttributes: []attribute.KeyValue{AttributeHttpStatusCode.Int(200)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+			u, _ := url.Parse(server.URL)
+
+			recorder := tracetest.NewSpanRecorder()
+			tracer := New(Config{TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))})
+			c := rpc.New(append(tracer.Options(), rpc.WithEndpoint(server.URL))...)
+			_, _ = c.GetBalance(context.Background(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+
+			spans := recorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, tt.expectedStatus, spans[0].Status().Code)
+			assert.Equal(t, append([]attribute.KeyValue{
+				AttributeRpcSystem.String("jsonrpc"),
+				AttributeJsonRpcVersion.String("2.0"),
+				AttributeServerAddress.String(u.Host),
+				AttributeRpcMethod.String("getBalance"),
+			}, tt.expectedAttributes...), spans[0].Attributes())
+		})
+	}
+}
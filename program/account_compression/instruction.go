@@ -0,0 +1,126 @@
+package account_compression
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+// Instruction is the anchor discriminator of an instruction
+type Instruction [8]uint8
+
+var (
+	InstructionInitEmptyMerkleTree = Instruction{191, 11, 119, 7, 180, 107, 220, 110}
+	InstructionReplaceLeaf         = Instruction{204, 165, 76, 100, 73, 147, 0, 128}
+	InstructionAppend              = Instruction{149, 120, 18, 222, 236, 225, 88, 203}
+)
+
+type InitEmptyMerkleTreeParam struct {
+	// MerkleTree is an account owned by the program, allocated with GetMerkleTreeAccountSize bytes
+	MerkleTree    common.PublicKey
+	Authority     common.PublicKey
+	MaxDepth      uint32
+	MaxBufferSize uint32
+}
+
+func InitEmptyMerkleTree(param InitEmptyMerkleTreeParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction   Instruction
+		MaxDepth      uint32
+		MaxBufferSize uint32
+	}{
+		Instruction:   InstructionInitEmptyMerkleTree,
+		MaxDepth:      param.MaxDepth,
+		MaxBufferSize: param.MaxBufferSize,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLAccountCompressionProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type ReplaceLeafParam struct {
+	MerkleTree   common.PublicKey
+	Authority    common.PublicKey
+	Root         [32]uint8
+	PreviousLeaf [32]uint8
+	NewLeaf      [32]uint8
+	Index        uint32
+	// Proof are the nodes from the leaf to the root, the ones kept in the canopy can be left out
+	Proof []common.PublicKey
+}
+
+// ReplaceLeaf replaces the leaf at index, root is a recent root the proof is valid for
+func ReplaceLeaf(param ReplaceLeafParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction  Instruction
+		Root         [32]uint8
+		PreviousLeaf [32]uint8
+		NewLeaf      [32]uint8
+		Index        uint32
+	}{
+		Instruction:  InstructionReplaceLeaf,
+		Root:         param.Root,
+		PreviousLeaf: param.PreviousLeaf,
+		NewLeaf:      param.NewLeaf,
+		Index:        param.Index,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 3+len(param.Proof))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		types.AccountMeta{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+	)
+	for _, node := range param.Proof {
+		accounts = append(accounts, types.AccountMeta{PubKey: node, IsSigner: false, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLAccountCompressionProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type AppendParam struct {
+	MerkleTree common.PublicKey
+	Authority  common.PublicKey
+	Leaf       [32]uint8
+}
+
+// Append appends the leaf after the rightmost leaf, no proof is needed
+func Append(param AppendParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        [32]uint8
+	}{
+		Instruction: InstructionAppend,
+		Leaf:        param.Leaf,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLAccountCompressionProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
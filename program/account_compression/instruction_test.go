@@ -0,0 +1,73 @@
+package account_compression
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstructionDiscriminator(t *testing.T) {
+	assert.Equal(t, anchor.Discriminator(InstructionInitEmptyMerkleTree[:]), anchor.InstructionDiscriminator("init_empty_merkle_tree"))
+	assert.Equal(t, anchor.Discriminator(InstructionReplaceLeaf[:]), anchor.InstructionDiscriminator("replace_leaf"))
+	assert.Equal(t, anchor.Discriminator(InstructionAppend[:]), anchor.InstructionDiscriminator("append"))
+}
+
+func TestInitEmptyMerkleTree(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLAccountCompressionProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: merkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{191, 11, 119, 7, 180, 107, 220, 110, 14, 0, 0, 0, 64, 0, 0, 0},
+	}, InitEmptyMerkleTree(InitEmptyMerkleTreeParam{
+		MerkleTree:    merkleTree,
+		Authority:     authority,
+		MaxDepth:      14,
+		MaxBufferSize: 64,
+	}))
+}
+
+func TestReplaceLeaf(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	node := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+
+	got := ReplaceLeaf(ReplaceLeafParam{
+		MerkleTree:   merkleTree,
+		Authority:    authority,
+		Root:         [32]uint8{1},
+		PreviousLeaf: [32]uint8{2},
+		NewLeaf:      [32]uint8{3},
+		Index:        4,
+		Proof:        []common.PublicKey{node},
+	})
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: merkleTree, IsSigner: false, IsWritable: true},
+		{PubKey: authority, IsSigner: true, IsWritable: false},
+		{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: node, IsSigner: false, IsWritable: false},
+	}, got.Accounts)
+	assert.Len(t, got.Data, 8+32*3+4)
+	assert.Equal(t, uint8(1), got.Data[8])
+	assert.Equal(t, uint8(2), got.Data[40])
+	assert.Equal(t, uint8(3), got.Data[72])
+	assert.Equal(t, []byte{4, 0, 0, 0}, got.Data[104:])
+}
+
+func TestAppend(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	got := Append(AppendParam{MerkleTree: merkleTree, Authority: authority, Leaf: [32]uint8{9}})
+	assert.Len(t, got.Accounts, 3)
+	assert.Equal(t, InstructionAppend[:], got.Data[:8])
+	assert.Equal(t, []byte{9}, got.Data[8:9])
+	assert.Len(t, got.Data, 40)
+}
@@ -0,0 +1,127 @@
+package account_compression
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// MerkleTreeHeaderSize is the size of the header before the tree
+const MerkleTreeHeaderSize = 56
+
+type CompressionAccountType uint8
+
+const (
+	CompressionAccountTypeUninitialized CompressionAccountType = iota
+	CompressionAccountTypeConcurrentMerkleTree
+)
+
+type MerkleTreeHeaderVersion uint8
+
+const (
+	MerkleTreeHeaderVersionV1 MerkleTreeHeaderVersion = iota
+)
+
+type MerkleTreeHeader struct {
+	AccountType   CompressionAccountType
+	Version       MerkleTreeHeaderVersion
+	MaxBufferSize uint32
+	MaxDepth      uint32
+	Authority     common.PublicKey
+	// CreationSlot is the slot the tree was initialized at, indexers replay the transactions from it
+	CreationSlot uint64
+}
+
+// ConcurrentMerkleTree is a concurrent merkle tree account, the change logs are left out
+type ConcurrentMerkleTree struct {
+	Header         MerkleTreeHeader
+	SequenceNumber uint64
+	ActiveIndex    uint64
+	BufferSize     uint64
+	// Root is the root of the latest change log
+	Root [32]uint8
+	// RightmostProof is the proof of the rightmost leaf, which appends use
+	RightmostProof [][32]uint8
+	RightmostLeaf  [32]uint8
+	// RightmostIndex is the number of leaves appended
+	RightmostIndex uint32
+	CanopyDepth    uint32
+}
+
+func changeLogSize(maxDepth uint64) uint64 {
+	// root, path nodes, index and padding
+	return 32 + 32*maxDepth + 4 + 4
+}
+
+func pathSize(maxDepth uint64) uint64 {
+	// proof, leaf, index and padding
+	return 32*maxDepth + 32 + 4 + 4
+}
+
+func merkleTreeSize(maxDepth, maxBufferSize uint64) uint64 {
+	return 8 + 8 + 8 + maxBufferSize*changeLogSize(maxDepth) + pathSize(maxDepth)
+}
+
+// GetMerkleTreeAccountSize returns the size of a tree account, the canopy keeps the top canopyDepth levels
+// of the tree on chain so the proofs are shorter.
+func GetMerkleTreeAccountSize(maxDepth, maxBufferSize, canopyDepth uint32) uint64 {
+	canopySize := uint64(0)
+	if canopyDepth > 0 {
+		canopySize = ((uint64(1) << (canopyDepth + 1)) - 2) * 32
+	}
+	return MerkleTreeHeaderSize + merkleTreeSize(uint64(maxDepth), uint64(maxBufferSize)) + canopySize
+}
+
+func DeserializeConcurrentMerkleTree(data []byte, accountOwner common.PublicKey) (ConcurrentMerkleTree, error) {
+	if accountOwner != common.SPLAccountCompressionProgramID {
+		return ConcurrentMerkleTree{}, ErrInvalidAccountOwner
+	}
+	if len(data) < MerkleTreeHeaderSize {
+		return ConcurrentMerkleTree{}, ErrInvalidAccountDataSize
+	}
+	if CompressionAccountType(data[0]) != CompressionAccountTypeConcurrentMerkleTree ||
+		MerkleTreeHeaderVersion(data[1]) != MerkleTreeHeaderVersionV1 {
+		return ConcurrentMerkleTree{}, ErrInvalidAccountData
+	}
+
+	header := MerkleTreeHeader{
+		AccountType:   CompressionAccountType(data[0]),
+		Version:       MerkleTreeHeaderVersion(data[1]),
+		MaxBufferSize: binary.LittleEndian.Uint32(data[2:6]),
+		MaxDepth:      binary.LittleEndian.Uint32(data[6:10]),
+		Authority:     common.PublicKeyFromBytes(data[10:42]),
+		CreationSlot:  binary.LittleEndian.Uint64(data[42:50]),
+	}
+	maxDepth := uint64(header.MaxDepth)
+	treeSize := merkleTreeSize(maxDepth, uint64(header.MaxBufferSize))
+	if uint64(len(data)) < MerkleTreeHeaderSize+treeSize {
+		return ConcurrentMerkleTree{}, ErrInvalidAccountDataSize
+	}
+
+	tree := data[MerkleTreeHeaderSize : MerkleTreeHeaderSize+treeSize]
+	merkleTree := ConcurrentMerkleTree{
+		Header:         header,
+		SequenceNumber: binary.LittleEndian.Uint64(tree[0:8]),
+		ActiveIndex:    binary.LittleEndian.Uint64(tree[8:16]),
+		BufferSize:     binary.LittleEndian.Uint64(tree[16:24]),
+	}
+	if merkleTree.ActiveIndex >= uint64(header.MaxBufferSize) {
+		return ConcurrentMerkleTree{}, ErrInvalidAccountData
+	}
+	changeLog := tree[24+merkleTree.ActiveIndex*changeLogSize(maxDepth):]
+	copy(merkleTree.Root[:], changeLog[:32])
+
+	path := tree[24+uint64(header.MaxBufferSize)*changeLogSize(maxDepth):]
+	merkleTree.RightmostProof = make([][32]uint8, maxDepth)
+	for i := uint64(0); i < maxDepth; i++ {
+		copy(merkleTree.RightmostProof[i][:], path[i*32:(i+1)*32])
+	}
+	copy(merkleTree.RightmostLeaf[:], path[maxDepth*32:maxDepth*32+32])
+	merkleTree.RightmostIndex = binary.LittleEndian.Uint32(path[maxDepth*32+32:])
+
+	// the canopy has 2^(depth+1)-2 nodes
+	canopyNodes := (uint64(len(data)) - MerkleTreeHeaderSize - treeSize) / 32
+	merkleTree.CanopyDepth = uint32(bits.Len64(canopyNodes+2)) - 2
+	return merkleTree, nil
+}
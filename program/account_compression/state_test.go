@@ -0,0 +1,67 @@
+package account_compression
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMerkleTreeAccountSize(t *testing.T) {
+	assert.Equal(t, uint64(31800), GetMerkleTreeAccountSize(14, 64, 0))
+	assert.Equal(t, uint64(31800+(1<<4-2)*32), GetMerkleTreeAccountSize(14, 64, 3))
+}
+
+func TestDeserializeConcurrentMerkleTree(t *testing.T) {
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	data := make([]byte, GetMerkleTreeAccountSize(3, 8, 2))
+	data[0] = uint8(CompressionAccountTypeConcurrentMerkleTree)
+	binary.LittleEndian.PutUint32(data[2:], 8)
+	binary.LittleEndian.PutUint32(data[6:], 3)
+	copy(data[10:], authority.Bytes())
+	binary.LittleEndian.PutUint64(data[42:], 100)
+
+	tree := data[MerkleTreeHeaderSize:]
+	binary.LittleEndian.PutUint64(tree[0:], 2)
+	binary.LittleEndian.PutUint64(tree[8:], 2)
+	binary.LittleEndian.PutUint64(tree[16:], 3)
+	// root of the change log at index 2, a change log takes 32+32*3+8 bytes
+	tree[24+2*136] = 7
+	path := tree[24+8*136:]
+	path[0], path[32], path[64] = 1, 2, 3
+	path[96] = 4
+	binary.LittleEndian.PutUint32(path[128:], 2)
+
+	got, err := DeserializeConcurrentMerkleTree(data, common.SPLAccountCompressionProgramID)
+	assert.NoError(t, err)
+	assert.Equal(t, ConcurrentMerkleTree{
+		Header: MerkleTreeHeader{
+			AccountType:   CompressionAccountTypeConcurrentMerkleTree,
+			Version:       MerkleTreeHeaderVersionV1,
+			MaxBufferSize: 8,
+			MaxDepth:      3,
+			Authority:     authority,
+			CreationSlot:  100,
+		},
+		SequenceNumber: 2,
+		ActiveIndex:    2,
+		BufferSize:     3,
+		Root:           [32]uint8{7},
+		RightmostProof: [][32]uint8{{1}, {2}, {3}},
+		RightmostLeaf:  [32]uint8{4},
+		RightmostIndex: 2,
+		CanopyDepth:    2,
+	}, got)
+
+	_, err = DeserializeConcurrentMerkleTree(data, common.SystemProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountOwner)
+
+	_, err = DeserializeConcurrentMerkleTree(data[:100], common.SPLAccountCompressionProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountDataSize)
+
+	data[0] = uint8(CompressionAccountTypeUninitialized)
+	_, err = DeserializeConcurrentMerkleTree(data, common.SPLAccountCompressionProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+}
@@ -0,0 +1,121 @@
+package account_compression
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// HashNodes returns the parent of two nodes, it is keccak256(left, right)
+func HashNodes(left, right [32]uint8) [32]uint8 {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(left[:])
+	h.Write(right[:])
+	var node [32]uint8
+	copy(node[:], h.Sum(nil))
+	return node
+}
+
+// EmptyNode returns the root of an empty subtree with the level, an empty leaf is 32 zero bytes
+func EmptyNode(level uint32) [32]uint8 {
+	var node [32]uint8
+	for i := uint32(0); i < level; i++ {
+		node = HashNodes(node, node)
+	}
+	return node
+}
+
+// ComputeRoot returns the root the proof of the leaf at index leads to
+func ComputeRoot(leaf [32]uint8, index uint32, proof [][32]uint8) [32]uint8 {
+	node := leaf
+	for i, sibling := range proof {
+		if (index>>i)&1 == 0 {
+			node = HashNodes(node, sibling)
+		} else {
+			node = HashNodes(sibling, node)
+		}
+	}
+	return node
+}
+
+// VerifyProof checks whether the leaf at index is in the tree with the root
+func VerifyProof(root, leaf [32]uint8, index uint32, proof [][32]uint8) bool {
+	computed := ComputeRoot(leaf, index, proof)
+	return bytes.Equal(computed[:], root[:])
+}
+
+// ProofAccounts returns the accounts of a full proof passed to an instruction. the top canopyDepth nodes
+// are stored in the tree account, so they are left out.
+func ProofAccounts(proof [][32]uint8, canopyDepth uint32) []types.AccountMeta {
+	n := len(proof) - int(canopyDepth)
+	if n < 0 {
+		n = 0
+	}
+	accounts := make([]types.AccountMeta, 0, n)
+	for _, node := range proof[:n] {
+		accounts = append(accounts, types.AccountMeta{PubKey: common.PublicKey(node), IsSigner: false, IsWritable: false})
+	}
+	return accounts
+}
+
+// MerkleTree is an off-chain merkle tree with the same hashing as a concurrent merkle tree,
+// it computes the root and the proofs of the leaves appended to an empty tree.
+type MerkleTree struct {
+	depth uint32
+	// layers keep the nodes from the leaves to the root, missing nodes are empty
+	layers [][][32]uint8
+	// empty are the empty nodes of the levels
+	empty [][32]uint8
+}
+
+func NewMerkleTree(depth uint32, leaves [][32]uint8) (*MerkleTree, error) {
+	if depth > 32 || uint64(len(leaves)) > uint64(1)<<depth {
+		return nil, fmt.Errorf("too many leaves for depth %v, leaves: %v", depth, len(leaves))
+	}
+	empty := make([][32]uint8, depth+1)
+	for level := uint32(1); level <= depth; level++ {
+		empty[level] = HashNodes(empty[level-1], empty[level-1])
+	}
+	layers := make([][][32]uint8, 0, depth+1)
+	layers = append(layers, append([][32]uint8{}, leaves...))
+	for level := uint32(0); level < depth; level++ {
+		nodes := layers[level]
+		parents := make([][32]uint8, 0, (len(nodes)+1)/2)
+		for i := 0; i < len(nodes); i += 2 {
+			right := empty[level]
+			if i+1 < len(nodes) {
+				right = nodes[i+1]
+			}
+			parents = append(parents, HashNodes(nodes[i], right))
+		}
+		layers = append(layers, parents)
+	}
+	return &MerkleTree{depth: depth, layers: layers, empty: empty}, nil
+}
+
+func (t *MerkleTree) Root() [32]uint8 {
+	if len(t.layers[t.depth]) == 0 {
+		return t.empty[t.depth]
+	}
+	return t.layers[t.depth][0]
+}
+
+// Proof returns the siblings from the leaf at index to the root
+func (t *MerkleTree) Proof(index uint32) ([][32]uint8, error) {
+	if uint64(index) >= uint64(1)<<t.depth {
+		return nil, fmt.Errorf("index out of range, index: %v", index)
+	}
+	proof := make([][32]uint8, 0, t.depth)
+	for level := uint32(0); level < t.depth; level++ {
+		sibling := int((index >> level) ^ 1)
+		if sibling < len(t.layers[level]) {
+			proof = append(proof, t.layers[level][sibling])
+		} else {
+			proof = append(proof, t.empty[level])
+		}
+	}
+	return proof, nil
+}
@@ -0,0 +1,64 @@
+package account_compression
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmptyNode(t *testing.T) {
+	assert.Equal(t, [32]uint8{}, EmptyNode(0))
+	node := EmptyNode(1)
+	assert.Equal(t, "ad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5", hex.EncodeToString(node[:]))
+	assert.Equal(t, HashNodes(EmptyNode(2), EmptyNode(2)), EmptyNode(3))
+}
+
+func TestMerkleTree(t *testing.T) {
+	leaves := [][32]uint8{{1}, {2}, {3}, {4}, {5}}
+	tree, err := NewMerkleTree(3, leaves)
+	assert.NoError(t, err)
+
+	root := tree.Root()
+	assert.Equal(t,
+		HashNodes(
+			HashNodes(HashNodes(leaves[0], leaves[1]), HashNodes(leaves[2], leaves[3])),
+			HashNodes(HashNodes(leaves[4], EmptyNode(0)), EmptyNode(1)),
+		),
+		root,
+	)
+
+	for i, leaf := range leaves {
+		proof, err := tree.Proof(uint32(i))
+		assert.NoError(t, err)
+		assert.Len(t, proof, 3)
+		assert.True(t, VerifyProof(root, leaf, uint32(i), proof))
+		assert.False(t, VerifyProof(root, leaf, uint32(i+1), proof))
+	}
+
+	// an empty leaf has a proof too
+	proof, err := tree.Proof(7)
+	assert.NoError(t, err)
+	assert.True(t, VerifyProof(root, [32]uint8{}, 7, proof))
+
+	_, err = tree.Proof(8)
+	assert.Error(t, err)
+
+	_, err = NewMerkleTree(2, leaves)
+	assert.Error(t, err)
+
+	empty, err := NewMerkleTree(14, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, EmptyNode(14), empty.Root())
+}
+
+func TestProofAccounts(t *testing.T) {
+	proof := [][32]uint8{{1}, {2}, {3}}
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: common.PublicKey(proof[0]), IsSigner: false, IsWritable: false},
+	}, ProofAccounts(proof, 2))
+	assert.Len(t, ProofAccounts(proof, 0), 3)
+	assert.Len(t, ProofAccounts(proof, 5), 0)
+}
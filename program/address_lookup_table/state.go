@@ -81,7 +81,9 @@ func DeserializeLookupTable(data []byte, accountOwner common.PublicKey) (Address
 		}
 
 		addressLookupTable.padding = binary.LittleEndian.Uint16(data[current : current+2])
-		current += 2
+
+		// addresses always start at the end of the meta no matter the authority exists or not
+		current = int(LOOKUP_TABLE_META_SIZE)
 
 		l := (len(data) - current) / 32
 		addresses := make([]common.PublicKey, 0, l)
@@ -96,6 +96,24 @@ func TestDeserializeLookupTable(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			args: args{
+				data:         []byte{1, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 230, 107, 61, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127, 96, 107, 250, 152, 133, 208, 224, 73, 251, 113, 151, 128, 139, 86, 80, 101, 70, 138, 50, 141, 153, 218, 110, 56, 39, 122, 181, 120, 55, 86, 185},
+				accountOwner: common.AddressLookupTableProgramID,
+			},
+			want: AddressLookupTable{
+				ProgramState:               ProgramStateLookupTable,
+				DeactivationSlot:           ^uint64(0),
+				LastExtendedSlot:           155020262,
+				LastExtendedSlotStartIndex: 0,
+				Authority:                  nil,
+				padding:                    0,
+				Addresses: []common.PublicKey{
+					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+				},
+			},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
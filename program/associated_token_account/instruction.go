@@ -14,6 +14,14 @@ const (
 	InstructionRecoverNested
 )
 
+// tokenProgramID returns the token program which owns the mint, default is common.TokenProgramID
+func tokenProgramID(p common.PublicKey) common.PublicKey {
+	if p == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return p
+}
+
 type CreateAssociatedTokenAccountParam struct {
 	Funder                 common.PublicKey
 	Owner                  common.PublicKey
@@ -53,6 +61,7 @@ type CreateParam struct {
 	Owner                  common.PublicKey
 	Mint                   common.PublicKey
 	AssociatedTokenAccount common.PublicKey
+	TokenProgramID         common.PublicKey
 }
 
 // Create creates an associated token account for the given wallet address and token mint. Return an error if the account exists.
@@ -74,7 +83,7 @@ func Create(param CreateParam) types.Instruction {
 			{PubKey: param.Owner, IsSigner: false, IsWritable: false},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
 			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
-			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
 			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 		},
 		Data: data,
@@ -86,6 +95,7 @@ type CreateIdempotentParam struct {
 	Owner                  common.PublicKey
 	Mint                   common.PublicKey
 	AssociatedTokenAccount common.PublicKey
+	TokenProgramID         common.PublicKey
 }
 
 // CreateIdempotent creates an associated token account for the given wallet address and token mint,
@@ -108,7 +118,7 @@ func CreateIdempotent(param CreateIdempotentParam) types.Instruction {
 			{PubKey: param.Owner, IsSigner: false, IsWritable: false},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
 			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
-			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
 			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 		},
 		Data: data,
@@ -122,6 +132,7 @@ type RecoverNestedParam struct {
 	NestedMint                        common.PublicKey
 	NestedMintAssociatedTokenAccount  common.PublicKey
 	DestinationAssociatedTokenAccount common.PublicKey
+	TokenProgramID                    common.PublicKey
 }
 
 // RecoverNested transfers from and closes a nested associated token account: an associated token account owned by an associated token account.
@@ -144,7 +155,7 @@ func RecoverNested(param RecoverNestedParam) types.Instruction {
 			{PubKey: param.OwnerAssociatedTokenAccount, IsSigner: false, IsWritable: true},
 			{PubKey: param.OwnerMint, IsSigner: false, IsWritable: false},
 			{PubKey: param.Owner, IsSigner: true, IsWritable: true},
-			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
 		},
 		Data: data,
 	}
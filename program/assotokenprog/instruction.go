@@ -2,6 +2,7 @@ package assotokenprog
 
 import (
 	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/associated_token_account"
 	"github.com/liangjies/solana-go-sdk/types"
 	"github.com/near/borsh-go"
 )
@@ -20,7 +21,7 @@ type CreateAssociatedTokenAccountParam struct {
 	AssociatedTokenAccount common.PublicKey
 }
 
-// CreateAssociatedTokenAccount is the only instruction in associated token program
+// CreateAssociatedTokenAccount creates an associated token account. Return an error if the account exists.
 func CreateAssociatedTokenAccount(param CreateAssociatedTokenAccountParam) types.Instruction {
 	data, err := borsh.Serialize(struct {
 		Instruction Instruction
@@ -45,3 +46,23 @@ func CreateAssociatedTokenAccount(param CreateAssociatedTokenAccountParam) types
 		Data: data,
 	}
 }
+
+// Deprecated: please use associated_token_account.CreateIdempotentParam
+type CreateIdempotentParam = associated_token_account.CreateIdempotentParam
+
+// CreateIdempotent creates an associated token account if it doesn't already exist.
+//
+// Deprecated: please use associated_token_account.CreateIdempotent
+func CreateIdempotent(param CreateIdempotentParam) types.Instruction {
+	return associated_token_account.CreateIdempotent(param)
+}
+
+// Deprecated: please use associated_token_account.RecoverNestedParam
+type RecoverNestedParam = associated_token_account.RecoverNestedParam
+
+// RecoverNested transfers from and closes a nested associated token account.
+//
+// Deprecated: please use associated_token_account.RecoverNested
+func RecoverNested(param RecoverNestedParam) types.Instruction {
+	return associated_token_account.RecoverNested(param)
+}
@@ -0,0 +1,195 @@
+package bpfloader
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+type Instruction uint32
+
+const (
+	InstructionInitializeBuffer Instruction = iota
+	InstructionWrite
+	InstructionDeployWithMaxDataLen
+	InstructionUpgrade
+	InstructionSetAuthority
+	InstructionClose
+)
+
+type InitializeBufferParam struct {
+	// Buffer is an account owned by the loader, allocated with GetBufferSize bytes
+	Buffer    common.PublicKey
+	Authority common.PublicKey
+}
+
+func InitializeBuffer(param InitializeBufferParam) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: false, IsWritable: false},
+		},
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+		}{
+			Instruction: InstructionInitializeBuffer,
+		}),
+	}
+}
+
+type WriteParam struct {
+	Buffer    common.PublicKey
+	Authority common.PublicKey
+	// Offset is the offset of the bytes in the program, the buffer metadata is not counted
+	Offset uint32
+	Bytes  []byte
+}
+
+func Write(param WriteParam) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+			Offset      uint32
+			Bytes       []byte
+		}{
+			Instruction: InstructionWrite,
+			Offset:      param.Offset,
+			Bytes:       param.Bytes,
+		}),
+	}
+}
+
+type DeployWithMaxDataLenParam struct {
+	Payer common.PublicKey
+	// Program is an account owned by the loader, allocated with ProgramSize bytes
+	Program common.PublicKey
+	Buffer  common.PublicKey
+	// Authority is the authority of the buffer, it becomes the upgrade authority of the program
+	Authority common.PublicKey
+	// MaxDataLen is the max size of the program, it leaves room for upgrades
+	MaxDataLen uint64
+}
+
+// DeployWithMaxDataLen deploys the program in the buffer, the program data account is created by the loader
+// and the buffer is closed
+func DeployWithMaxDataLen(param DeployWithMaxDataLenParam) types.Instruction {
+	programData := GetProgramDataAddress(param.Program)
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: programData, IsSigner: false, IsWritable: true},
+			{PubKey: param.Program, IsSigner: false, IsWritable: true},
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+			MaxDataLen  uint64
+		}{
+			Instruction: InstructionDeployWithMaxDataLen,
+			MaxDataLen:  param.MaxDataLen,
+		}),
+	}
+}
+
+type UpgradeParam struct {
+	Program common.PublicKey
+	Buffer  common.PublicKey
+	// Spill receives the lamports of the buffer
+	Spill     common.PublicKey
+	Authority common.PublicKey
+}
+
+// Upgrade replaces the program with the one in the buffer, the buffer has to have the same authority as the program
+func Upgrade(param UpgradeParam) types.Instruction {
+	programData := GetProgramDataAddress(param.Program)
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: programData, IsSigner: false, IsWritable: true},
+			{PubKey: param.Program, IsSigner: false, IsWritable: true},
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Spill, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+		}{
+			Instruction: InstructionUpgrade,
+		}),
+	}
+}
+
+type SetAuthorityParam struct {
+	// Account is a buffer or a program data account
+	Account          common.PublicKey
+	CurrentAuthority common.PublicKey
+	// NewAuthority is nil to make a program immutable, a buffer always has an authority
+	NewAuthority *common.PublicKey
+}
+
+func SetAuthority(param SetAuthorityParam) types.Instruction {
+	accounts := []types.AccountMeta{
+		{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		{PubKey: param.CurrentAuthority, IsSigner: true, IsWritable: false},
+	}
+	if param.NewAuthority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NewAuthority, IsSigner: false, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts:  accounts,
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+		}{
+			Instruction: InstructionSetAuthority,
+		}),
+	}
+}
+
+type CloseParam struct {
+	// Account is a buffer, a program data account or an uninitialized account
+	Account   common.PublicKey
+	Recipient common.PublicKey
+	// Authority is required unless the account is uninitialized
+	Authority *common.PublicKey
+	// Program is required to close a program data account
+	Program *common.PublicKey
+}
+
+// Close closes the account and sends its lamports to the recipient. a closed program can't be deployed again.
+func Close(param CloseParam) types.Instruction {
+	accounts := []types.AccountMeta{
+		{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		{PubKey: param.Recipient, IsSigner: false, IsWritable: true},
+	}
+	if param.Authority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Authority, IsSigner: true, IsWritable: false})
+	}
+	if param.Program != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Program, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts:  accounts,
+		Data: bincode.MustSerializeData(struct {
+			Instruction Instruction
+		}{
+			Instruction: InstructionClose,
+		}),
+	}
+}
@@ -0,0 +1,116 @@
+package bpfloader
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitializeBuffer(t *testing.T) {
+	buffer := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: buffer, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{0, 0, 0, 0},
+	}, InitializeBuffer(InitializeBufferParam{Buffer: buffer, Authority: authority}))
+}
+
+func TestWrite(t *testing.T) {
+	buffer := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: buffer, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{1, 0, 0, 0, 0, 4, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3},
+	}, Write(WriteParam{Buffer: buffer, Authority: authority, Offset: 1024, Bytes: []byte{1, 2, 3}}))
+}
+
+func TestDeployWithMaxDataLen(t *testing.T) {
+	payer := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	program := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	buffer := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: payer, IsSigner: true, IsWritable: true},
+			{PubKey: GetProgramDataAddress(program), IsSigner: false, IsWritable: true},
+			{PubKey: program, IsSigner: false, IsWritable: true},
+			{PubKey: buffer, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: payer, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{2, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0},
+	}, DeployWithMaxDataLen(DeployWithMaxDataLenParam{
+		Payer:      payer,
+		Program:    program,
+		Buffer:     buffer,
+		Authority:  payer,
+		MaxDataLen: 65536,
+	}))
+}
+
+func TestUpgrade(t *testing.T) {
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	program := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	buffer := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: GetProgramDataAddress(program), IsSigner: false, IsWritable: true},
+			{PubKey: program, IsSigner: false, IsWritable: true},
+			{PubKey: buffer, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: authority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{3, 0, 0, 0},
+	}, Upgrade(UpgradeParam{Program: program, Buffer: buffer, Spill: authority, Authority: authority}))
+}
+
+func TestSetAuthority(t *testing.T) {
+	account := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	current := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	next := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+
+	got := SetAuthority(SetAuthorityParam{Account: account, CurrentAuthority: current, NewAuthority: pointer.Get(next)})
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: account, IsSigner: false, IsWritable: true},
+		{PubKey: current, IsSigner: true, IsWritable: false},
+		{PubKey: next, IsSigner: false, IsWritable: false},
+	}, got.Accounts)
+	assert.Equal(t, []byte{4, 0, 0, 0}, got.Data)
+
+	got = SetAuthority(SetAuthorityParam{Account: account, CurrentAuthority: current})
+	assert.Len(t, got.Accounts, 2)
+}
+
+func TestClose(t *testing.T) {
+	account := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	program := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+
+	got := Close(CloseParam{Account: account, Recipient: authority, Authority: pointer.Get(authority), Program: pointer.Get(program)})
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: account, IsSigner: false, IsWritable: true},
+		{PubKey: authority, IsSigner: false, IsWritable: true},
+		{PubKey: authority, IsSigner: true, IsWritable: false},
+		{PubKey: program, IsSigner: false, IsWritable: true},
+	}, got.Accounts)
+	assert.Equal(t, []byte{5, 0, 0, 0}, got.Data)
+
+	got = Close(CloseParam{Account: account, Recipient: authority})
+	assert.Len(t, got.Accounts, 2)
+}
@@ -0,0 +1,116 @@
+package bpfloader
+
+import (
+	"encoding/binary"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+const (
+	// BufferMetadataSize is the size of the state before the program in a buffer account
+	BufferMetadataSize = 37
+	// ProgramDataMetadataSize is the size of the state before the program in a program data account
+	ProgramDataMetadataSize = 45
+	// ProgramSize is the size of a program account
+	ProgramSize = 36
+)
+
+type AccountType uint32
+
+const (
+	AccountTypeUninitialized AccountType = iota
+	AccountTypeBuffer
+	AccountTypeProgram
+	AccountTypeProgramData
+)
+
+type Buffer struct {
+	Authority *common.PublicKey
+	// Data is the program written to the buffer
+	Data []byte
+}
+
+type Program struct {
+	ProgramDataAddress common.PublicKey
+}
+
+type ProgramData struct {
+	// Slot is the slot the program was deployed or upgraded last
+	Slot uint64
+	// UpgradeAuthority is nil if the program is immutable
+	UpgradeAuthority *common.PublicKey
+	Data             []byte
+}
+
+// GetProgramDataAddress derives the program data account of a program
+func GetProgramDataAddress(program common.PublicKey) common.PublicKey {
+	pubkey, _, _ := common.FindProgramAddress(
+		[][]byte{
+			program.Bytes(),
+		},
+		common.BPFLoaderUpgradeableProgramID,
+	)
+	return pubkey
+}
+
+// GetBufferSize returns the size of a buffer account for a program with programLen bytes
+func GetBufferSize(programLen uint64) uint64 {
+	return BufferMetadataSize + programLen
+}
+
+// GetProgramDataSize returns the size of a program data account for a program up to maxDataLen bytes
+func GetProgramDataSize(maxDataLen uint64) uint64 {
+	return ProgramDataMetadataSize + maxDataLen
+}
+
+func DeserializeBuffer(data []byte, accountOwner common.PublicKey) (Buffer, error) {
+	if err := checkAccountType(data, accountOwner, AccountTypeBuffer, BufferMetadataSize); err != nil {
+		return Buffer{}, err
+	}
+	return Buffer{
+		Authority: deserializeAuthority(data[4:BufferMetadataSize]),
+		Data:      data[BufferMetadataSize:],
+	}, nil
+}
+
+func DeserializeProgram(data []byte, accountOwner common.PublicKey) (Program, error) {
+	if err := checkAccountType(data, accountOwner, AccountTypeProgram, ProgramSize); err != nil {
+		return Program{}, err
+	}
+	return Program{
+		ProgramDataAddress: common.PublicKeyFromBytes(data[4:36]),
+	}, nil
+}
+
+func DeserializeProgramData(data []byte, accountOwner common.PublicKey) (ProgramData, error) {
+	if err := checkAccountType(data, accountOwner, AccountTypeProgramData, ProgramDataMetadataSize); err != nil {
+		return ProgramData{}, err
+	}
+	return ProgramData{
+		Slot:             binary.LittleEndian.Uint64(data[4:12]),
+		UpgradeAuthority: deserializeAuthority(data[12:ProgramDataMetadataSize]),
+		Data:             data[ProgramDataMetadataSize:],
+	}, nil
+}
+
+func checkAccountType(data []byte, accountOwner common.PublicKey, accountType AccountType, size int) error {
+	if accountOwner != common.BPFLoaderUpgradeableProgramID {
+		return ErrInvalidAccountOwner
+	}
+	if len(data) < size {
+		return ErrInvalidAccountDataSize
+	}
+	if AccountType(binary.LittleEndian.Uint32(data)) != accountType {
+		return ErrInvalidAccountData
+	}
+	return nil
+}
+
+// deserializeAuthority decodes an option of a public key, the space is reserved even if it is none
+func deserializeAuthority(data []byte) *common.PublicKey {
+	if data[0] == 0 {
+		return nil
+	}
+	authority := common.PublicKeyFromBytes(data[1:33])
+	return &authority
+}
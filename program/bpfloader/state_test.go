@@ -0,0 +1,71 @@
+package bpfloader
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProgramDataAddress(t *testing.T) {
+	assert.Equal(t,
+		common.PublicKeyFromString("PwDiXFxQsGra4sFFTT8r1QWRMd4vfumiWC1jfWNfdYT"),
+		GetProgramDataAddress(common.MetaplexTokenMetaProgramID),
+	)
+}
+
+func TestDeserializeBuffer(t *testing.T) {
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	data := []byte{1, 0, 0, 0, 1}
+	data = append(data, authority.Bytes()...)
+	data = append(data, 0x7f, 0x45, 0x4c, 0x46)
+
+	got, err := DeserializeBuffer(data, common.BPFLoaderUpgradeableProgramID)
+	assert.NoError(t, err)
+	assert.Equal(t, Buffer{
+		Authority: pointer.Get(authority),
+		Data:      []byte{0x7f, 0x45, 0x4c, 0x46},
+	}, got)
+
+	_, err = DeserializeBuffer(data, common.BPFLoaderProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountOwner)
+
+	_, err = DeserializeBuffer(data[:36], common.BPFLoaderUpgradeableProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountDataSize)
+
+	_, err = DeserializeProgram(data, common.BPFLoaderUpgradeableProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+}
+
+func TestDeserializeProgram(t *testing.T) {
+	programData := GetProgramDataAddress(common.MetaplexTokenMetaProgramID)
+
+	got, err := DeserializeProgram(append([]byte{2, 0, 0, 0}, programData.Bytes()...), common.BPFLoaderUpgradeableProgramID)
+	assert.NoError(t, err)
+	assert.Equal(t, Program{ProgramDataAddress: programData}, got)
+}
+
+func TestDeserializeProgramData(t *testing.T) {
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	data := []byte{3, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 1}
+	data = append(data, authority.Bytes()...)
+	data = append(data, 1, 2, 3)
+	got, err := DeserializeProgramData(data, common.BPFLoaderUpgradeableProgramID)
+	assert.NoError(t, err)
+	assert.Equal(t, ProgramData{
+		Slot:             513,
+		UpgradeAuthority: pointer.Get(authority),
+		Data:             []byte{1, 2, 3},
+	}, got)
+
+	// an immutable program keeps the space of the authority
+	data = make([]byte, ProgramDataMetadataSize+1)
+	data[0] = 3
+	got, err = DeserializeProgramData(data, common.BPFLoaderUpgradeableProgramID)
+	assert.NoError(t, err)
+	assert.Nil(t, got.UpgradeAuthority)
+	assert.Equal(t, []byte{0}, got.Data)
+}
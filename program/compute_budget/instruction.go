@@ -13,6 +13,7 @@ const (
 	InstructionRequestHeapFrame
 	InstructionSetComputeUnitLimit
 	InstructionSetComputeUnitPrice
+	InstructionSetLoadedAccountsDataSizeLimit
 )
 
 type RequestUnitsParam struct {
@@ -114,3 +115,27 @@ func SetComputeUnitPrice(param SetComputeUnitPriceParam) types.Instruction {
 		Data:      data,
 	}
 }
+
+type SetLoadedAccountsDataSizeLimitParam struct {
+	Bytes uint32
+}
+
+// SetLoadedAccountsDataSizeLimit set a specific transaction-wide account data size limit, in bytes, is allowed to load.
+func SetLoadedAccountsDataSizeLimit(param SetLoadedAccountsDataSizeLimitParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Bytes       uint32
+	}{
+		Instruction: InstructionSetLoadedAccountsDataSizeLimit,
+		Bytes:       param.Bytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.ComputeBudgetProgramID,
+		Accounts:  []types.AccountMeta{},
+		Data:      data,
+	}
+}
@@ -132,3 +132,34 @@ func TestSetComputeUnitPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLoadedAccountsDataSizeLimit(t *testing.T) {
+	type args struct {
+		param SetLoadedAccountsDataSizeLimitParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: SetLoadedAccountsDataSizeLimitParam{
+					Bytes: 65536,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.ComputeBudgetProgramID,
+				Accounts:  []types.AccountMeta{},
+				Data:      []byte{4, 0, 0, 1, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetLoadedAccountsDataSizeLimit(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetLoadedAccountsDataSizeLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package ed25519
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+const (
+	PublicKeySerializedSize        = 32
+	SignatureSerializedSize        = 64
+	SignatureOffsetsSerializedSize = 14
+	SignatureOffsetsStart          = 2
+	DataStart                      = SignatureOffsetsSerializedSize + SignatureOffsetsStart
+)
+
+// CurrentInstructionIndex points the offsets to the data of the ed25519 instruction itself
+const CurrentInstructionIndex uint16 = math.MaxUint16
+
+type Ed25519SignatureOffsets struct {
+	SignatureOffset           uint16
+	SignatureInstructionIndex uint16
+	PublicKeyOffset           uint16
+	PublicKeyInstructionIndex uint16
+	MessageDataOffset         uint16
+	MessageDataSize           uint16
+	MessageInstructionIndex   uint16
+}
+
+// NewEd25519Instruction verifies every signature of the message signed by the public key at the same index,
+// the keys, the signatures and the messages are all in the data of the instruction
+func NewEd25519Instruction(pubkeys []common.PublicKey, msgs [][]byte, sigs [][]byte) (types.Instruction, error) {
+	if len(pubkeys) != len(msgs) || len(msgs) != len(sigs) {
+		return types.Instruction{}, fmt.Errorf("provided a different number of keys, messages, or signatures")
+	}
+	if len(pubkeys) > math.MaxUint8 {
+		return types.Instruction{}, fmt.Errorf("too many signatures, count: %v", len(pubkeys))
+	}
+
+	n := len(pubkeys)
+	instrData := make([]byte, SignatureOffsetsStart+n*SignatureOffsetsSerializedSize)
+	instrData[0] = uint8(n) // count of offsets structs, followed by a padding byte
+
+	for i, pubkey := range pubkeys {
+		sig := sigs[i]
+		msg := msgs[i]
+		if len(sig) != SignatureSerializedSize {
+			return types.Instruction{}, fmt.Errorf("invalid signature length, index: %v, length: %v", i, len(sig))
+		}
+
+		pubkeyOffset := len(instrData)
+		instrData = append(instrData, pubkey.Bytes()...)
+		sigOffset := len(instrData)
+		instrData = append(instrData, sig...)
+		msgOffset := len(instrData)
+		instrData = append(instrData, msg...)
+		if len(instrData) > math.MaxUint16 {
+			return types.Instruction{}, fmt.Errorf("instruction data is too large")
+		}
+
+		osetsBytes, err := bincode.SerializeData(Ed25519SignatureOffsets{
+			SignatureOffset:           uint16(sigOffset),
+			SignatureInstructionIndex: CurrentInstructionIndex,
+			PublicKeyOffset:           uint16(pubkeyOffset),
+			PublicKeyInstructionIndex: CurrentInstructionIndex,
+			MessageDataOffset:         uint16(msgOffset),
+			MessageDataSize:           uint16(len(msg)),
+			MessageInstructionIndex:   CurrentInstructionIndex,
+		})
+		if err != nil {
+			return types.Instruction{}, err
+		}
+
+		osetsStart := SignatureOffsetsStart + i*SignatureOffsetsSerializedSize
+		copy(instrData[osetsStart:osetsStart+SignatureOffsetsSerializedSize], osetsBytes)
+	}
+
+	return types.Instruction{
+		ProgramID: common.Ed25519ProgramID,
+		Data:      instrData,
+	}, nil
+}
+
+// NewEd25519InstructionWithAccount signs the message by the account and verifies the signature
+func NewEd25519InstructionWithAccount(account types.Account, msg []byte) (types.Instruction, error) {
+	return NewEd25519Instruction([]common.PublicKey{account.PublicKey}, [][]byte{msg}, [][]byte{account.Sign(msg)})
+}
+
+// NewEd25519InstructionWithOffsets verifies signatures whose keys, signatures and messages are in the data of other instructions
+func NewEd25519InstructionWithOffsets(offsets []Ed25519SignatureOffsets) (types.Instruction, error) {
+	if len(offsets) > math.MaxUint8 {
+		return types.Instruction{}, fmt.Errorf("too many signatures, count: %v", len(offsets))
+	}
+
+	instrData := make([]byte, SignatureOffsetsStart, SignatureOffsetsStart+len(offsets)*SignatureOffsetsSerializedSize)
+	instrData[0] = uint8(len(offsets))
+	for _, o := range offsets {
+		osetsBytes, err := bincode.SerializeData(o)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		instrData = append(instrData, osetsBytes...)
+	}
+
+	return types.Instruction{
+		ProgramID: common.Ed25519ProgramID,
+		Data:      instrData,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEd25519InstructionWithAccount(t *testing.T) {
+	account, err := types.AccountFromSeed(make([]byte, 32))
+	assert.NoError(t, err)
+	msg := []byte("message")
+
+	instr, err := NewEd25519InstructionWithAccount(account, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, common.Ed25519ProgramID, instr.ProgramID)
+	assert.Len(t, instr.Accounts, 0)
+
+	// the same layout as solana_sdk::ed25519_instruction::new_ed25519_instruction
+	expected := []byte{
+		1, 0,
+		48, 0, 0xff, 0xff,
+		16, 0, 0xff, 0xff,
+		112, 0, 7, 0, 0xff, 0xff,
+	}
+	expected = append(expected, account.PublicKey.Bytes()...)
+	expected = append(expected, account.Sign(msg)...)
+	expected = append(expected, msg...)
+	assert.Equal(t, expected, instr.Data)
+}
+
+func TestNewEd25519Instruction(t *testing.T) {
+	a1, err := types.AccountFromSeed(make([]byte, 32))
+	assert.NoError(t, err)
+	a2 := types.NewAccount()
+	msgs := [][]byte{[]byte("first"), []byte("second message")}
+
+	instr, err := NewEd25519Instruction(
+		[]common.PublicKey{a1.PublicKey, a2.PublicKey},
+		msgs,
+		[][]byte{a1.Sign(msgs[0]), a2.Sign(msgs[1])},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(2), instr.Data[0])
+
+	// verify the signatures like the program does
+	data := instr.Data
+	for i := 0; i < 2; i++ {
+		o := data[SignatureOffsetsStart+i*SignatureOffsetsSerializedSize:]
+		sigOffset := binary.LittleEndian.Uint16(o[0:])
+		pubkeyOffset := binary.LittleEndian.Uint16(o[4:])
+		msgOffset := binary.LittleEndian.Uint16(o[8:])
+		msgSize := binary.LittleEndian.Uint16(o[10:])
+		assert.Equal(t, CurrentInstructionIndex, binary.LittleEndian.Uint16(o[12:]))
+		assert.True(t, ed25519.Verify(
+			data[pubkeyOffset:pubkeyOffset+PublicKeySerializedSize],
+			data[msgOffset:msgOffset+msgSize],
+			data[sigOffset:sigOffset+SignatureSerializedSize],
+		))
+	}
+
+	_, err = NewEd25519Instruction([]common.PublicKey{a1.PublicKey}, msgs, nil)
+	assert.Error(t, err)
+
+	_, err = NewEd25519Instruction([]common.PublicKey{a1.PublicKey}, msgs[:1], [][]byte{{1}})
+	assert.Error(t, err)
+}
+
+func TestNewEd25519InstructionWithOffsets(t *testing.T) {
+	instr, err := NewEd25519InstructionWithOffsets([]Ed25519SignatureOffsets{
+		{
+			SignatureOffset:           100,
+			SignatureInstructionIndex: 1,
+			PublicKeyOffset:           4,
+			PublicKeyInstructionIndex: 1,
+			MessageDataOffset:         164,
+			MessageDataSize:           32,
+			MessageInstructionIndex:   1,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 100, 0, 1, 0, 4, 0, 1, 0, 164, 0, 32, 0, 1, 0}, instr.Data)
+}
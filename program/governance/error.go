@@ -0,0 +1,8 @@
+package governance
+
+import "errors"
+
+var (
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidAccountType     = errors.New("invalid governance account type")
+)
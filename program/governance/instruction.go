@@ -0,0 +1,512 @@
+package governance
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+type Instruction borsh.Enum
+
+const (
+	InstructionCreateRealm Instruction = iota
+	InstructionDepositGoverningTokens
+	InstructionWithdrawGoverningTokens
+	InstructionSetGovernanceDelegate
+	InstructionCreateGovernance
+	InstructionCreateProgramGovernance
+	InstructionCreateProposal
+	InstructionAddSignatory
+	InstructionLegacy1
+	InstructionInsertTransaction
+	InstructionRemoveTransaction
+	InstructionCancelProposal
+	InstructionSignOffProposal
+	InstructionCastVote
+	InstructionFinalizeVote
+	InstructionRelinquishVote
+	InstructionExecuteTransaction
+	InstructionCreateMintGovernance
+	InstructionCreateTokenGovernance
+	InstructionSetGovernanceConfig
+	InstructionFlagTransactionError
+	InstructionSetRealmAuthority
+	InstructionSetRealmConfig
+	InstructionCreateTokenOwnerRecord
+	InstructionUpdateProgramMetadata
+	InstructionCreateNativeTreasury
+	InstructionRevokeGoverningTokens
+	InstructionRefundProposalDeposit
+	InstructionCompleteProposal
+	InstructionAddRequiredSignatory
+	InstructionRemoveRequiredSignatory
+)
+
+type GoverningTokenConfigArgs struct {
+	UseVoterWeightAddin    bool
+	UseMaxVoterWeightAddin bool
+	TokenType              GoverningTokenType
+}
+
+type RealmConfigArgs struct {
+	UseCouncilMint                       bool
+	MinCommunityWeightToCreateGovernance uint64
+	CommunityMintMaxVoterWeightSource    MintMaxVoterWeightSource
+	CommunityTokenConfigArgs             GoverningTokenConfigArgs
+	CouncilTokenConfigArgs               GoverningTokenConfigArgs
+}
+
+// all params have a ProgramID field, leave it empty to use common.SPLGovernanceProgramID
+
+type CreateRealmParam struct {
+	ProgramID                            common.PublicKey
+	Name                                 string
+	RealmAuthority                       common.PublicKey
+	CommunityTokenMint                   common.PublicKey
+	Payer                                common.PublicKey
+	CouncilTokenMint                     *common.PublicKey
+	MinCommunityWeightToCreateGovernance uint64
+	CommunityMintMaxVoterWeightSource    MintMaxVoterWeightSource
+	CommunityTokenType                   GoverningTokenType
+	CouncilTokenType                     GoverningTokenType
+	// the voter weight addins are optional
+	CommunityVoterWeightAddin    *common.PublicKey
+	MaxCommunityVoterWeightAddin *common.PublicKey
+	CouncilVoterWeightAddin      *common.PublicKey
+	MaxCouncilVoterWeightAddin   *common.PublicKey
+}
+
+func CreateRealm(param CreateRealmParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Name        string
+		ConfigArgs  RealmConfigArgs
+	}{
+		Instruction: InstructionCreateRealm,
+		Name:        param.Name,
+		ConfigArgs: RealmConfigArgs{
+			UseCouncilMint:                       param.CouncilTokenMint != nil,
+			MinCommunityWeightToCreateGovernance: param.MinCommunityWeightToCreateGovernance,
+			CommunityMintMaxVoterWeightSource:    param.CommunityMintMaxVoterWeightSource,
+			CommunityTokenConfigArgs: GoverningTokenConfigArgs{
+				UseVoterWeightAddin:    param.CommunityVoterWeightAddin != nil,
+				UseMaxVoterWeightAddin: param.MaxCommunityVoterWeightAddin != nil,
+				TokenType:              param.CommunityTokenType,
+			},
+			CouncilTokenConfigArgs: GoverningTokenConfigArgs{
+				UseVoterWeightAddin:    param.CouncilVoterWeightAddin != nil,
+				UseMaxVoterWeightAddin: param.MaxCouncilVoterWeightAddin != nil,
+				TokenType:              param.CouncilTokenType,
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	realm := mustAddress(GetRealmAddress(param.ProgramID, param.Name))
+	accounts := []types.AccountMeta{
+		{PubKey: realm, IsSigner: false, IsWritable: true},
+		{PubKey: param.RealmAuthority, IsSigner: false, IsWritable: false},
+		{PubKey: param.CommunityTokenMint, IsSigner: false, IsWritable: false},
+		{PubKey: mustAddress(GetGoverningTokenHoldingAddress(param.ProgramID, realm, param.CommunityTokenMint)), IsSigner: false, IsWritable: true},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+	}
+	if param.CouncilTokenMint != nil {
+		accounts = append(accounts,
+			types.AccountMeta{PubKey: *param.CouncilTokenMint, IsSigner: false, IsWritable: false},
+			types.AccountMeta{PubKey: mustAddress(GetGoverningTokenHoldingAddress(param.ProgramID, realm, *param.CouncilTokenMint)), IsSigner: false, IsWritable: true},
+		)
+	}
+	accounts = append(accounts, types.AccountMeta{PubKey: mustAddress(GetRealmConfigAddress(param.ProgramID, realm)), IsSigner: false, IsWritable: true})
+	for _, addin := range []*common.PublicKey{
+		param.CommunityVoterWeightAddin,
+		param.MaxCommunityVoterWeightAddin,
+		param.CouncilVoterWeightAddin,
+		param.MaxCouncilVoterWeightAddin,
+	} {
+		if addin != nil {
+			accounts = append(accounts, types.AccountMeta{PubKey: *addin, IsSigner: false, IsWritable: false})
+		}
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type DepositGoverningTokensParam struct {
+	ProgramID                     common.PublicKey
+	Realm                         common.PublicKey
+	GoverningTokenMint            common.PublicKey
+	GoverningTokenSource          common.PublicKey
+	GoverningTokenOwner           common.PublicKey
+	GoverningTokenSourceAuthority common.PublicKey
+	Payer                         common.PublicKey
+	Amount                        uint64
+}
+
+// DepositGoverningTokens deposits community or council tokens into the realm, the token owner record is created if it doesn't exist
+func DepositGoverningTokens(param DepositGoverningTokensParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Amount      uint64
+	}{
+		Instruction: InstructionDepositGoverningTokens,
+		Amount:      param.Amount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Realm, IsSigner: false, IsWritable: false},
+			{PubKey: mustAddress(GetGoverningTokenHoldingAddress(param.ProgramID, param.Realm, param.GoverningTokenMint)), IsSigner: false, IsWritable: true},
+			{PubKey: param.GoverningTokenSource, IsSigner: false, IsWritable: true},
+			{PubKey: param.GoverningTokenOwner, IsSigner: true, IsWritable: false},
+			{PubKey: param.GoverningTokenSourceAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: mustAddress(GetTokenOwnerRecordAddress(param.ProgramID, param.Realm, param.GoverningTokenMint, param.GoverningTokenOwner)), IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: mustAddress(GetRealmConfigAddress(param.ProgramID, param.Realm)), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type CreateGovernanceParam struct {
+	ProgramID common.PublicKey
+	Realm     common.PublicKey
+	// GovernanceSeed is any unique key to derive the governance address
+	GovernanceSeed    common.PublicKey
+	TokenOwnerRecord  common.PublicKey
+	Payer             common.PublicKey
+	CreateAuthority   common.PublicKey
+	VoterWeightRecord *common.PublicKey
+	Config            GovernanceConfig
+}
+
+func CreateGovernance(param CreateGovernanceParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Config      GovernanceConfig
+	}{
+		Instruction: InstructionCreateGovernance,
+		Config:      param.Config,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Realm, IsSigner: false, IsWritable: false},
+		{PubKey: mustAddress(GetGovernanceAddress(param.ProgramID, param.Realm, param.GovernanceSeed)), IsSigner: false, IsWritable: true},
+		{PubKey: param.GovernanceSeed, IsSigner: false, IsWritable: false},
+		{PubKey: param.TokenOwnerRecord, IsSigner: false, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: param.CreateAuthority, IsSigner: true, IsWritable: false},
+	}
+	accounts = withRealmConfigAccounts(accounts, param.ProgramID, param.Realm, param.VoterWeightRecord, nil)
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type CreateProposalParam struct {
+	ProgramID           common.PublicKey
+	Realm               common.PublicKey
+	Governance          common.PublicKey
+	ProposalOwnerRecord common.PublicKey
+	GoverningTokenMint  common.PublicKey
+	// GovernanceAuthority is the token owner or its governance delegate
+	GovernanceAuthority common.PublicKey
+	Payer               common.PublicKey
+	// ProposalSeed is any unique key to derive the proposal address, see GetProposalAddress
+	ProposalSeed      common.PublicKey
+	Name              string
+	DescriptionLink   string
+	VoteType          VoteType
+	Options           []string
+	UseDenyOption     bool
+	VoterWeightRecord *common.PublicKey
+}
+
+func CreateProposal(param CreateProposalParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction     Instruction
+		Name            string
+		DescriptionLink string
+		VoteType        VoteType
+		Options         []string
+		UseDenyOption   bool
+		ProposalSeed    common.PublicKey
+	}{
+		Instruction:     InstructionCreateProposal,
+		Name:            param.Name,
+		DescriptionLink: param.DescriptionLink,
+		VoteType:        param.VoteType,
+		Options:         param.Options,
+		UseDenyOption:   param.UseDenyOption,
+		ProposalSeed:    param.ProposalSeed,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	proposal := mustAddress(GetProposalAddress(param.ProgramID, param.Governance, param.GoverningTokenMint, param.ProposalSeed))
+	accounts := []types.AccountMeta{
+		{PubKey: param.Realm, IsSigner: false, IsWritable: false},
+		{PubKey: proposal, IsSigner: false, IsWritable: true},
+		{PubKey: param.Governance, IsSigner: false, IsWritable: true},
+		{PubKey: param.ProposalOwnerRecord, IsSigner: false, IsWritable: true},
+		{PubKey: param.GoverningTokenMint, IsSigner: false, IsWritable: false},
+		{PubKey: param.GovernanceAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+	}
+	accounts = withRealmConfigAccounts(accounts, param.ProgramID, param.Realm, param.VoterWeightRecord, nil)
+	accounts = append(accounts, types.AccountMeta{PubKey: mustAddress(GetProposalDepositAddress(param.ProgramID, proposal, param.Payer)), IsSigner: false, IsWritable: true})
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type AccountMetaData struct {
+	PubKey     common.PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+type InstructionData struct {
+	ProgramID common.PublicKey
+	Accounts  []AccountMetaData
+	Data      []byte
+}
+
+type InsertTransactionParam struct {
+	ProgramID           common.PublicKey
+	Governance          common.PublicKey
+	Proposal            common.PublicKey
+	TokenOwnerRecord    common.PublicKey
+	GovernanceAuthority common.PublicKey
+	Payer               common.PublicKey
+	OptionIndex         uint8
+	Index               uint16
+	// Instructions are executed by the governance, the governance or its native treasury can be a signer
+	Instructions []types.Instruction
+}
+
+// InsertTransaction adds a transaction to an option of a draft proposal
+func InsertTransaction(param InsertTransactionParam) types.Instruction {
+	instructions := make([]InstructionData, 0, len(param.Instructions))
+	for _, instruction := range param.Instructions {
+		accounts := make([]AccountMetaData, 0, len(instruction.Accounts))
+		for _, account := range instruction.Accounts {
+			accounts = append(accounts, AccountMetaData{
+				PubKey:     account.PubKey,
+				IsSigner:   account.IsSigner,
+				IsWritable: account.IsWritable,
+			})
+		}
+		instructions = append(instructions, InstructionData{
+			ProgramID: instruction.ProgramID,
+			Accounts:  accounts,
+			Data:      instruction.Data,
+		})
+	}
+
+	data, err := borsh.Serialize(struct {
+		Instruction  Instruction
+		OptionIndex  uint8
+		Index        uint16
+		Legacy       uint32
+		Instructions []InstructionData
+	}{
+		Instruction:  InstructionInsertTransaction,
+		OptionIndex:  param.OptionIndex,
+		Index:        param.Index,
+		Instructions: instructions,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Governance, IsSigner: false, IsWritable: false},
+			{PubKey: param.Proposal, IsSigner: false, IsWritable: true},
+			{PubKey: param.TokenOwnerRecord, IsSigner: false, IsWritable: false},
+			{PubKey: param.GovernanceAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: mustAddress(GetProposalTransactionAddress(param.ProgramID, param.Proposal, param.OptionIndex, param.Index)), IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type SignOffProposalParam struct {
+	ProgramID  common.PublicKey
+	Realm      common.PublicKey
+	Governance common.PublicKey
+	Proposal   common.PublicKey
+	Signatory  common.PublicKey
+	// ProposalOwnerRecord is required if the proposal has no signatories and the owner signs it off,
+	// otherwise the signatory record of Signatory is used
+	ProposalOwnerRecord *common.PublicKey
+}
+
+// SignOffProposal moves a draft proposal to voting once all signatories signed it off
+func SignOffProposal(param SignOffProposalParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionSignOffProposal,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Realm, IsSigner: false, IsWritable: false},
+		{PubKey: param.Governance, IsSigner: false, IsWritable: false},
+		{PubKey: param.Proposal, IsSigner: false, IsWritable: true},
+		{PubKey: param.Signatory, IsSigner: true, IsWritable: false},
+	}
+	if param.ProposalOwnerRecord != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.ProposalOwnerRecord, IsSigner: false, IsWritable: false})
+	} else {
+		accounts = append(accounts, types.AccountMeta{PubKey: mustAddress(GetSignatoryRecordAddress(param.ProgramID, param.Proposal, param.Signatory)), IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type CastVoteParam struct {
+	ProgramID             common.PublicKey
+	Realm                 common.PublicKey
+	Governance            common.PublicKey
+	Proposal              common.PublicKey
+	ProposalOwnerRecord   common.PublicKey
+	VoterTokenOwnerRecord common.PublicKey
+	// GovernanceAuthority is the voter or its governance delegate
+	GovernanceAuthority common.PublicKey
+	// VoteGoverningTokenMint is the mint of the voter token owner record
+	VoteGoverningTokenMint common.PublicKey
+	Payer                  common.PublicKey
+	Vote                   Vote
+	VoterWeightRecord      *common.PublicKey
+	MaxVoterWeightRecord   *common.PublicKey
+}
+
+func CastVote(param CastVoteParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Vote        Vote
+	}{
+		Instruction: InstructionCastVote,
+		Vote:        param.Vote,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Realm, IsSigner: false, IsWritable: false},
+		{PubKey: param.Governance, IsSigner: false, IsWritable: true},
+		{PubKey: param.Proposal, IsSigner: false, IsWritable: true},
+		{PubKey: param.ProposalOwnerRecord, IsSigner: false, IsWritable: true},
+		{PubKey: param.VoterTokenOwnerRecord, IsSigner: false, IsWritable: true},
+		{PubKey: param.GovernanceAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: mustAddress(GetVoteRecordAddress(param.ProgramID, param.Proposal, param.VoterTokenOwnerRecord)), IsSigner: false, IsWritable: true},
+		{PubKey: param.VoteGoverningTokenMint, IsSigner: false, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+	}
+	accounts = withRealmConfigAccounts(accounts, param.ProgramID, param.Realm, param.VoterWeightRecord, param.MaxVoterWeightRecord)
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type ExecuteTransactionParam struct {
+	ProgramID           common.PublicKey
+	Governance          common.PublicKey
+	Proposal            common.PublicKey
+	ProposalTransaction common.PublicKey
+	// Instructions are the instructions stored in the proposal transaction, their programs and accounts are passed to the program
+	Instructions []types.Instruction
+}
+
+// ExecuteTransaction executes a transaction of a succeeded proposal after its hold up time
+func ExecuteTransaction(param ExecuteTransactionParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionExecuteTransaction,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Governance, IsSigner: false, IsWritable: false},
+		{PubKey: param.Proposal, IsSigner: false, IsWritable: true},
+		{PubKey: param.ProposalTransaction, IsSigner: false, IsWritable: true},
+	}
+	for _, instruction := range param.Instructions {
+		accounts = append(accounts, types.AccountMeta{PubKey: instruction.ProgramID, IsSigner: false, IsWritable: false})
+		for _, account := range instruction.Accounts {
+			// the governance signs by invoke_signed
+			accounts = append(accounts, types.AccountMeta{PubKey: account.PubKey, IsSigner: false, IsWritable: account.IsWritable})
+		}
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+func withRealmConfigAccounts(accounts []types.AccountMeta, programID, realm common.PublicKey, voterWeightRecord, maxVoterWeightRecord *common.PublicKey) []types.AccountMeta {
+	accounts = append(accounts, types.AccountMeta{PubKey: mustAddress(GetRealmConfigAddress(programID, realm)), IsSigner: false, IsWritable: false})
+	if voterWeightRecord != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *voterWeightRecord, IsSigner: false, IsWritable: false})
+	}
+	if maxVoterWeightRecord != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *maxVoterWeightRecord, IsSigner: false, IsWritable: false})
+	}
+	return accounts
+}
+
+func mustAddress(pubkey common.PublicKey, err error) common.PublicKey {
+	if err != nil {
+		panic(err)
+	}
+	return pubkey
+}
@@ -0,0 +1,212 @@
+package governance
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testRealm  = common.PublicKeyFromString("DPiH3H3c7t47BMxqTxLsuPQpEC6Kne8GA9VXbxpnZxFE")
+	testMint   = common.PublicKeyFromString("DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263")
+	testOwner  = common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	testSource = common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+)
+
+func TestCreateRealm(t *testing.T) {
+	council := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	got := CreateRealm(CreateRealmParam{
+		Name:               "dao",
+		RealmAuthority:     testOwner,
+		CommunityTokenMint: testMint,
+		Payer:              testOwner,
+		CouncilTokenMint:   &council,
+		CommunityMintMaxVoterWeightSource: MintMaxVoterWeightSource{
+			SupplyFraction: MintMaxVoterWeightSourceValue{Value: MintMaxVoterWeightSourceSupplyFractionBase},
+		},
+		MinCommunityWeightToCreateGovernance: 1,
+		CouncilTokenType:                     GoverningTokenTypeMembership,
+	})
+
+	realm, _ := GetRealmAddress(common.PublicKey{}, "dao")
+	communityHolding, _ := GetGoverningTokenHoldingAddress(common.PublicKey{}, realm, testMint)
+	councilHolding, _ := GetGoverningTokenHoldingAddress(common.PublicKey{}, realm, council)
+	realmConfig, _ := GetRealmConfigAddress(common.PublicKey{}, realm)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLGovernanceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: realm, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: false, IsWritable: false},
+			{PubKey: testMint, IsSigner: false, IsWritable: false},
+			{PubKey: communityHolding, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: council, IsSigner: false, IsWritable: false},
+			{PubKey: councilHolding, IsSigner: false, IsWritable: true},
+			{PubKey: realmConfig, IsSigner: false, IsWritable: true},
+		},
+		Data: []byte{
+			0,
+			3, 0, 0, 0, 'd', 'a', 'o',
+			1,
+			1, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0xe4, 0x0b, 0x54, 0x02, 0, 0, 0,
+			0, 0, 0,
+			0, 0, 1,
+		},
+	}, got)
+}
+
+func TestDepositGoverningTokens(t *testing.T) {
+	programID := common.PublicKeyFromString("GTesTBiEWE32WHXXE2S4XbZvA5CrEc4xs6ZgRe895dP")
+	got := DepositGoverningTokens(DepositGoverningTokensParam{
+		ProgramID:                     programID,
+		Realm:                         testRealm,
+		GoverningTokenMint:            testMint,
+		GoverningTokenSource:          testSource,
+		GoverningTokenOwner:           testOwner,
+		GoverningTokenSourceAuthority: testOwner,
+		Payer:                         testOwner,
+		Amount:                        258,
+	})
+
+	holding, _ := GetGoverningTokenHoldingAddress(programID, testRealm, testMint)
+	record, _ := GetTokenOwnerRecordAddress(programID, testRealm, testMint, testOwner)
+	realmConfig, _ := GetRealmConfigAddress(programID, testRealm)
+	assert.Equal(t, types.Instruction{
+		ProgramID: programID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testRealm, IsSigner: false, IsWritable: false},
+			{PubKey: holding, IsSigner: false, IsWritable: true},
+			{PubKey: testSource, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: false},
+			{PubKey: testOwner, IsSigner: true, IsWritable: false},
+			{PubKey: record, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: realmConfig, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{1, 2, 1, 0, 0, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestCastVote(t *testing.T) {
+	governance := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	proposal := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	ownerRecord := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	voterRecord := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+
+	tests := []struct {
+		name     string
+		vote     Vote
+		wantData []byte
+	}{
+		{
+			name:     "approve",
+			vote:     NewApproveVote([]VoteChoice{{Rank: 0, WeightPercentage: 100}}),
+			wantData: []byte{13, 0, 1, 0, 0, 0, 0, 100},
+		},
+		{
+			name:     "deny",
+			vote:     Vote{Enum: VoteDeny},
+			wantData: []byte{13, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CastVote(CastVoteParam{
+				Realm:                  testRealm,
+				Governance:             governance,
+				Proposal:               proposal,
+				ProposalOwnerRecord:    ownerRecord,
+				VoterTokenOwnerRecord:  voterRecord,
+				GovernanceAuthority:    testOwner,
+				VoteGoverningTokenMint: testMint,
+				Payer:                  testOwner,
+				Vote:                   tt.vote,
+			})
+
+			voteRecord, _ := GetVoteRecordAddress(common.PublicKey{}, proposal, voterRecord)
+			realmConfig, _ := GetRealmConfigAddress(common.PublicKey{}, testRealm)
+			assert.Equal(t, types.Instruction{
+				ProgramID: common.SPLGovernanceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testRealm, IsSigner: false, IsWritable: false},
+					{PubKey: governance, IsSigner: false, IsWritable: true},
+					{PubKey: proposal, IsSigner: false, IsWritable: true},
+					{PubKey: ownerRecord, IsSigner: false, IsWritable: true},
+					{PubKey: voterRecord, IsSigner: false, IsWritable: true},
+					{PubKey: testOwner, IsSigner: true, IsWritable: false},
+					{PubKey: voteRecord, IsSigner: false, IsWritable: true},
+					{PubKey: testMint, IsSigner: false, IsWritable: false},
+					{PubKey: testOwner, IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: realmConfig, IsSigner: false, IsWritable: false},
+				},
+				Data: tt.wantData,
+			}, got)
+		})
+	}
+}
+
+func TestInsertAndExecuteTransaction(t *testing.T) {
+	governance := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	proposal := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	treasury, _ := GetNativeTreasuryAddress(common.PublicKey{}, governance)
+	instruction := types.Instruction{
+		ProgramID: common.SystemProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: treasury, IsSigner: true, IsWritable: true},
+			{PubKey: testOwner, IsSigner: false, IsWritable: true},
+		},
+		Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+	}
+
+	insert := InsertTransaction(InsertTransactionParam{
+		Governance:          governance,
+		Proposal:            proposal,
+		TokenOwnerRecord:    testSource,
+		GovernanceAuthority: testOwner,
+		Payer:               testOwner,
+		OptionIndex:         0,
+		Index:               1,
+		Instructions:        []types.Instruction{instruction},
+	})
+	proposalTransaction, _ := GetProposalTransactionAddress(common.PublicKey{}, proposal, 0, 1)
+	assert.Equal(t, proposalTransaction, insert.Accounts[4].PubKey)
+
+	wantData := []byte{9, 0, 1, 0, 0, 0, 0, 0, 1, 0, 0, 0}
+	wantData = append(wantData, common.SystemProgramID.Bytes()...)
+	wantData = append(wantData, 2, 0, 0, 0)
+	wantData = append(wantData, treasury.Bytes()...)
+	wantData = append(wantData, 1, 1)
+	wantData = append(wantData, testOwner.Bytes()...)
+	wantData = append(wantData, 0, 1)
+	wantData = append(wantData, 12, 0, 0, 0)
+	wantData = append(wantData, instruction.Data...)
+	assert.Equal(t, wantData, insert.Data)
+
+	execute := ExecuteTransaction(ExecuteTransactionParam{
+		Governance:          governance,
+		Proposal:            proposal,
+		ProposalTransaction: proposalTransaction,
+		Instructions:        []types.Instruction{instruction},
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLGovernanceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: governance, IsSigner: false, IsWritable: false},
+			{PubKey: proposal, IsSigner: false, IsWritable: true},
+			{PubKey: proposalTransaction, IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: treasury, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: false, IsWritable: true},
+		},
+		Data: []byte{16},
+	}, execute)
+}
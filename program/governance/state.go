@@ -0,0 +1,354 @@
+package governance
+
+import (
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+)
+
+type GovernanceAccountType borsh.Enum
+
+const (
+	GovernanceAccountTypeUninitialized GovernanceAccountType = iota
+	GovernanceAccountTypeRealmV1
+	GovernanceAccountTypeTokenOwnerRecordV1
+	GovernanceAccountTypeGovernanceV1
+	GovernanceAccountTypeProgramGovernanceV1
+	GovernanceAccountTypeProposalV1
+	GovernanceAccountTypeSignatoryRecordV1
+	GovernanceAccountTypeVoteRecordV1
+	GovernanceAccountTypeProposalInstructionV1
+	GovernanceAccountTypeMintGovernanceV1
+	GovernanceAccountTypeTokenGovernanceV1
+	GovernanceAccountTypeRealmConfig
+	GovernanceAccountTypeVoteRecordV2
+	GovernanceAccountTypeProposalTransactionV2
+	GovernanceAccountTypeProposalV2
+	GovernanceAccountTypeProgramMetadata
+	GovernanceAccountTypeRealmV2
+	GovernanceAccountTypeTokenOwnerRecordV2
+	GovernanceAccountTypeGovernanceV2
+	GovernanceAccountTypeProgramGovernanceV2
+	GovernanceAccountTypeMintGovernanceV2
+	GovernanceAccountTypeTokenGovernanceV2
+	GovernanceAccountTypeSignatoryRecordV2
+	GovernanceAccountTypeProposalDeposit
+	GovernanceAccountTypeRequiredSignatory
+)
+
+// MintMaxVoterWeightSourceSupplyFractionBase is 100% of the mint supply
+const MintMaxVoterWeightSourceSupplyFractionBase uint64 = 10_000_000_000
+
+const (
+	MintMaxVoterWeightSourceSupplyFraction borsh.Enum = iota
+	MintMaxVoterWeightSourceAbsolute
+)
+
+// MintMaxVoterWeightSource is the max vote weight of a governing mint.
+// use {SupplyFraction: {MintMaxVoterWeightSourceSupplyFractionBase}} for the full supply.
+type MintMaxVoterWeightSource struct {
+	Enum           borsh.Enum `borsh_enum:"true"`
+	SupplyFraction MintMaxVoterWeightSourceValue
+	Absolute       MintMaxVoterWeightSourceValue
+}
+
+type MintMaxVoterWeightSourceValue struct {
+	Value uint64
+}
+
+type GoverningTokenType borsh.Enum
+
+const (
+	GoverningTokenTypeLiquid GoverningTokenType = iota
+	GoverningTokenTypeMembership
+	GoverningTokenTypeDormant
+)
+
+type RealmConfig struct {
+	Legacy1                              uint8
+	Legacy2                              uint8
+	Reserved                             [6]uint8
+	MinCommunityWeightToCreateGovernance uint64
+	CommunityMintMaxVoterWeightSource    MintMaxVoterWeightSource
+	CouncilMint                          *common.PublicKey
+}
+
+type Realm struct {
+	AccountType   GovernanceAccountType
+	CommunityMint common.PublicKey
+	Config        RealmConfig
+	Reserved      [6]uint8
+	Legacy1       uint16
+	Authority     *common.PublicKey
+	Name          string
+}
+
+type TokenOwnerRecord struct {
+	AccountType                 GovernanceAccountType
+	Realm                       common.PublicKey
+	GoverningTokenMint          common.PublicKey
+	GoverningTokenOwner         common.PublicKey
+	GoverningTokenDepositAmount uint64
+	UnrelinquishedVotesCount    uint64
+	OutstandingProposalCount    uint8
+	Version                     uint8
+	Reserved                    [6]uint8
+	GovernanceDelegate          *common.PublicKey
+}
+
+const (
+	VoteThresholdYesVotePercentage borsh.Enum = iota
+	VoteThresholdQuorumPercentage
+	VoteThresholdDisabled
+)
+
+// VoteThreshold is either a yes vote percentage, a quorum percentage or disabled
+type VoteThreshold struct {
+	Enum              borsh.Enum `borsh_enum:"true"`
+	YesVotePercentage VoteThresholdPercentage
+	QuorumPercentage  VoteThresholdPercentage
+	Disabled          struct{}
+}
+
+type VoteThresholdPercentage struct {
+	Percentage uint8
+}
+
+type VoteTipping borsh.Enum
+
+const (
+	VoteTippingStrict VoteTipping = iota
+	VoteTippingEarly
+	VoteTippingDisabled
+)
+
+type GovernanceConfig struct {
+	CommunityVoteThreshold             VoteThreshold
+	MinCommunityWeightToCreateProposal uint64
+	MinTransactionHoldUpTime           uint32
+	VotingBaseTime                     uint32
+	CommunityVoteTipping               VoteTipping
+	CouncilVoteThreshold               VoteThreshold
+	CouncilVetoVoteThreshold           VoteThreshold
+	MinCouncilWeightToCreateProposal   uint64
+	CouncilVoteTipping                 VoteTipping
+	CommunityVetoVoteThreshold         VoteThreshold
+	VotingCoolOffTime                  uint32
+	DepositExemptProposalCount         uint8
+}
+
+type Governance struct {
+	AccountType              GovernanceAccountType
+	Realm                    common.PublicKey
+	GovernanceSeed           common.PublicKey
+	Reserved1                uint32
+	Config                   GovernanceConfig
+	ReservedV2               [119]uint8
+	RequiredSignatoriesCount uint8
+	ActiveProposalCount      uint64
+}
+
+type ProposalState borsh.Enum
+
+const (
+	ProposalStateDraft ProposalState = iota
+	ProposalStateSigningOff
+	ProposalStateVoting
+	ProposalStateSucceeded
+	ProposalStateExecuting
+	ProposalStateCompleted
+	ProposalStateCancelled
+	ProposalStateDefeated
+	ProposalStateExecutingWithErrors
+	ProposalStateVetoed
+)
+
+type MultiChoiceType borsh.Enum
+
+const (
+	MultiChoiceTypeFullWeight MultiChoiceType = iota
+	MultiChoiceTypeWeighted
+)
+
+const (
+	VoteTypeSingleChoice borsh.Enum = iota
+	VoteTypeMultiChoice
+)
+
+// VoteType is either single choice or multi choice
+type VoteType struct {
+	Enum         borsh.Enum `borsh_enum:"true"`
+	SingleChoice struct{}
+	MultiChoice  MultiChoiceVoteType
+}
+
+type MultiChoiceVoteType struct {
+	ChoiceType        MultiChoiceType
+	MinVoterOptions   uint8
+	MaxVoterOptions   uint8
+	MaxWinningOptions uint8
+}
+
+type OptionVoteResult borsh.Enum
+
+const (
+	OptionVoteResultNone OptionVoteResult = iota
+	OptionVoteResultSucceeded
+	OptionVoteResultDefeated
+)
+
+type ProposalOption struct {
+	Label                     string
+	VoteWeight                uint64
+	VoteResult                OptionVoteResult
+	TransactionsExecutedCount uint16
+	TransactionsCount         uint16
+	TransactionsNextIndex     uint16
+}
+
+type InstructionExecutionFlags borsh.Enum
+
+const (
+	InstructionExecutionFlagsNone InstructionExecutionFlags = iota
+	InstructionExecutionFlagsOrdered
+	InstructionExecutionFlagsUseTransaction
+)
+
+type Proposal struct {
+	AccountType               GovernanceAccountType
+	Governance                common.PublicKey
+	GoverningTokenMint        common.PublicKey
+	State                     ProposalState
+	TokenOwnerRecord          common.PublicKey
+	SignatoriesCount          uint8
+	SignatoriesSignedOffCount uint8
+	VoteType                  VoteType
+	Options                   []ProposalOption
+	DenyVoteWeight            *uint64
+	Reserved1                 uint8
+	AbstainVoteWeight         *uint64
+	StartVotingAt             *int64
+	DraftAt                   int64
+	SigningOffAt              *int64
+	VotingAt                  *int64
+	VotingAtSlot              *uint64
+	VotingCompletedAt         *int64
+	ExecutingAt               *int64
+	ClosedAt                  *int64
+	ExecutionFlags            InstructionExecutionFlags
+	MaxVoteWeight             *uint64
+	MaxVotingTime             *uint32
+	VoteThreshold             *VoteThreshold
+	Reserved                  [64]uint8
+	Name                      string
+	DescriptionLink           string
+	VetoVoteWeight            uint64
+}
+
+type VoteChoice struct {
+	Rank             uint8
+	WeightPercentage uint8
+}
+
+// Vote is the vote cast on a proposal, use NewApproveVote for an approve vote
+type Vote struct {
+	Enum    borsh.Enum `borsh_enum:"true"`
+	Approve ApproveVote
+	Deny    struct{}
+	Abstain struct{}
+	Veto    struct{}
+}
+
+type ApproveVote struct {
+	Choices []VoteChoice
+}
+
+const (
+	VoteApprove borsh.Enum = iota
+	VoteDeny
+	VoteAbstain
+	VoteVeto
+)
+
+// NewApproveVote approves the options with the weight percentages, use []VoteChoice{{WeightPercentage: 100}} for a single choice proposal
+func NewApproveVote(choices []VoteChoice) Vote {
+	return Vote{Enum: VoteApprove, Approve: ApproveVote{Choices: choices}}
+}
+
+type VoteRecord struct {
+	AccountType         GovernanceAccountType
+	Proposal            common.PublicKey
+	GoverningTokenOwner common.PublicKey
+	IsRelinquished      bool
+	VoterWeight         uint64
+	Vote                Vote
+}
+
+func RealmDeserialize(data []byte) (Realm, error) {
+	var realm Realm
+	if err := deserialize(data, &realm, GovernanceAccountTypeRealmV2); err != nil {
+		return Realm{}, err
+	}
+	return realm, nil
+}
+
+func TokenOwnerRecordDeserialize(data []byte) (TokenOwnerRecord, error) {
+	var record TokenOwnerRecord
+	if err := deserialize(data, &record, GovernanceAccountTypeTokenOwnerRecordV2); err != nil {
+		return TokenOwnerRecord{}, err
+	}
+	return record, nil
+}
+
+// GovernanceDeserialize parses a GovernanceV2 account, it also accepts program, mint and token governances
+func GovernanceDeserialize(data []byte) (Governance, error) {
+	var governance Governance
+	if err := deserialize(data, &governance,
+		GovernanceAccountTypeGovernanceV2,
+		GovernanceAccountTypeProgramGovernanceV2,
+		GovernanceAccountTypeMintGovernanceV2,
+		GovernanceAccountTypeTokenGovernanceV2,
+	); err != nil {
+		return Governance{}, err
+	}
+	return governance, nil
+}
+
+func ProposalDeserialize(data []byte) (Proposal, error) {
+	var proposal Proposal
+	if err := deserialize(data, &proposal, GovernanceAccountTypeProposalV2); err != nil {
+		return Proposal{}, err
+	}
+	return proposal, nil
+}
+
+func VoteRecordDeserialize(data []byte) (VoteRecord, error) {
+	var record VoteRecord
+	if err := deserialize(data, &record, GovernanceAccountTypeVoteRecordV2); err != nil {
+		return VoteRecord{}, err
+	}
+	return record, nil
+}
+
+func deserialize(data []byte, v any, accountTypes ...GovernanceAccountType) error {
+	if len(data) == 0 {
+		return ErrInvalidAccountDataSize
+	}
+	accountType := GovernanceAccountType(data[0])
+	matched := false
+	for _, t := range accountTypes {
+		if t == accountType {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return fmt.Errorf("%w: %v", ErrInvalidAccountType, accountType)
+	}
+	// the trailing reserved space is not parsed
+	if err := borsh.Deserialize(v, data); err != nil {
+		return fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return nil
+}
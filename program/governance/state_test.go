@@ -0,0 +1,136 @@
+package governance
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealmDeserialize(t *testing.T) {
+	council := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	want := Realm{
+		AccountType:   GovernanceAccountTypeRealmV2,
+		CommunityMint: testMint,
+		Config: RealmConfig{
+			MinCommunityWeightToCreateGovernance: 1000,
+			CommunityMintMaxVoterWeightSource: MintMaxVoterWeightSource{
+				Enum:     MintMaxVoterWeightSourceAbsolute,
+				Absolute: MintMaxVoterWeightSourceValue{Value: 5},
+			},
+			CouncilMint: &council,
+		},
+		Authority: &testOwner,
+		Name:      "dao",
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+	// reserved space
+	data = append(data, make([]byte, 128)...)
+
+	got, err := RealmDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	data[0] = uint8(GovernanceAccountTypeRealmV1)
+	_, err = RealmDeserialize(data)
+	assert.ErrorIs(t, err, ErrInvalidAccountType)
+
+	_, err = RealmDeserialize(nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountDataSize)
+}
+
+func TestTokenOwnerRecordDeserialize(t *testing.T) {
+	want := TokenOwnerRecord{
+		AccountType:                 GovernanceAccountTypeTokenOwnerRecordV2,
+		Realm:                       testRealm,
+		GoverningTokenMint:          testMint,
+		GoverningTokenOwner:         testOwner,
+		GoverningTokenDepositAmount: 100,
+		UnrelinquishedVotesCount:    2,
+		OutstandingProposalCount:    1,
+		Version:                     1,
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+
+	got, err := TokenOwnerRecordDeserialize(append(data, make([]byte, 128)...))
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestGovernanceDeserialize(t *testing.T) {
+	want := Governance{
+		AccountType:    GovernanceAccountTypeGovernanceV2,
+		Realm:          testRealm,
+		GovernanceSeed: testOwner,
+		Config: GovernanceConfig{
+			CommunityVoteThreshold: VoteThreshold{
+				Enum:              VoteThresholdYesVotePercentage,
+				YesVotePercentage: VoteThresholdPercentage{Percentage: 60},
+			},
+			MinCommunityWeightToCreateProposal: 1,
+			VotingBaseTime:                     259200,
+			CommunityVoteTipping:               VoteTippingEarly,
+			CouncilVoteThreshold:               VoteThreshold{Enum: VoteThresholdDisabled},
+			CouncilVetoVoteThreshold:           VoteThreshold{Enum: VoteThresholdDisabled},
+			CouncilVoteTipping:                 VoteTippingDisabled,
+			CommunityVetoVoteThreshold:         VoteThreshold{Enum: VoteThresholdDisabled},
+		},
+		ActiveProposalCount: 3,
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+
+	got, err := GovernanceDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestProposalDeserialize(t *testing.T) {
+	denyVoteWeight := uint64(10)
+	votingAt := int64(1700000000)
+	want := Proposal{
+		AccountType:        GovernanceAccountTypeProposalV2,
+		Governance:         testOwner,
+		GoverningTokenMint: testMint,
+		State:              ProposalStateVoting,
+		TokenOwnerRecord:   testSource,
+		VoteType:           VoteType{Enum: VoteTypeSingleChoice},
+		Options: []ProposalOption{
+			{Label: "Approve", VoteWeight: 20, TransactionsCount: 1, TransactionsNextIndex: 1},
+		},
+		DenyVoteWeight: &denyVoteWeight,
+		DraftAt:        1699990000,
+		VotingAt:       &votingAt,
+		VoteThreshold: &VoteThreshold{
+			Enum:             VoteThresholdQuorumPercentage,
+			QuorumPercentage: VoteThresholdPercentage{Percentage: 10},
+		},
+		Name:            "proposal",
+		DescriptionLink: "https://example.com",
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+
+	got, err := ProposalDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestVoteRecordDeserialize(t *testing.T) {
+	want := VoteRecord{
+		AccountType:         GovernanceAccountTypeVoteRecordV2,
+		Proposal:            testRealm,
+		GoverningTokenOwner: testOwner,
+		VoterWeight:         50,
+		Vote:                NewApproveVote([]VoteChoice{{WeightPercentage: 100}}),
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+
+	got, err := VoteRecordDeserialize(append(data, make([]byte, 8)...))
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
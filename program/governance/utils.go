@@ -0,0 +1,66 @@
+package governance
+
+import (
+	"encoding/binary"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// all helpers take the governance program id since every dao can deploy its own instance,
+// leave it empty to use common.SPLGovernanceProgramID
+
+func GetRealmAddress(programID common.PublicKey, name string) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), []byte(name))
+}
+
+func GetRealmConfigAddress(programID, realm common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("realm-config"), realm.Bytes())
+}
+
+func GetGoverningTokenHoldingAddress(programID, realm, governingTokenMint common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), realm.Bytes(), governingTokenMint.Bytes())
+}
+
+func GetTokenOwnerRecordAddress(programID, realm, governingTokenMint, governingTokenOwner common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), realm.Bytes(), governingTokenMint.Bytes(), governingTokenOwner.Bytes())
+}
+
+func GetGovernanceAddress(programID, realm, governanceSeed common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("account-governance"), realm.Bytes(), governanceSeed.Bytes())
+}
+
+func GetNativeTreasuryAddress(programID, governance common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("native-treasury"), governance.Bytes())
+}
+
+func GetProposalAddress(programID, governance, governingTokenMint, proposalSeed common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), governance.Bytes(), governingTokenMint.Bytes(), proposalSeed.Bytes())
+}
+
+func GetProposalDepositAddress(programID, proposal, payer common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("proposal-deposit"), proposal.Bytes(), payer.Bytes())
+}
+
+func GetProposalTransactionAddress(programID, proposal common.PublicKey, optionIndex uint8, index uint16) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), proposal.Bytes(), []byte{optionIndex}, binary.LittleEndian.AppendUint16(nil, index))
+}
+
+func GetSignatoryRecordAddress(programID, proposal, signatory common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), proposal.Bytes(), signatory.Bytes())
+}
+
+func GetVoteRecordAddress(programID, proposal, tokenOwnerRecord common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("governance"), proposal.Bytes(), tokenOwnerRecord.Bytes())
+}
+
+func findProgramAddress(programID common.PublicKey, seeds ...[]byte) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(seeds, getProgramID(programID))
+	return pubkey, err
+}
+
+func getProgramID(programID common.PublicKey) common.PublicKey {
+	if programID == (common.PublicKey{}) {
+		return common.SPLGovernanceProgramID
+	}
+	return programID
+}
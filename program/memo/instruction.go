@@ -1,10 +1,15 @@
 package memo
 
 import (
+	"errors"
+	"unicode/utf8"
+
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/types"
 )
 
+var ErrInvalidUTF8 = errors.New("memo is not a valid utf-8 string")
+
 type BuildMemoParam struct {
 	SignerPubkeys []common.PublicKey
 	Memo          []byte
@@ -25,3 +30,19 @@ func BuildMemo(param BuildMemoParam) types.Instruction {
 		Data:      param.Memo,
 	}
 }
+
+// ValidateMemo returns ErrInvalidUTF8 if the memo is not a valid utf-8 string, which the program rejects
+func ValidateMemo(memo []byte) error {
+	if !utf8.Valid(memo) {
+		return ErrInvalidUTF8
+	}
+	return nil
+}
+
+// BuildMemoChecked is BuildMemo which validates the memo by ValidateMemo first
+func BuildMemoChecked(param BuildMemoParam) (types.Instruction, error) {
+	if err := ValidateMemo(param.Memo); err != nil {
+		return types.Instruction{}, err
+	}
+	return BuildMemo(param), nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestBuildMemo(t *testing.T) {
@@ -53,3 +54,12 @@ func TestBuildMemo(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildMemoChecked(t *testing.T) {
+	instruction, err := BuildMemoChecked(BuildMemoParam{Memo: []byte("👻")})
+	assert.Nil(t, err)
+	assert.Equal(t, BuildMemo(BuildMemoParam{Memo: []byte("👻")}), instruction)
+
+	_, err = BuildMemoChecked(BuildMemoParam{Memo: []byte{0xff, 0xfe}})
+	assert.ErrorIs(t, err, ErrInvalidUTF8)
+}
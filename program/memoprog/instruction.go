@@ -2,9 +2,12 @@ package memoprog
 
 import (
 	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/memo"
 	"github.com/liangjies/solana-go-sdk/types"
 )
 
+var ErrInvalidUTF8 = memo.ErrInvalidUTF8
+
 type BuildMemoParam struct {
 	SignerPubkeys []common.PublicKey
 	Memo          []byte
@@ -25,3 +28,16 @@ func BuildMemo(param BuildMemoParam) types.Instruction {
 		Data:      param.Memo,
 	}
 }
+
+// ValidateMemo returns ErrInvalidUTF8 if the memo is not a valid utf-8 string, which the program rejects
+func ValidateMemo(m []byte) error {
+	return memo.ValidateMemo(m)
+}
+
+// BuildMemoChecked is BuildMemo which validates the memo by ValidateMemo first
+func BuildMemoChecked(param BuildMemoParam) (types.Instruction, error) {
+	if err := ValidateMemo(param.Memo); err != nil {
+		return types.Instruction{}, err
+	}
+	return BuildMemo(param), nil
+}
@@ -0,0 +1,254 @@
+package bubblegum
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+// Instruction is the anchor discriminator of an instruction
+type Instruction [8]uint8
+
+var (
+	InstructionCreateTree = Instruction{165, 83, 136, 142, 89, 202, 47, 220}
+	InstructionMintV1     = Instruction{145, 98, 192, 118, 184, 147, 118, 104}
+	InstructionTransfer   = Instruction{163, 52, 200, 231, 140, 3, 69, 186}
+	InstructionBurn       = Instruction{116, 110, 29, 56, 107, 219, 42, 93}
+)
+
+type CreateTreeParam struct {
+	// MerkleTree is an account owned by the account compression program, see account_compression.GetMerkleTreeAccountSize
+	MerkleTree    common.PublicKey
+	Payer         common.PublicKey
+	TreeCreator   common.PublicKey
+	MaxDepth      uint32
+	MaxBufferSize uint32
+	// Public allows anyone to mint to the tree, optional
+	Public *bool
+}
+
+// CreateTree initializes the merkle tree and its tree config, the tree creator is the tree delegate
+func CreateTree(param CreateTreeParam) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction   Instruction
+		MaxDepth      uint32
+		MaxBufferSize uint32
+		Public        *bool
+	}{
+		Instruction:   InstructionCreateTree,
+		MaxDepth:      param.MaxDepth,
+		MaxBufferSize: param.MaxBufferSize,
+		Public:        param.Public,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	treeAuthority, err := GetTreeAuthority(param.MerkleTree)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: treeAuthority, IsSigner: false, IsWritable: true},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: param.TreeCreator, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}, nil
+}
+
+type MintV1Param struct {
+	MerkleTree common.PublicKey
+	LeafOwner  common.PublicKey
+	// LeafDelegate default is the leaf owner
+	LeafDelegate common.PublicKey
+	Payer        common.PublicKey
+	// TreeDelegate is the tree creator or the tree delegate, anyone can mint to a public tree
+	TreeDelegate common.PublicKey
+	Metadata     MetadataArgs
+}
+
+// MintV1 mints a compressed nft to the tree, the creators can't be verified by it
+func MintV1(param MintV1Param) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Metadata    MetadataArgs
+	}{
+		Instruction: InstructionMintV1,
+		Metadata:    param.Metadata,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	treeAuthority, err := GetTreeAuthority(param.MerkleTree)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	leafDelegate := param.LeafDelegate
+	if leafDelegate == (common.PublicKey{}) {
+		leafDelegate = param.LeafOwner
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: treeAuthority, IsSigner: false, IsWritable: true},
+			{PubKey: param.LeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: leafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: false},
+			{PubKey: param.TreeDelegate, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}, nil
+}
+
+// LeafParam locates a leaf in the tree, the values are usually fetched from the asset proof of a das api
+type LeafParam struct {
+	Root        [32]uint8
+	DataHash    [32]uint8
+	CreatorHash [32]uint8
+	Nonce       uint64
+	Index       uint32
+	// Proof are the nodes from the leaf to the root, the ones kept in the canopy can be left out
+	Proof []common.PublicKey
+}
+
+type TransferParam struct {
+	MerkleTree common.PublicKey
+	LeafOwner  common.PublicKey
+	// LeafDelegate default is the leaf owner
+	LeafDelegate common.PublicKey
+	// DelegateIsSigner signs by the leaf delegate instead of the leaf owner
+	DelegateIsSigner bool
+	NewLeafOwner     common.PublicKey
+	Leaf             LeafParam
+}
+
+// Transfer transfers a compressed nft to the new leaf owner
+func Transfer(param TransferParam) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Root        [32]uint8
+		DataHash    [32]uint8
+		CreatorHash [32]uint8
+		Nonce       uint64
+		Index       uint32
+	}{
+		Instruction: InstructionTransfer,
+		Root:        param.Leaf.Root,
+		DataHash:    param.Leaf.DataHash,
+		CreatorHash: param.Leaf.CreatorHash,
+		Nonce:       param.Leaf.Nonce,
+		Index:       param.Leaf.Index,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	treeAuthority, err := GetTreeAuthority(param.MerkleTree)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	leafOwner, leafDelegate := leafAccounts(param.LeafOwner, param.LeafDelegate, param.DelegateIsSigner)
+
+	accounts := []types.AccountMeta{
+		{PubKey: treeAuthority, IsSigner: false, IsWritable: false},
+		leafOwner,
+		leafDelegate,
+		{PubKey: param.NewLeafOwner, IsSigner: false, IsWritable: false},
+		{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+		{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+	}
+	accounts = append(accounts, proofAccounts(param.Leaf.Proof)...)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+type BurnParam struct {
+	MerkleTree common.PublicKey
+	LeafOwner  common.PublicKey
+	// LeafDelegate default is the leaf owner
+	LeafDelegate common.PublicKey
+	// DelegateIsSigner signs by the leaf delegate instead of the leaf owner
+	DelegateIsSigner bool
+	Leaf             LeafParam
+}
+
+// Burn replaces the leaf of a compressed nft with an empty node
+func Burn(param BurnParam) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Root        [32]uint8
+		DataHash    [32]uint8
+		CreatorHash [32]uint8
+		Nonce       uint64
+		Index       uint32
+	}{
+		Instruction: InstructionBurn,
+		Root:        param.Leaf.Root,
+		DataHash:    param.Leaf.DataHash,
+		CreatorHash: param.Leaf.CreatorHash,
+		Nonce:       param.Leaf.Nonce,
+		Index:       param.Leaf.Index,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	treeAuthority, err := GetTreeAuthority(param.MerkleTree)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	leafOwner, leafDelegate := leafAccounts(param.LeafOwner, param.LeafDelegate, param.DelegateIsSigner)
+
+	accounts := []types.AccountMeta{
+		{PubKey: treeAuthority, IsSigner: false, IsWritable: false},
+		leafOwner,
+		leafDelegate,
+		{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+		{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+	}
+	accounts = append(accounts, proofAccounts(param.Leaf.Proof)...)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+func leafAccounts(leafOwner, leafDelegate common.PublicKey, delegateIsSigner bool) (types.AccountMeta, types.AccountMeta) {
+	if leafDelegate == (common.PublicKey{}) {
+		leafDelegate = leafOwner
+	}
+	return types.AccountMeta{PubKey: leafOwner, IsSigner: !delegateIsSigner, IsWritable: false},
+		types.AccountMeta{PubKey: leafDelegate, IsSigner: delegateIsSigner, IsWritable: false}
+}
+
+func proofAccounts(proof []common.PublicKey) []types.AccountMeta {
+	accounts := make([]types.AccountMeta, 0, len(proof))
+	for _, node := range proof {
+		accounts = append(accounts, types.AccountMeta{PubKey: node, IsSigner: false, IsWritable: false})
+	}
+	return accounts
+}
@@ -0,0 +1,140 @@
+package bubblegum
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstructionDiscriminator(t *testing.T) {
+	assert.Equal(t, anchor.Discriminator(InstructionCreateTree[:]), anchor.InstructionDiscriminator("create_tree"))
+	assert.Equal(t, anchor.Discriminator(InstructionMintV1[:]), anchor.InstructionDiscriminator("mint_v1"))
+	assert.Equal(t, anchor.Discriminator(InstructionTransfer[:]), anchor.InstructionDiscriminator("transfer"))
+	assert.Equal(t, anchor.Discriminator(InstructionBurn[:]), anchor.InstructionDiscriminator("burn"))
+}
+
+func TestCreateTree(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	payer := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	treeAuthority, err := GetTreeAuthority(merkleTree)
+	assert.NoError(t, err)
+
+	got, err := CreateTree(CreateTreeParam{
+		MerkleTree:    merkleTree,
+		Payer:         payer,
+		TreeCreator:   payer,
+		MaxDepth:      14,
+		MaxBufferSize: 64,
+		Public:        pointer.Get(false),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: treeAuthority, IsSigner: false, IsWritable: true},
+			{PubKey: merkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: payer, IsSigner: true, IsWritable: true},
+			{PubKey: payer, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{165, 83, 136, 142, 89, 202, 47, 220, 14, 0, 0, 0, 64, 0, 0, 0, 1, 0},
+	}, got)
+}
+
+func TestMintV1(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	owner := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	payer := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	treeAuthority, err := GetTreeAuthority(merkleTree)
+	assert.NoError(t, err)
+
+	got, err := MintV1(MintV1Param{
+		MerkleTree:   merkleTree,
+		LeafOwner:    owner,
+		Payer:        payer,
+		TreeDelegate: payer,
+		Metadata:     MetadataArgs{Name: "a", SellerFeeBasisPoints: 1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: treeAuthority, IsSigner: false, IsWritable: true},
+			{PubKey: owner, IsSigner: false, IsWritable: false},
+			{PubKey: owner, IsSigner: false, IsWritable: false},
+			{PubKey: merkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: payer, IsSigner: true, IsWritable: false},
+			{PubKey: payer, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{
+			145, 98, 192, 118, 184, 147, 118, 104,
+			1, 0, 0, 0, 'a',
+			0, 0, 0, 0,
+			0, 0, 0, 0,
+			1, 0,
+			0, 0,
+			0, 0, 0, 0,
+			0,
+			0, 0, 0, 0,
+		},
+	}, got)
+}
+
+func TestTransfer(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	owner := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	delegate := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	newOwner := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	node := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+
+	got, err := Transfer(TransferParam{
+		MerkleTree:       merkleTree,
+		LeafOwner:        owner,
+		LeafDelegate:     delegate,
+		DelegateIsSigner: true,
+		NewLeafOwner:     newOwner,
+		Leaf: LeafParam{
+			Root:        [32]uint8{1},
+			DataHash:    [32]uint8{2},
+			CreatorHash: [32]uint8{3},
+			Nonce:       4,
+			Index:       5,
+			Proof:       []common.PublicKey{node},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got.Accounts, 9)
+	assert.Equal(t, types.AccountMeta{PubKey: owner, IsSigner: false, IsWritable: false}, got.Accounts[1])
+	assert.Equal(t, types.AccountMeta{PubKey: delegate, IsSigner: true, IsWritable: false}, got.Accounts[2])
+	assert.Equal(t, types.AccountMeta{PubKey: newOwner, IsSigner: false, IsWritable: false}, got.Accounts[3])
+	assert.Equal(t, types.AccountMeta{PubKey: node, IsSigner: false, IsWritable: false}, got.Accounts[8])
+	assert.Len(t, got.Data, 8+32*3+8+4)
+	assert.Equal(t, InstructionTransfer[:], got.Data[:8])
+	assert.Equal(t, []byte{4, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0}, got.Data[104:])
+}
+
+func TestBurn(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	owner := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	got, err := Burn(BurnParam{
+		MerkleTree: merkleTree,
+		LeafOwner:  owner,
+		Leaf:       LeafParam{Nonce: 1},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got.Accounts, 7)
+	assert.Equal(t, types.AccountMeta{PubKey: owner, IsSigner: true, IsWritable: false}, got.Accounts[1])
+	assert.Equal(t, types.AccountMeta{PubKey: owner, IsSigner: false, IsWritable: false}, got.Accounts[2])
+	assert.Equal(t, types.AccountMeta{PubKey: merkleTree, IsSigner: false, IsWritable: true}, got.Accounts[3])
+	assert.Equal(t, InstructionBurn[:], got.Data[:8])
+}
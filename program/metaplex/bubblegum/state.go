@@ -0,0 +1,132 @@
+package bubblegum
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/near/borsh-go"
+	"golang.org/x/crypto/sha3"
+)
+
+var TreeConfigDiscriminator = [8]uint8{122, 245, 175, 248, 171, 34, 0, 207}
+
+var ErrInvalidAccountData = errors.New("invalid tree config account data")
+
+type TokenProgramVersion borsh.Enum
+
+const (
+	TokenProgramVersionOriginal TokenProgramVersion = iota
+	TokenProgramVersionToken2022
+)
+
+// MetadataArgs is the metadata of a compressed nft, it is hashed into the leaf instead of being stored in an account
+type MetadataArgs struct {
+	Name                 string
+	Symbol               string
+	Uri                  string
+	SellerFeeBasisPoints uint16
+	PrimarySaleHappened  bool
+	IsMutable            bool
+	EditionNonce         *uint8
+	TokenStandard        *token_metadata.TokenStandard
+	Collection           *token_metadata.Collection
+	Uses                 *token_metadata.Uses
+	TokenProgramVersion  TokenProgramVersion
+	Creators             []token_metadata.Creator
+}
+
+type DecompressibleState borsh.Enum
+
+const (
+	DecompressibleStateEnabled DecompressibleState = iota
+	DecompressibleStateDisabled
+)
+
+// TreeConfig is the tree authority account, it records who can mint to the tree
+type TreeConfig struct {
+	Discriminator     [8]uint8
+	TreeCreator       common.PublicKey
+	TreeDelegate      common.PublicKey
+	TotalMintCapacity uint64
+	NumMinted         uint64
+	IsPublic          bool
+	IsDecompressible  DecompressibleState
+}
+
+func TreeConfigDeserialize(data []byte) (TreeConfig, error) {
+	if len(data) < 8 || !bytes.Equal(data[:8], TreeConfigDiscriminator[:]) {
+		return TreeConfig{}, ErrInvalidAccountData
+	}
+	var treeConfig TreeConfig
+	err := borsh.Deserialize(&treeConfig, data)
+	if err != nil {
+		return TreeConfig{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return treeConfig, nil
+}
+
+// LeafSchema is the content of a leaf of the merkle tree, the leaf is its hash
+type LeafSchema struct {
+	// Id is the asset id, see GetAssetId
+	Id          common.PublicKey
+	Owner       common.PublicKey
+	Delegate    common.PublicKey
+	Nonce       uint64
+	DataHash    [32]uint8
+	CreatorHash [32]uint8
+}
+
+// Hash returns the leaf of the schema, it is keccak256(version, id, owner, delegate, nonce, data hash, creator hash)
+func (l LeafSchema) Hash() [32]uint8 {
+	nonce := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonce, l.Nonce)
+	return keccak256(
+		[]byte{1}, // version 1
+		l.Id.Bytes(),
+		l.Owner.Bytes(),
+		l.Delegate.Bytes(),
+		nonce,
+		l.DataHash[:],
+		l.CreatorHash[:],
+	)
+}
+
+// HashMetadata returns the data hash of the metadata, it is keccak256(keccak256(metadata), seller fee basis points)
+func HashMetadata(metadata MetadataArgs) ([32]uint8, error) {
+	data, err := borsh.Serialize(metadata)
+	if err != nil {
+		return [32]uint8{}, err
+	}
+	metadataHash := keccak256(data)
+	sellerFeeBasisPoints := make([]byte, 2)
+	binary.LittleEndian.PutUint16(sellerFeeBasisPoints, metadata.SellerFeeBasisPoints)
+	return keccak256(metadataHash[:], sellerFeeBasisPoints), nil
+}
+
+// HashCreators returns the creator hash of the creators
+func HashCreators(creators []token_metadata.Creator) [32]uint8 {
+	data := make([]byte, 0, len(creators)*34)
+	for _, creator := range creators {
+		verified := uint8(0)
+		if creator.Verified {
+			verified = 1
+		}
+		data = append(data, creator.Address.Bytes()...)
+		data = append(data, verified, creator.Share)
+	}
+	return keccak256(data)
+}
+
+func keccak256(data ...[]byte) [32]uint8 {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	var hash [32]uint8
+	copy(hash[:], h.Sum(nil))
+	return hash
+}
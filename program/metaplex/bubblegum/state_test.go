@@ -0,0 +1,96 @@
+package bubblegum
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestHashCreators(t *testing.T) {
+	got := HashCreators(nil)
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(got[:]))
+
+	creator := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	got = HashCreators([]token_metadata.Creator{{Address: creator, Verified: true, Share: 100}})
+	h := sha3.NewLegacyKeccak256()
+	h.Write(creator.Bytes())
+	h.Write([]byte{1, 100})
+	assert.Equal(t, h.Sum(nil), got[:])
+}
+
+func TestHashMetadata(t *testing.T) {
+	metadata := MetadataArgs{
+		Name:                 "cNFT",
+		Symbol:               "C",
+		Uri:                  "https://example.com/0.json",
+		SellerFeeBasisPoints: 500,
+		IsMutable:            true,
+		Creators: []token_metadata.Creator{
+			{Address: common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"), Share: 100},
+		},
+	}
+	got, err := HashMetadata(metadata)
+	assert.NoError(t, err)
+
+	data, err := borsh.Serialize(metadata)
+	assert.NoError(t, err)
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	metadataHash := h.Sum(nil)
+	h = sha3.NewLegacyKeccak256()
+	h.Write(metadataHash)
+	h.Write([]byte{0xf4, 0x01})
+	assert.Equal(t, h.Sum(nil), got[:])
+}
+
+func TestLeafSchemaHash(t *testing.T) {
+	leaf := LeafSchema{
+		Id:          common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+		Owner:       common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		Delegate:    common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+		Nonce:       258,
+		DataHash:    [32]uint8{1},
+		CreatorHash: [32]uint8{2},
+	}
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte{1})
+	h.Write(leaf.Id.Bytes())
+	h.Write(leaf.Owner.Bytes())
+	h.Write(leaf.Delegate.Bytes())
+	h.Write([]byte{2, 1, 0, 0, 0, 0, 0, 0})
+	h.Write(leaf.DataHash[:])
+	h.Write(leaf.CreatorHash[:])
+	got := leaf.Hash()
+	assert.Equal(t, h.Sum(nil), got[:])
+}
+
+func TestTreeConfigDeserialize(t *testing.T) {
+	assert.Equal(t, anchor.Discriminator(TreeConfigDiscriminator[:]), anchor.AccountDiscriminator("TreeConfig"))
+
+	treeConfig := TreeConfig{
+		Discriminator:     TreeConfigDiscriminator,
+		TreeCreator:       common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		TreeDelegate:      common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+		TotalMintCapacity: 16384,
+		NumMinted:         3,
+		IsPublic:          false,
+		IsDecompressible:  DecompressibleStateDisabled,
+	}
+	data, err := borsh.Serialize(treeConfig)
+	assert.NoError(t, err)
+	// the account is padded
+	data = append(data, make([]byte, 6)...)
+
+	got, err := TreeConfigDeserialize(data)
+	assert.NoError(t, err)
+	assert.Equal(t, treeConfig, got)
+
+	_, err = TreeConfigDeserialize(data[1:])
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+}
@@ -0,0 +1,53 @@
+package bubblegum
+
+import (
+	"encoding/binary"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// GetTreeAuthority derives the tree config account of a merkle tree
+func GetTreeAuthority(merkleTree common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			merkleTree.Bytes(),
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return pubkey, err
+}
+
+// GetAssetId derives the id of the compressed nft minted with the nonce, the nonce is the number of nfts minted to the tree before it
+func GetAssetId(merkleTree common.PublicKey, nonce uint64) (common.PublicKey, error) {
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, nonce)
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("asset"),
+			merkleTree.Bytes(),
+			nonceBytes,
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return pubkey, err
+}
+
+// NewLeafSchema returns the leaf of the nft minted to the tree with the nonce and the metadata
+func NewLeafSchema(merkleTree common.PublicKey, nonce uint64, owner, delegate common.PublicKey, metadata MetadataArgs) (LeafSchema, error) {
+	assetId, err := GetAssetId(merkleTree, nonce)
+	if err != nil {
+		return LeafSchema{}, err
+	}
+	dataHash, err := HashMetadata(metadata)
+	if err != nil {
+		return LeafSchema{}, err
+	}
+	return LeafSchema{
+		Id:          assetId,
+		Owner:       owner,
+		Delegate:    delegate,
+		Nonce:       nonce,
+		DataHash:    dataHash,
+		CreatorHash: HashCreators(metadata.Creators),
+	}, nil
+}
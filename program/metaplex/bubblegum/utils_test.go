@@ -0,0 +1,41 @@
+package bubblegum
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/account_compression"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLeafSchema(t *testing.T) {
+	merkleTree := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	owner := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	metadata := MetadataArgs{
+		Name:                 "cNFT",
+		Uri:                  "https://example.com/0.json",
+		SellerFeeBasisPoints: 500,
+		Creators:             []token_metadata.Creator{{Address: owner, Share: 100}},
+	}
+
+	leaves := make([][32]uint8, 0, 2)
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		leaf, err := NewLeafSchema(merkleTree, nonce, owner, owner, metadata)
+		assert.NoError(t, err)
+
+		assetId, err := GetAssetId(merkleTree, nonce)
+		assert.NoError(t, err)
+		assert.Equal(t, assetId, leaf.Id)
+		assert.Equal(t, HashCreators(metadata.Creators), leaf.CreatorHash)
+		leaves = append(leaves, leaf.Hash())
+	}
+	assert.NotEqual(t, leaves[0], leaves[1])
+
+	// the proof of a minted leaf is built from the leaves minted to the tree
+	tree, err := account_compression.NewMerkleTree(3, leaves)
+	assert.NoError(t, err)
+	proof, err := tree.Proof(1)
+	assert.NoError(t, err)
+	assert.True(t, account_compression.VerifyProof(tree.Root(), leaves[1], 1, proof))
+}
@@ -0,0 +1,209 @@
+package candy_guard
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/candy_machine"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+// Instruction is the anchor discriminator of an instruction
+type Instruction [8]uint8
+
+var (
+	InstructionInitialize = Instruction{175, 175, 109, 31, 13, 152, 155, 237}
+	InstructionWrap       = Instruction{178, 40, 10, 189, 228, 129, 186, 140}
+	InstructionMintV2     = Instruction{120, 121, 23, 146, 173, 110, 199, 205}
+)
+
+type InitializeParam struct {
+	// Base is a new keypair the candy guard address is derived from
+	Base      common.PublicKey
+	Authority common.PublicKey
+	Payer     common.PublicKey
+	Data      CandyGuardData
+}
+
+// Initialize creates a candy guard at the address derived from the base
+func Initialize(param InitializeParam) (types.Instruction, error) {
+	guardData, err := param.Data.Serialize()
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Data        []byte
+	}{
+		Instruction: InstructionInitialize,
+		Data:        guardData,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	candyGuard, err := GetCandyGuardAddress(param.Base)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyGuardProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: candyGuard, IsSigner: false, IsWritable: true},
+			{PubKey: param.Base, IsSigner: true, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: false, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}, nil
+}
+
+type WrapParam struct {
+	CandyGuard common.PublicKey
+	// Authority is the authority of the candy guard
+	Authority    common.PublicKey
+	CandyMachine common.PublicKey
+	// CandyMachineAuthority is the authority of the candy machine
+	CandyMachineAuthority common.PublicKey
+}
+
+// Wrap sets the candy guard as the mint authority of the candy machine, so the candy machine is minted through the candy guard
+func Wrap(param WrapParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionWrap,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyGuardProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.CandyGuard, IsSigner: false, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: param.CandyMachine, IsSigner: false, IsWritable: true},
+			{PubKey: common.MetaplexCandyMachineV3ProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: param.CandyMachineAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type MintV2Param struct {
+	CandyGuard   common.PublicKey
+	CandyMachine common.PublicKey
+	Payer        common.PublicKey
+	Minter       common.PublicKey
+	// NftMint is a new keypair, the mint is created by the candy machine
+	NftMint                   common.PublicKey
+	NftMintAuthority          common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionUpdateAuthority common.PublicKey
+	TokenStandard             candy_machine.TokenStandard
+	// RuleSet is the rule set of the candy machine, optional
+	RuleSet *common.PublicKey
+	// Group is the label of the group to mint with, optional
+	Group *string
+	// MintArgs are the arguments of the guards, most guards don't need any
+	MintArgs []byte
+	// RemainingAccounts are the accounts of the guards, see GuardSet.MintRemainingAccounts
+	RemainingAccounts []types.AccountMeta
+}
+
+// MintV2 mints the next nft of the candy machine to the associated token account of the minter if the guards pass
+func MintV2(param MintV2Param) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		MintArgs    []byte
+		Group       *string
+	}{
+		Instruction: InstructionMintV2,
+		MintArgs:    param.MintArgs,
+		Group:       param.Group,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	authorityPda, err := candy_machine.GetAuthorityPda(param.CandyMachine)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	nftAccounts, err := candy_machine.NftMintAccounts(candy_machine.NftMintAccountsParam{
+		AuthorityPda:              authorityPda,
+		NftOwner:                  param.Minter,
+		NftMint:                   param.NftMint,
+		NftMintAuthority:          param.NftMintAuthority,
+		CollectionMint:            param.CollectionMint,
+		CollectionUpdateAuthority: param.CollectionUpdateAuthority,
+		TokenStandard:             param.TokenStandard,
+		RuleSet:                   param.RuleSet,
+		Placeholder:               common.MetaplexCandyGuardProgramID,
+	})
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.CandyGuard, IsSigner: false, IsWritable: false},
+		{PubKey: common.MetaplexCandyMachineV3ProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: param.CandyMachine, IsSigner: false, IsWritable: true},
+		{PubKey: authorityPda, IsSigner: false, IsWritable: true},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: param.Minter, IsSigner: true, IsWritable: true},
+	}
+	accounts = append(accounts, nftAccounts...)
+	accounts = append(accounts, param.RemainingAccounts...)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyGuardProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+type MintRemainingAccountsParam struct {
+	CandyGuard   common.PublicKey
+	CandyMachine common.PublicKey
+	Minter       common.PublicKey
+}
+
+// MintRemainingAccounts returns the accounts the guards need on a mint, in the order of the guards.
+// an allow list proof has to be created by the route instruction before the mint.
+func (s GuardSet) MintRemainingAccounts(param MintRemainingAccountsParam) ([]types.AccountMeta, error) {
+	var accounts []types.AccountMeta
+	if s.SolPayment != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: s.SolPayment.Destination, IsSigner: false, IsWritable: true})
+	}
+	if s.TokenPayment != nil {
+		tokenAccount, _, err := common.FindAssociatedTokenAddress(param.Minter, s.TokenPayment.Mint)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts,
+			types.AccountMeta{PubKey: tokenAccount, IsSigner: false, IsWritable: true},
+			types.AccountMeta{PubKey: s.TokenPayment.DestinationAta, IsSigner: false, IsWritable: true},
+		)
+	}
+	if s.ThirdPartySigner != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: s.ThirdPartySigner.SignerKey, IsSigner: true, IsWritable: false})
+	}
+	if s.AllowList != nil {
+		proof, err := GetAllowListProof(s.AllowList.MerkleRoot, param.Minter, param.CandyGuard, param.CandyMachine)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, types.AccountMeta{PubKey: proof, IsSigner: false, IsWritable: false})
+	}
+	if s.MintLimit != nil {
+		counter, err := GetMintLimitCounter(s.MintLimit.Id, param.Minter, param.CandyGuard, param.CandyMachine)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, types.AccountMeta{PubKey: counter, IsSigner: false, IsWritable: true})
+	}
+	return accounts, nil
+}
@@ -0,0 +1,114 @@
+package candy_guard
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/candy_machine"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstructionDiscriminator(t *testing.T) {
+	assert.Equal(t, anchor.Discriminator(InstructionInitialize[:]), anchor.InstructionDiscriminator("initialize"))
+	assert.Equal(t, anchor.Discriminator(InstructionWrap[:]), anchor.InstructionDiscriminator("wrap"))
+	assert.Equal(t, anchor.Discriminator(InstructionMintV2[:]), anchor.InstructionDiscriminator("mint_v2"))
+}
+
+func TestInitialize(t *testing.T) {
+	base := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	candyGuard, err := GetCandyGuardAddress(base)
+	assert.NoError(t, err)
+
+	got, err := Initialize(InitializeParam{
+		Base:      base,
+		Authority: authority,
+		Payer:     authority,
+		Data:      CandyGuardData{Default: GuardSet{StartDate: &StartDate{Date: 1}}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.MetaplexCandyGuardProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: candyGuard, IsSigner: false, IsWritable: true},
+			{PubKey: base, IsSigner: true, IsWritable: false},
+			{PubKey: authority, IsSigner: false, IsWritable: false},
+			{PubKey: authority, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{
+			175, 175, 109, 31, 13, 152, 155, 237,
+			20, 0, 0, 0,
+			8, 0, 0, 0, 0, 0, 0, 0,
+			1, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0,
+		},
+	}, got)
+
+	_, err = Initialize(InitializeParam{Data: CandyGuardData{Groups: []Group{{Label: "too long"}}}})
+	assert.Error(t, err)
+}
+
+func TestMintV2(t *testing.T) {
+	candyGuard := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+	candyMachine := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	minter := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	nftMint := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	collectionMint := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	destination := common.PublicKeyFromString("7FzXBBPjzrNJbm9MrZKZcyvP3ojVeYPUG2XkBPVZvuBu")
+
+	guards := GuardSet{
+		MintLimit:  &MintLimit{Id: 1, Limit: 2},
+		SolPayment: &SolPayment{Lamports: 1, Destination: destination},
+	}
+	remainingAccounts, err := guards.MintRemainingAccounts(MintRemainingAccountsParam{
+		CandyGuard:   candyGuard,
+		CandyMachine: candyMachine,
+		Minter:       minter,
+	})
+	assert.NoError(t, err)
+	counter, err := GetMintLimitCounter(1, minter, candyGuard, candyMachine)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: destination, IsSigner: false, IsWritable: true},
+		{PubKey: counter, IsSigner: false, IsWritable: true},
+	}, remainingAccounts)
+
+	got, err := MintV2(MintV2Param{
+		CandyGuard:                candyGuard,
+		CandyMachine:              candyMachine,
+		Payer:                     minter,
+		Minter:                    minter,
+		NftMint:                   nftMint,
+		NftMintAuthority:          minter,
+		CollectionMint:            collectionMint,
+		CollectionUpdateAuthority: destination,
+		TokenStandard:             candy_machine.TokenStandardNonFungible,
+		Group:                     pointer.Get("vip"),
+		RemainingAccounts:         remainingAccounts,
+	})
+	assert.NoError(t, err)
+
+	authorityPda, err := candy_machine.GetAuthorityPda(candyMachine)
+	assert.NoError(t, err)
+	assert.Len(t, got.Accounts, 27)
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: candyGuard, IsSigner: false, IsWritable: false},
+		{PubKey: common.MetaplexCandyMachineV3ProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: candyMachine, IsSigner: false, IsWritable: true},
+		{PubKey: authorityPda, IsSigner: false, IsWritable: true},
+		{PubKey: minter, IsSigner: true, IsWritable: true},
+		{PubKey: minter, IsSigner: true, IsWritable: true},
+		{PubKey: nftMint, IsSigner: true, IsWritable: true},
+	}, got.Accounts[:7])
+	assert.Equal(t, common.MetaplexCandyGuardProgramID, got.Accounts[11].PubKey)
+	assert.Equal(t, remainingAccounts, got.Accounts[25:])
+	assert.Equal(t, []byte{
+		120, 121, 23, 146, 173, 110, 199, 205,
+		0, 0, 0, 0,
+		1, 3, 0, 0, 0, 'v', 'i', 'p',
+	}, got.Data)
+}
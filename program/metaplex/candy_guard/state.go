@@ -0,0 +1,261 @@
+package candy_guard
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+)
+
+// MaxLabelSize is the max length of the label of a group
+const MaxLabelSize = 6
+
+// DataOffset is the offset of the guards in a candy guard account
+const DataOffset = 8 + 32 + 1 + 32
+
+var CandyGuardDiscriminator = [8]uint8{44, 207, 199, 184, 112, 103, 34, 181}
+
+var (
+	ErrInvalidAccountData = errors.New("invalid candy guard account data")
+	ErrGroupNotFound      = errors.New("group not found")
+)
+
+// BotTax charges lamports for an invalid transaction instead of failing it
+type BotTax struct {
+	Lamports uint64
+	// LastInstruction requires the mint to be the last instruction of the transaction
+	LastInstruction bool
+}
+
+type SolPayment struct {
+	Lamports    uint64
+	Destination common.PublicKey
+}
+
+type TokenPayment struct {
+	Amount         uint64
+	Mint           common.PublicKey
+	DestinationAta common.PublicKey
+}
+
+type StartDate struct {
+	// Date is an unix timestamp
+	Date int64
+}
+
+type ThirdPartySigner struct {
+	SignerKey common.PublicKey
+}
+
+type EndDate struct {
+	// Date is an unix timestamp
+	Date int64
+}
+
+type AllowList struct {
+	MerkleRoot [32]uint8
+}
+
+type MintLimit struct {
+	// Id identifies the counter, guards with the same id share the counter
+	Id    uint8
+	Limit uint16
+}
+
+type RedeemedAmount struct {
+	Maximum uint64
+}
+
+type AddressGate struct {
+	Address common.PublicKey
+}
+
+// GuardSet is a set of guards, nil ones are disabled.
+// the fields are in the order of the guards of the program, see guardBits.
+type GuardSet struct {
+	BotTax           *BotTax
+	SolPayment       *SolPayment
+	TokenPayment     *TokenPayment
+	StartDate        *StartDate
+	ThirdPartySigner *ThirdPartySigner
+	EndDate          *EndDate
+	AllowList        *AllowList
+	MintLimit        *MintLimit
+	RedeemedAmount   *RedeemedAmount
+	AddressGate      *AddressGate
+}
+
+// guardBits are the feature bits of the fields of GuardSet
+var guardBits = []uint{0, 1, 2, 3, 4, 7, 8, 9, 11, 12}
+
+// guardSizes are the serialized sizes of the fields of GuardSet
+var guardSizes = []int{9, 40, 72, 8, 32, 8, 32, 3, 8, 32}
+
+type Group struct {
+	// Label is up to MaxLabelSize bytes
+	Label  string
+	Guards GuardSet
+}
+
+type CandyGuardData struct {
+	Default GuardSet
+	Groups  []Group
+}
+
+type CandyGuard struct {
+	Discriminator [8]uint8
+	// Base is the key the candy guard address is derived from
+	Base      common.PublicKey
+	Bump      uint8
+	Authority common.PublicKey
+
+	Data CandyGuardData `borsh_skip:"true"`
+}
+
+// Serialize encodes the guard set as the features bit mask followed by the enabled guards
+func (s GuardSet) Serialize() ([]byte, error) {
+	var features uint64
+	buf := make([]byte, 8)
+	v := reflect.ValueOf(s)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsNil() {
+			continue
+		}
+		features |= 1 << guardBits[i]
+		b, err := borsh.Serialize(v.Field(i).Elem().Interface())
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, b...)
+	}
+	binary.LittleEndian.PutUint64(buf, features)
+	return buf, nil
+}
+
+// deserializeGuardSet decodes a guard set and returns it with the number of bytes it takes
+func deserializeGuardSet(data []byte) (GuardSet, int, error) {
+	if len(data) < 8 {
+		return GuardSet{}, 0, ErrInvalidAccountData
+	}
+	features := binary.LittleEndian.Uint64(data)
+	offset := 8
+
+	var s GuardSet
+	v := reflect.ValueOf(&s).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if features&(1<<guardBits[i]) == 0 {
+			continue
+		}
+		features &^= 1 << guardBits[i]
+		if len(data) < offset+guardSizes[i] {
+			return GuardSet{}, 0, ErrInvalidAccountData
+		}
+		guard := reflect.New(v.Field(i).Type().Elem())
+		if err := borsh.Deserialize(guard.Interface(), data[offset:offset+guardSizes[i]]); err != nil {
+			return GuardSet{}, 0, fmt.Errorf("failed to deserialize guard, err: %v", err)
+		}
+		v.Field(i).Set(guard)
+		offset += guardSizes[i]
+	}
+	if features != 0 {
+		return GuardSet{}, 0, fmt.Errorf("unsupported guards, features: %b", features)
+	}
+	return s, offset, nil
+}
+
+// Serialize encodes the data as the argument of Initialize, the same as it is stored in a candy guard account
+func (d CandyGuardData) Serialize() ([]byte, error) {
+	buf, err := d.Default.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(d.Groups)))
+	for _, group := range d.Groups {
+		if len(group.Label) > MaxLabelSize {
+			return nil, fmt.Errorf("label is too long, label: %v", group.Label)
+		}
+		label := make([]byte, MaxLabelSize)
+		copy(label, group.Label)
+		buf = append(buf, label...)
+
+		guards, err := group.Guards.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, guards...)
+	}
+	return buf, nil
+}
+
+func CandyGuardDataDeserialize(data []byte) (CandyGuardData, error) {
+	defaultGuards, offset, err := deserializeGuardSet(data)
+	if err != nil {
+		return CandyGuardData{}, err
+	}
+	if len(data) < offset+4 {
+		return CandyGuardData{}, ErrInvalidAccountData
+	}
+	count := binary.LittleEndian.Uint32(data[offset:])
+	offset += 4
+
+	groups := make([]Group, 0, count)
+	for i := uint32(0); i < count; i++ {
+		if len(data) < offset+MaxLabelSize {
+			return CandyGuardData{}, ErrInvalidAccountData
+		}
+		label := string(bytes.TrimRight(data[offset:offset+MaxLabelSize], "\x00"))
+		offset += MaxLabelSize
+
+		guards, n, err := deserializeGuardSet(data[offset:])
+		if err != nil {
+			return CandyGuardData{}, err
+		}
+		offset += n
+		groups = append(groups, Group{Label: label, Guards: guards})
+	}
+	return CandyGuardData{Default: defaultGuards, Groups: groups}, nil
+}
+
+// ActiveGuards returns the guards checked on a mint with the label, the guards of the group take the place of the default ones.
+// an empty label returns the default guards.
+func (d CandyGuardData) ActiveGuards(label string) (GuardSet, error) {
+	if label == "" {
+		return d.Default, nil
+	}
+	for _, group := range d.Groups {
+		if group.Label != label {
+			continue
+		}
+		active := d.Default
+		v := reflect.ValueOf(&active).Elem()
+		g := reflect.ValueOf(group.Guards)
+		for i := 0; i < g.NumField(); i++ {
+			if !g.Field(i).IsNil() {
+				v.Field(i).Set(g.Field(i))
+			}
+		}
+		return active, nil
+	}
+	return GuardSet{}, ErrGroupNotFound
+}
+
+func CandyGuardDeserialize(data []byte) (CandyGuard, error) {
+	if len(data) < DataOffset || !bytes.Equal(data[:8], CandyGuardDiscriminator[:]) {
+		return CandyGuard{}, ErrInvalidAccountData
+	}
+
+	var candyGuard CandyGuard
+	err := borsh.Deserialize(&candyGuard, data)
+	if err != nil {
+		return CandyGuard{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	candyGuard.Data, err = CandyGuardDataDeserialize(data[DataOffset:])
+	if err != nil {
+		return CandyGuard{}, err
+	}
+	return candyGuard, nil
+}
@@ -0,0 +1,114 @@
+package candy_guard
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardSetSerialize(t *testing.T) {
+	guards := GuardSet{
+		StartDate: &StartDate{Date: 1},
+		MintLimit: &MintLimit{Id: 2, Limit: 3},
+	}
+	got, err := guards.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{
+		8, 2, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0,
+		2, 3, 0,
+	}, got)
+}
+
+func TestCandyGuardData(t *testing.T) {
+	destination := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	data := CandyGuardData{
+		Default: GuardSet{
+			BotTax:     &BotTax{Lamports: 10000000, LastInstruction: true},
+			SolPayment: &SolPayment{Lamports: 1000000000, Destination: destination},
+			EndDate:    &EndDate{Date: 1700000000},
+		},
+		Groups: []Group{
+			{
+				Label: "early",
+				Guards: GuardSet{
+					SolPayment:     &SolPayment{Lamports: 500000000, Destination: destination},
+					AllowList:      &AllowList{MerkleRoot: [32]uint8{1, 2, 3}},
+					RedeemedAmount: &RedeemedAmount{Maximum: 100},
+				},
+			},
+			{
+				Label: "public",
+				Guards: GuardSet{
+					TokenPayment:     &TokenPayment{Amount: 1, Mint: destination, DestinationAta: destination},
+					ThirdPartySigner: &ThirdPartySigner{SignerKey: destination},
+					AddressGate:      &AddressGate{Address: destination},
+				},
+			},
+		},
+	}
+	b, err := data.Serialize()
+	assert.NoError(t, err)
+
+	got, err := CandyGuardDataDeserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	active, err := got.ActiveGuards("early")
+	assert.NoError(t, err)
+	assert.Equal(t, GuardSet{
+		BotTax:         &BotTax{Lamports: 10000000, LastInstruction: true},
+		SolPayment:     &SolPayment{Lamports: 500000000, Destination: destination},
+		EndDate:        &EndDate{Date: 1700000000},
+		AllowList:      &AllowList{MerkleRoot: [32]uint8{1, 2, 3}},
+		RedeemedAmount: &RedeemedAmount{Maximum: 100},
+	}, active)
+	// the default guards are not changed
+	assert.Equal(t, uint64(1000000000), got.Default.SolPayment.Lamports)
+
+	_, err = got.ActiveGuards("late")
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+
+	_, err = CandyGuardData{Groups: []Group{{Label: "too long"}}}.Serialize()
+	assert.Error(t, err)
+}
+
+func TestCandyGuardDataDeserializeUnsupportedGuard(t *testing.T) {
+	// gatekeeper
+	_, err := CandyGuardDataDeserialize([]byte{64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.EqualError(t, err, "unsupported guards, features: 1000000")
+}
+
+func TestCandyGuardDeserialize(t *testing.T) {
+	base := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	guardData := CandyGuardData{
+		Default: GuardSet{StartDate: &StartDate{Date: 1}},
+		Groups:  []Group{{Label: "a", Guards: GuardSet{MintLimit: &MintLimit{Id: 1, Limit: 1}}}},
+	}
+
+	data := append([]byte{}, CandyGuardDiscriminator[:]...)
+	data = append(data, base.Bytes()...)
+	data = append(data, 255)
+	data = append(data, authority.Bytes()...)
+	b, err := guardData.Serialize()
+	assert.NoError(t, err)
+	data = append(data, b...)
+
+	got, err := CandyGuardDeserialize(data)
+	assert.NoError(t, err)
+	assert.Equal(t, CandyGuard{
+		Discriminator: CandyGuardDiscriminator,
+		Base:          base,
+		Bump:          255,
+		Authority:     authority,
+		Data:          guardData,
+	}, got)
+
+	_, err = CandyGuardDeserialize(data[:DataOffset-1])
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+
+	assert.Equal(t, anchor.Discriminator(CandyGuardDiscriminator[:]), anchor.AccountDiscriminator("CandyGuard"))
+}
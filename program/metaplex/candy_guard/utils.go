@@ -0,0 +1,45 @@
+package candy_guard
+
+import "github.com/liangjies/solana-go-sdk/common"
+
+// GetCandyGuardAddress derives the candy guard account from its base key
+func GetCandyGuardAddress(base common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("candy_guard"),
+			base.Bytes(),
+		},
+		common.MetaplexCandyGuardProgramID,
+	)
+	return pubkey, err
+}
+
+// GetMintLimitCounter derives the account which counts the mints of the minter for the mint limit guard with the id
+func GetMintLimitCounter(id uint8, minter, candyGuard, candyMachine common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("mint_limit"),
+			{id},
+			minter.Bytes(),
+			candyGuard.Bytes(),
+			candyMachine.Bytes(),
+		},
+		common.MetaplexCandyGuardProgramID,
+	)
+	return pubkey, err
+}
+
+// GetAllowListProof derives the account which records that the minter is verified in the allow list with the merkle root
+func GetAllowListProof(merkleRoot [32]uint8, minter, candyGuard, candyMachine common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("allow_list"),
+			merkleRoot[:],
+			minter.Bytes(),
+			candyGuard.Bytes(),
+			candyMachine.Bytes(),
+		},
+		common.MetaplexCandyGuardProgramID,
+	)
+	return pubkey, err
+}
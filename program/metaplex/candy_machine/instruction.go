@@ -0,0 +1,272 @@
+package candy_machine
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+// Instruction is the anchor discriminator of an instruction
+type Instruction [8]uint8
+
+var (
+	InstructionInitializeV2   = Instruction{67, 153, 175, 39, 218, 16, 38, 32}
+	InstructionAddConfigLines = Instruction{223, 50, 224, 227, 151, 8, 115, 106}
+	InstructionMintV2         = Instruction{120, 121, 23, 146, 173, 110, 199, 205}
+)
+
+type InitializeV2Param struct {
+	// CandyMachine is an account allocated with GetCandyMachineSize bytes and owned by the candy machine program
+	CandyMachine common.PublicKey
+	Authority    common.PublicKey
+	Payer        common.PublicKey
+	// RuleSet is the rule set of the minted programmable nfts, optional
+	RuleSet                   *common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionUpdateAuthority common.PublicKey
+	TokenStandard             TokenStandard
+	Data                      CandyMachineData
+}
+
+// InitializeV2 initializes a candy machine, the collection update authority approves the authority pda as its collection delegate
+func InitializeV2(param InitializeV2Param) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction   Instruction
+		Data          CandyMachineData
+		TokenStandard TokenStandard
+	}{
+		Instruction:   InstructionInitializeV2,
+		Data:          param.Data,
+		TokenStandard: param.TokenStandard,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	authorityPda, err := GetAuthorityPda(param.CandyMachine)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	collectionMetadata, err := token_metadata.GetTokenMetaPubkey(param.CollectionMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	collectionMasterEdition, err := token_metadata.GetMasterEdition(param.CollectionMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	collectionDelegateRecord, err := token_metadata.GetCollectionDelegateRecord(param.CollectionMint, param.CollectionUpdateAuthority, authorityPda)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.CandyMachine, IsSigner: false, IsWritable: true},
+		{PubKey: authorityPda, IsSigner: false, IsWritable: true},
+		{PubKey: param.Authority, IsSigner: false, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		optionalAccount(param.RuleSet, common.MetaplexCandyMachineV3ProgramID),
+		{PubKey: collectionMetadata, IsSigner: false, IsWritable: true},
+		{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+		{PubKey: collectionMasterEdition, IsSigner: false, IsWritable: false},
+		{PubKey: param.CollectionUpdateAuthority, IsSigner: true, IsWritable: true},
+		{PubKey: collectionDelegateRecord, IsSigner: false, IsWritable: true},
+		{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+	}
+	accounts = append(accounts, authorizationRulesAccounts(param.RuleSet, common.MetaplexCandyMachineV3ProgramID)...)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyMachineV3ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+type AddConfigLinesParam struct {
+	CandyMachine common.PublicKey
+	Authority    common.PublicKey
+	// Index is the position of the first line
+	Index       uint32
+	ConfigLines []ConfigLine
+}
+
+// AddConfigLines writes the config lines from index, lines already added are replaced
+func AddConfigLines(param AddConfigLinesParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Index       uint32
+		ConfigLines []ConfigLine
+	}{
+		Instruction: InstructionAddConfigLines,
+		Index:       param.Index,
+		ConfigLines: param.ConfigLines,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyMachineV3ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.CandyMachine, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type MintV2Param struct {
+	CandyMachine common.PublicKey
+	// MintAuthority is the mint authority of the candy machine, a candy machine wrapped by a candy guard is minted through the candy guard
+	MintAuthority common.PublicKey
+	Payer         common.PublicKey
+	NftOwner      common.PublicKey
+	// NftMint is a new keypair, the mint is created by the candy machine
+	NftMint                   common.PublicKey
+	NftMintAuthority          common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionUpdateAuthority common.PublicKey
+	TokenStandard             TokenStandard
+	// RuleSet is the rule set of the candy machine, optional
+	RuleSet *common.PublicKey
+}
+
+// MintV2 mints the next nft of the candy machine to the associated token account of the owner
+func MintV2(param MintV2Param) (types.Instruction, error) {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionMintV2,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	authorityPda, err := GetAuthorityPda(param.CandyMachine)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	nftAccounts, err := NftMintAccounts(NftMintAccountsParam{
+		AuthorityPda:              authorityPda,
+		NftOwner:                  param.NftOwner,
+		NftMint:                   param.NftMint,
+		NftMintAuthority:          param.NftMintAuthority,
+		CollectionMint:            param.CollectionMint,
+		CollectionUpdateAuthority: param.CollectionUpdateAuthority,
+		TokenStandard:             param.TokenStandard,
+		RuleSet:                   param.RuleSet,
+		Placeholder:               common.MetaplexCandyMachineV3ProgramID,
+	})
+	if err != nil {
+		return types.Instruction{}, err
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.CandyMachine, IsSigner: false, IsWritable: true},
+		{PubKey: authorityPda, IsSigner: false, IsWritable: true},
+		{PubKey: param.MintAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: param.NftOwner, IsSigner: false, IsWritable: false},
+	}
+	accounts = append(accounts, nftAccounts...)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexCandyMachineV3ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}, nil
+}
+
+type NftMintAccountsParam struct {
+	AuthorityPda              common.PublicKey
+	NftOwner                  common.PublicKey
+	NftMint                   common.PublicKey
+	NftMintAuthority          common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionUpdateAuthority common.PublicKey
+	TokenStandard             TokenStandard
+	RuleSet                   *common.PublicKey
+	// Placeholder takes the place of the optional accounts which are not used, it is the id of the invoked program
+	Placeholder common.PublicKey
+}
+
+// NftMintAccounts returns the accounts from nft_mint to authorization_rules of mint_v2,
+// they are shared by the candy machine and the candy guard
+func NftMintAccounts(param NftMintAccountsParam) ([]types.AccountMeta, error) {
+	nftMetadata, err := token_metadata.GetTokenMetaPubkey(param.NftMint)
+	if err != nil {
+		return nil, err
+	}
+	nftMasterEdition, err := token_metadata.GetMasterEdition(param.NftMint)
+	if err != nil {
+		return nil, err
+	}
+	token, _, err := common.FindAssociatedTokenAddress(param.NftOwner, param.NftMint)
+	if err != nil {
+		return nil, err
+	}
+	tokenRecord := param.Placeholder
+	if param.TokenStandard == TokenStandardProgrammableNonFungible {
+		tokenRecord, err = token_metadata.GetTokenRecord(param.NftMint, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	collectionDelegateRecord, err := token_metadata.GetCollectionDelegateRecord(param.CollectionMint, param.CollectionUpdateAuthority, param.AuthorityPda)
+	if err != nil {
+		return nil, err
+	}
+	collectionMetadata, err := token_metadata.GetTokenMetaPubkey(param.CollectionMint)
+	if err != nil {
+		return nil, err
+	}
+	collectionMasterEdition, err := token_metadata.GetMasterEdition(param.CollectionMint)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.NftMint, IsSigner: true, IsWritable: true},
+		{PubKey: param.NftMintAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: nftMetadata, IsSigner: false, IsWritable: true},
+		{PubKey: nftMasterEdition, IsSigner: false, IsWritable: true},
+		{PubKey: token, IsSigner: false, IsWritable: true},
+		{PubKey: tokenRecord, IsSigner: false, IsWritable: param.TokenStandard == TokenStandardProgrammableNonFungible},
+		{PubKey: collectionDelegateRecord, IsSigner: false, IsWritable: false},
+		{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+		{PubKey: collectionMetadata, IsSigner: false, IsWritable: true},
+		{PubKey: collectionMasterEdition, IsSigner: false, IsWritable: false},
+		{PubKey: param.CollectionUpdateAuthority, IsSigner: false, IsWritable: false},
+		{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarSlotHashesPubkey, IsSigner: false, IsWritable: false},
+	}
+	accounts = append(accounts, authorizationRulesAccounts(param.RuleSet, param.Placeholder)...)
+	return accounts, nil
+}
+
+func optionalAccount(pubkey *common.PublicKey, placeholder common.PublicKey) types.AccountMeta {
+	if pubkey == nil {
+		return types.AccountMeta{PubKey: placeholder, IsSigner: false, IsWritable: false}
+	}
+	return types.AccountMeta{PubKey: *pubkey, IsSigner: false, IsWritable: false}
+}
+
+func authorizationRulesAccounts(ruleSet *common.PublicKey, placeholder common.PublicKey) []types.AccountMeta {
+	if ruleSet == nil {
+		return []types.AccountMeta{
+			{PubKey: placeholder, IsSigner: false, IsWritable: false},
+			{PubKey: placeholder, IsSigner: false, IsWritable: false},
+		}
+	}
+	return []types.AccountMeta{
+		{PubKey: common.MetaplexTokenAuthRulesProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: *ruleSet, IsSigner: false, IsWritable: false},
+	}
+}
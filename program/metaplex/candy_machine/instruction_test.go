@@ -0,0 +1,126 @@
+package candy_machine
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/anchor"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstructionDiscriminator(t *testing.T) {
+	assert.Equal(t, anchor.Discriminator(InstructionInitializeV2[:]), anchor.InstructionDiscriminator("initialize_v2"))
+	assert.Equal(t, anchor.Discriminator(InstructionAddConfigLines[:]), anchor.InstructionDiscriminator("add_config_lines"))
+	assert.Equal(t, anchor.Discriminator(InstructionMintV2[:]), anchor.InstructionDiscriminator("mint_v2"))
+	assert.Equal(t, anchor.Discriminator(CandyMachineDiscriminator[:]), anchor.AccountDiscriminator("CandyMachine"))
+}
+
+func TestAddConfigLines(t *testing.T) {
+	candyMachine := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	got := AddConfigLines(AddConfigLinesParam{
+		CandyMachine: candyMachine,
+		Authority:    authority,
+		Index:        2,
+		ConfigLines:  []ConfigLine{{Name: "a", Uri: "bc"}},
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.MetaplexCandyMachineV3ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: candyMachine, IsSigner: false, IsWritable: true},
+			{PubKey: authority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{
+			223, 50, 224, 227, 151, 8, 115, 106,
+			2, 0, 0, 0,
+			1, 0, 0, 0,
+			1, 0, 0, 0, 'a',
+			2, 0, 0, 0, 'b', 'c',
+		},
+	}, got)
+}
+
+func TestInitializeV2(t *testing.T) {
+	candyMachine := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	collectionMint := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	ruleSet := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+
+	authorityPda, err := GetAuthorityPda(candyMachine)
+	assert.NoError(t, err)
+	collectionMetadata, err := token_metadata.GetTokenMetaPubkey(collectionMint)
+	assert.NoError(t, err)
+	delegateRecord, err := token_metadata.GetCollectionDelegateRecord(collectionMint, authority, authorityPda)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ruleSet *common.PublicKey
+		want    []common.PublicKey
+	}{
+		{
+			name: "without rule set",
+			want: []common.PublicKey{common.MetaplexCandyMachineV3ProgramID, common.MetaplexCandyMachineV3ProgramID, common.MetaplexCandyMachineV3ProgramID},
+		},
+		{
+			name:    "with rule set",
+			ruleSet: pointer.Get(ruleSet),
+			want:    []common.PublicKey{ruleSet, common.MetaplexTokenAuthRulesProgramID, ruleSet},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InitializeV2(InitializeV2Param{
+				CandyMachine:              candyMachine,
+				Authority:                 authority,
+				Payer:                     authority,
+				RuleSet:                   tt.ruleSet,
+				CollectionMint:            collectionMint,
+				CollectionUpdateAuthority: authority,
+				TokenStandard:             TokenStandardProgrammableNonFungible,
+				Data:                      CandyMachineData{ItemsAvailable: 1},
+			})
+			assert.NoError(t, err)
+			assert.Len(t, got.Accounts, 15)
+			assert.Equal(t, authorityPda, got.Accounts[1].PubKey)
+			assert.Equal(t, collectionMetadata, got.Accounts[5].PubKey)
+			assert.Equal(t, delegateRecord, got.Accounts[9].PubKey)
+			assert.Equal(t, tt.want, []common.PublicKey{got.Accounts[4].PubKey, got.Accounts[13].PubKey, got.Accounts[14].PubKey})
+			assert.Equal(t, InstructionInitializeV2[:], got.Data[:8])
+			assert.Equal(t, uint8(TokenStandardProgrammableNonFungible), got.Data[len(got.Data)-1])
+		})
+	}
+}
+
+func TestMintV2(t *testing.T) {
+	candyMachine := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	owner := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	nftMint := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	collectionMint := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+
+	got, err := MintV2(MintV2Param{
+		CandyMachine:              candyMachine,
+		MintAuthority:             owner,
+		Payer:                     owner,
+		NftOwner:                  owner,
+		NftMint:                   nftMint,
+		NftMintAuthority:          owner,
+		CollectionMint:            collectionMint,
+		CollectionUpdateAuthority: owner,
+		TokenStandard:             TokenStandardNonFungible,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, InstructionMintV2[:], got.Data)
+	assert.Len(t, got.Accounts, 24)
+
+	token, _, err := common.FindAssociatedTokenAddress(owner, nftMint)
+	assert.NoError(t, err)
+	assert.Equal(t, types.AccountMeta{PubKey: nftMint, IsSigner: true, IsWritable: true}, got.Accounts[5])
+	assert.Equal(t, types.AccountMeta{PubKey: token, IsSigner: false, IsWritable: true}, got.Accounts[9])
+	assert.Equal(t, types.AccountMeta{PubKey: common.MetaplexCandyMachineV3ProgramID, IsSigner: false, IsWritable: false}, got.Accounts[10])
+	assert.Equal(t, common.SysVarSlotHashesPubkey, got.Accounts[21].PubKey)
+}
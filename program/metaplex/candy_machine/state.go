@@ -0,0 +1,152 @@
+package candy_machine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+)
+
+const (
+	MaxNameLength   = 32
+	MaxSymbolLength = 10
+	MaxUriLength    = 200
+	MaxCreatorLimit = 5
+	// HiddenSection is the offset of the config lines, it is the size of the account with the longest data
+	HiddenSection = 850
+)
+
+var CandyMachineDiscriminator = [8]uint8{51, 173, 177, 113, 25, 241, 109, 189}
+
+var ErrInvalidAccountData = errors.New("invalid candy machine account data")
+
+type AccountVersion borsh.Enum
+
+const (
+	AccountVersionV1 AccountVersion = iota
+	AccountVersionV2
+)
+
+// TokenStandard is the token standard of the minted nfts, it follows token_metadata.TokenStandard
+type TokenStandard uint8
+
+const (
+	TokenStandardNonFungible             TokenStandard = 0
+	TokenStandardProgrammableNonFungible TokenStandard = 4
+)
+
+type Creator struct {
+	Address         common.PublicKey
+	Verified        bool
+	PercentageShare uint8
+}
+
+// ConfigLineSettings describes how the config lines are stored. the name and the uri of a minted nft are
+// the prefix followed by the config line, so only the part after the prefix has to be added.
+type ConfigLineSettings struct {
+	PrefixName   string
+	NameLength   uint32
+	PrefixUri    string
+	UriLength    uint32
+	IsSequential bool
+}
+
+// HiddenSettings mints every nft with the same name and uri, no config line is needed
+type HiddenSettings struct {
+	Name string
+	Uri  string
+	Hash [32]uint8
+}
+
+type CandyMachineData struct {
+	ItemsAvailable       uint64
+	Symbol               string
+	SellerFeeBasisPoints uint16
+	MaxSupply            uint64
+	IsMutable            bool
+	Creators             []Creator
+	ConfigLineSettings   *ConfigLineSettings
+	HiddenSettings       *HiddenSettings
+}
+
+type ConfigLine struct {
+	Name string
+	Uri  string
+}
+
+type CandyMachine struct {
+	Discriminator  [8]uint8
+	Version        AccountVersion
+	TokenStandard  TokenStandard
+	Features       [6]uint8
+	Authority      common.PublicKey
+	MintAuthority  common.PublicKey
+	CollectionMint common.PublicKey
+	ItemsRedeemed  uint64
+	Data           CandyMachineData
+
+	// ItemsLoaded is the number of config lines added
+	ItemsLoaded uint32 `borsh_skip:"true"`
+	// ConfigLines are the stored config lines, which don't include the prefix. a line not added yet is empty.
+	ConfigLines []ConfigLine `borsh_skip:"true"`
+}
+
+// configLineSize is the size of a stored config line
+func (d CandyMachineData) configLineSize() uint64 {
+	if d.ConfigLineSettings != nil {
+		return uint64(d.ConfigLineSettings.NameLength) + uint64(d.ConfigLineSettings.UriLength)
+	}
+	return MaxNameLength + MaxUriLength
+}
+
+// GetCandyMachineSize returns the size of the candy machine account, it has to be allocated before InitializeV2
+func GetCandyMachineSize(data CandyMachineData) uint64 {
+	if data.HiddenSettings != nil {
+		return HiddenSection
+	}
+	items := data.ItemsAvailable
+	return HiddenSection +
+		4 + // items loaded
+		items*data.configLineSize() + // config lines
+		items/8 + 1 + // bit mask of the loaded lines
+		items*4 // mint indices
+}
+
+func CandyMachineDeserialize(data []byte) (CandyMachine, error) {
+	if len(data) < HiddenSection || !bytes.Equal(data[:8], CandyMachineDiscriminator[:]) {
+		return CandyMachine{}, ErrInvalidAccountData
+	}
+
+	var candyMachine CandyMachine
+	err := borsh.Deserialize(&candyMachine, data)
+	if err != nil {
+		return CandyMachine{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	if candyMachine.Data.HiddenSettings != nil {
+		return candyMachine, nil
+	}
+
+	items := candyMachine.Data.ItemsAvailable
+	lineSize := candyMachine.Data.configLineSize()
+	if uint64(len(data)) < HiddenSection+4+items*lineSize {
+		return CandyMachine{}, ErrInvalidAccountData
+	}
+	candyMachine.ItemsLoaded = binary.LittleEndian.Uint32(data[HiddenSection:])
+
+	nameLength := uint64(MaxNameLength)
+	if candyMachine.Data.ConfigLineSettings != nil {
+		nameLength = uint64(candyMachine.Data.ConfigLineSettings.NameLength)
+	}
+	candyMachine.ConfigLines = make([]ConfigLine, 0, items)
+	for i := uint64(0); i < items; i++ {
+		line := data[HiddenSection+4+i*lineSize : HiddenSection+4+(i+1)*lineSize]
+		candyMachine.ConfigLines = append(candyMachine.ConfigLines, ConfigLine{
+			Name: string(bytes.TrimRight(line[:nameLength], "\x00")),
+			Uri:  string(bytes.TrimRight(line[nameLength:], "\x00")),
+		})
+	}
+	return candyMachine, nil
+}
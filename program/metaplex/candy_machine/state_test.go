@@ -0,0 +1,105 @@
+package candy_machine
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCandyMachineSize(t *testing.T) {
+	tests := []struct {
+		name string
+		data CandyMachineData
+		want uint64
+	}{
+		{
+			name: "hidden settings",
+			data: CandyMachineData{
+				ItemsAvailable: 100,
+				HiddenSettings: &HiddenSettings{Name: "hidden", Uri: "https://example.com"},
+			},
+			want: HiddenSection,
+		},
+		{
+			name: "config line settings",
+			data: CandyMachineData{
+				ItemsAvailable:     10,
+				ConfigLineSettings: &ConfigLineSettings{NameLength: 4, UriLength: 20},
+			},
+			want: HiddenSection + 4 + 10*24 + 2 + 40,
+		},
+		{
+			name: "default config lines",
+			data: CandyMachineData{ItemsAvailable: 8},
+			want: HiddenSection + 4 + 8*(MaxNameLength+MaxUriLength) + 2 + 32,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetCandyMachineSize(tt.data))
+		})
+	}
+}
+
+func TestCandyMachineDeserialize(t *testing.T) {
+	candyMachine := CandyMachine{
+		Discriminator:  CandyMachineDiscriminator,
+		Version:        AccountVersionV2,
+		TokenStandard:  TokenStandardNonFungible,
+		Authority:      common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		MintAuthority:  common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+		CollectionMint: common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"),
+		ItemsRedeemed:  1,
+		Data: CandyMachineData{
+			ItemsAvailable:       3,
+			Symbol:               "TST",
+			SellerFeeBasisPoints: 500,
+			IsMutable:            true,
+			Creators: []Creator{
+				{
+					Address:         common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+					Verified:        true,
+					PercentageShare: 100,
+				},
+			},
+			ConfigLineSettings: &ConfigLineSettings{
+				PrefixName:   "Test #",
+				NameLength:   4,
+				PrefixUri:    "https://example.com/",
+				UriLength:    10,
+				IsSequential: false,
+			},
+		},
+	}
+	header, err := borsh.Serialize(candyMachine)
+	assert.NoError(t, err)
+
+	data := make([]byte, GetCandyMachineSize(candyMachine.Data))
+	copy(data, header)
+	binary.LittleEndian.PutUint32(data[HiddenSection:], 2)
+	copy(data[HiddenSection+4:], "1")
+	copy(data[HiddenSection+4+4:], "1.json")
+	copy(data[HiddenSection+4+14:], "2")
+	copy(data[HiddenSection+4+14+4:], "2.json")
+
+	got, err := CandyMachineDeserialize(data)
+	assert.NoError(t, err)
+
+	candyMachine.ItemsLoaded = 2
+	candyMachine.ConfigLines = []ConfigLine{
+		{Name: "1", Uri: "1.json"},
+		{Name: "2", Uri: "2.json"},
+		{Name: "", Uri: ""},
+	}
+	assert.Equal(t, candyMachine, got)
+
+	_, err = CandyMachineDeserialize(data[:HiddenSection+10])
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+
+	data[0] = 0
+	_, err = CandyMachineDeserialize(data)
+	assert.ErrorIs(t, err, ErrInvalidAccountData)
+}
@@ -0,0 +1,15 @@
+package candy_machine
+
+import "github.com/liangjies/solana-go-sdk/common"
+
+// GetAuthorityPda derives the account which is the update authority of the minted nfts
+func GetAuthorityPda(candyMachine common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("candy_machine"),
+			candyMachine.Bytes(),
+		},
+		common.MetaplexCandyMachineV3ProgramID,
+	)
+	return pubkey, err
+}
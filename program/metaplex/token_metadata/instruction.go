@@ -177,6 +177,51 @@ func UpdateMetadataAccount(param UpdateMetadataAccountParam) types.Instruction {
 	}
 }
 
+type UpdateMetadataAccountV2Param struct {
+	MetadataAccount     common.PublicKey
+	UpdateAuthority     common.PublicKey
+	Data                *DataV2
+	NewUpdateAuthority  *common.PublicKey
+	PrimarySaleHappened *bool
+	IsMutable           *bool
+}
+
+func UpdateMetadataAccountV2(param UpdateMetadataAccountV2Param) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction         Instruction
+		Data                *DataV2
+		NewUpdateAuthority  *common.PublicKey
+		PrimarySaleHappened *bool
+		IsMutable           *bool
+	}{
+		Instruction:         InstructionUpdateMetadataAccountV2,
+		Data:                param.Data,
+		NewUpdateAuthority:  param.NewUpdateAuthority,
+		PrimarySaleHappened: param.PrimarySaleHappened,
+		IsMutable:           param.IsMutable,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{
+				PubKey:     param.MetadataAccount,
+				IsSigner:   false,
+				IsWritable: true,
+			},
+			{
+				PubKey:     param.UpdateAuthority,
+				IsSigner:   true,
+				IsWritable: false,
+			},
+		},
+		Data: data,
+	}
+}
+
 type CreateMasterEditionParam struct {
 	Edition         common.PublicKey
 	Mint            common.PublicKey
@@ -256,6 +301,7 @@ type SignMetadataParam struct {
 	Creator  common.PublicKey
 }
 
+// SignMetadata verifies the creator in the metadata, the creator needs to sign the tx
 func SignMetadata(param SignMetadataParam) types.Instruction {
 	data, err := borsh.Serialize(struct {
 		Instruction Instruction
@@ -299,7 +345,9 @@ type MintNewEditionFromMasterEditionViaTokeParam struct {
 	Edition                    uint64
 }
 
-func MintNewEditionFromMasterEditionViaToken(param MintNewEditionFromMasterEditionViaTokeParam) types.Instruction {
+type MintNewEditionFromMasterEditionViaTokenParam = MintNewEditionFromMasterEditionViaTokeParam
+
+func MintNewEditionFromMasterEditionViaToken(param MintNewEditionFromMasterEditionViaTokenParam) types.Instruction {
 	data, err := borsh.Serialize(struct {
 		Instruction Instruction
 		Edition     uint64
@@ -388,6 +436,58 @@ func MintNewEditionFromMasterEditionViaToken(param MintNewEditionFromMasterEditi
 	}
 }
 
+type MintPrintEditionParam struct {
+	// MasterMint is the mint of the master edition
+	MasterMint common.PublicKey
+	// NewMint is an initialized mint with one token in an account of NewMintAuthority
+	NewMint                    common.PublicKey
+	NewMintAuthority           common.PublicKey
+	Payer                      common.PublicKey
+	TokenAccountOwner          common.PublicKey
+	TokenAccount               common.PublicKey
+	NewMetadataUpdateAuthority common.PublicKey
+	Edition                    uint64
+}
+
+// MintPrintEdition derives the metadata, edition and edition marker accounts of a print,
+// then builds MintNewEditionFromMasterEditionViaToken. TokenAccount holds the master edition token.
+func MintPrintEdition(param MintPrintEditionParam) (types.Instruction, error) {
+	newMetadata, err := GetTokenMetaPubkey(param.NewMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	newEdition, err := GetEdition(param.NewMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	masterEdition, err := GetMasterEdition(param.MasterMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	editionMark, err := GetEditionMark(param.MasterMint, param.Edition)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	masterMetadata, err := GetTokenMetaPubkey(param.MasterMint)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	return MintNewEditionFromMasterEditionViaToken(MintNewEditionFromMasterEditionViaTokenParam{
+		NewMetaData:                newMetadata,
+		NewEdition:                 newEdition,
+		MasterEdition:              masterEdition,
+		NewMint:                    param.NewMint,
+		EditionMark:                editionMark,
+		NewMintAuthority:           param.NewMintAuthority,
+		Payer:                      param.Payer,
+		TokenAccountOwner:          param.TokenAccountOwner,
+		TokenAccount:               param.TokenAccount,
+		NewMetadataUpdateAuthority: param.NewMetadataUpdateAuthority,
+		MasterMetadata:             masterMetadata,
+		Edition:                    param.Edition,
+	}), nil
+}
+
 type CreateMetadataAccountV2Param struct {
 	Metadata                common.PublicKey
 	Mint                    common.PublicKey
@@ -457,17 +557,9 @@ func CreateMetadataAccountV2(param CreateMetadataAccountV2Param) types.Instructi
 	}
 }
 
-type CreateMasterEditionV3Param struct {
-	Edition         common.PublicKey
-	Mint            common.PublicKey
-	UpdateAuthority common.PublicKey
-	MintAuthority   common.PublicKey
-	Metadata        common.PublicKey
-	Payer           common.PublicKey
-	MaxSupply       *uint64
-}
+type CreateMasterEditionV3Param = CreateMasterEditionParam
 
-func CreateMasterEditionV3(param CreateMasterEditionParam) types.Instruction {
+func CreateMasterEditionV3(param CreateMasterEditionV3Param) types.Instruction {
 	data, err := borsh.Serialize(struct {
 		Instruction Instruction
 		MaxSupply   *uint64
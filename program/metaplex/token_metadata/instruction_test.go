@@ -279,6 +279,65 @@ func TestUpdateMetadataAccount(t *testing.T) {
 	}
 }
 
+func TestUpdateMetadataAccountV2(t *testing.T) {
+	type args struct {
+		param UpdateMetadataAccountV2Param
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateMetadataAccountV2Param{
+					MetadataAccount: common.PublicKeyFromString("metadata11111111111111111111111111111111111"),
+					UpdateAuthority: common.PublicKeyFromString("updateAuthority1111111111111111111111111111"),
+					Data: &DataV2{
+						Name:                 "n",
+						Symbol:               "s",
+						Uri:                  "u",
+						SellerFeeBasisPoints: 500,
+					},
+					IsMutable: pointer.Get[bool](false),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexTokenMetaProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("metadata11111111111111111111111111111111111"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("updateAuthority1111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{15, 1, 1, 0, 0, 0, 110, 1, 0, 0, 0, 115, 1, 0, 0, 0, 117, 244, 1, 0, 0, 0, 0, 0, 1, 0},
+			},
+		},
+		{
+			args: args{
+				param: UpdateMetadataAccountV2Param{
+					MetadataAccount:     common.PublicKeyFromString("metadata11111111111111111111111111111111111"),
+					UpdateAuthority:     common.PublicKeyFromString("updateAuthority1111111111111111111111111111"),
+					PrimarySaleHappened: pointer.Get[bool](true),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexTokenMetaProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("metadata11111111111111111111111111111111111"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("updateAuthority1111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{15, 0, 0, 1, 1, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateMetadataAccountV2(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateMetadataAccountV2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSignMetadata(t *testing.T) {
 	type args struct {
 		param SignMetadataParam
@@ -455,3 +514,40 @@ func TestCreateMetadataAccountV2(t *testing.T) {
 		})
 	}
 }
+
+func TestMintPrintEdition(t *testing.T) {
+	masterMint := common.PublicKeyFromString("7WUw2LkJJ6kAjuJM4gf6XcJdLdpKPXEGZQf1E3qisXie")
+	newMint := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	payer := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	tokenAccount := common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY")
+
+	got, err := MintPrintEdition(MintPrintEditionParam{
+		MasterMint:                 masterMint,
+		NewMint:                    newMint,
+		NewMintAuthority:           payer,
+		Payer:                      payer,
+		TokenAccountOwner:          payer,
+		TokenAccount:               tokenAccount,
+		NewMetadataUpdateAuthority: payer,
+		Edition:                    1,
+	})
+	assert.Nil(t, err)
+
+	newMetadata, _ := GetTokenMetaPubkey(newMint)
+	newEdition, _ := GetEdition(newMint)
+	masterMetadata, _ := GetTokenMetaPubkey(masterMint)
+	assert.Equal(t, MintNewEditionFromMasterEditionViaToken(MintNewEditionFromMasterEditionViaTokenParam{
+		NewMetaData:                newMetadata,
+		NewEdition:                 newEdition,
+		MasterEdition:              common.PublicKeyFromString("2e446uJgJ3o2qBPAmCAubM3FXmbwxQuoWgqERo2Fcjka"),
+		NewMint:                    newMint,
+		EditionMark:                common.PublicKeyFromString("HXr9QjSNttPntDHPvLokf8MgXu3vUdytVMjRFzJSc2VR"),
+		NewMintAuthority:           payer,
+		Payer:                      payer,
+		TokenAccountOwner:          payer,
+		TokenAccount:               tokenAccount,
+		NewMetadataUpdateAuthority: payer,
+		MasterMetadata:             masterMetadata,
+		Edition:                    1,
+	}), got)
+}
@@ -154,3 +154,50 @@ type MasterEditionV2 struct {
 	Supply    uint64
 	MaxSupply *uint64
 }
+
+func MasterEditionV2Deserialize(data []byte) (MasterEditionV2, error) {
+	var masterEdition MasterEditionV2
+	err := borsh.Deserialize(&masterEdition, data)
+	if err != nil {
+		return MasterEditionV2{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return masterEdition, nil
+}
+
+// Edition is the edition account of a print
+type Edition struct {
+	Key Key
+	// Parent is the master edition account
+	Parent  common.PublicKey
+	Edition uint64
+}
+
+func EditionDeserialize(data []byte) (Edition, error) {
+	var edition Edition
+	err := borsh.Deserialize(&edition, data)
+	if err != nil {
+		return Edition{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return edition, nil
+}
+
+// EditionMarker records which editions of a master edition are printed, one bit per edition, EDITION_MARKER_BIT_SIZE editions per marker
+type EditionMarker struct {
+	Key    Key
+	Ledger [31]uint8
+}
+
+func EditionMarkerDeserialize(data []byte) (EditionMarker, error) {
+	var editionMarker EditionMarker
+	err := borsh.Deserialize(&editionMarker, data)
+	if err != nil {
+		return EditionMarker{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return editionMarker, nil
+}
+
+// IsEditionTaken returns whether edition is printed, the marker has to be the one derived by GetEditionMark for edition
+func (m EditionMarker) IsEditionTaken(edition uint64) bool {
+	offset := edition % EDITION_MARKER_BIT_SIZE
+	return m.Ledger[offset/8]&(0b1000_0000>>(offset%8)) != 0
+}
@@ -161,3 +161,37 @@ func TestMetadataDeserialize(t *testing.T) {
 		})
 	}
 }
+
+func TestMasterEditionV2Deserialize(t *testing.T) {
+	got, err := MasterEditionV2Deserialize([]byte{6, 2, 0, 0, 0, 0, 0, 0, 0, 1, 10, 0, 0, 0, 0, 0, 0, 0})
+	assert.Nil(t, err)
+	assert.Equal(t, MasterEditionV2{Key: KeyMasterEditionV2, Supply: 2, MaxSupply: pointer.Get[uint64](10)}, got)
+}
+
+func TestEditionDeserialize(t *testing.T) {
+	parent := common.PublicKeyFromString("2e446uJgJ3o2qBPAmCAubM3FXmbwxQuoWgqERo2Fcjka")
+	data := append([]byte{1}, parent.Bytes()...)
+	data = append(data, 3, 0, 0, 0, 0, 0, 0, 0)
+
+	got, err := EditionDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, Edition{Key: KeyEditionV1, Parent: parent, Edition: 3}, got)
+}
+
+func TestEditionMarker_IsEditionTaken(t *testing.T) {
+	data := make([]byte, 32)
+	data[0] = byte(KeyEditionMarker)
+	// editions 1, 9 and 247 of the first marker
+	data[1] = 0b0100_0000
+	data[2] = 0b0100_0000
+	data[31] = 0b0000_0001
+
+	marker, err := EditionMarkerDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, KeyEditionMarker, marker.Key)
+	for edition := uint64(0); edition < EDITION_MARKER_BIT_SIZE; edition++ {
+		assert.Equal(t, edition == 1 || edition == 9 || edition == 247, marker.IsEditionTaken(edition), edition)
+	}
+	// edition 249 is the second bit of the second marker
+	assert.True(t, marker.IsEditionTaken(249))
+}
@@ -37,6 +37,12 @@ func GetMasterEdition(mint common.PublicKey) (common.PublicKey, error) {
 	return msaterEdtion, nil
 }
 
+// GetEdition derives the edition account of a print, it has the same seeds as a master edition
+func GetEdition(mint common.PublicKey) (common.PublicKey, error) {
+	return GetMasterEdition(mint)
+}
+
+// GetEditionMark derives the marker account which records whether edition of the master edition is printed
 func GetEditionMark(mint common.PublicKey, edition uint64) (common.PublicKey, error) {
 	editionNumber := edition / EDITION_MARKER_BIT_SIZE
 	pubkey, _, err := common.FindProgramAddress(
@@ -51,3 +57,34 @@ func GetEditionMark(mint common.PublicKey, edition uint64) (common.PublicKey, er
 	)
 	return pubkey, err
 }
+
+// GetTokenRecord derives the token record account of a token account which holds a programmable nft
+func GetTokenRecord(mint common.PublicKey, token common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("token_record"),
+			token.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
+
+// GetCollectionDelegateRecord derives the record which allows delegate to verify items of the collection mint
+func GetCollectionDelegateRecord(mint common.PublicKey, updateAuthority common.PublicKey, delegate common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("collection_delegate"),
+			updateAuthority.Bytes(),
+			delegate.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
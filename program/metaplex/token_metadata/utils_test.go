@@ -86,3 +86,9 @@ func TestGetTokenMetaPubkey(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEdition(t *testing.T) {
+	got, err := GetEdition(common.PublicKeyFromString("7WUw2LkJJ6kAjuJM4gf6XcJdLdpKPXEGZQf1E3qisXie"))
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromString("2e446uJgJ3o2qBPAmCAubM3FXmbwxQuoWgqERo2Fcjka"), got)
+}
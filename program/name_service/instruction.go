@@ -0,0 +1,180 @@
+package name_service
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+type Instruction borsh.Enum
+
+const (
+	InstructionCreate Instruction = iota
+	InstructionUpdate
+	InstructionTransfer
+	InstructionDelete
+	InstructionRealloc
+)
+
+type CreateParam struct {
+	Payer common.PublicKey
+	// NameAccount is GetNameAccountKey(HashedName, NameClass, NameParent)
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	HashedName  []byte
+	// Lamports funds the record, it should be the rent exemption of NameRecordHeaderSize + Space
+	Lamports uint64
+	// Space is the size of the data after the header
+	Space uint32
+	// NameClass is optional, it signs the creation
+	NameClass *common.PublicKey
+	// NameParent is optional, its owner signs the creation
+	NameParent      *common.PublicKey
+	NameParentOwner *common.PublicKey
+}
+
+func Create(param CreateParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		HashedName  []byte
+		Lamports    uint64
+		Space       uint32
+	}{
+		Instruction: InstructionCreate,
+		HashedName:  param.HashedName,
+		Lamports:    param.Lamports,
+		Space:       param.Space,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.NameOwner, IsSigner: false, IsWritable: false},
+	}
+	if param.NameClass != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameClass, IsSigner: true, IsWritable: false})
+	} else {
+		accounts = append(accounts, types.AccountMeta{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false})
+	}
+	if param.NameParent != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameParent, IsSigner: false, IsWritable: false})
+	} else {
+		accounts = append(accounts, types.AccountMeta{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false})
+	}
+	if param.NameParentOwner != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameParentOwner, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type UpdateParam struct {
+	NameAccount common.PublicKey
+	// UpdateSigner is the owner, or the class if the record has one
+	UpdateSigner common.PublicKey
+	// NameParent is required if the parent owner updates the record
+	NameParent *common.PublicKey
+	// Offset is the offset in the data after the header
+	Offset uint32
+	Data   []byte
+}
+
+func Update(param UpdateParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Offset      uint32
+		Data        []byte
+	}{
+		Instruction: InstructionUpdate,
+		Offset:      param.Offset,
+		Data:        param.Data,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.UpdateSigner, IsSigner: true, IsWritable: false},
+	}
+	if param.NameParent != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameParent, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type TransferParam struct {
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	NewOwner    common.PublicKey
+	// NameClass is required if the record has a class, it signs the transfer
+	NameClass *common.PublicKey
+}
+
+func Transfer(param TransferParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		NewOwner    common.PublicKey
+	}{
+		Instruction: InstructionTransfer,
+		NewOwner:    param.NewOwner,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.NameOwner, IsSigner: true, IsWritable: false},
+	}
+	if param.NameClass != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameClass, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type DeleteParam struct {
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	// RefundTarget receives the lamports of the record
+	RefundTarget common.PublicKey
+}
+
+func Delete(param DeleteParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionDelete,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.NameOwner, IsSigner: true, IsWritable: false},
+			{PubKey: param.RefundTarget, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,91 @@
+package name_service
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	payer := common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	owner := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	hashedName := GetHashName("test")
+	nameAccount := GetNameAccountKey(hashedName, common.PublicKey{}, SolTldAuthority)
+
+	got := Create(CreateParam{
+		Payer:           payer,
+		NameAccount:     nameAccount,
+		NameOwner:       owner,
+		HashedName:      hashedName,
+		Lamports:        1,
+		Space:           2,
+		NameParent:      &SolTldAuthority,
+		NameParentOwner: &payer,
+	})
+
+	wantData := []byte{0, 32, 0, 0, 0}
+	wantData = append(wantData, hashedName...)
+	wantData = append(wantData, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: payer, IsSigner: true, IsWritable: true},
+			{PubKey: nameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: owner, IsSigner: false, IsWritable: false},
+			{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false},
+			{PubKey: SolTldAuthority, IsSigner: false, IsWritable: false},
+			{PubKey: payer, IsSigner: true, IsWritable: false},
+		},
+		Data: wantData,
+	}, got)
+}
+
+func TestUpdateTransferDelete(t *testing.T) {
+	nameAccount := GetDomainKey("bonfida.sol")
+	owner := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	newOwner := common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: nameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: owner, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{1, 4, 0, 0, 0, 2, 0, 0, 0, 'h', 'i'},
+	}, Update(UpdateParam{
+		NameAccount:  nameAccount,
+		UpdateSigner: owner,
+		Offset:       4,
+		Data:         []byte("hi"),
+	}))
+
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: nameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: owner, IsSigner: true, IsWritable: false},
+		},
+		Data: append([]byte{2}, newOwner.Bytes()...),
+	}, Transfer(TransferParam{
+		NameAccount: nameAccount,
+		NameOwner:   owner,
+		NewOwner:    newOwner,
+	}))
+
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: nameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: owner, IsSigner: true, IsWritable: false},
+			{PubKey: newOwner, IsSigner: false, IsWritable: true},
+		},
+		Data: []byte{3},
+	}, Delete(DeleteParam{
+		NameAccount:  nameAccount,
+		NameOwner:    owner,
+		RefundTarget: newOwner,
+	}))
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/liangjies/solana-go-sdk/common"
 )
 
+// NameRecordHeaderSize is the size of the header, the data of a record follows it
+const NameRecordHeaderSize = 96
+
 type NameRecordHeader struct {
 	ParentName common.PublicKey
 	Owner      common.PublicKey
@@ -14,7 +17,7 @@ type NameRecordHeader struct {
 }
 
 func NameRecordHeaderFromData(data []byte) (NameRecordHeader, error) {
-	if len(data) < 96 {
+	if len(data) < NameRecordHeaderSize {
 		return NameRecordHeader{}, fmt.Errorf("data length should bigger than 96")
 	}
 	return NameRecordHeader{
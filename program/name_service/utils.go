@@ -2,6 +2,7 @@ package name_service
 
 import (
 	"crypto/sha256"
+	"strings"
 
 	"github.com/liangjies/solana-go-sdk/common"
 )
@@ -37,3 +38,14 @@ func GetTwitterRegistryKey(twitterHandle string) common.PublicKey {
 		TwitterRootParentRegisteryKey,
 	)
 }
+
+// GetDomainKey return the pubkey correspond to a .sol domain, e.g. "bonfida.sol" or "bonfida".
+// a subdomain, e.g. "dex.bonfida.sol", is a record under the domain with a "\x00" prefixed name.
+func GetDomainKey(domain string) common.PublicKey {
+	labels := strings.Split(strings.TrimSuffix(domain, ".sol"), ".")
+	key := GetNameAccountKey(GetHashName(labels[len(labels)-1]), common.PublicKey{}, SolTldAuthority)
+	for i := len(labels) - 2; i >= 0; i-- {
+		key = GetNameAccountKey(GetHashName("\x00"+labels[i]), common.PublicKey{}, key)
+	}
+	return key
+}
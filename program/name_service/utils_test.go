@@ -94,3 +94,10 @@ func TestGetNameAccountKey(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDomainKey(t *testing.T) {
+	domainKey := common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb")
+	assert.Equal(t, domainKey, GetDomainKey("bonfida.sol"))
+	assert.Equal(t, domainKey, GetDomainKey("bonfida"))
+	assert.Equal(t, GetNameAccountKey(GetHashName("\x00dex"), common.PublicKey{}, domainKey), GetDomainKey("dex.bonfida.sol"))
+}
@@ -0,0 +1,10 @@
+package openbook
+
+import "errors"
+
+var (
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidPadding         = errors.New("invalid account padding")
+	ErrInvalidAccountFlags    = errors.New("invalid account flags")
+	ErrInvalidSlab            = errors.New("invalid orderbook slab")
+)
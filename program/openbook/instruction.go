@@ -0,0 +1,306 @@
+package openbook
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+type Instruction uint32
+
+const (
+	InstructionInitializeMarket Instruction = iota
+	InstructionNewOrder
+	InstructionMatchOrders
+	InstructionConsumeEvents
+	InstructionCancelOrder
+	InstructionSettleFunds
+	InstructionCancelOrderByClientId
+	InstructionDisableMarket
+	InstructionSweepFees
+	InstructionNewOrderV2
+	InstructionNewOrderV3
+	InstructionCancelOrderV2
+	InstructionCancelOrderByClientIdV2
+	InstructionSendTake
+	InstructionCloseOpenOrders
+	InstructionInitOpenOrders
+	InstructionPrune
+	InstructionConsumeEventsPermissioned
+	InstructionCancelOrdersByClientIds
+	InstructionReplaceOrderByClientId
+	InstructionReplaceOrdersByClientIds
+)
+
+// the instruction data starts with a version byte and a u32 instruction
+const instructionVersion uint8 = 0
+
+type Side uint32
+
+const (
+	SideBid Side = iota
+	SideAsk
+)
+
+type OrderType uint32
+
+const (
+	OrderTypeLimit OrderType = iota
+	OrderTypeImmediateOrCancel
+	OrderTypePostOnly
+)
+
+type SelfTradeBehavior uint32
+
+const (
+	SelfTradeBehaviorDecrementTake SelfTradeBehavior = iota
+	SelfTradeBehaviorCancelProvide
+	SelfTradeBehaviorAbortTransaction
+)
+
+// all params have a ProgramID field, leave it empty to use common.OpenBookDexProgramID,
+// or pass common.SerumDexV3ProgramID for serum markets.
+
+type NewOrderV3Param struct {
+	ProgramID    common.PublicKey
+	Market       common.PublicKey
+	OpenOrders   common.PublicKey
+	RequestQueue common.PublicKey
+	EventQueue   common.PublicKey
+	Bids         common.PublicKey
+	Asks         common.PublicKey
+	// Payer is the quote token account for a bid or the base token account for an ask
+	Payer      common.PublicKey
+	Owner      common.PublicKey
+	BaseVault  common.PublicKey
+	QuoteVault common.PublicKey
+	// FeeDiscount is an optional SRM token account for a fee discount
+	FeeDiscount *common.PublicKey
+
+	Side Side
+	// LimitPrice is in quote lots per base lot
+	LimitPrice uint64
+	// MaxBaseQuantity is in base lots
+	MaxBaseQuantity uint64
+	// MaxQuoteQuantity is in native quote tokens including fees
+	MaxQuoteQuantity  uint64
+	SelfTradeBehavior SelfTradeBehavior
+	OrderType         OrderType
+	ClientOrderID     uint64
+	// Limit is the max number of orders to match, default is 65535
+	Limit uint16
+	// MaxTs is the unix timestamp after which the order is rejected, default is no expiry
+	MaxTs int64
+}
+
+// NewOrderV3 places an order, the open orders account is initialized at the first order if it is
+// created by the owner beforehand with OpenOrdersAccountSize and owned by the dex program.
+func NewOrderV3(param NewOrderV3Param) types.Instruction {
+	limit := param.Limit
+	if limit == 0 {
+		limit = math.MaxUint16
+	}
+	maxTs := param.MaxTs
+	if maxTs == 0 {
+		maxTs = math.MaxInt64
+	}
+	data, err := bincode.SerializeData(struct {
+		Version           uint8
+		Instruction       Instruction
+		Side              Side
+		LimitPrice        uint64
+		MaxBaseQuantity   uint64
+		MaxQuoteQuantity  uint64
+		SelfTradeBehavior SelfTradeBehavior
+		OrderType         OrderType
+		ClientOrderID     uint64
+		Limit             uint16
+		MaxTs             int64
+	}{
+		Version:           instructionVersion,
+		Instruction:       InstructionNewOrderV3,
+		Side:              param.Side,
+		LimitPrice:        param.LimitPrice,
+		MaxBaseQuantity:   param.MaxBaseQuantity,
+		MaxQuoteQuantity:  param.MaxQuoteQuantity,
+		SelfTradeBehavior: param.SelfTradeBehavior,
+		OrderType:         param.OrderType,
+		ClientOrderID:     param.ClientOrderID,
+		Limit:             limit,
+		MaxTs:             maxTs,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Market, IsSigner: false, IsWritable: true},
+		{PubKey: param.OpenOrders, IsSigner: false, IsWritable: true},
+		{PubKey: param.RequestQueue, IsSigner: false, IsWritable: true},
+		{PubKey: param.EventQueue, IsSigner: false, IsWritable: true},
+		{PubKey: param.Bids, IsSigner: false, IsWritable: true},
+		{PubKey: param.Asks, IsSigner: false, IsWritable: true},
+		{PubKey: param.Payer, IsSigner: false, IsWritable: true},
+		{PubKey: param.Owner, IsSigner: true, IsWritable: false},
+		{PubKey: param.BaseVault, IsSigner: false, IsWritable: true},
+		{PubKey: param.QuoteVault, IsSigner: false, IsWritable: true},
+		{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+	}
+	if param.FeeDiscount != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.FeeDiscount, IsSigner: false, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type CancelOrderV2Param struct {
+	ProgramID  common.PublicKey
+	Market     common.PublicKey
+	Bids       common.PublicKey
+	Asks       common.PublicKey
+	OpenOrders common.PublicKey
+	Owner      common.PublicKey
+	EventQueue common.PublicKey
+	Side       Side
+	OrderID    OrderID
+}
+
+func CancelOrderV2(param CancelOrderV2Param) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Version     uint8
+		Instruction Instruction
+		Side        Side
+		OrderID     OrderID
+	}{
+		Version:     instructionVersion,
+		Instruction: InstructionCancelOrderV2,
+		Side:        param.Side,
+		OrderID:     param.OrderID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  cancelOrderAccounts(param.Market, param.Bids, param.Asks, param.OpenOrders, param.Owner, param.EventQueue),
+		Data:      data,
+	}
+}
+
+type CancelOrderByClientIdV2Param struct {
+	ProgramID     common.PublicKey
+	Market        common.PublicKey
+	Bids          common.PublicKey
+	Asks          common.PublicKey
+	OpenOrders    common.PublicKey
+	Owner         common.PublicKey
+	EventQueue    common.PublicKey
+	ClientOrderID uint64
+}
+
+func CancelOrderByClientIdV2(param CancelOrderByClientIdV2Param) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Version       uint8
+		Instruction   Instruction
+		ClientOrderID uint64
+	}{
+		Version:       instructionVersion,
+		Instruction:   InstructionCancelOrderByClientIdV2,
+		ClientOrderID: param.ClientOrderID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  cancelOrderAccounts(param.Market, param.Bids, param.Asks, param.OpenOrders, param.Owner, param.EventQueue),
+		Data:      data,
+	}
+}
+
+func cancelOrderAccounts(market, bids, asks, openOrders, owner, eventQueue common.PublicKey) []types.AccountMeta {
+	return []types.AccountMeta{
+		{PubKey: market, IsSigner: false, IsWritable: false},
+		{PubKey: bids, IsSigner: false, IsWritable: true},
+		{PubKey: asks, IsSigner: false, IsWritable: true},
+		{PubKey: openOrders, IsSigner: false, IsWritable: true},
+		{PubKey: owner, IsSigner: true, IsWritable: false},
+		{PubKey: eventQueue, IsSigner: false, IsWritable: true},
+	}
+}
+
+type SettleFundsParam struct {
+	ProgramID  common.PublicKey
+	Market     common.PublicKey
+	OpenOrders common.PublicKey
+	Owner      common.PublicKey
+	BaseVault  common.PublicKey
+	QuoteVault common.PublicKey
+	// BaseWallet and QuoteWallet are the owner token accounts which receive the free funds
+	BaseWallet  common.PublicKey
+	QuoteWallet common.PublicKey
+	// VaultSigner is GetVaultSignerAddress(programID, market, Market.VaultSignerNonce)
+	VaultSigner common.PublicKey
+	// ReferrerQuoteWallet optionally receives the referrer rebates
+	ReferrerQuoteWallet *common.PublicKey
+}
+
+// SettleFunds moves the free funds of an open orders account to the owner wallets
+func SettleFunds(param SettleFundsParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Version     uint8
+		Instruction Instruction
+	}{
+		Version:     instructionVersion,
+		Instruction: InstructionSettleFunds,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.Market, IsSigner: false, IsWritable: true},
+		{PubKey: param.OpenOrders, IsSigner: false, IsWritable: true},
+		{PubKey: param.Owner, IsSigner: true, IsWritable: false},
+		{PubKey: param.BaseVault, IsSigner: false, IsWritable: true},
+		{PubKey: param.QuoteVault, IsSigner: false, IsWritable: true},
+		{PubKey: param.BaseWallet, IsSigner: false, IsWritable: true},
+		{PubKey: param.QuoteWallet, IsSigner: false, IsWritable: true},
+		{PubKey: param.VaultSigner, IsSigner: false, IsWritable: false},
+		{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+	}
+	if param.ReferrerQuoteWallet != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.ReferrerQuoteWallet, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+// GetVaultSignerAddress returns the authority of the market vaults
+func GetVaultSignerAddress(programID, market common.PublicKey, nonce uint64) (common.PublicKey, error) {
+	return common.CreateProgramAddress(
+		[][]byte{market.Bytes(), binary.LittleEndian.AppendUint64(nil, nonce)},
+		getProgramID(programID),
+	)
+}
+
+func getProgramID(programID common.PublicKey) common.PublicKey {
+	if programID == (common.PublicKey{}) {
+		return common.OpenBookDexProgramID
+	}
+	return programID
+}
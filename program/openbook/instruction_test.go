@@ -0,0 +1,133 @@
+package openbook
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMarket     = common.PublicKeyFromString("8BnEgHoWFysVcuFFX7QztDmzuH8r5ZFvyP3sYwn1XTh6")
+	testOpenOrders = common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	testOwner      = common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	testBids       = common.PublicKeyFromString("5jWUncPNBMZJ3sTHKmMLszypVkoRK6bfEQMQUHweeQnh")
+	testAsks       = common.PublicKeyFromString("EaXdHx7x3mdGA38j5RSmKYSXMzAFzzUXCLNBEDXDn1d5")
+	testEventQueue = common.PublicKeyFromString("8CvwxZ9Db6XbLD46NZwwmVDZZRDy7eydFcAGkXKh9axa")
+)
+
+func TestNewOrderV3(t *testing.T) {
+	requestQueue := common.PublicKeyFromString("CPjXDcggXckEq9e4QeXUieVJBpUNpLEmpihLpg5vWjGF")
+	payer := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	baseVault := common.PublicKeyFromString("CKxTHwM9fPMRRvZmFnFoqKNd9pQR21c5Aq9bh5h9oghX")
+	quoteVault := common.PublicKeyFromString("6A5NHCj1yF6urc9wZNe6Bcjj4LVszQNj5DwAWG97yzMu")
+
+	got := NewOrderV3(NewOrderV3Param{
+		Market:           testMarket,
+		OpenOrders:       testOpenOrders,
+		RequestQueue:     requestQueue,
+		EventQueue:       testEventQueue,
+		Bids:             testBids,
+		Asks:             testAsks,
+		Payer:            payer,
+		Owner:            testOwner,
+		BaseVault:        baseVault,
+		QuoteVault:       quoteVault,
+		Side:             SideAsk,
+		LimitPrice:       1,
+		MaxBaseQuantity:  2,
+		MaxQuoteQuantity: 3,
+		OrderType:        OrderTypePostOnly,
+		ClientOrderID:    4,
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.OpenBookDexProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testMarket, IsSigner: false, IsWritable: true},
+			{PubKey: testOpenOrders, IsSigner: false, IsWritable: true},
+			{PubKey: requestQueue, IsSigner: false, IsWritable: true},
+			{PubKey: testEventQueue, IsSigner: false, IsWritable: true},
+			{PubKey: testBids, IsSigner: false, IsWritable: true},
+			{PubKey: testAsks, IsSigner: false, IsWritable: true},
+			{PubKey: payer, IsSigner: false, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: false},
+			{PubKey: baseVault, IsSigner: false, IsWritable: true},
+			{PubKey: quoteVault, IsSigner: false, IsWritable: true},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{
+			0, 10, 0, 0, 0,
+			1, 0, 0, 0,
+			1, 0, 0, 0, 0, 0, 0, 0,
+			2, 0, 0, 0, 0, 0, 0, 0,
+			3, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0,
+			2, 0, 0, 0,
+			4, 0, 0, 0, 0, 0, 0, 0,
+			255, 255,
+			255, 255, 255, 255, 255, 255, 255, 127,
+		},
+	}, got)
+}
+
+func TestCancelOrder(t *testing.T) {
+	wantAccounts := []types.AccountMeta{
+		{PubKey: testMarket, IsSigner: false, IsWritable: false},
+		{PubKey: testBids, IsSigner: false, IsWritable: true},
+		{PubKey: testAsks, IsSigner: false, IsWritable: true},
+		{PubKey: testOpenOrders, IsSigner: false, IsWritable: true},
+		{PubKey: testOwner, IsSigner: true, IsWritable: false},
+		{PubKey: testEventQueue, IsSigner: false, IsWritable: true},
+	}
+
+	got := CancelOrderV2(CancelOrderV2Param{
+		ProgramID:  common.SerumDexV3ProgramID,
+		Market:     testMarket,
+		Bids:       testBids,
+		Asks:       testAsks,
+		OpenOrders: testOpenOrders,
+		Owner:      testOwner,
+		EventQueue: testEventQueue,
+		Side:       SideBid,
+		OrderID:    OrderID{1, 15: 2},
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SerumDexV3ProgramID,
+		Accounts:  wantAccounts,
+		Data:      []byte{0, 11, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+	}, got)
+
+	got = CancelOrderByClientIdV2(CancelOrderByClientIdV2Param{
+		Market:        testMarket,
+		Bids:          testBids,
+		Asks:          testAsks,
+		OpenOrders:    testOpenOrders,
+		Owner:         testOwner,
+		EventQueue:    testEventQueue,
+		ClientOrderID: 258,
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.OpenBookDexProgramID,
+		Accounts:  wantAccounts,
+		Data:      []byte{0, 12, 0, 0, 0, 2, 1, 0, 0, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestSettleFunds(t *testing.T) {
+	vaultSigner := common.PublicKeyFromString("GVXRSBjFk6e6J3NbVPXohDJetcTjaeeuykUpbQF8UoMU")
+	got := SettleFunds(SettleFundsParam{
+		Market:      testMarket,
+		OpenOrders:  testOpenOrders,
+		Owner:       testOwner,
+		BaseVault:   testBids,
+		QuoteVault:  testAsks,
+		BaseWallet:  testEventQueue,
+		QuoteWallet: testOwner,
+		VaultSigner: vaultSigner,
+	})
+	assert.Equal(t, []byte{0, 5, 0, 0, 0}, got.Data)
+	assert.Len(t, got.Accounts, 9)
+	assert.Equal(t, types.AccountMeta{PubKey: vaultSigner, IsSigner: false, IsWritable: false}, got.Accounts[7])
+}
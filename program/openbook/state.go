@@ -0,0 +1,315 @@
+package openbook
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// every dex account is wrapped by these paddings
+var (
+	headPadding = []byte("serum")
+	tailPadding = []byte("padding")
+)
+
+type AccountFlag uint64
+
+const (
+	AccountFlagInitialized AccountFlag = 1 << iota
+	AccountFlagMarket
+	AccountFlagOpenOrders
+	AccountFlagRequestQueue
+	AccountFlagEventQueue
+	AccountFlagBids
+	AccountFlagAsks
+	AccountFlagDisabled
+	AccountFlagClosed
+	AccountFlagPermissioned
+	AccountFlagCrankAuthorityRequired
+)
+
+// OrderID is the little-endian u128 id of an order, the price in lots is the high 64 bits
+type OrderID [16]byte
+
+func (id OrderID) Price() uint64 {
+	return binary.LittleEndian.Uint64(id[8:])
+}
+
+// SeqNum is the low 64 bits, it is inverted for bids
+func (id OrderID) SeqNum() uint64 {
+	return binary.LittleEndian.Uint64(id[:8])
+}
+
+const MarketAccountSize = 388
+
+// the layout of a market, permissioned markets have more fields after referrer rebates accrued
+//
+//	[0:5]     "serum"
+//	[5:13]    account flags
+//	[13:45]   own address
+//	[45:53]   vault signer nonce
+//	[53:85]   base mint
+//	[85:117]  quote mint
+//	[117:149] base vault
+//	[149:157] base deposits total
+//	[157:165] base fees accrued
+//	[165:197] quote vault
+//	[197:205] quote deposits total
+//	[205:213] quote fees accrued
+//	[213:221] quote dust threshold
+//	[221:253] request queue
+//	[253:285] event queue
+//	[285:317] bids
+//	[317:349] asks
+//	[349:357] base lot size
+//	[357:365] quote lot size
+//	[365:373] fee rate bps
+//	[373:381] referrer rebates accrued
+type Market struct {
+	AccountFlags           AccountFlag
+	OwnAddress             common.PublicKey
+	VaultSignerNonce       uint64
+	BaseMint               common.PublicKey
+	QuoteMint              common.PublicKey
+	BaseVault              common.PublicKey
+	BaseDepositsTotal      uint64
+	BaseFeesAccrued        uint64
+	QuoteVault             common.PublicKey
+	QuoteDepositsTotal     uint64
+	QuoteFeesAccrued       uint64
+	QuoteDustThreshold     uint64
+	RequestQueue           common.PublicKey
+	EventQueue             common.PublicKey
+	Bids                   common.PublicKey
+	Asks                   common.PublicKey
+	BaseLotSize            uint64
+	QuoteLotSize           uint64
+	FeeRateBps             uint64
+	ReferrerRebatesAccrued uint64
+}
+
+func MarketFromData(data []byte) (Market, error) {
+	if len(data) < MarketAccountSize {
+		return Market{}, ErrInvalidAccountDataSize
+	}
+	if err := checkPaddings(data); err != nil {
+		return Market{}, err
+	}
+	flags := AccountFlag(binary.LittleEndian.Uint64(data[5:13]))
+	if flags&(AccountFlagInitialized|AccountFlagMarket) != AccountFlagInitialized|AccountFlagMarket {
+		return Market{}, ErrInvalidAccountFlags
+	}
+
+	return Market{
+		AccountFlags:           flags,
+		OwnAddress:             common.PublicKeyFromBytes(data[13:45]),
+		VaultSignerNonce:       binary.LittleEndian.Uint64(data[45:53]),
+		BaseMint:               common.PublicKeyFromBytes(data[53:85]),
+		QuoteMint:              common.PublicKeyFromBytes(data[85:117]),
+		BaseVault:              common.PublicKeyFromBytes(data[117:149]),
+		BaseDepositsTotal:      binary.LittleEndian.Uint64(data[149:157]),
+		BaseFeesAccrued:        binary.LittleEndian.Uint64(data[157:165]),
+		QuoteVault:             common.PublicKeyFromBytes(data[165:197]),
+		QuoteDepositsTotal:     binary.LittleEndian.Uint64(data[197:205]),
+		QuoteFeesAccrued:       binary.LittleEndian.Uint64(data[205:213]),
+		QuoteDustThreshold:     binary.LittleEndian.Uint64(data[213:221]),
+		RequestQueue:           common.PublicKeyFromBytes(data[221:253]),
+		EventQueue:             common.PublicKeyFromBytes(data[253:285]),
+		Bids:                   common.PublicKeyFromBytes(data[285:317]),
+		Asks:                   common.PublicKeyFromBytes(data[317:349]),
+		BaseLotSize:            binary.LittleEndian.Uint64(data[349:357]),
+		QuoteLotSize:           binary.LittleEndian.Uint64(data[357:365]),
+		FeeRateBps:             binary.LittleEndian.Uint64(data[365:373]),
+		ReferrerRebatesAccrued: binary.LittleEndian.Uint64(data[373:381]),
+	}, nil
+}
+
+type Order struct {
+	OrderID OrderID
+	// Price is in quote lots per base lot
+	Price uint64
+	// Quantity is in base lots
+	Quantity uint64
+	// OpenOrders is the open orders account of the order
+	OpenOrders    common.PublicKey
+	OwnerSlot     uint8
+	FeeTier       uint8
+	ClientOrderID uint64
+}
+
+type Orderbook struct {
+	IsBids bool
+	// Orders are sorted from the best price, orders with the same price are in time priority
+	Orders []Order
+}
+
+const (
+	slabHeaderOffset = 5 + 8
+	slabNodesOffset  = slabHeaderOffset + 32
+	slabNodeSize     = 72
+
+	slabNodeTagInner = 1
+	slabNodeTagLeaf  = 2
+)
+
+// OrderbookFromData parses the bids or the asks account of a market
+//
+//	[0:5]   "serum"
+//	[5:13]  account flags
+//	[13:21] bump index
+//	[21:29] free list len
+//	[29:33] free list head
+//	[33:37] root node
+//	[37:45] leaf count
+//	[45:]   nodes, 72 bytes each
+func OrderbookFromData(data []byte) (Orderbook, error) {
+	if len(data) < slabNodesOffset+len(tailPadding) {
+		return Orderbook{}, ErrInvalidAccountDataSize
+	}
+	if err := checkPaddings(data); err != nil {
+		return Orderbook{}, err
+	}
+	flags := AccountFlag(binary.LittleEndian.Uint64(data[5:13]))
+	if flags&AccountFlagInitialized == 0 || flags&(AccountFlagBids|AccountFlagAsks) == 0 {
+		return Orderbook{}, ErrInvalidAccountFlags
+	}
+	isBids := flags&AccountFlagBids != 0
+
+	nodes := data[slabNodesOffset : len(data)-len(tailPadding)]
+	nodeCount := uint32(len(nodes) / slabNodeSize)
+	root := binary.LittleEndian.Uint32(data[slabHeaderOffset+20 : slabHeaderOffset+24])
+	leafCount := binary.LittleEndian.Uint32(data[slabHeaderOffset+24 : slabHeaderOffset+28])
+
+	orders := make([]Order, 0, leafCount)
+	if leafCount == 0 {
+		return Orderbook{IsBids: isBids, Orders: orders}, nil
+	}
+
+	// in-order traversal, child 0 has the lower keys
+	stack := []uint32{root}
+	for visited := uint32(0); len(stack) > 0; visited++ {
+		// a tree visits every node once, more visits mean a cycle
+		if visited >= nodeCount || len(orders) > int(leafCount) {
+			return Orderbook{}, ErrInvalidSlab
+		}
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if index >= nodeCount {
+			return Orderbook{}, ErrInvalidSlab
+		}
+		node := nodes[index*slabNodeSize : (index+1)*slabNodeSize]
+		switch binary.LittleEndian.Uint32(node[:4]) {
+		case slabNodeTagInner:
+			// push the higher child first so the lower one is visited first
+			stack = append(stack, binary.LittleEndian.Uint32(node[28:32]), binary.LittleEndian.Uint32(node[24:28]))
+		case slabNodeTagLeaf:
+			var orderID OrderID
+			copy(orderID[:], node[8:24])
+			orders = append(orders, Order{
+				OrderID:       orderID,
+				Price:         orderID.Price(),
+				Quantity:      binary.LittleEndian.Uint64(node[56:64]),
+				OpenOrders:    common.PublicKeyFromBytes(node[24:56]),
+				OwnerSlot:     node[4],
+				FeeTier:       node[5],
+				ClientOrderID: binary.LittleEndian.Uint64(node[64:72]),
+			})
+		default:
+			return Orderbook{}, ErrInvalidSlab
+		}
+	}
+
+	// the best bid is the highest key
+	if isBids {
+		for i, j := 0, len(orders)-1; i < j; i, j = i+1, j-1 {
+			orders[i], orders[j] = orders[j], orders[i]
+		}
+	}
+	return Orderbook{IsBids: isBids, Orders: orders}, nil
+}
+
+const OpenOrdersAccountSize = 3228
+
+type OpenOrder struct {
+	Slot          uint8
+	IsBid         bool
+	OrderID       OrderID
+	ClientOrderID uint64
+}
+
+// the layout of an open orders account
+//
+//	[0:5]       "serum"
+//	[5:13]      account flags
+//	[13:45]     market
+//	[45:77]     owner
+//	[77:85]     base token free
+//	[85:93]     base token total
+//	[93:101]    quote token free
+//	[101:109]   quote token total
+//	[109:125]   free slot bits
+//	[125:141]   is bid bits
+//	[141:2189]  order ids, 128 * u128
+//	[2189:3213] client order ids, 128 * u64
+//	[3213:3221] referrer rebates accrued
+type OpenOrders struct {
+	AccountFlags           AccountFlag
+	Market                 common.PublicKey
+	Owner                  common.PublicKey
+	BaseTokenFree          uint64
+	BaseTokenTotal         uint64
+	QuoteTokenFree         uint64
+	QuoteTokenTotal        uint64
+	Orders                 []OpenOrder
+	ReferrerRebatesAccrued uint64
+}
+
+func OpenOrdersFromData(data []byte) (OpenOrders, error) {
+	if len(data) != OpenOrdersAccountSize {
+		return OpenOrders{}, ErrInvalidAccountDataSize
+	}
+	if err := checkPaddings(data); err != nil {
+		return OpenOrders{}, err
+	}
+	flags := AccountFlag(binary.LittleEndian.Uint64(data[5:13]))
+	if flags&(AccountFlagInitialized|AccountFlagOpenOrders) != AccountFlagInitialized|AccountFlagOpenOrders {
+		return OpenOrders{}, ErrInvalidAccountFlags
+	}
+
+	freeSlotBits := data[109:125]
+	isBidBits := data[125:141]
+	var orders []OpenOrder
+	for slot := 0; slot < 128; slot++ {
+		if freeSlotBits[slot/8]&(1<<(slot%8)) != 0 {
+			continue
+		}
+		var orderID OrderID
+		copy(orderID[:], data[141+slot*16:157+slot*16])
+		orders = append(orders, OpenOrder{
+			Slot:          uint8(slot),
+			IsBid:         isBidBits[slot/8]&(1<<(slot%8)) != 0,
+			OrderID:       orderID,
+			ClientOrderID: binary.LittleEndian.Uint64(data[2189+slot*8 : 2197+slot*8]),
+		})
+	}
+
+	return OpenOrders{
+		AccountFlags:           flags,
+		Market:                 common.PublicKeyFromBytes(data[13:45]),
+		Owner:                  common.PublicKeyFromBytes(data[45:77]),
+		BaseTokenFree:          binary.LittleEndian.Uint64(data[77:85]),
+		BaseTokenTotal:         binary.LittleEndian.Uint64(data[85:93]),
+		QuoteTokenFree:         binary.LittleEndian.Uint64(data[93:101]),
+		QuoteTokenTotal:        binary.LittleEndian.Uint64(data[101:109]),
+		Orders:                 orders,
+		ReferrerRebatesAccrued: binary.LittleEndian.Uint64(data[3213:3221]),
+	}, nil
+}
+
+func checkPaddings(data []byte) error {
+	if !bytes.HasPrefix(data, headPadding) || !bytes.HasSuffix(data, tailPadding) {
+		return ErrInvalidPadding
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package openbook
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccountData(size int, flags AccountFlag) []byte {
+	data := make([]byte, size)
+	copy(data, headPadding)
+	copy(data[size-len(tailPadding):], tailPadding)
+	binary.LittleEndian.PutUint64(data[5:13], uint64(flags))
+	return data
+}
+
+func TestMarketFromData(t *testing.T) {
+	data := newAccountData(MarketAccountSize, AccountFlagInitialized|AccountFlagMarket)
+	copy(data[13:45], testMarket.Bytes())
+	binary.LittleEndian.PutUint64(data[45:53], 1)
+	copy(data[285:317], testBids.Bytes())
+	copy(data[317:349], testAsks.Bytes())
+	binary.LittleEndian.PutUint64(data[349:357], 100)
+	binary.LittleEndian.PutUint64(data[357:365], 10)
+
+	market, err := MarketFromData(data)
+	assert.Nil(t, err)
+	assert.Equal(t, testMarket, market.OwnAddress)
+	assert.Equal(t, uint64(1), market.VaultSignerNonce)
+	assert.Equal(t, testBids, market.Bids)
+	assert.Equal(t, testAsks, market.Asks)
+	assert.Equal(t, uint64(100), market.BaseLotSize)
+	assert.Equal(t, uint64(10), market.QuoteLotSize)
+
+	_, err = MarketFromData(newAccountData(MarketAccountSize, AccountFlagInitialized|AccountFlagOpenOrders))
+	assert.ErrorIs(t, err, ErrInvalidAccountFlags)
+
+	data[0] = 0
+	_, err = MarketFromData(data)
+	assert.ErrorIs(t, err, ErrInvalidPadding)
+
+	_, err = MarketFromData(data[:100])
+	assert.ErrorIs(t, err, ErrInvalidAccountDataSize)
+}
+
+func putLeaf(node []byte, price, seqNum, quantity, clientOrderID uint64) {
+	binary.LittleEndian.PutUint32(node[:4], slabNodeTagLeaf)
+	binary.LittleEndian.PutUint64(node[8:16], seqNum)
+	binary.LittleEndian.PutUint64(node[16:24], price)
+	copy(node[24:56], testOpenOrders.Bytes())
+	binary.LittleEndian.PutUint64(node[56:64], quantity)
+	binary.LittleEndian.PutUint64(node[64:72], clientOrderID)
+}
+
+func newSlabData(flags AccountFlag) []byte {
+	data := newAccountData(slabNodesOffset+3*slabNodeSize+len(tailPadding), AccountFlagInitialized|flags)
+	binary.LittleEndian.PutUint32(data[33:37], 0)
+	binary.LittleEndian.PutUint32(data[37:41], 2)
+
+	nodes := data[slabNodesOffset:]
+	binary.LittleEndian.PutUint32(nodes[:4], slabNodeTagInner)
+	binary.LittleEndian.PutUint32(nodes[24:28], 2)
+	binary.LittleEndian.PutUint32(nodes[28:32], 1)
+	putLeaf(nodes[slabNodeSize:2*slabNodeSize], 120, 2, 5, 22)
+	putLeaf(nodes[2*slabNodeSize:3*slabNodeSize], 100, 1, 3, 11)
+	return data
+}
+
+func TestOrderbookFromData(t *testing.T) {
+	asks, err := OrderbookFromData(newSlabData(AccountFlagAsks))
+	assert.Nil(t, err)
+	assert.False(t, asks.IsBids)
+	assert.Len(t, asks.Orders, 2)
+	assert.Equal(t, uint64(100), asks.Orders[0].Price)
+	assert.Equal(t, uint64(3), asks.Orders[0].Quantity)
+	assert.Equal(t, uint64(11), asks.Orders[0].ClientOrderID)
+	assert.Equal(t, testOpenOrders, asks.Orders[0].OpenOrders)
+	assert.Equal(t, uint64(120), asks.Orders[1].Price)
+
+	bids, err := OrderbookFromData(newSlabData(AccountFlagBids))
+	assert.Nil(t, err)
+	assert.True(t, bids.IsBids)
+	assert.Equal(t, uint64(120), bids.Orders[0].Price)
+	assert.Equal(t, uint64(100), bids.Orders[1].Price)
+
+	// the inner node points to itself
+	data := newSlabData(AccountFlagAsks)
+	binary.LittleEndian.PutUint32(data[slabNodesOffset+24:slabNodesOffset+28], 0)
+	_, err = OrderbookFromData(data)
+	assert.ErrorIs(t, err, ErrInvalidSlab)
+}
+
+func TestOpenOrdersFromData(t *testing.T) {
+	data := newAccountData(OpenOrdersAccountSize, AccountFlagInitialized|AccountFlagOpenOrders)
+	copy(data[13:45], testMarket.Bytes())
+	copy(data[45:77], testOwner.Bytes())
+	binary.LittleEndian.PutUint64(data[77:85], 7)
+	// every slot is free except slot 3
+	for i := 109; i < 125; i++ {
+		data[i] = 0xff
+	}
+	data[109] = 0xf7
+	data[125] = 0x08
+	data[141+3*16] = 9
+	binary.LittleEndian.PutUint64(data[2189+3*8:], 42)
+
+	openOrders, err := OpenOrdersFromData(data)
+	assert.Nil(t, err)
+	assert.Equal(t, OpenOrders{
+		AccountFlags:  AccountFlagInitialized | AccountFlagOpenOrders,
+		Market:        testMarket,
+		Owner:         testOwner,
+		BaseTokenFree: 7,
+		Orders: []OpenOrder{
+			{Slot: 3, IsBid: true, OrderID: OrderID{9}, ClientOrderID: 42},
+		},
+	}, openOrders)
+
+	_, err = OpenOrdersFromData(newAccountData(OpenOrdersAccountSize, AccountFlagInitialized|AccountFlagMarket))
+	assert.ErrorIs(t, err, ErrInvalidAccountFlags)
+}
@@ -2,10 +2,12 @@ package secp256k1
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/liangjies/solana-go-sdk/common"
 	"github.com/liangjies/solana-go-sdk/pkg/bincode"
 	"github.com/liangjies/solana-go-sdk/types"
+	"golang.org/x/crypto/sha3"
 )
 
 const (
@@ -13,6 +15,13 @@ const (
 	DataStart             = OffsetsSerializedSize + 1
 )
 
+const (
+	// HashedPubkeySerializedSize is the size of an ethereum address
+	HashedPubkeySerializedSize = 20
+	// SignatureSerializedSize is the size of a signature without the recovery id, which follows it
+	SignatureSerializedSize = 64
+)
+
 type SecpSignatureOffsets struct {
 	SignatureOffsets           uint16
 	SignatureInstructionIndex  uint8
@@ -31,6 +40,14 @@ func NewSecp256k1Instruction(msgs [][]byte, sigs [][]byte, addrs [][]byte, thisI
 	if len(msgs) != len(sigs) || len(sigs) != len(addrs) {
 		return types.Instruction{}, fmt.Errorf("Provided a different number of keys, messages, or signatures")
 	}
+	for i := range msgs {
+		if len(sigs[i]) != SignatureSerializedSize+1 {
+			return types.Instruction{}, fmt.Errorf("invalid signature length, index: %v, length: %v", i, len(sigs[i]))
+		}
+		if len(addrs[i]) != HashedPubkeySerializedSize {
+			return types.Instruction{}, fmt.Errorf("invalid eth address length, index: %v, length: %v", i, len(addrs[i]))
+		}
+	}
 	n := len(msgs)
 	instrData := []byte{}
 	instrData = append(instrData, uint8(n)) // Count of Offsets structs
@@ -76,3 +93,40 @@ func NewSecp256k1Instruction(msgs [][]byte, sigs [][]byte, addrs [][]byte, thisI
 		Data:      instrData,
 	}, nil
 }
+
+// NewSecp256k1InstructionWithOffsets verifies signatures whose addresses, signatures and messages are in the data of other instructions
+func NewSecp256k1InstructionWithOffsets(param Secp256k1InstructionParam) (types.Instruction, error) {
+	if len(param.Offsets) > math.MaxUint8 {
+		return types.Instruction{}, fmt.Errorf("too many signatures, count: %v", len(param.Offsets))
+	}
+
+	instrData := make([]byte, 1, 1+len(param.Offsets)*OffsetsSerializedSize)
+	instrData[0] = uint8(len(param.Offsets))
+	for _, o := range param.Offsets {
+		osetsBytes, err := bincode.SerializeData(o)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		instrData = append(instrData, osetsBytes...)
+	}
+
+	return types.Instruction{
+		ProgramID: common.Secp256k1ProgramID,
+		Data:      instrData,
+	}, nil
+}
+
+// EthAddressFromPublicKey returns the ethereum address of an uncompressed public key, which is 64 bytes
+// or 65 bytes with the 0x04 prefix. the address is the last 20 bytes of keccak256 of the key.
+func EthAddressFromPublicKey(pubkey []byte) ([]byte, error) {
+	switch {
+	case len(pubkey) == 65 && pubkey[0] == 0x04:
+		pubkey = pubkey[1:]
+	case len(pubkey) == 64:
+	default:
+		return nil, fmt.Errorf("invalid uncompressed public key, length: %v", len(pubkey))
+	}
+	h := sha3.NewLegacyKeccak256()
+	h.Write(pubkey)
+	return h.Sum(nil)[32-HashedPubkeySerializedSize:], nil
+}
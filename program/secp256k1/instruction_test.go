@@ -2,6 +2,7 @@ package secp256k1
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,3 +41,45 @@ func TestNewSecp256k1Instruction(t *testing.T) {
 	instrDataStr := base64.StdEncoding.EncodeToString(instr.Data)
 	assert.Equal(t, checkDataStr, instrDataStr)
 }
+
+func TestNewSecp256k1InstructionInvalidInput(t *testing.T) {
+	_, err := NewSecp256k1Instruction([][]byte{[]byte("message")}, [][]byte{make([]byte, 64)}, [][]byte{make([]byte, 20)}, 0)
+	assert.Error(t, err)
+
+	_, err = NewSecp256k1Instruction([][]byte{[]byte("message")}, [][]byte{make([]byte, 65)}, [][]byte{make([]byte, 32)}, 0)
+	assert.Error(t, err)
+}
+
+func TestNewSecp256k1InstructionWithOffsets(t *testing.T) {
+	instr, err := NewSecp256k1InstructionWithOffsets(Secp256k1InstructionParam{
+		Offsets: []SecpSignatureOffsets{
+			{
+				SignatureOffsets:           32,
+				SignatureInstructionIndex:  1,
+				EthAddressOffset:           12,
+				EthAddressInstructionIndex: 1,
+				MessageDataOffset:          97,
+				MessageDataSize:            7,
+				MessageInstructionIndex:    1,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 32, 0, 1, 12, 0, 1, 97, 0, 7, 0, 1}, instr.Data)
+}
+
+func TestEthAddressFromPublicKey(t *testing.T) {
+	// the public key of the private key 1
+	pubkey, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+
+	addr, err := EthAddressFromPublicKey(pubkey)
+	assert.NoError(t, err)
+	assert.Equal(t, "7e5f4552091a69125d5dfcb7b8c2659029395bdf", hex.EncodeToString(addr))
+
+	addr, err = EthAddressFromPublicKey(pubkey[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, "7e5f4552091a69125d5dfcb7b8c2659029395bdf", hex.EncodeToString(addr))
+
+	_, err = EthAddressFromPublicKey(pubkey[:33])
+	assert.Error(t, err)
+}
@@ -0,0 +1,8 @@
+package stake
+
+import "errors"
+
+var (
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidStakeStateType  = errors.New("invalid stake state type")
+)
@@ -20,6 +20,12 @@ const (
 	InstructionSetLockup
 	InstructionMerge
 	InstructionAuthorizeWithSeed
+	InstructionInitializeChecked
+	InstructionAuthorizeChecked
+	InstructionAuthorizeCheckedWithSeed
+	InstructionSetLockupChecked
+	InstructionGetMinimumDelegation
+	InstructionDeactivateDelinquent
 )
 
 type StakeAuthorizationType uint32
@@ -346,3 +352,205 @@ func AuthorizeWithSeed(param AuthorizeWithSeedParam) types.Instruction {
 		Data:      data,
 	}
 }
+
+type InitializeCheckedParam struct {
+	Stake      common.PublicKey
+	Staker     common.PublicKey
+	Withdrawer common.PublicKey
+}
+
+// InitializeChecked is the same as Initialize but the withdrawer needs to sign the tx and the lockup is default
+func InitializeChecked(param InitializeCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionInitializeChecked,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Stake, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Staker, IsSigner: false, IsWritable: false},
+			{PubKey: param.Withdrawer, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeCheckedParam struct {
+	Stake     common.PublicKey
+	Auth      common.PublicKey
+	NewAuth   common.PublicKey
+	AuthType  StakeAuthorizationType
+	Custodian *common.PublicKey
+}
+
+// AuthorizeChecked is the same as Authorize but the new authority needs to sign the tx
+func AuthorizeChecked(param AuthorizeCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		StakeAuthorizationType StakeAuthorizationType
+	}{
+		Instruction:            InstructionAuthorizeChecked,
+		StakeAuthorizationType: param.AuthType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 5)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Stake, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		types.AccountMeta{PubKey: param.NewAuth, IsSigner: true, IsWritable: false},
+	)
+	if param.Custodian != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Custodian, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type AuthorizeCheckedWithSeedParam struct {
+	Stake     common.PublicKey
+	AuthBase  common.PublicKey
+	AuthSeed  string
+	AuthOwner common.PublicKey
+	NewAuth   common.PublicKey
+	AuthType  StakeAuthorizationType
+	Custodian *common.PublicKey
+}
+
+// AuthorizeCheckedWithSeed is the same as AuthorizeWithSeed but the new authority needs to sign the tx
+func AuthorizeCheckedWithSeed(param AuthorizeCheckedWithSeedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		StakeAuthorizationType StakeAuthorizationType
+		AuthSeed               string
+		AuthOwner              common.PublicKey
+	}{
+		Instruction:            InstructionAuthorizeCheckedWithSeed,
+		StakeAuthorizationType: param.AuthType,
+		AuthSeed:               param.AuthSeed,
+		AuthOwner:              param.AuthOwner,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 5)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Stake, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.AuthBase, IsSigner: true, IsWritable: false},
+		types.AccountMeta{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.NewAuth, IsSigner: true, IsWritable: false},
+	)
+	if param.Custodian != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Custodian, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type LockupCheckedParam struct {
+	UnixTimestamp *int64
+	Epoch         *uint64
+}
+
+type SetLockupCheckedParam struct {
+	Stake        common.PublicKey
+	Auth         common.PublicKey
+	NewCustodian *common.PublicKey
+	Lockup       LockupCheckedParam
+}
+
+// SetLockupChecked is the same as SetLockup but the new custodian needs to sign the tx
+func SetLockupChecked(param SetLockupCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction   Instruction
+		UnixTimestamp *int64
+		Epoch         *uint64
+	}{
+		Instruction:   InstructionSetLockupChecked,
+		UnixTimestamp: param.Lockup.UnixTimestamp,
+		Epoch:         param.Lockup.Epoch,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 3)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Stake, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+	)
+	if param.NewCustodian != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NewCustodian, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+// GetMinimumDelegation returns the minimum delegation amount via the return data, it is useful in simulation
+func GetMinimumDelegation() types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionGetMinimumDelegation,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts:  []types.AccountMeta{},
+		Data:      data,
+	}
+}
+
+type DeactivateDelinquentParam struct {
+	Stake          common.PublicKey
+	DelinquentVote common.PublicKey
+	ReferenceVote  common.PublicKey
+}
+
+// DeactivateDelinquent deactivates stake delegated to a vote account that has been delinquent for at least 5 epochs
+func DeactivateDelinquent(param DeactivateDelinquentParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionDeactivateDelinquent,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Stake, IsSigner: false, IsWritable: true},
+			{PubKey: param.DelinquentVote, IsSigner: false, IsWritable: false},
+			{PubKey: param.ReferenceVote, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
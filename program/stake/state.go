@@ -0,0 +1,136 @@
+package stake
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+type StakeStateType uint32
+
+const (
+	StakeStateTypeUninitialized StakeStateType = iota
+	StakeStateTypeInitialized
+	StakeStateTypeStake
+	StakeStateTypeRewardsPool
+)
+
+// the layout of a stake account
+//
+//	[0:4]     state type
+//	[4:12]    meta.rent_exempt_reserve
+//	[12:44]   meta.authorized.staker
+//	[44:76]   meta.authorized.withdrawer
+//	[76:84]   meta.lockup.unix_timestamp
+//	[84:92]   meta.lockup.epoch
+//	[92:124]  meta.lockup.custodian
+//	[124:156] stake.delegation.voter_pubkey
+//	[156:164] stake.delegation.stake
+//	[164:172] stake.delegation.activation_epoch
+//	[172:180] stake.delegation.deactivation_epoch
+//	[180:188] stake.delegation.warmup_cooldown_rate
+//	[188:196] stake.credits_observed
+//	[196:197] stake flags
+const (
+	MetaOffset       = 4
+	MetaSize         = 120
+	StakeOffset      = MetaOffset + MetaSize
+	StakeSize        = 72
+	StakeFlagsOffset = StakeOffset + StakeSize
+)
+
+type Meta struct {
+	RentExemptReserve uint64
+	Authorized        Authorized
+	Lockup            Lockup
+}
+
+type Delegation struct {
+	VoterPubkey        common.PublicKey
+	Stake              uint64
+	ActivationEpoch    uint64
+	DeactivationEpoch  uint64
+	WarmupCooldownRate float64
+}
+
+type Stake struct {
+	Delegation      Delegation
+	CreditsObserved uint64
+}
+
+// StakeAccount is stake program account.
+// Meta is set when the account is initialized or delegated, Stake is only set when it is delegated.
+type StakeAccount struct {
+	Type       StakeStateType
+	Meta       *Meta
+	Stake      *Stake
+	StakeFlags uint8
+}
+
+func StakeAccountFromData(data []byte) (StakeAccount, error) {
+	if len(data) < MetaOffset {
+		return StakeAccount{}, ErrInvalidAccountDataSize
+	}
+
+	stakeStateType := StakeStateType(binary.LittleEndian.Uint32(data[:MetaOffset]))
+	switch stakeStateType {
+	case StakeStateTypeUninitialized, StakeStateTypeRewardsPool:
+		return StakeAccount{Type: stakeStateType}, nil
+	case StakeStateTypeInitialized:
+		if len(data) < StakeOffset {
+			return StakeAccount{}, ErrInvalidAccountDataSize
+		}
+		meta := metaFromData(data[MetaOffset:StakeOffset])
+		return StakeAccount{
+			Type: stakeStateType,
+			Meta: &meta,
+		}, nil
+	case StakeStateTypeStake:
+		if len(data) < StakeFlagsOffset {
+			return StakeAccount{}, ErrInvalidAccountDataSize
+		}
+		meta := metaFromData(data[MetaOffset:StakeOffset])
+		stake := stakeFromData(data[StakeOffset:StakeFlagsOffset])
+		// stake flags is appended to the old layout
+		var stakeFlags uint8
+		if len(data) > StakeFlagsOffset {
+			stakeFlags = data[StakeFlagsOffset]
+		}
+		return StakeAccount{
+			Type:       stakeStateType,
+			Meta:       &meta,
+			Stake:      &stake,
+			StakeFlags: stakeFlags,
+		}, nil
+	}
+	return StakeAccount{}, ErrInvalidStakeStateType
+}
+
+func metaFromData(data []byte) Meta {
+	return Meta{
+		RentExemptReserve: binary.LittleEndian.Uint64(data[0:8]),
+		Authorized: Authorized{
+			Staker:     common.PublicKeyFromBytes(data[8:40]),
+			Withdrawer: common.PublicKeyFromBytes(data[40:72]),
+		},
+		Lockup: Lockup{
+			UnixTimestamp: int64(binary.LittleEndian.Uint64(data[72:80])),
+			Epoch:         binary.LittleEndian.Uint64(data[80:88]),
+			Cusodian:      common.PublicKeyFromBytes(data[88:120]),
+		},
+	}
+}
+
+func stakeFromData(data []byte) Stake {
+	return Stake{
+		Delegation: Delegation{
+			VoterPubkey:        common.PublicKeyFromBytes(data[0:32]),
+			Stake:              binary.LittleEndian.Uint64(data[32:40]),
+			ActivationEpoch:    binary.LittleEndian.Uint64(data[40:48]),
+			DeactivationEpoch:  binary.LittleEndian.Uint64(data[48:56]),
+			WarmupCooldownRate: math.Float64frombits(binary.LittleEndian.Uint64(data[56:64])),
+		},
+		CreditsObserved: binary.LittleEndian.Uint64(data[64:72]),
+	}
+}
@@ -0,0 +1,115 @@
+package stake
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakeAccountFromData(t *testing.T) {
+	staker := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	withdrawer := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+	voter := common.PublicKeyFromString("CUQwQyNDPdGM2KfC7B4NJhrSwDwRjdqKetpwBHe9CvEk")
+
+	newData := func(stakeStateType StakeStateType) []byte {
+		data := make([]byte, AccountSize)
+		binary.LittleEndian.PutUint32(data[0:4], uint32(stakeStateType))
+		binary.LittleEndian.PutUint64(data[4:12], 2282880)
+		copy(data[12:44], staker.Bytes())
+		copy(data[44:76], withdrawer.Bytes())
+		binary.LittleEndian.PutUint64(data[76:84], uint64(1700000000))
+		binary.LittleEndian.PutUint64(data[84:92], 10)
+		if stakeStateType == StakeStateTypeStake {
+			copy(data[124:156], voter.Bytes())
+			binary.LittleEndian.PutUint64(data[156:164], 1000000000)
+			binary.LittleEndian.PutUint64(data[164:172], 300)
+			binary.LittleEndian.PutUint64(data[172:180], math.MaxUint64)
+			binary.LittleEndian.PutUint64(data[180:188], math.Float64bits(0.25))
+			binary.LittleEndian.PutUint64(data[188:196], 12345)
+			data[196] = 1
+		}
+		return data
+	}
+	meta := Meta{
+		RentExemptReserve: 2282880,
+		Authorized: Authorized{
+			Staker:     staker,
+			Withdrawer: withdrawer,
+		},
+		Lockup: Lockup{
+			UnixTimestamp: 1700000000,
+			Epoch:         10,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    StakeAccount
+		wantErr error
+	}{
+		{
+			name:    "uninitialized",
+			data:    make([]byte, AccountSize),
+			want:    StakeAccount{Type: StakeStateTypeUninitialized},
+			wantErr: nil,
+		},
+		{
+			name: "initialized",
+			data: newData(StakeStateTypeInitialized),
+			want: StakeAccount{
+				Type: StakeStateTypeInitialized,
+				Meta: &meta,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "stake",
+			data: newData(StakeStateTypeStake),
+			want: StakeAccount{
+				Type: StakeStateTypeStake,
+				Meta: &meta,
+				Stake: &Stake{
+					Delegation: Delegation{
+						VoterPubkey:        voter,
+						Stake:              1000000000,
+						ActivationEpoch:    300,
+						DeactivationEpoch:  math.MaxUint64,
+						WarmupCooldownRate: 0.25,
+					},
+					CreditsObserved: 12345,
+				},
+				StakeFlags: 1,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "stake data size is not enough",
+			data:    newData(StakeStateTypeStake)[:StakeFlagsOffset-1],
+			want:    StakeAccount{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			name:    "invalid type",
+			data:    []byte{4, 0, 0, 0},
+			want:    StakeAccount{},
+			wantErr: ErrInvalidStakeStateType,
+		},
+		{
+			name:    "empty",
+			data:    []byte{},
+			want:    StakeAccount{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StakeAccountFromData(tt.data)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
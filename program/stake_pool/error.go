@@ -0,0 +1,5 @@
+package stake_pool
+
+import "errors"
+
+var ErrInvalidAccountType = errors.New("invalid stake pool account type")
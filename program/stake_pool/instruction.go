@@ -0,0 +1,434 @@
+package stake_pool
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/near/borsh-go"
+)
+
+type Instruction borsh.Enum
+
+const (
+	InstructionInitialize Instruction = iota
+	InstructionAddValidatorToPool
+	InstructionRemoveValidatorFromPool
+	InstructionDecreaseValidatorStake
+	InstructionIncreaseValidatorStake
+	InstructionSetPreferredValidator
+	InstructionUpdateValidatorListBalance
+	InstructionUpdateStakePoolBalance
+	InstructionCleanupRemovedValidatorEntries
+	InstructionDepositStake
+	InstructionWithdrawStake
+	InstructionSetManager
+	InstructionSetFee
+	InstructionSetStaker
+	InstructionDepositSol
+	InstructionSetFundingAuthority
+	InstructionWithdrawSol
+	InstructionCreateTokenMetadata
+	InstructionUpdateTokenMetadata
+	InstructionIncreaseAdditionalValidatorStake
+	InstructionDecreaseAdditionalValidatorStake
+	InstructionDecreaseValidatorStakeWithReserve
+	InstructionRedelegate
+	InstructionDepositStakeWithSlippage
+	InstructionWithdrawStakeWithSlippage
+	InstructionDepositSolWithSlippage
+	InstructionWithdrawSolWithSlippage
+)
+
+// MaxValidatorsToUpdate is the number of validators updated by one UpdateValidatorListBalance
+const MaxValidatorsToUpdate = 5
+
+// all params have a ProgramID field, leave it empty to use common.SPLStakePoolProgramID.
+// TokenProgramID is the token program of the pool mint, leave it empty to use common.TokenProgramID.
+
+type DepositSolParam struct {
+	ProgramID                 common.PublicKey
+	StakePool                 common.PublicKey
+	ReserveStake              common.PublicKey
+	LamportsFrom              common.PublicKey
+	PoolTokensTo              common.PublicKey
+	ManagerFeeAccount         common.PublicKey
+	ReferrerPoolTokensAccount common.PublicKey
+	PoolMint                  common.PublicKey
+	TokenProgramID            common.PublicKey
+	// SolDepositAuthority is required if the pool has a sol deposit authority
+	SolDepositAuthority *common.PublicKey
+	Lamports            uint64
+}
+
+// DepositSol deposits lamports into the reserve and mints pool tokens
+func DepositSol(param DepositSolParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Lamports    uint64
+	}{
+		Instruction: InstructionDepositSol,
+		Lamports:    param.Lamports,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.StakePool, IsSigner: false, IsWritable: true},
+		{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+		{PubKey: param.ReserveStake, IsSigner: false, IsWritable: true},
+		{PubKey: param.LamportsFrom, IsSigner: true, IsWritable: true},
+		{PubKey: param.PoolTokensTo, IsSigner: false, IsWritable: true},
+		{PubKey: param.ManagerFeeAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.ReferrerPoolTokensAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+	}
+	if param.SolDepositAuthority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.SolDepositAuthority, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type WithdrawSolParam struct {
+	ProgramID             common.PublicKey
+	StakePool             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	PoolTokensFrom        common.PublicKey
+	ReserveStake          common.PublicKey
+	LamportsTo            common.PublicKey
+	ManagerFeeAccount     common.PublicKey
+	PoolMint              common.PublicKey
+	TokenProgramID        common.PublicKey
+	// SolWithdrawAuthority is required if the pool has a sol withdraw authority
+	SolWithdrawAuthority *common.PublicKey
+	PoolTokens           uint64
+}
+
+// WithdrawSol burns pool tokens and withdraws lamports from the reserve
+func WithdrawSol(param WithdrawSolParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		PoolTokens  uint64
+	}{
+		Instruction: InstructionWithdrawSol,
+		PoolTokens:  param.PoolTokens,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.StakePool, IsSigner: false, IsWritable: true},
+		{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+		{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: param.PoolTokensFrom, IsSigner: false, IsWritable: true},
+		{PubKey: param.ReserveStake, IsSigner: false, IsWritable: true},
+		{PubKey: param.LamportsTo, IsSigner: false, IsWritable: true},
+		{PubKey: param.ManagerFeeAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+		{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarStakeHistoryPubkey, IsSigner: false, IsWritable: false},
+		{PubKey: common.StakeProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+	}
+	if param.SolWithdrawAuthority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.SolWithdrawAuthority, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type DepositStakeParam struct {
+	ProgramID     common.PublicKey
+	StakePool     common.PublicKey
+	ValidatorList common.PublicKey
+	// StakeDepositAuthority is required if the pool has a custom stake deposit authority, it signs the deposit.
+	// leave it nil to use the default authority, see GetDepositAuthorityAddress
+	StakeDepositAuthority     *common.PublicKey
+	DepositStake              common.PublicKey
+	ValidatorStake            common.PublicKey
+	ReserveStake              common.PublicKey
+	PoolTokensTo              common.PublicKey
+	ManagerFeeAccount         common.PublicKey
+	ReferrerPoolTokensAccount common.PublicKey
+	PoolMint                  common.PublicKey
+	TokenProgramID            common.PublicKey
+}
+
+// DepositStake deposits an active stake account delegated to a validator of the pool and mints pool tokens.
+// the staker and the withdrawer of the stake account must be authorized to the stake deposit authority
+// beforehand, e.g. by stake.Authorize in the same transaction.
+func DepositStake(param DepositStakeParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionDepositStake,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	depositAuthority := types.AccountMeta{IsSigner: false, IsWritable: false}
+	if param.StakeDepositAuthority != nil {
+		depositAuthority.PubKey = *param.StakeDepositAuthority
+		depositAuthority.IsSigner = true
+	} else {
+		depositAuthority.PubKey = mustAddress(GetDepositAuthorityAddress(param.ProgramID, param.StakePool))
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.StakePool, IsSigner: false, IsWritable: true},
+			{PubKey: param.ValidatorList, IsSigner: false, IsWritable: true},
+			depositAuthority,
+			{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+			{PubKey: param.DepositStake, IsSigner: false, IsWritable: true},
+			{PubKey: param.ValidatorStake, IsSigner: false, IsWritable: true},
+			{PubKey: param.ReserveStake, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolTokensTo, IsSigner: false, IsWritable: true},
+			{PubKey: param.ManagerFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.ReferrerPoolTokensAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarStakeHistoryPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+			{PubKey: common.StakeProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WithdrawStakeParam struct {
+	ProgramID     common.PublicKey
+	StakePool     common.PublicKey
+	ValidatorList common.PublicKey
+	// StakeToSplit is a validator stake account, or the reserve if no validator has enough stake
+	StakeToSplit common.PublicKey
+	// StakeToReceive is an uninitialized stake account owned by the stake program
+	StakeToReceive common.PublicKey
+	// UserStakeAuthority becomes the staker and the withdrawer of StakeToReceive
+	UserStakeAuthority    common.PublicKey
+	UserTransferAuthority common.PublicKey
+	UserPoolTokenAccount  common.PublicKey
+	ManagerFeeAccount     common.PublicKey
+	PoolMint              common.PublicKey
+	TokenProgramID        common.PublicKey
+	PoolTokens            uint64
+}
+
+// WithdrawStake burns pool tokens and splits a stake account out of the pool
+func WithdrawStake(param WithdrawStakeParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		PoolTokens  uint64
+	}{
+		Instruction: InstructionWithdrawStake,
+		PoolTokens:  param.PoolTokens,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.StakePool, IsSigner: false, IsWritable: true},
+			{PubKey: param.ValidatorList, IsSigner: false, IsWritable: true},
+			{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+			{PubKey: param.StakeToSplit, IsSigner: false, IsWritable: true},
+			{PubKey: param.StakeToReceive, IsSigner: false, IsWritable: true},
+			{PubKey: param.UserStakeAuthority, IsSigner: false, IsWritable: false},
+			{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.UserPoolTokenAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.ManagerFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+			{PubKey: common.StakeProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpdateValidatorListBalanceParam struct {
+	ProgramID     common.PublicKey
+	StakePool     common.PublicKey
+	ValidatorList common.PublicKey
+	ReserveStake  common.PublicKey
+	// Validators are the entries from StartIndex, at most MaxValidatorsToUpdate fit in one instruction
+	Validators []ValidatorStakeInfo
+	StartIndex uint32
+	// NoMerge skips merging the transient stake accounts
+	NoMerge bool
+}
+
+func UpdateValidatorListBalance(param UpdateValidatorListBalanceParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		StartIndex  uint32
+		NoMerge     bool
+	}{
+		Instruction: InstructionUpdateValidatorListBalance,
+		StartIndex:  param.StartIndex,
+		NoMerge:     param.NoMerge,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 7+2*len(param.Validators))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.StakePool, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.ValidatorList, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.ReserveStake, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: common.SysVarStakeHistoryPubkey, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: common.StakeProgramID, IsSigner: false, IsWritable: false},
+	)
+	for _, validator := range param.Validators {
+		accounts = append(accounts,
+			types.AccountMeta{PubKey: mustAddress(GetValidatorStakeAddress(param.ProgramID, validator.VoteAccountAddress, param.StakePool, validator.ValidatorSeedSuffix)), IsSigner: false, IsWritable: true},
+			types.AccountMeta{PubKey: mustAddress(GetTransientStakeAddress(param.ProgramID, validator.VoteAccountAddress, param.StakePool, validator.TransientSeedSuffix)), IsSigner: false, IsWritable: true},
+		)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type UpdateStakePoolBalanceParam struct {
+	ProgramID         common.PublicKey
+	StakePool         common.PublicKey
+	ValidatorList     common.PublicKey
+	ReserveStake      common.PublicKey
+	ManagerFeeAccount common.PublicKey
+	PoolMint          common.PublicKey
+	TokenProgramID    common.PublicKey
+}
+
+func UpdateStakePoolBalance(param UpdateStakePoolBalanceParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionUpdateStakePoolBalance,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.StakePool, IsSigner: false, IsWritable: true},
+			{PubKey: mustAddress(GetWithdrawAuthorityAddress(param.ProgramID, param.StakePool)), IsSigner: false, IsWritable: false},
+			{PubKey: param.ValidatorList, IsSigner: false, IsWritable: true},
+			{PubKey: param.ReserveStake, IsSigner: false, IsWritable: false},
+			{PubKey: param.ManagerFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type CleanupRemovedValidatorEntriesParam struct {
+	ProgramID     common.PublicKey
+	StakePool     common.PublicKey
+	ValidatorList common.PublicKey
+}
+
+func CleanupRemovedValidatorEntries(param CleanupRemovedValidatorEntriesParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionCleanupRemovedValidatorEntries,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.StakePool, IsSigner: false, IsWritable: false},
+			{PubKey: param.ValidatorList, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type UpdateStakePoolParam struct {
+	ProgramID        common.PublicKey
+	StakePoolAddress common.PublicKey
+	StakePool        StakePool
+	ValidatorList    ValidatorList
+	NoMerge          bool
+}
+
+// UpdateStakePool returns the instructions to update a pool at the start of an epoch:
+// the validator list balances in chunks of MaxValidatorsToUpdate, then the pool balance and the cleanup.
+// the list updates can be sent in parallel, the last two instructions must be sent after them.
+func UpdateStakePool(param UpdateStakePoolParam) []types.Instruction {
+	validators := param.ValidatorList.Validators
+	instructions := make([]types.Instruction, 0, (len(validators)+MaxValidatorsToUpdate-1)/MaxValidatorsToUpdate+2)
+	for start := 0; start < len(validators); start += MaxValidatorsToUpdate {
+		end := start + MaxValidatorsToUpdate
+		if end > len(validators) {
+			end = len(validators)
+		}
+		instructions = append(instructions, UpdateValidatorListBalance(UpdateValidatorListBalanceParam{
+			ProgramID:     param.ProgramID,
+			StakePool:     param.StakePoolAddress,
+			ValidatorList: param.StakePool.ValidatorList,
+			ReserveStake:  param.StakePool.ReserveStake,
+			Validators:    validators[start:end],
+			StartIndex:    uint32(start),
+			NoMerge:       param.NoMerge,
+		}))
+	}
+	return append(instructions,
+		UpdateStakePoolBalance(UpdateStakePoolBalanceParam{
+			ProgramID:         param.ProgramID,
+			StakePool:         param.StakePoolAddress,
+			ValidatorList:     param.StakePool.ValidatorList,
+			ReserveStake:      param.StakePool.ReserveStake,
+			ManagerFeeAccount: param.StakePool.ManagerFeeAccount,
+			PoolMint:          param.StakePool.PoolMint,
+			TokenProgramID:    param.StakePool.TokenProgramID,
+		}),
+		CleanupRemovedValidatorEntries(CleanupRemovedValidatorEntriesParam{
+			ProgramID:     param.ProgramID,
+			StakePool:     param.StakePoolAddress,
+			ValidatorList: param.StakePool.ValidatorList,
+		}),
+	)
+}
+
+func tokenProgramID(p common.PublicKey) common.PublicKey {
+	if p == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return p
+}
+
+func mustAddress(pubkey common.PublicKey, err error) common.PublicKey {
+	if err != nil {
+		panic(err)
+	}
+	return pubkey
+}
@@ -0,0 +1,154 @@
+package stake_pool
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testStakePool     = common.PublicKeyFromString("Jito4APyf642JPZPx3hGc6WWJ8zPKtRbRs4P815Awbb")
+	testValidatorList = common.PublicKeyFromString("3R3nGZpQs2aZo5FDQvd2MUQ6R7KhAPainds6uT6uE2mn")
+	testReserve       = common.PublicKeyFromString("BgKUXdS29YcHCFrPm5M8oLHiTzZaMDjsebggjoaQ6KFL")
+	testPoolMint      = common.PublicKeyFromString("J1toso1uCk3RLmjorhTtrVwY9HJ7X8V9yYac6Y7kGCPn")
+	testFeeAccount    = common.PublicKeyFromString("feeeFLLsam6xZJFc6UQFrHqkvVt4jfmVvi2BRLkUZ4i")
+	testUser          = common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	testUserToken     = common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+)
+
+func TestDepositSol(t *testing.T) {
+	withdrawAuthority, err := GetWithdrawAuthorityAddress(common.PublicKey{}, testStakePool)
+	assert.Nil(t, err)
+
+	got := DepositSol(DepositSolParam{
+		StakePool:                 testStakePool,
+		ReserveStake:              testReserve,
+		LamportsFrom:              testUser,
+		PoolTokensTo:              testUserToken,
+		ManagerFeeAccount:         testFeeAccount,
+		ReferrerPoolTokensAccount: testUserToken,
+		PoolMint:                  testPoolMint,
+		Lamports:                  1000000000,
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLStakePoolProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testStakePool, IsSigner: false, IsWritable: true},
+			{PubKey: withdrawAuthority, IsSigner: false, IsWritable: false},
+			{PubKey: testReserve, IsSigner: false, IsWritable: true},
+			{PubKey: testUser, IsSigner: true, IsWritable: true},
+			{PubKey: testUserToken, IsSigner: false, IsWritable: true},
+			{PubKey: testFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: testUserToken, IsSigner: false, IsWritable: true},
+			{PubKey: testPoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{14, 0, 202, 154, 59, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestWithdrawSol(t *testing.T) {
+	withdrawAuthority, err := GetWithdrawAuthorityAddress(common.PublicKey{}, testStakePool)
+	assert.Nil(t, err)
+	solWithdrawAuthority := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+
+	got := WithdrawSol(WithdrawSolParam{
+		StakePool:             testStakePool,
+		UserTransferAuthority: testUser,
+		PoolTokensFrom:        testUserToken,
+		ReserveStake:          testReserve,
+		LamportsTo:            testUser,
+		ManagerFeeAccount:     testFeeAccount,
+		PoolMint:              testPoolMint,
+		TokenProgramID:        common.Token2022ProgramID,
+		SolWithdrawAuthority:  &solWithdrawAuthority,
+		PoolTokens:            1,
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLStakePoolProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testStakePool, IsSigner: false, IsWritable: true},
+			{PubKey: withdrawAuthority, IsSigner: false, IsWritable: false},
+			{PubKey: testUser, IsSigner: true, IsWritable: false},
+			{PubKey: testUserToken, IsSigner: false, IsWritable: true},
+			{PubKey: testReserve, IsSigner: false, IsWritable: true},
+			{PubKey: testUser, IsSigner: false, IsWritable: true},
+			{PubKey: testFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: testPoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarStakeHistoryPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.StakeProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.Token2022ProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: solWithdrawAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{16, 1, 0, 0, 0, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestDepositStake(t *testing.T) {
+	depositAuthority, err := GetDepositAuthorityAddress(common.PublicKey{}, testStakePool)
+	assert.Nil(t, err)
+	customAuthority := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+
+	param := DepositStakeParam{
+		StakePool:                 testStakePool,
+		ValidatorList:             testValidatorList,
+		DepositStake:              common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		ValidatorStake:            common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		ReserveStake:              testReserve,
+		PoolTokensTo:              testUserToken,
+		ManagerFeeAccount:         testFeeAccount,
+		ReferrerPoolTokensAccount: testUserToken,
+		PoolMint:                  testPoolMint,
+	}
+	got := DepositStake(param)
+	assert.Equal(t, []byte{9}, got.Data)
+	assert.Len(t, got.Accounts, 15)
+	assert.Equal(t, types.AccountMeta{PubKey: depositAuthority, IsSigner: false, IsWritable: false}, got.Accounts[2])
+
+	param.StakeDepositAuthority = &customAuthority
+	got = DepositStake(param)
+	assert.Equal(t, types.AccountMeta{PubKey: customAuthority, IsSigner: true, IsWritable: false}, got.Accounts[2])
+}
+
+func TestUpdateStakePool(t *testing.T) {
+	validators := make([]ValidatorStakeInfo, 7)
+	for i := range validators {
+		validators[i].VoteAccountAddress = common.PublicKeyFromBytes([]byte{byte(i + 1)})
+	}
+	validators[1].ValidatorSeedSuffix = 3
+	validators[1].TransientSeedSuffix = 4
+
+	got := UpdateStakePool(UpdateStakePoolParam{
+		StakePoolAddress: testStakePool,
+		StakePool: StakePool{
+			ValidatorList:     testValidatorList,
+			ReserveStake:      testReserve,
+			ManagerFeeAccount: testFeeAccount,
+			PoolMint:          testPoolMint,
+			TokenProgramID:    common.TokenProgramID,
+		},
+		ValidatorList: ValidatorList{Validators: validators},
+	})
+	assert.Len(t, got, 4)
+
+	assert.Equal(t, []byte{6, 0, 0, 0, 0, 0}, got[0].Data)
+	assert.Len(t, got[0].Accounts, 7+2*5)
+	validatorStake, _ := GetValidatorStakeAddress(common.PublicKey{}, validators[1].VoteAccountAddress, testStakePool, 3)
+	transientStake, _ := GetTransientStakeAddress(common.PublicKey{}, validators[1].VoteAccountAddress, testStakePool, 4)
+	assert.Equal(t, validatorStake, got[0].Accounts[9].PubKey)
+	assert.Equal(t, transientStake, got[0].Accounts[10].PubKey)
+
+	assert.Equal(t, []byte{6, 5, 0, 0, 0, 0}, got[1].Data)
+	assert.Len(t, got[1].Accounts, 7+2*2)
+
+	assert.Equal(t, []byte{7}, got[2].Data)
+	assert.Equal(t, []byte{8}, got[3].Data)
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: testStakePool, IsSigner: false, IsWritable: false},
+		{PubKey: testValidatorList, IsSigner: false, IsWritable: true},
+	}, got[3].Accounts)
+}
@@ -0,0 +1,137 @@
+package stake_pool
+
+import (
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/stake"
+	"github.com/near/borsh-go"
+)
+
+type AccountType borsh.Enum
+
+const (
+	AccountTypeUninitialized AccountType = iota
+	AccountTypeStakePool
+	AccountTypeValidatorList
+)
+
+// Fee is numerator / denominator
+type Fee struct {
+	Denominator uint64
+	Numerator   uint64
+}
+
+const (
+	FutureEpochNone borsh.Enum = iota
+	FutureEpochOne
+	FutureEpochTwo
+)
+
+// FutureEpochFee is a fee which takes effect in one or two epochs
+type FutureEpochFee struct {
+	Enum borsh.Enum `borsh_enum:"true"`
+	None struct{}
+	One  Fee
+	Two  Fee
+}
+
+type StakePool struct {
+	AccountType                           AccountType
+	Manager                               common.PublicKey
+	Staker                                common.PublicKey
+	StakeDepositAuthority                 common.PublicKey
+	StakeWithdrawBumpSeed                 uint8
+	ValidatorList                         common.PublicKey
+	ReserveStake                          common.PublicKey
+	PoolMint                              common.PublicKey
+	ManagerFeeAccount                     common.PublicKey
+	TokenProgramID                        common.PublicKey
+	TotalLamports                         uint64
+	PoolTokenSupply                       uint64
+	LastUpdateEpoch                       uint64
+	Lockup                                stake.Lockup
+	EpochFee                              Fee
+	NextEpochFee                          FutureEpochFee
+	PreferredDepositValidatorVoteAddress  *common.PublicKey
+	PreferredWithdrawValidatorVoteAddress *common.PublicKey
+	StakeDepositFee                       Fee
+	StakeWithdrawalFee                    Fee
+	NextStakeWithdrawalFee                FutureEpochFee
+	StakeReferralFee                      uint8
+	SolDepositAuthority                   *common.PublicKey
+	SolDepositFee                         Fee
+	SolReferralFee                        uint8
+	SolWithdrawAuthority                  *common.PublicKey
+	SolWithdrawalFee                      Fee
+	NextSolWithdrawalFee                  FutureEpochFee
+	LastEpochPoolTokenSupply              uint64
+	LastEpochTotalLamports                uint64
+}
+
+func StakePoolDeserialize(data []byte) (StakePool, error) {
+	if len(data) == 0 || AccountType(data[0]) != AccountTypeStakePool {
+		return StakePool{}, ErrInvalidAccountType
+	}
+	var stakePool StakePool
+	if err := borsh.Deserialize(&stakePool, data); err != nil {
+		return StakePool{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return stakePool, nil
+}
+
+// PoolTokensForDeposit returns the pool tokens minted for the lamports before fees
+func (p StakePool) PoolTokensForDeposit(lamports uint64) uint64 {
+	if p.TotalLamports == 0 || p.PoolTokenSupply == 0 {
+		return lamports
+	}
+	return mulDiv(lamports, p.PoolTokenSupply, p.TotalLamports)
+}
+
+// LamportsForPoolTokens returns the lamports the pool tokens are worth before fees
+func (p StakePool) LamportsForPoolTokens(poolTokens uint64) uint64 {
+	if p.PoolTokenSupply == 0 {
+		return 0
+	}
+	return mulDiv(poolTokens, p.TotalLamports, p.PoolTokenSupply)
+}
+
+type StakeStatus uint8
+
+const (
+	StakeStatusActive StakeStatus = iota
+	StakeStatusDeactivatingTransient
+	StakeStatusReadyForRemoval
+	StakeStatusDeactivatingValidator
+	StakeStatusDeactivatingAll
+)
+
+type ValidatorStakeInfo struct {
+	ActiveStakeLamports    uint64
+	TransientStakeLamports uint64
+	LastUpdateEpoch        uint64
+	TransientSeedSuffix    uint64
+	Unused                 uint32
+	ValidatorSeedSuffix    uint32
+	Status                 StakeStatus
+	VoteAccountAddress     common.PublicKey
+}
+
+type ValidatorList struct {
+	AccountType   AccountType
+	MaxValidators uint32
+	Validators    []ValidatorStakeInfo
+}
+
+// ValidatorListDeserialize parses a validator list, the account is allocated for MaxValidators
+// so the unused space is ignored
+func ValidatorListDeserialize(data []byte) (ValidatorList, error) {
+	if len(data) == 0 || AccountType(data[0]) != AccountTypeValidatorList {
+		return ValidatorList{}, ErrInvalidAccountType
+	}
+	var validatorList ValidatorList
+	if err := borsh.Deserialize(&validatorList, data); err != nil {
+		return ValidatorList{}, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return validatorList, nil
+}
@@ -0,0 +1,73 @@
+package stake_pool
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/program/stake"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakePoolDeserialize(t *testing.T) {
+	solDepositAuthority := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	want := StakePool{
+		AccountType:           AccountTypeStakePool,
+		Manager:               testUser,
+		Staker:                testUser,
+		StakeDepositAuthority: testUser,
+		StakeWithdrawBumpSeed: 255,
+		ValidatorList:         testValidatorList,
+		ReserveStake:          testReserve,
+		PoolMint:              testPoolMint,
+		ManagerFeeAccount:     testFeeAccount,
+		TokenProgramID:        common.TokenProgramID,
+		TotalLamports:         1100,
+		PoolTokenSupply:       1000,
+		LastUpdateEpoch:       600,
+		Lockup:                stake.Lockup{},
+		EpochFee:              Fee{Denominator: 100, Numerator: 4},
+		NextEpochFee:          FutureEpochFee{Enum: FutureEpochTwo, Two: Fee{Denominator: 100, Numerator: 5}},
+		StakeDepositFee:       Fee{Denominator: 1000, Numerator: 1},
+		SolDepositAuthority:   &solDepositAuthority,
+		SolReferralFee:        50,
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+
+	got, err := StakePoolDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	assert.Equal(t, uint64(1000), got.PoolTokensForDeposit(1100))
+	assert.Equal(t, uint64(1100), got.LamportsForPoolTokens(1000))
+
+	_, err = StakePoolDeserialize(append([]byte{byte(AccountTypeValidatorList)}, data[1:]...))
+	assert.ErrorIs(t, err, ErrInvalidAccountType)
+}
+
+func TestValidatorListDeserialize(t *testing.T) {
+	want := ValidatorList{
+		AccountType:   AccountTypeValidatorList,
+		MaxValidators: 3,
+		Validators: []ValidatorStakeInfo{
+			{
+				ActiveStakeLamports:    5000000000,
+				TransientStakeLamports: 1,
+				LastUpdateEpoch:        600,
+				TransientSeedSuffix:    2,
+				ValidatorSeedSuffix:    1,
+				Status:                 StakeStatusActive,
+				VoteAccountAddress:     testUser,
+			},
+		},
+	}
+	data, err := borsh.Serialize(want)
+	assert.Nil(t, err)
+	// the space of the other 2 validators
+	data = append(data, make([]byte, 2*73)...)
+
+	got, err := ValidatorListDeserialize(data)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
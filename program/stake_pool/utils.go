@@ -0,0 +1,57 @@
+package stake_pool
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// all helpers take the stake pool program id, leave it empty to use common.SPLStakePoolProgramID
+
+// GetWithdrawAuthorityAddress returns the authority of the pool stake accounts and the pool mint
+func GetWithdrawAuthorityAddress(programID, stakePool common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, stakePool.Bytes(), []byte("withdraw"))
+}
+
+// GetDepositAuthorityAddress returns the default stake deposit authority
+func GetDepositAuthorityAddress(programID, stakePool common.PublicKey) (common.PublicKey, error) {
+	return findProgramAddress(programID, stakePool.Bytes(), []byte("deposit"))
+}
+
+// GetValidatorStakeAddress returns the stake account of a validator, seed is ValidatorStakeInfo.ValidatorSeedSuffix
+func GetValidatorStakeAddress(programID, voteAccount, stakePool common.PublicKey, seed uint32) (common.PublicKey, error) {
+	seeds := [][]byte{voteAccount.Bytes(), stakePool.Bytes()}
+	if seed != 0 {
+		seeds = append(seeds, binary.LittleEndian.AppendUint32(nil, seed))
+	}
+	return findProgramAddress(programID, seeds...)
+}
+
+// GetTransientStakeAddress returns the transient stake account of a validator, seed is ValidatorStakeInfo.TransientSeedSuffix
+func GetTransientStakeAddress(programID, voteAccount, stakePool common.PublicKey, seed uint64) (common.PublicKey, error) {
+	return findProgramAddress(programID, []byte("transient"), voteAccount.Bytes(), stakePool.Bytes(), binary.LittleEndian.AppendUint64(nil, seed))
+}
+
+func findProgramAddress(programID common.PublicKey, seeds ...[]byte) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(seeds, getProgramID(programID))
+	return pubkey, err
+}
+
+func getProgramID(programID common.PublicKey) common.PublicKey {
+	if programID == (common.PublicKey{}) {
+		return common.SPLStakePoolProgramID
+	}
+	return programID
+}
+
+// mulDiv returns a * b / c in 128 bits, it saturates on overflow
+func mulDiv(a, b, c uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi >= c {
+		return math.MaxUint64
+	}
+	q, _ := bits.Div64(hi, lo, c)
+	return q
+}
@@ -0,0 +1,285 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// DecodeInstruction is the inverse of the instruction builders. it returns one of the params, e.g. TransferParam.
+// accounts are the account keys the compiled instruction indexes into. for a v0 message,
+// the addresses loaded from lookup tables need to be appended after the static keys.
+func DecodeInstruction(instruction types.CompiledInstruction, accounts []common.PublicKey) (any, error) {
+	if instruction.ProgramIDIndex < 0 || instruction.ProgramIDIndex >= len(accounts) {
+		return nil, ErrInvalidAccountIndex
+	}
+	if accounts[instruction.ProgramIDIndex] != common.SystemProgramID {
+		return nil, ErrInvalidProgramID
+	}
+
+	keys := make([]common.PublicKey, 0, len(instruction.Accounts))
+	for _, idx := range instruction.Accounts {
+		if idx < 0 || idx >= len(accounts) {
+			return nil, ErrInvalidAccountIndex
+		}
+		keys = append(keys, accounts[idx])
+	}
+
+	var ins Instruction
+	if err := bincode.DeserializeData(instruction.Data, &ins); err != nil {
+		return nil, fmt.Errorf("%w, err: %v", ErrInvalidInstructionData, err)
+	}
+
+	decoder, ok := instructionDecoders[ins]
+	if !ok {
+		return nil, ErrUnknownInstruction
+	}
+	if len(keys) < decoder.minAccounts {
+		return nil, ErrNotEnoughAccounts
+	}
+	return decoder.decode(instruction.Data, keys)
+}
+
+type instructionDecoder struct {
+	minAccounts int
+	decode      func(data []byte, keys []common.PublicKey) (any, error)
+}
+
+var instructionDecoders = map[Instruction]instructionDecoder{
+	InstructionCreateAccount: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Lamports    uint64
+				Space       uint64
+				Owner       common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return CreateAccountParam{
+				From:     keys[0],
+				New:      keys[1],
+				Owner:    d.Owner,
+				Lamports: d.Lamports,
+				Space:    d.Space,
+			}, nil
+		},
+	},
+	InstructionAssign: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction       Instruction
+				AssignToProgramID common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return AssignParam{
+				From:  keys[0],
+				Owner: d.AssignToProgramID,
+			}, nil
+		},
+	},
+	InstructionTransfer: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Lamports    uint64
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return TransferParam{
+				From:   keys[0],
+				To:     keys[1],
+				Amount: d.Lamports,
+			}, nil
+		},
+	},
+	InstructionCreateAccountWithSeed: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Base        common.PublicKey
+				Seed        string
+				Lamports    uint64
+				Space       uint64
+				ProgramID   common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return CreateAccountWithSeedParam{
+				From:     keys[0],
+				New:      keys[1],
+				Base:     d.Base,
+				Owner:    d.ProgramID,
+				Seed:     d.Seed,
+				Lamports: d.Lamports,
+				Space:    d.Space,
+			}, nil
+		},
+	},
+	InstructionAdvanceNonceAccount: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			return AdvanceNonceAccountParam{
+				Nonce: keys[0],
+				Auth:  keys[2],
+			}, nil
+		},
+	},
+	InstructionWithdrawNonceAccount: {
+		minAccounts: 5,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Lamports    uint64
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return WithdrawNonceAccountParam{
+				Nonce:  keys[0],
+				To:     keys[1],
+				Auth:   keys[4],
+				Amount: d.Lamports,
+			}, nil
+		},
+	},
+	InstructionInitializeNonceAccount: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Auth        common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeNonceAccountParam{
+				Nonce: keys[0],
+				Auth:  d.Auth,
+			}, nil
+		},
+	},
+	InstructionAuthorizeNonceAccount: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Auth        common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return AuthorizeNonceAccountParam{
+				Nonce:   keys[0],
+				Auth:    keys[1],
+				NewAuth: d.Auth,
+			}, nil
+		},
+	},
+	InstructionAllocate: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Space       uint64
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return AllocateParam{
+				Account: keys[0],
+				Space:   d.Space,
+			}, nil
+		},
+	},
+	InstructionAllocateWithSeed: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Base        common.PublicKey
+				Seed        string
+				Space       uint64
+				ProgramID   common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return AllocateWithSeedParam{
+				Account: keys[0],
+				Base:    d.Base,
+				Owner:   d.ProgramID,
+				Seed:    d.Seed,
+				Space:   d.Space,
+			}, nil
+		},
+	},
+	InstructionAssignWithSeed: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction       Instruction
+				Base              common.PublicKey
+				Seed              string
+				AssignToProgramID common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return AssignWithSeedParam{
+				Account: keys[0],
+				Owner:   d.AssignToProgramID,
+				Base:    d.Base,
+				Seed:    d.Seed,
+			}, nil
+		},
+	},
+	InstructionTransferWithSeed: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			var d struct {
+				Instruction Instruction
+				Lamports    uint64
+				Seed        string
+				ProgramID   common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return TransferWithSeedParam{
+				From:   keys[0],
+				Base:   keys[1],
+				To:     keys[2],
+				Owner:  d.ProgramID,
+				Seed:   d.Seed,
+				Amount: d.Lamports,
+			}, nil
+		},
+	},
+	InstructionUpgradeNonceAccount: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey) (any, error) {
+			return UpgradeNonceAccountParam{
+				NonceAccountPubkey: keys[0],
+			}, nil
+		},
+	},
+}
+
+func deserialize(data []byte, v any) error {
+	if err := bincode.DeserializeData(data, v); err != nil {
+		return fmt.Errorf("%w, err: %v", ErrInvalidInstructionData, err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// compile turns an instruction into a compiled one which the program id is at index 0
+func compile(instruction types.Instruction) (types.CompiledInstruction, []common.PublicKey) {
+	accounts := []common.PublicKey{instruction.ProgramID}
+	indexes := make([]int, 0, len(instruction.Accounts))
+	for _, meta := range instruction.Accounts {
+		indexes = append(indexes, len(accounts))
+		accounts = append(accounts, meta.PubKey)
+	}
+	return types.CompiledInstruction{
+		ProgramIDIndex: 0,
+		Accounts:       indexes,
+		Data:           instruction.Data,
+	}, accounts
+}
+
+func TestDecodeInstruction(t *testing.T) {
+	from := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	to := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	base := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		want        any
+	}{
+		{
+			name:        "CreateAccount",
+			instruction: CreateAccount(CreateAccountParam{From: from, New: to, Owner: common.TokenProgramID, Lamports: 1, Space: 165}),
+			want:        CreateAccountParam{From: from, New: to, Owner: common.TokenProgramID, Lamports: 1, Space: 165},
+		},
+		{
+			name:        "Assign",
+			instruction: Assign(AssignParam{From: from, Owner: common.StakeProgramID}),
+			want:        AssignParam{From: from, Owner: common.StakeProgramID},
+		},
+		{
+			name:        "Transfer",
+			instruction: Transfer(TransferParam{From: from, To: to, Amount: 99999}),
+			want:        TransferParam{From: from, To: to, Amount: 99999},
+		},
+		{
+			name:        "CreateAccountWithSeed",
+			instruction: CreateAccountWithSeed(CreateAccountWithSeedParam{From: from, New: to, Base: base, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}),
+			want:        CreateAccountWithSeedParam{From: from, New: to, Base: base, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200},
+		},
+		{
+			name:        "CreateAccountWithSeed base is from",
+			instruction: CreateAccountWithSeed(CreateAccountWithSeedParam{From: from, New: to, Base: from, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}),
+			want:        CreateAccountWithSeedParam{From: from, New: to, Base: from, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200},
+		},
+		{
+			name:        "AdvanceNonceAccount",
+			instruction: AdvanceNonceAccount(AdvanceNonceAccountParam{Nonce: from, Auth: to}),
+			want:        AdvanceNonceAccountParam{Nonce: from, Auth: to},
+		},
+		{
+			name:        "WithdrawNonceAccount",
+			instruction: WithdrawNonceAccount(WithdrawNonceAccountParam{Nonce: from, Auth: to, To: base, Amount: 10}),
+			want:        WithdrawNonceAccountParam{Nonce: from, Auth: to, To: base, Amount: 10},
+		},
+		{
+			name:        "InitializeNonceAccount",
+			instruction: InitializeNonceAccount(InitializeNonceAccountParam{Nonce: from, Auth: to}),
+			want:        InitializeNonceAccountParam{Nonce: from, Auth: to},
+		},
+		{
+			name:        "AuthorizeNonceAccount",
+			instruction: AuthorizeNonceAccount(AuthorizeNonceAccountParam{Nonce: from, Auth: to, NewAuth: base}),
+			want:        AuthorizeNonceAccountParam{Nonce: from, Auth: to, NewAuth: base},
+		},
+		{
+			name:        "Allocate",
+			instruction: Allocate(AllocateParam{Account: from, Space: 10}),
+			want:        AllocateParam{Account: from, Space: 10},
+		},
+		{
+			name:        "AllocateWithSeed",
+			instruction: AllocateWithSeed(AllocateWithSeedParam{Account: from, Base: base, Owner: common.StakeProgramID, Seed: "s", Space: 10}),
+			want:        AllocateWithSeedParam{Account: from, Base: base, Owner: common.StakeProgramID, Seed: "s", Space: 10},
+		},
+		{
+			name:        "AssignWithSeed",
+			instruction: AssignWithSeed(AssignWithSeedParam{Account: from, Base: base, Owner: common.StakeProgramID, Seed: "s"}),
+			want:        AssignWithSeedParam{Account: from, Base: base, Owner: common.StakeProgramID, Seed: "s"},
+		},
+		{
+			name:        "TransferWithSeed",
+			instruction: TransferWithSeed(TransferWithSeedParam{From: from, To: to, Base: base, Owner: common.StakeProgramID, Seed: "s", Amount: 5}),
+			want:        TransferWithSeedParam{From: from, To: to, Base: base, Owner: common.StakeProgramID, Seed: "s", Amount: 5},
+		},
+		{
+			name:        "UpgradeNonceAccount",
+			instruction: UpgradeNonceAccount(UpgradeNonceAccountParam{NonceAccountPubkey: from}),
+			want:        UpgradeNonceAccountParam{NonceAccountPubkey: from},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeInstruction(compile(tt.instruction))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeInstructionError(t *testing.T) {
+	transfer, accounts := compile(Transfer(TransferParam{
+		From:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+		To:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+		Amount: 1,
+	}))
+
+	tests := []struct {
+		name        string
+		instruction types.CompiledInstruction
+		accounts    []common.PublicKey
+		err         error
+	}{
+		{
+			name:        "invalid program id",
+			instruction: transfer,
+			accounts:    []common.PublicKey{common.TokenProgramID, accounts[1], accounts[2]},
+			err:         ErrInvalidProgramID,
+		},
+		{
+			name:        "invalid account index",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 3}, Data: transfer.Data},
+			accounts:    accounts,
+			err:         ErrInvalidAccountIndex,
+		},
+		{
+			name:        "not enough accounts",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1}, Data: transfer.Data},
+			accounts:    accounts,
+			err:         ErrNotEnoughAccounts,
+		},
+		{
+			name:        "unknown instruction",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2}, Data: []byte{99, 0, 0, 0}},
+			accounts:    accounts,
+			err:         ErrUnknownInstruction,
+		},
+		{
+			name:        "invalid instruction data",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2}, Data: transfer.Data[:6]},
+			accounts:    accounts,
+			err:         ErrInvalidInstructionData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeInstruction(tt.instruction, tt.accounts)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package system
+
+import "errors"
+
+var (
+	ErrInvalidProgramID       = errors.New("invalid program id")
+	ErrInvalidAccountIndex    = errors.New("invalid account index")
+	ErrNotEnoughAccounts      = errors.New("not enough accounts")
+	ErrUnknownInstruction     = errors.New("unknown instruction")
+	ErrInvalidInstructionData = errors.New("invalid instruction data")
+)
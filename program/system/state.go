@@ -25,6 +25,16 @@ func FeeCalculatorDeserialize(data []byte) (FeeCalculator, error) {
 
 const NonceAccountSize = 80
 
+const (
+	NonceVersionLegacy  uint32 = 0
+	NonceVersionCurrent uint32 = 1
+)
+
+const (
+	NonceStateUninitialized uint32 = 0
+	NonceStateInitialized   uint32 = 1
+)
+
 type NonceAccount struct {
 	Version          uint32
 	State            uint32
@@ -0,0 +1,53 @@
+package sysvar
+
+import (
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bytes_decoder"
+)
+
+type Clock struct {
+	Slot                uint64
+	EpochStartTimestamp int64
+	Epoch               uint64
+	LeaderScheduleEpoch uint64
+	UnixTimestamp       int64
+}
+
+func DeserializeClock(data []byte, owner common.PublicKey) (Clock, error) {
+	if owner != common.SysVarPubkey {
+		return Clock{}, ErrInvalidAccountOwner
+	}
+
+	current := 0
+	fields := make([]uint64, 5)
+	for i := range fields {
+		v, err := bytes_decoder.GetUint64(&current, data)
+		if err != nil {
+			return Clock{}, err
+		}
+		fields[i] = v
+	}
+
+	return Clock{
+		Slot:                fields[0],
+		EpochStartTimestamp: int64(fields[1]),
+		Epoch:               fields[2],
+		LeaderScheduleEpoch: fields[3],
+		UnixTimestamp:       int64(fields[4]),
+	}, nil
+}
+
+// DefaultSlotDuration is the target duration of a slot
+const DefaultSlotDuration = 400 * time.Millisecond
+
+// EstimateSlotTime estimates the time of slot from the timestamp of the clock, assuming every slot takes slotDuration.
+// slot can be before or after the slot of the clock, e.g. the first slot of the next epoch for an unlock schedule.
+func (c Clock) EstimateSlotTime(slot uint64, slotDuration time.Duration) time.Time {
+	t := time.Unix(c.UnixTimestamp, 0)
+	if slot >= c.Slot {
+		return t.Add(time.Duration(slot-c.Slot) * slotDuration)
+	}
+	return t.Add(-time.Duration(c.Slot-slot) * slotDuration)
+}
@@ -0,0 +1,80 @@
+package sysvar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeClock(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Clock
+		wantErr bool
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want:    Clock{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					1, 0, 0, 0, 0, 0, 0, 0,
+					2, 0, 0, 0, 0, 0, 0, 0,
+					3, 0, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want:    Clock{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					0xc4, 0x45, 0x8e, 0x0f, 0, 0, 0, 0,
+					0x38, 0x4d, 0x1a, 0x65, 0, 0, 0, 0,
+					0x1e, 0x02, 0, 0, 0, 0, 0, 0,
+					0x1f, 0x02, 0, 0, 0, 0, 0, 0,
+					0xaa, 0x6e, 0x1b, 0x65, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: Clock{
+				Slot:                260982212,
+				EpochStartTimestamp: 1696222520,
+				Epoch:               542,
+				LeaderScheduleEpoch: 543,
+				UnixTimestamp:       1696296618,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeClock(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestClock_EstimateSlotTime(t *testing.T) {
+	clock := Clock{
+		Slot:          1000,
+		UnixTimestamp: 1696296618,
+	}
+	assert.Equal(t, time.Unix(1696296618, 0), clock.EstimateSlotTime(1000, DefaultSlotDuration))
+	assert.Equal(t, time.Unix(1696296658, 0), clock.EstimateSlotTime(1100, DefaultSlotDuration))
+	assert.Equal(t, time.Unix(1696296578, 0), clock.EstimateSlotTime(900, DefaultSlotDuration))
+}
@@ -0,0 +1,135 @@
+package sysvar
+
+import (
+	"math/bits"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bytes_decoder"
+)
+
+type EpochSchedule struct {
+	SlotsPerEpoch            uint64
+	LeaderScheduleSlotOffset uint64
+	Warmup                   bool
+	FirstNormalEpoch         uint64
+	FirstNormalSlot          uint64
+}
+
+func DeserializeEpochSchedule(data []byte, owner common.PublicKey) (EpochSchedule, error) {
+	if owner != common.SysVarPubkey {
+		return EpochSchedule{}, ErrInvalidAccountOwner
+	}
+
+	current := 0
+	slotsPerEpoch, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return EpochSchedule{}, err
+	}
+	leaderScheduleSlotOffset, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return EpochSchedule{}, err
+	}
+	warmup, err := bytes_decoder.GetUint8(&current, data)
+	if err != nil {
+		return EpochSchedule{}, err
+	}
+	firstNormalEpoch, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return EpochSchedule{}, err
+	}
+	firstNormalSlot, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return EpochSchedule{}, err
+	}
+
+	return EpochSchedule{
+		SlotsPerEpoch:            slotsPerEpoch,
+		LeaderScheduleSlotOffset: leaderScheduleSlotOffset,
+		Warmup:                   warmup == 1,
+		FirstNormalEpoch:         firstNormalEpoch,
+		FirstNormalSlot:          firstNormalSlot,
+	}, nil
+}
+
+// MinimumSlotsPerEpoch is the length of the first epoch when the epochs warm up
+const MinimumSlotsPerEpoch uint64 = 32
+
+// NewEpochSchedule returns the schedule a cluster is created with. if warmup is set, the epochs start at
+// MinimumSlotsPerEpoch slots and double until they reach slotsPerEpoch.
+func NewEpochSchedule(slotsPerEpoch uint64, warmup bool) EpochSchedule {
+	var firstNormalEpoch, firstNormalSlot uint64
+	if warmup {
+		nextPowerOfTwo := uint64(1)
+		if slotsPerEpoch > 1 {
+			nextPowerOfTwo <<= bits.Len64(slotsPerEpoch - 1)
+		}
+		// the subtractions saturate at 0 like the ones of agave, a schedule shorter than
+		// MinimumSlotsPerEpoch has no warmup epochs
+		if log2SlotsPerEpoch := bits.TrailingZeros64(nextPowerOfTwo) - bits.TrailingZeros64(MinimumSlotsPerEpoch); log2SlotsPerEpoch > 0 {
+			firstNormalEpoch = uint64(log2SlotsPerEpoch)
+		}
+		if nextPowerOfTwo > MinimumSlotsPerEpoch {
+			firstNormalSlot = nextPowerOfTwo - MinimumSlotsPerEpoch
+		}
+	}
+	return EpochSchedule{
+		SlotsPerEpoch:            slotsPerEpoch,
+		LeaderScheduleSlotOffset: slotsPerEpoch,
+		Warmup:                   warmup,
+		FirstNormalEpoch:         firstNormalEpoch,
+		FirstNormalSlot:          firstNormalSlot,
+	}
+}
+
+// GetSlotsInEpoch returns the number of slots of epoch
+func (s EpochSchedule) GetSlotsInEpoch(epoch uint64) uint64 {
+	if epoch < s.FirstNormalEpoch {
+		return uint64(1) << (epoch + uint64(bits.TrailingZeros64(MinimumSlotsPerEpoch)))
+	}
+	return s.SlotsPerEpoch
+}
+
+// GetEpoch returns the epoch of slot
+func (s EpochSchedule) GetEpoch(slot uint64) uint64 {
+	epoch, _ := s.GetEpochAndSlotIndex(slot)
+	return epoch
+}
+
+// GetEpochAndSlotIndex returns the epoch of slot and the index of slot in the epoch
+func (s EpochSchedule) GetEpochAndSlotIndex(slot uint64) (uint64, uint64) {
+	if slot < s.FirstNormalSlot {
+		epoch := uint64(bits.Len64(slot+MinimumSlotsPerEpoch) - bits.TrailingZeros64(MinimumSlotsPerEpoch) - 1)
+		epochLen := uint64(1) << (epoch + uint64(bits.TrailingZeros64(MinimumSlotsPerEpoch)))
+		return epoch, slot - (epochLen - MinimumSlotsPerEpoch)
+	}
+	normalSlotIndex := slot - s.FirstNormalSlot
+	return s.FirstNormalEpoch + normalSlotIndex/s.SlotsPerEpoch, normalSlotIndex % s.SlotsPerEpoch
+}
+
+// GetFirstSlotInEpoch returns the first slot of epoch
+func (s EpochSchedule) GetFirstSlotInEpoch(epoch uint64) uint64 {
+	if epoch <= s.FirstNormalEpoch {
+		return (uint64(1)<<epoch - 1) * MinimumSlotsPerEpoch
+	}
+	return (epoch-s.FirstNormalEpoch)*s.SlotsPerEpoch + s.FirstNormalSlot
+}
+
+// GetLastSlotInEpoch returns the last slot of epoch
+func (s EpochSchedule) GetLastSlotInEpoch(epoch uint64) uint64 {
+	return s.GetFirstSlotInEpoch(epoch) + s.GetSlotsInEpoch(epoch) - 1
+}
+
+// GetSlotsRemainingInEpoch returns the number of slots after slot until the end of its epoch
+func (s EpochSchedule) GetSlotsRemainingInEpoch(slot uint64) uint64 {
+	return s.GetLastSlotInEpoch(s.GetEpoch(slot)) - slot
+}
+
+// GetLeaderScheduleEpoch returns the epoch of the leader schedule which is generated at slot
+func (s EpochSchedule) GetLeaderScheduleEpoch(slot uint64) uint64 {
+	if slot < s.FirstNormalSlot {
+		return s.GetEpoch(slot) + 1
+	}
+	newSlotsSinceFirstNormalSlot := slot - s.FirstNormalSlot
+	newFirstNormalLeaderScheduleSlot := newSlotsSinceFirstNormalSlot + s.LeaderScheduleSlotOffset
+	return s.FirstNormalEpoch + newFirstNormalLeaderScheduleSlot/s.SlotsPerEpoch
+}
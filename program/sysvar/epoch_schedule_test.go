@@ -0,0 +1,173 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeEpochSchedule(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    EpochSchedule
+		wantErr bool
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want:    EpochSchedule{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want:    EpochSchedule{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					1,
+					0x0e, 0, 0, 0, 0, 0, 0, 0,
+					0xe0, 0x7f, 0x07, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: EpochSchedule{
+				SlotsPerEpoch:            432000,
+				LeaderScheduleSlotOffset: 432000,
+				Warmup:                   true,
+				FirstNormalEpoch:         14,
+				FirstNormalSlot:          491488,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeEpochSchedule(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestEpochSchedule(t *testing.T) {
+	warmup := NewEpochSchedule(8192, true)
+	assert.Equal(t, EpochSchedule{
+		SlotsPerEpoch:            8192,
+		LeaderScheduleSlotOffset: 8192,
+		Warmup:                   true,
+		FirstNormalEpoch:         8,
+		FirstNormalSlot:          8160,
+	}, warmup)
+	// shorter than the minimum, there is no warmup epoch
+	assert.Equal(t, EpochSchedule{
+		SlotsPerEpoch:            16,
+		LeaderScheduleSlotOffset: 16,
+		Warmup:                   true,
+	}, NewEpochSchedule(16, true))
+
+	tests := []struct {
+		name             string
+		schedule         EpochSchedule
+		slot             uint64
+		epoch            uint64
+		slotIndex        uint64
+		slotsRemaining   uint64
+		leaderSchedule   uint64
+		firstSlotInEpoch uint64
+		slotsInEpoch     uint64
+		lastSlotInEpoch  uint64
+	}{
+		{
+			name:             "warmup first slot",
+			schedule:         warmup,
+			slot:             0,
+			epoch:            0,
+			slotIndex:        0,
+			slotsRemaining:   31,
+			leaderSchedule:   1,
+			firstSlotInEpoch: 0,
+			slotsInEpoch:     32,
+			lastSlotInEpoch:  31,
+		},
+		{
+			name:             "warmup second epoch",
+			schedule:         warmup,
+			slot:             95,
+			epoch:            1,
+			slotIndex:        63,
+			slotsRemaining:   0,
+			leaderSchedule:   2,
+			firstSlotInEpoch: 32,
+			slotsInEpoch:     64,
+			lastSlotInEpoch:  95,
+		},
+		{
+			name:             "warmup first normal slot",
+			schedule:         warmup,
+			slot:             8160,
+			epoch:            8,
+			slotIndex:        0,
+			slotsRemaining:   8191,
+			leaderSchedule:   9,
+			firstSlotInEpoch: 8160,
+			slotsInEpoch:     8192,
+			lastSlotInEpoch:  16351,
+		},
+		{
+			name:             "warmup normal epoch",
+			schedule:         warmup,
+			slot:             8160 + 8192*2 + 100,
+			epoch:            10,
+			slotIndex:        100,
+			slotsRemaining:   8091,
+			leaderSchedule:   11,
+			firstSlotInEpoch: 8160 + 8192*2,
+			slotsInEpoch:     8192,
+			lastSlotInEpoch:  8160 + 8192*3 - 1,
+		},
+		{
+			name:             "mainnet",
+			schedule:         NewEpochSchedule(432000, false),
+			slot:             260982212,
+			epoch:            604,
+			slotIndex:        54212,
+			slotsRemaining:   377787,
+			leaderSchedule:   605,
+			firstSlotInEpoch: 260928000,
+			slotsInEpoch:     432000,
+			lastSlotInEpoch:  261359999,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epoch, slotIndex := tt.schedule.GetEpochAndSlotIndex(tt.slot)
+			assert.Equal(t, tt.epoch, epoch)
+			assert.Equal(t, tt.slotIndex, slotIndex)
+			assert.Equal(t, tt.epoch, tt.schedule.GetEpoch(tt.slot))
+			assert.Equal(t, tt.slotsRemaining, tt.schedule.GetSlotsRemainingInEpoch(tt.slot))
+			assert.Equal(t, tt.leaderSchedule, tt.schedule.GetLeaderScheduleEpoch(tt.slot))
+			assert.Equal(t, tt.firstSlotInEpoch, tt.schedule.GetFirstSlotInEpoch(tt.epoch))
+			assert.Equal(t, tt.slotsInEpoch, tt.schedule.GetSlotsInEpoch(tt.epoch))
+			assert.Equal(t, tt.lastSlotInEpoch, tt.schedule.GetLastSlotInEpoch(tt.epoch))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package sysvar
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bytes_decoder"
+)
+
+type RecentBlockhash struct {
+	Blockhash            [32]byte
+	LamportsPerSignature uint64
+}
+
+// RecentBlockhashes is sorted by slot in descending order, the sysvar is deprecated but it is still updated
+type RecentBlockhashes []RecentBlockhash
+
+func DeserializeRecentBlockhashes(data []byte, owner common.PublicKey) (RecentBlockhashes, error) {
+	if owner != common.SysVarPubkey {
+		return RecentBlockhashes{}, ErrInvalidAccountOwner
+	}
+
+	current := 0
+	len, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return RecentBlockhashes{}, err
+	}
+
+	v := make([]RecentBlockhash, 0, len)
+	for i := uint64(0); i < len; i++ {
+		blockhash, err := bytes_decoder.GetBytes32(&current, data)
+		if err != nil {
+			return RecentBlockhashes{}, err
+		}
+		lamportsPerSignature, err := bytes_decoder.GetUint64(&current, data)
+		if err != nil {
+			return RecentBlockhashes{}, err
+		}
+
+		v = append(v, RecentBlockhash{Blockhash: blockhash, LamportsPerSignature: lamportsPerSignature})
+	}
+	return v, nil
+}
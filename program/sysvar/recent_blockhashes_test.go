@@ -0,0 +1,69 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeRecentBlockhashes(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    RecentBlockhashes
+		wantErr bool
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want:    RecentBlockhashes{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					1, 0, 0, 0, 0, 0, 0, 0,
+					1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want:    RecentBlockhashes{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					2, 0, 0, 0, 0, 0, 0, 0,
+					2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 0x88, 0x13, 0, 0, 0, 0, 0, 0,
+					1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0x88, 0x13, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: RecentBlockhashes{
+				{
+					Blockhash:            [32]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+					LamportsPerSignature: 5000,
+				},
+				{
+					Blockhash:            [32]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+					LamportsPerSignature: 5000,
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeRecentBlockhashes(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
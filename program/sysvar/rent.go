@@ -0,0 +1,55 @@
+package sysvar
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bytes_decoder"
+)
+
+// AccountStorageOverhead is the size charged for the metadata of every account on top of its data
+const AccountStorageOverhead uint64 = 128
+
+type Rent struct {
+	LamportsPerByteYear uint64
+	ExemptionThreshold  float64
+	BurnPercent         uint8
+}
+
+// DefaultRent is the rent of all clusters, the sysvar should be used if the cluster might have another one
+var DefaultRent = Rent{
+	LamportsPerByteYear: 3480,
+	ExemptionThreshold:  2.0,
+	BurnPercent:         50,
+}
+
+func DeserializeRent(data []byte, owner common.PublicKey) (Rent, error) {
+	if owner != common.SysVarPubkey {
+		return Rent{}, ErrInvalidAccountOwner
+	}
+
+	current := 0
+	lamportsPerByteYear, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return Rent{}, err
+	}
+	exemptionThreshold, err := bytes_decoder.GetFloat64(&current, data)
+	if err != nil {
+		return Rent{}, err
+	}
+	burnPercent, err := bytes_decoder.GetUint8(&current, data)
+	if err != nil {
+		return Rent{}, err
+	}
+
+	return Rent{
+		LamportsPerByteYear: lamportsPerByteYear,
+		ExemptionThreshold:  exemptionThreshold,
+		BurnPercent:         burnPercent,
+	}, nil
+}
+
+// MinimumBalance returns the lamports an account with dataLen bytes of data needs to be rent exempt,
+// it is the same as getMinimumBalanceForRentExemption
+func (r Rent) MinimumBalance(dataLen uint64) uint64 {
+	bytes := AccountStorageOverhead + dataLen
+	return uint64(float64(bytes*r.LamportsPerByteYear) * r.ExemptionThreshold)
+}
@@ -0,0 +1,59 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeRent(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Rent
+		wantErr bool
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want:    Rent{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data:  []byte{152, 13, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				owner: common.SysVarPubkey,
+			},
+			want:    Rent{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data:  []byte{152, 13, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 50},
+				owner: common.SysVarPubkey,
+			},
+			want:    DefaultRent,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeRent(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestRent_MinimumBalance(t *testing.T) {
+	assert.Equal(t, uint64(890880), DefaultRent.MinimumBalance(0))
+	assert.Equal(t, uint64(1447680), DefaultRent.MinimumBalance(80))
+	assert.Equal(t, uint64(2039280), DefaultRent.MinimumBalance(165))
+}
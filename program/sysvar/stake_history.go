@@ -0,0 +1,56 @@
+package sysvar
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bytes_decoder"
+)
+
+type StakeHistoryEntry struct {
+	Epoch        uint64
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+// StakeHistory is sorted by epoch in descending order
+type StakeHistory []StakeHistoryEntry
+
+func DeserializeStakeHistory(data []byte, owner common.PublicKey) (StakeHistory, error) {
+	if owner != common.SysVarPubkey {
+		return StakeHistory{}, ErrInvalidAccountOwner
+	}
+
+	current := 0
+	len, err := bytes_decoder.GetUint64(&current, data)
+	if err != nil {
+		return StakeHistory{}, err
+	}
+
+	v := make([]StakeHistoryEntry, 0, len)
+	for i := uint64(0); i < len; i++ {
+		var fields [4]uint64
+		for j := range fields {
+			fields[j], err = bytes_decoder.GetUint64(&current, data)
+			if err != nil {
+				return StakeHistory{}, err
+			}
+		}
+		v = append(v, StakeHistoryEntry{
+			Epoch:        fields[0],
+			Effective:    fields[1],
+			Activating:   fields[2],
+			Deactivating: fields[3],
+		})
+	}
+	return v, nil
+}
+
+// Get returns the entry of epoch
+func (s StakeHistory) Get(epoch uint64) (StakeHistoryEntry, bool) {
+	for _, entry := range s {
+		if entry.Epoch == epoch {
+			return entry, true
+		}
+	}
+	return StakeHistoryEntry{}, false
+}
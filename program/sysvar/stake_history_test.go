@@ -0,0 +1,87 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeStakeHistory(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    StakeHistory
+		wantErr bool
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want:    StakeHistory{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					2, 0, 0, 0, 0, 0, 0, 0,
+					2, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want:    StakeHistory{},
+			wantErr: true,
+		},
+		{
+			args: args{
+				data: []byte{
+					2, 0, 0, 0, 0, 0, 0, 0,
+					2, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0,
+					1, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: StakeHistory{
+				{
+					Epoch:        2,
+					Effective:    20,
+					Activating:   3,
+					Deactivating: 4,
+				},
+				{
+					Epoch:        1,
+					Effective:    10,
+					Activating:   1,
+					Deactivating: 2,
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeStakeHistory(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestStakeHistory_Get(t *testing.T) {
+	stakeHistory := StakeHistory{
+		{Epoch: 2, Effective: 20},
+		{Epoch: 1, Effective: 10},
+	}
+
+	entry, ok := stakeHistory.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, StakeHistoryEntry{Epoch: 1, Effective: 10}, entry)
+
+	_, ok = stakeHistory.Get(3)
+	assert.False(t, ok)
+}
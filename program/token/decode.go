@@ -0,0 +1,417 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// DecodeInstruction is the inverse of the instruction builders. it returns one of the params, e.g. TransferParam.
+// both the token program and the token-2022 program are accepted, ProgramID of the param is filled with the one in use.
+// accounts are the account keys the compiled instruction indexes into. for a v0 message,
+// the addresses loaded from lookup tables need to be appended after the static keys.
+func DecodeInstruction(instruction types.CompiledInstruction, accounts []common.PublicKey) (any, error) {
+	if instruction.ProgramIDIndex < 0 || instruction.ProgramIDIndex >= len(accounts) {
+		return nil, ErrInvalidAccountIndex
+	}
+	programID := accounts[instruction.ProgramIDIndex]
+	if programID != common.TokenProgramID && programID != common.Token2022ProgramID {
+		return nil, ErrInvalidProgramID
+	}
+
+	keys := make([]common.PublicKey, 0, len(instruction.Accounts))
+	for _, idx := range instruction.Accounts {
+		if idx < 0 || idx >= len(accounts) {
+			return nil, ErrInvalidAccountIndex
+		}
+		keys = append(keys, accounts[idx])
+	}
+
+	if len(instruction.Data) < 1 {
+		return nil, ErrInvalidInstructionData
+	}
+	decoder, ok := instructionDecoders[Instruction(instruction.Data[0])]
+	if !ok {
+		return nil, ErrUnknownInstruction
+	}
+	if len(keys) < decoder.minAccounts {
+		return nil, ErrNotEnoughAccounts
+	}
+	return decoder.decode(instruction.Data, keys, programID)
+}
+
+type instructionDecoder struct {
+	minAccounts int
+	decode      func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error)
+}
+
+var instructionDecoders = map[Instruction]instructionDecoder{
+	InstructionInitializeMint: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d initializeMintData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeMintParam{
+				Decimals:   d.Decimals,
+				Mint:       keys[0],
+				MintAuth:   d.MintAuthority,
+				FreezeAuth: d.FreezeAuthority,
+				ProgramID:  programID,
+			}, nil
+		},
+	},
+	InstructionInitializeAccount: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return InitializeAccountParam{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Owner:     keys[2],
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionInitializeMultisig: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d minimumRequiredData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeMultisigParam{
+				Account:     keys[0],
+				Signers:     signers(keys, 2),
+				MinRequired: d.MinimumRequired,
+				ProgramID:   programID,
+			}, nil
+		},
+	},
+	InstructionTransfer: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return TransferParam{
+				From:      keys[0],
+				To:        keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionApprove: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return ApproveParam{
+				From:      keys[0],
+				To:        keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionRevoke: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return RevokeParam{
+				From:      keys[0],
+				Auth:      keys[1],
+				Signers:   signers(keys, 2),
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionSetAuthority: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d struct {
+				Instruction   Instruction
+				AuthorityType AuthorityType
+				NewAuth       *common.PublicKey
+			}
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return SetAuthorityParam{
+				Account:   keys[0],
+				NewAuth:   d.NewAuth,
+				AuthType:  d.AuthorityType,
+				Auth:      keys[1],
+				Signers:   signers(keys, 2),
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionMintTo: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return MintToParam{
+				Mint:      keys[0],
+				To:        keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionBurn: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return BurnParam{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionCloseAccount: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return CloseAccountParam{
+				Account:   keys[0],
+				To:        keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionFreezeAccount: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return FreezeAccountParam{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionThawAccount: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return ThawAccountParam{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionTransferChecked: {
+		minAccounts: 4,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountCheckedData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return TransferCheckedParam{
+				From:      keys[0],
+				Mint:      keys[1],
+				To:        keys[2],
+				Auth:      keys[3],
+				Signers:   signers(keys, 4),
+				Amount:    d.Amount,
+				Decimals:  d.Decimals,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionApproveChecked: {
+		minAccounts: 4,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountCheckedData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return ApproveCheckedParam{
+				From:      keys[0],
+				Mint:      keys[1],
+				To:        keys[2],
+				Auth:      keys[3],
+				Signers:   signers(keys, 4),
+				Amount:    d.Amount,
+				Decimals:  d.Decimals,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionMintToChecked: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountCheckedData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return MintToCheckedParam{
+				Mint:      keys[0],
+				To:        keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				Decimals:  d.Decimals,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionBurnChecked: {
+		minAccounts: 3,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d amountCheckedData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return BurnCheckedParam{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Auth:      keys[2],
+				Signers:   signers(keys, 3),
+				Amount:    d.Amount,
+				Decimals:  d.Decimals,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionInitializeAccount2: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d ownerData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeAccount2Param{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Owner:     d.Owner,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionSyncNative: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			return SyncNativeParam{
+				Account:   keys[0],
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionInitializeAccount3: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d ownerData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeAccount3Param{
+				Account:   keys[0],
+				Mint:      keys[1],
+				Owner:     d.Owner,
+				ProgramID: programID,
+			}, nil
+		},
+	},
+	InstructionInitializeMultisig2: {
+		minAccounts: 2,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d minimumRequiredData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeMultisig2Param{
+				Account:     keys[0],
+				Signers:     signers(keys, 1),
+				MinRequired: d.MinimumRequired,
+				ProgramID:   programID,
+			}, nil
+		},
+	},
+	InstructionInitializeMint2: {
+		minAccounts: 1,
+		decode: func(data []byte, keys []common.PublicKey, programID common.PublicKey) (any, error) {
+			var d initializeMintData
+			if err := deserialize(data, &d); err != nil {
+				return nil, err
+			}
+			return InitializeMint2Param{
+				Decimals:   d.Decimals,
+				Mint:       keys[0],
+				MintAuth:   d.MintAuthority,
+				FreezeAuth: d.FreezeAuthority,
+				ProgramID:  programID,
+			}, nil
+		},
+	},
+}
+
+type amountData struct {
+	Instruction Instruction
+	Amount      uint64
+}
+
+type amountCheckedData struct {
+	Instruction Instruction
+	Amount      uint64
+	Decimals    uint8
+}
+
+type ownerData struct {
+	Instruction Instruction
+	Owner       common.PublicKey
+}
+
+type minimumRequiredData struct {
+	Instruction     Instruction
+	MinimumRequired uint8
+}
+
+type initializeMintData struct {
+	Instruction   Instruction
+	Decimals      uint8
+	MintAuthority common.PublicKey
+	// the key after a none option may be omitted, so only decode the key when the option is some
+	FreezeAuthority *common.PublicKey
+}
+
+// signers returns the multisig signers which follow the fixed accounts
+func signers(keys []common.PublicKey, fixed int) []common.PublicKey {
+	if len(keys) <= fixed {
+		return nil
+	}
+	return append([]common.PublicKey{}, keys[fixed:]...)
+}
+
+func deserialize(data []byte, v any) error {
+	if err := bincode.DeserializeData(data, v); err != nil {
+		return fmt.Errorf("%w, err: %v", ErrInvalidInstructionData, err)
+	}
+	return nil
+}
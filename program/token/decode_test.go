@@ -0,0 +1,230 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// compile turns an instruction into a compiled one which the program id is at index 0
+func compile(instruction types.Instruction) (types.CompiledInstruction, []common.PublicKey) {
+	accounts := []common.PublicKey{instruction.ProgramID}
+	indexes := make([]int, 0, len(instruction.Accounts))
+	for _, meta := range instruction.Accounts {
+		indexes = append(indexes, len(accounts))
+		accounts = append(accounts, meta.PubKey)
+	}
+	return types.CompiledInstruction{
+		ProgramIDIndex: 0,
+		Accounts:       indexes,
+		Data:           instruction.Data,
+	}, accounts
+}
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	d := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+	signers := []common.PublicKey{
+		common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+		common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
+	}
+	tokenProgramID := common.TokenProgramID
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		want        any
+	}{
+		{
+			name:        "InitializeMint",
+			instruction: InitializeMint(InitializeMintParam{Decimals: 9, Mint: a, MintAuth: b}),
+			want:        InitializeMintParam{Decimals: 9, Mint: a, MintAuth: b, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeMint with freeze authority",
+			instruction: InitializeMint(InitializeMintParam{Decimals: 9, Mint: a, MintAuth: b, FreezeAuth: &c}),
+			want:        InitializeMintParam{Decimals: 9, Mint: a, MintAuth: b, FreezeAuth: &c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeAccount",
+			instruction: InitializeAccount(InitializeAccountParam{Account: a, Mint: b, Owner: c}),
+			want:        InitializeAccountParam{Account: a, Mint: b, Owner: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeMultisig",
+			instruction: InitializeMultisig(InitializeMultisigParam{Account: a, Signers: signers, MinRequired: 1}),
+			want:        InitializeMultisigParam{Account: a, Signers: signers, MinRequired: 1, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "Transfer",
+			instruction: Transfer(TransferParam{From: a, To: b, Auth: c, Amount: 99999}),
+			want:        TransferParam{From: a, To: b, Auth: c, Amount: 99999, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "Transfer by multisig with token-2022",
+			instruction: Transfer(TransferParam{From: a, To: b, Auth: c, Signers: signers, Amount: 1, ProgramID: common.Token2022ProgramID}),
+			want:        TransferParam{From: a, To: b, Auth: c, Signers: signers, Amount: 1, ProgramID: common.Token2022ProgramID},
+		},
+		{
+			name:        "Approve",
+			instruction: Approve(ApproveParam{From: a, To: b, Auth: c, Amount: 1}),
+			want:        ApproveParam{From: a, To: b, Auth: c, Amount: 1, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "Revoke",
+			instruction: Revoke(RevokeParam{From: a, Auth: b}),
+			want:        RevokeParam{From: a, Auth: b, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "SetAuthority",
+			instruction: SetAuthority(SetAuthorityParam{Account: a, NewAuth: &b, AuthType: AuthorityTypeCloseAccount, Auth: c}),
+			want:        SetAuthorityParam{Account: a, NewAuth: &b, AuthType: AuthorityTypeCloseAccount, Auth: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "SetAuthority to none",
+			instruction: SetAuthority(SetAuthorityParam{Account: a, AuthType: AuthorityTypeMintTokens, Auth: c}),
+			want:        SetAuthorityParam{Account: a, AuthType: AuthorityTypeMintTokens, Auth: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "MintTo",
+			instruction: MintTo(MintToParam{Mint: a, To: b, Auth: c, Amount: 10}),
+			want:        MintToParam{Mint: a, To: b, Auth: c, Amount: 10, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "Burn",
+			instruction: Burn(BurnParam{Account: a, Mint: b, Auth: c, Amount: 10}),
+			want:        BurnParam{Account: a, Mint: b, Auth: c, Amount: 10, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "CloseAccount",
+			instruction: CloseAccount(CloseAccountParam{Account: a, To: b, Auth: c}),
+			want:        CloseAccountParam{Account: a, To: b, Auth: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "FreezeAccount",
+			instruction: FreezeAccount(FreezeAccountParam{Account: a, Mint: b, Auth: c}),
+			want:        FreezeAccountParam{Account: a, Mint: b, Auth: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "ThawAccount",
+			instruction: ThawAccount(ThawAccountParam{Account: a, Mint: b, Auth: c}),
+			want:        ThawAccountParam{Account: a, Mint: b, Auth: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "TransferChecked",
+			instruction: TransferChecked(TransferCheckedParam{From: a, To: b, Mint: d, Auth: c, Amount: 10, Decimals: 6}),
+			want:        TransferCheckedParam{From: a, To: b, Mint: d, Auth: c, Amount: 10, Decimals: 6, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "ApproveChecked",
+			instruction: ApproveChecked(ApproveCheckedParam{From: a, To: b, Mint: d, Auth: c, Amount: 10, Decimals: 6}),
+			want:        ApproveCheckedParam{From: a, To: b, Mint: d, Auth: c, Amount: 10, Decimals: 6, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "MintToChecked",
+			instruction: MintToChecked(MintToCheckedParam{Mint: a, To: b, Auth: c, Signers: signers, Amount: 10, Decimals: 6}),
+			want:        MintToCheckedParam{Mint: a, To: b, Auth: c, Signers: signers, Amount: 10, Decimals: 6, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "BurnChecked",
+			instruction: BurnChecked(BurnCheckedParam{Account: a, Mint: b, Auth: c, Amount: 10, Decimals: 6}),
+			want:        BurnCheckedParam{Account: a, Mint: b, Auth: c, Amount: 10, Decimals: 6, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeAccount2",
+			instruction: InitializeAccount2(InitializeAccount2Param{Account: a, Mint: b, Owner: c}),
+			want:        InitializeAccount2Param{Account: a, Mint: b, Owner: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "SyncNative",
+			instruction: SyncNative(SyncNativeParam{Account: a}),
+			want:        SyncNativeParam{Account: a, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeAccount3",
+			instruction: InitializeAccount3(InitializeAccount3Param{Account: a, Mint: b, Owner: c}),
+			want:        InitializeAccount3Param{Account: a, Mint: b, Owner: c, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeMultisig2",
+			instruction: InitializeMultisig2(InitializeMultisig2Param{Account: a, Signers: signers, MinRequired: 2}),
+			want:        InitializeMultisig2Param{Account: a, Signers: signers, MinRequired: 2, ProgramID: tokenProgramID},
+		},
+		{
+			name:        "InitializeMint2",
+			instruction: InitializeMint2(InitializeMint2Param{Decimals: 0, Mint: a, MintAuth: b, FreezeAuth: pointer.Get[common.PublicKey](c)}),
+			want:        InitializeMint2Param{Decimals: 0, Mint: a, MintAuth: b, FreezeAuth: pointer.Get[common.PublicKey](c), ProgramID: tokenProgramID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeInstruction(compile(tt.instruction))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeInstructionError(t *testing.T) {
+	transfer, accounts := compile(Transfer(TransferParam{
+		From:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+		To:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+		Auth:   common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		Amount: 1,
+	}))
+
+	tests := []struct {
+		name        string
+		instruction types.CompiledInstruction
+		accounts    []common.PublicKey
+		err         error
+	}{
+		{
+			name:        "invalid program id",
+			instruction: transfer,
+			accounts:    append([]common.PublicKey{common.SystemProgramID}, accounts[1:]...),
+			err:         ErrInvalidProgramID,
+		},
+		{
+			name:        "invalid account index",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2, 4}, Data: transfer.Data},
+			accounts:    accounts,
+			err:         ErrInvalidAccountIndex,
+		},
+		{
+			name:        "not enough accounts",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2}, Data: transfer.Data},
+			accounts:    accounts,
+			err:         ErrNotEnoughAccounts,
+		},
+		{
+			name:        "unknown instruction",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2, 3}, Data: []byte{99}},
+			accounts:    accounts,
+			err:         ErrUnknownInstruction,
+		},
+		{
+			name:        "empty data",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2, 3}, Data: []byte{}},
+			accounts:    accounts,
+			err:         ErrInvalidInstructionData,
+		},
+		{
+			name:        "invalid instruction data",
+			instruction: types.CompiledInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2, 3}, Data: transfer.Data[:5]},
+			accounts:    accounts,
+			err:         ErrInvalidInstructionData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeInstruction(tt.instruction, tt.accounts)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrInvalidAccountOwner    = errors.New("invalid account owner")
 	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidProgramID       = errors.New("invalid program id")
+	ErrInvalidAccountIndex    = errors.New("invalid account index")
+	ErrNotEnoughAccounts      = errors.New("not enough accounts")
+	ErrUnknownInstruction     = errors.New("unknown instruction")
+	ErrInvalidInstructionData = errors.New("invalid instruction data")
 )
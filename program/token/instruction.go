@@ -32,11 +32,22 @@ const (
 	InstructionInitializeMint2
 )
 
+// programID returns the token program which the instruction is sent to.
+// all params have a ProgramID field, leave it empty to use the original token program
+// or pass common.Token2022ProgramID to work with token-2022 mints.
+func programID(p common.PublicKey) common.PublicKey {
+	if p == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return p
+}
+
 type InitializeMintParam struct {
 	Decimals   uint8
 	Mint       common.PublicKey
 	MintAuth   common.PublicKey
 	FreezeAuth *common.PublicKey
+	ProgramID  common.PublicKey
 }
 
 // InitializeMint init a mint, if you don't need to freeze, pass the empty pubKey common.PublicKey{}
@@ -63,7 +74,7 @@ func InitializeMint(param InitializeMintParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts: []types.AccountMeta{
 			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
 			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
@@ -73,9 +84,10 @@ func InitializeMint(param InitializeMintParam) types.Instruction {
 }
 
 type InitializeAccountParam struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Owner   common.PublicKey
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Owner     common.PublicKey
+	ProgramID common.PublicKey
 }
 
 // InitializeAccount init a token account which can receive token
@@ -96,7 +108,7 @@ func InitializeAccount(param InitializeAccountParam) types.Instruction {
 		{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 	}
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -106,6 +118,7 @@ type InitializeMultisigParam struct {
 	Account     common.PublicKey
 	Signers     []common.PublicKey
 	MinRequired uint8
+	ProgramID   common.PublicKey
 }
 
 func InitializeMultisig(param InitializeMultisigParam) types.Instruction {
@@ -115,6 +128,9 @@ func InitializeMultisig(param InitializeMultisigParam) types.Instruction {
 	if len(param.Signers) > 11 {
 		panic("maximum of signer is 11")
 	}
+	if param.MinRequired < 1 {
+		panic("required number too small")
+	}
 	if param.MinRequired > uint8(len(param.Signers)) {
 		panic("required number too big")
 	}
@@ -135,23 +151,25 @@ func InitializeMultisig(param InitializeMultisigParam) types.Instruction {
 		types.AccountMeta{PubKey: param.Account, IsSigner: false, IsWritable: true},
 		types.AccountMeta{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 	)
+	// the members don't sign the initialization
 	for _, signerPubkey := range param.Signers {
-		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: true, IsWritable: false})
+		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: false, IsWritable: false})
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type TransferParam struct {
-	From    common.PublicKey
-	To      common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
-	Amount  uint64
+	From      common.PublicKey
+	To        common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	ProgramID common.PublicKey
 }
 
 func Transfer(param TransferParam) types.Instruction {
@@ -174,18 +192,19 @@ func Transfer(param TransferParam) types.Instruction {
 		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: true, IsWritable: false})
 	}
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type ApproveParam struct {
-	From    common.PublicKey
-	To      common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
-	Amount  uint64
+	From      common.PublicKey
+	To        common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	ProgramID common.PublicKey
 }
 
 func Approve(param ApproveParam) types.Instruction {
@@ -209,16 +228,17 @@ func Approve(param ApproveParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type RevokeParam struct {
-	From    common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
+	From      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func Revoke(param RevokeParam) types.Instruction {
@@ -241,7 +261,7 @@ func Revoke(param RevokeParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -257,11 +277,12 @@ const (
 )
 
 type SetAuthorityParam struct {
-	Account  common.PublicKey
-	NewAuth  *common.PublicKey
-	AuthType AuthorityType
-	Auth     common.PublicKey
-	Signers  []common.PublicKey
+	Account   common.PublicKey
+	NewAuth   *common.PublicKey
+	AuthType  AuthorityType
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func SetAuthority(param SetAuthorityParam) types.Instruction {
@@ -294,18 +315,19 @@ func SetAuthority(param SetAuthorityParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type MintToParam struct {
-	Mint    common.PublicKey
-	To      common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
-	Amount  uint64
+	Mint      common.PublicKey
+	To        common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	ProgramID common.PublicKey
 }
 
 func MintTo(param MintToParam) types.Instruction {
@@ -331,18 +353,19 @@ func MintTo(param MintToParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type BurnParam struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
-	Amount  uint64
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	ProgramID common.PublicKey
 }
 
 func Burn(param BurnParam) types.Instruction {
@@ -368,17 +391,18 @@ func Burn(param BurnParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type CloseAccountParam struct {
-	Account common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
-	To      common.PublicKey
+	Account   common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	To        common.PublicKey
+	ProgramID common.PublicKey
 }
 
 // Close an account and transfer its all SOL to dest, only account's token balance is zero can be closed.
@@ -401,17 +425,18 @@ func CloseAccount(param CloseAccountParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type FreezeAccountParam struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func FreezeAccount(param FreezeAccountParam) types.Instruction {
@@ -433,17 +458,18 @@ func FreezeAccount(param FreezeAccountParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type ThawAccountParam struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Auth    common.PublicKey
-	Signers []common.PublicKey
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func ThawAccount(param ThawAccountParam) types.Instruction {
@@ -465,20 +491,21 @@ func ThawAccount(param ThawAccountParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type TransferCheckedParam struct {
-	From     common.PublicKey
-	To       common.PublicKey
-	Mint     common.PublicKey
-	Auth     common.PublicKey
-	Signers  []common.PublicKey
-	Amount   uint64
-	Decimals uint8
+	From      common.PublicKey
+	To        common.PublicKey
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	ProgramID common.PublicKey
 }
 
 func TransferChecked(param TransferCheckedParam) types.Instruction {
@@ -505,20 +532,21 @@ func TransferChecked(param TransferCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type ApproveCheckedParam struct {
-	From     common.PublicKey
-	Mint     common.PublicKey
-	To       common.PublicKey
-	Auth     common.PublicKey
-	Signers  []common.PublicKey
-	Amount   uint64
-	Decimals uint8
+	From      common.PublicKey
+	Mint      common.PublicKey
+	To        common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	ProgramID common.PublicKey
 }
 
 func ApproveChecked(param ApproveCheckedParam) types.Instruction {
@@ -545,19 +573,20 @@ func ApproveChecked(param ApproveCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type MintToCheckedParam struct {
-	Mint     common.PublicKey
-	Auth     common.PublicKey
-	Signers  []common.PublicKey
-	To       common.PublicKey
-	Amount   uint64
-	Decimals uint8
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	To        common.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	ProgramID common.PublicKey
 }
 
 func MintToChecked(param MintToCheckedParam) types.Instruction {
@@ -585,19 +614,20 @@ func MintToChecked(param MintToCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type BurnCheckedParam struct {
-	Account  common.PublicKey
-	Auth     common.PublicKey
-	Signers  []common.PublicKey
-	Mint     common.PublicKey
-	Amount   uint64
-	Decimals uint8
+	Account   common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Mint      common.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	ProgramID common.PublicKey
 }
 
 func BurnChecked(param BurnCheckedParam) types.Instruction {
@@ -625,16 +655,17 @@ func BurnChecked(param BurnCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
 }
 
 type InitializeAccount2Param struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Owner   common.PublicKey
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Owner     common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func InitializeAccount2(param InitializeAccount2Param) types.Instruction {
@@ -650,7 +681,7 @@ func InitializeAccount2(param InitializeAccount2Param) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts: []types.AccountMeta{
 			{PubKey: param.Account, IsSigner: false, IsWritable: true},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
@@ -661,7 +692,8 @@ func InitializeAccount2(param InitializeAccount2Param) types.Instruction {
 }
 
 type SyncNativeParam struct {
-	Account common.PublicKey
+	Account   common.PublicKey
+	ProgramID common.PublicKey
 }
 
 // SyncNative will update your wrapped SOL balance
@@ -676,7 +708,7 @@ func SyncNative(param SyncNativeParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts: []types.AccountMeta{
 			{PubKey: param.Account, IsSigner: false, IsWritable: true},
 		},
@@ -685,9 +717,10 @@ func SyncNative(param SyncNativeParam) types.Instruction {
 }
 
 type InitializeAccount3Param struct {
-	Account common.PublicKey
-	Mint    common.PublicKey
-	Owner   common.PublicKey
+	Account   common.PublicKey
+	Mint      common.PublicKey
+	Owner     common.PublicKey
+	ProgramID common.PublicKey
 }
 
 func InitializeAccount3(param InitializeAccount3Param) types.Instruction {
@@ -703,7 +736,7 @@ func InitializeAccount3(param InitializeAccount3Param) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts: []types.AccountMeta{
 			{PubKey: param.Account, IsSigner: false, IsWritable: true},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
@@ -716,6 +749,7 @@ type InitializeMultisig2Param struct {
 	Account     common.PublicKey
 	Signers     []common.PublicKey
 	MinRequired uint8
+	ProgramID   common.PublicKey
 }
 
 func InitializeMultisig2(param InitializeMultisig2Param) types.Instruction {
@@ -725,6 +759,9 @@ func InitializeMultisig2(param InitializeMultisig2Param) types.Instruction {
 	if len(param.Signers) > 11 {
 		panic("maximum of signer is 11")
 	}
+	if param.MinRequired < 1 {
+		panic("required number too small")
+	}
 	if param.MinRequired > uint8(len(param.Signers)) {
 		panic("required number too big")
 	}
@@ -744,12 +781,13 @@ func InitializeMultisig2(param InitializeMultisig2Param) types.Instruction {
 	accounts = append(accounts,
 		types.AccountMeta{PubKey: param.Account, IsSigner: false, IsWritable: true},
 	)
+	// the members don't sign the initialization
 	for _, signerPubkey := range param.Signers {
-		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: true, IsWritable: false})
+		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: false, IsWritable: false})
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -760,6 +798,7 @@ type InitializeMint2Param struct {
 	Mint       common.PublicKey
 	MintAuth   common.PublicKey
 	FreezeAuth *common.PublicKey
+	ProgramID  common.PublicKey
 }
 
 func InitializeMint2(param InitializeMint2Param) types.Instruction {
@@ -785,7 +824,7 @@ func InitializeMint2(param InitializeMint2Param) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programID(param.ProgramID),
 		Accounts: []types.AccountMeta{
 			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
 		},
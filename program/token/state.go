@@ -53,6 +53,14 @@ func MultisigAccountFromData(data []byte) (MultisigAccount, error) {
 	}, nil
 }
 
+// DeserializeMultisigAccount parses a multisig account of the token program or the token-2022 program
+func DeserializeMultisigAccount(data []byte, accountOwner common.PublicKey) (MultisigAccount, error) {
+	if accountOwner != common.TokenProgramID && accountOwner != common.Token2022ProgramID {
+		return MultisigAccount{}, ErrInvalidAccountOwner
+	}
+	return MultisigAccountFromData(data)
+}
+
 const MintAccountSize = 82
 
 type MintAccount struct {
@@ -95,6 +103,21 @@ func MintAccountFromData(data []byte) (MintAccount, error) {
 	}, nil
 }
 
+// DeserializeMintAccount parses a mint of the token program or the token-2022 program
+func DeserializeMintAccount(data []byte, accountOwner common.PublicKey) (MintAccount, error) {
+	switch accountOwner {
+	case common.TokenProgramID:
+		return MintAccountFromData(data)
+	case common.Token2022ProgramID:
+		// token-2022 mints share the same base layout, extensions are appended after it
+		if len(data) < MintAccountSize {
+			return MintAccount{}, ErrInvalidAccountDataSize
+		}
+		return MintAccountFromData(data[:MintAccountSize])
+	}
+	return MintAccount{}, ErrInvalidAccountOwner
+}
+
 const TokenAccountSize = 165
 
 type TokenAccountState uint8
@@ -164,8 +187,15 @@ func TokenAccountFromData(data []byte) (TokenAccount, error) {
 }
 
 func DeserializeTokenAccount(data []byte, accountOwner common.PublicKey) (TokenAccount, error) {
-	if accountOwner != common.TokenProgramID {
-		return TokenAccount{}, ErrInvalidAccountOwner
+	switch accountOwner {
+	case common.TokenProgramID:
+		return TokenAccountFromData(data)
+	case common.Token2022ProgramID:
+		// token-2022 accounts share the same base layout, extensions are appended after it
+		if len(data) < TokenAccountSize {
+			return TokenAccount{}, ErrInvalidAccountDataSize
+		}
+		return TokenAccountFromData(data[:TokenAccountSize])
 	}
-	return TokenAccountFromData(data)
+	return TokenAccount{}, ErrInvalidAccountOwner
 }
@@ -239,6 +239,23 @@ func TestDeserializeTokenAccount(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			args: args{
+				data:         []byte{105, 145, 9, 101, 129, 184, 46, 130, 176, 132, 102, 98, 17, 241, 215, 189, 90, 219, 106, 196, 196, 121, 174, 243, 65, 40, 132, 7, 252, 112, 238, 112, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 0, 186, 69, 61, 244, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0},
+				accountOwner: common.Token2022ProgramID,
+			},
+			want: TokenAccount{
+				Mint:            common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH"),
+				Owner:           common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				Amount:          1049000000000,
+				Delegate:        nil,
+				State:           TokenAccountStateInitialized,
+				IsNative:        nil,
+				DelegatedAmount: 0,
+				CloseAuthority:  nil,
+			},
+			wantErr: nil,
+		},
 		{
 			args: args{
 				data:         []byte{0x6, 0x9b, 0x88, 0x57, 0xfe, 0xab, 0x81, 0x84, 0xfb, 0x68, 0x7f, 0x63, 0x46, 0x18, 0xc0, 0x35, 0xda, 0xc4, 0x39, 0xdc, 0x1a, 0xeb, 0x3b, 0x55, 0x98, 0xa0, 0xf0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0x10, 0x76, 0x16, 0x77, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x1, 0x0, 0x0, 0x0, 0xf0, 0x1d, 0x1f, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
@@ -265,3 +282,48 @@ func TestDeserializeTokenAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestDeserializeMultisigAccount(t *testing.T) {
+	data := make([]byte, MultisigAccountSize)
+	data[0], data[1], data[2] = 1, 1, 1
+	copy(data[3:35], common.PublicKeyFromString("S1gner1111111111111111111111111111111111111").Bytes())
+	want := MultisigAccount{
+		M:             1,
+		N:             1,
+		IsInitialized: true,
+		Signers:       []common.PublicKey{common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")},
+	}
+
+	for _, owner := range []common.PublicKey{common.TokenProgramID, common.Token2022ProgramID} {
+		got, err := DeserializeMultisigAccount(data, owner)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := DeserializeMultisigAccount(data, common.SystemProgramID)
+	assert.Equal(t, ErrInvalidAccountOwner, err)
+}
+
+func TestDeserializeMintAccount(t *testing.T) {
+	data := make([]byte, MintAccountSize)
+	data[44], data[45] = 6, 1
+	want := MintAccount{
+		Decimals:      6,
+		IsInitialized: true,
+	}
+
+	got, err := DeserializeMintAccount(data, common.TokenProgramID)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	// a token-2022 mint with extensions
+	got, err = DeserializeMintAccount(append(data, make([]byte, 100)...), common.Token2022ProgramID)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = DeserializeMintAccount(data[:MintAccountSize-1], common.Token2022ProgramID)
+	assert.Equal(t, ErrInvalidAccountDataSize, err)
+
+	_, err = DeserializeMintAccount(data, common.SystemProgramID)
+	assert.Equal(t, ErrInvalidAccountOwner, err)
+}
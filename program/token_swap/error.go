@@ -0,0 +1,8 @@
+package token_swap
+
+import "errors"
+
+var (
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrUnsupportedVersion     = errors.New("unsupported token swap version")
+)
@@ -0,0 +1,347 @@
+package token_swap
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+type Instruction uint8
+
+const (
+	InstructionInitialize Instruction = iota
+	InstructionSwap
+	InstructionDepositAllTokenTypes
+	InstructionWithdrawAllTokenTypes
+	InstructionDepositSingleTokenTypeExactAmountIn
+	InstructionWithdrawSingleTokenTypeExactAmountOut
+)
+
+// all params have a ProgramID field, leave it empty to use common.SPLTokenSwapProgramID.
+// TokenProgramID is the token program of the pool, leave it empty to use common.TokenProgramID.
+// the swap authority is derived from the swap account, see GetAuthorityAddress.
+
+type InitializeParam struct {
+	ProgramID common.PublicKey
+	// TokenSwap is the new swap account, it needs to be created with TokenSwapAccountSize in the same tx
+	TokenSwap common.PublicKey
+	// TokenA and TokenB are owned by the swap authority and hold the initial liquidity
+	TokenA common.PublicKey
+	TokenB common.PublicKey
+	// PoolMint has the swap authority as the mint authority and a zero supply
+	PoolMint common.PublicKey
+	// FeeAccount is a pool token account which receives the owner fees
+	FeeAccount common.PublicKey
+	// Destination is a pool token account which receives the initial pool tokens
+	Destination    common.PublicKey
+	TokenProgramID common.PublicKey
+	Fees           Fees
+	SwapCurve      SwapCurve
+}
+
+func Initialize(param InitializeParam) types.Instruction {
+	authority, nonce, err := GetAuthorityAddress(param.ProgramID, param.TokenSwap)
+	if err != nil {
+		panic(err)
+	}
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Nonce       uint8
+		Fees        Fees
+		SwapCurve   SwapCurve
+	}{
+		Instruction: InstructionInitialize,
+		Nonce:       nonce,
+		Fees:        param.Fees,
+		SwapCurve:   param.SwapCurve,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TokenSwap, IsSigner: true, IsWritable: true},
+			{PubKey: authority, IsSigner: false, IsWritable: false},
+			{PubKey: param.TokenA, IsSigner: false, IsWritable: false},
+			{PubKey: param.TokenB, IsSigner: false, IsWritable: false},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: param.FeeAccount, IsSigner: false, IsWritable: false},
+			{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type SwapParam struct {
+	ProgramID             common.PublicKey
+	TokenSwap             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	// Source and Destination are the user token accounts
+	Source      common.PublicKey
+	Destination common.PublicKey
+	// SwapSource and SwapDestination are the swap token accounts of the same mints
+	SwapSource      common.PublicKey
+	SwapDestination common.PublicKey
+	PoolMint        common.PublicKey
+	PoolFeeAccount  common.PublicKey
+	TokenProgramID  common.PublicKey
+	// HostFeeAccount is an optional pool token account which receives the host fee
+	HostFeeAccount   *common.PublicKey
+	AmountIn         uint64
+	MinimumAmountOut uint64
+}
+
+func Swap(param SwapParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction      Instruction
+		AmountIn         uint64
+		MinimumAmountOut uint64
+	}{
+		Instruction:      InstructionSwap,
+		AmountIn:         param.AmountIn,
+		MinimumAmountOut: param.MinimumAmountOut,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.TokenSwap, IsSigner: false, IsWritable: false},
+		{PubKey: mustAuthority(param.ProgramID, param.TokenSwap), IsSigner: false, IsWritable: false},
+		{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: param.Source, IsSigner: false, IsWritable: true},
+		{PubKey: param.SwapSource, IsSigner: false, IsWritable: true},
+		{PubKey: param.SwapDestination, IsSigner: false, IsWritable: true},
+		{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+		{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+		{PubKey: param.PoolFeeAccount, IsSigner: false, IsWritable: true},
+		{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+	}
+	if param.HostFeeAccount != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.HostFeeAccount, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type DepositAllTokenTypesParam struct {
+	ProgramID             common.PublicKey
+	TokenSwap             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	// SourceA and SourceB are the user token accounts
+	SourceA common.PublicKey
+	SourceB common.PublicKey
+	// SwapTokenA and SwapTokenB are the swap token accounts
+	SwapTokenA common.PublicKey
+	SwapTokenB common.PublicKey
+	PoolMint   common.PublicKey
+	// Destination is the user pool token account
+	Destination         common.PublicKey
+	TokenProgramID      common.PublicKey
+	PoolTokenAmount     uint64
+	MaximumTokenAAmount uint64
+	MaximumTokenBAmount uint64
+}
+
+// DepositAllTokenTypes deposits both tokens in the current ratio for PoolTokenAmount pool tokens
+func DepositAllTokenTypes(param DepositAllTokenTypesParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction         Instruction
+		PoolTokenAmount     uint64
+		MaximumTokenAAmount uint64
+		MaximumTokenBAmount uint64
+	}{
+		Instruction:         InstructionDepositAllTokenTypes,
+		PoolTokenAmount:     param.PoolTokenAmount,
+		MaximumTokenAAmount: param.MaximumTokenAAmount,
+		MaximumTokenBAmount: param.MaximumTokenBAmount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TokenSwap, IsSigner: false, IsWritable: false},
+			{PubKey: mustAuthority(param.ProgramID, param.TokenSwap), IsSigner: false, IsWritable: false},
+			{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.SourceA, IsSigner: false, IsWritable: true},
+			{PubKey: param.SourceB, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenA, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenB, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WithdrawAllTokenTypesParam struct {
+	ProgramID             common.PublicKey
+	TokenSwap             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	PoolMint              common.PublicKey
+	// Source is the user pool token account
+	Source     common.PublicKey
+	SwapTokenA common.PublicKey
+	SwapTokenB common.PublicKey
+	// DestinationA and DestinationB are the user token accounts
+	DestinationA        common.PublicKey
+	DestinationB        common.PublicKey
+	PoolFeeAccount      common.PublicKey
+	TokenProgramID      common.PublicKey
+	PoolTokenAmount     uint64
+	MinimumTokenAAmount uint64
+	MinimumTokenBAmount uint64
+}
+
+// WithdrawAllTokenTypes burns PoolTokenAmount pool tokens for both tokens in the current ratio
+func WithdrawAllTokenTypes(param WithdrawAllTokenTypesParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction         Instruction
+		PoolTokenAmount     uint64
+		MinimumTokenAAmount uint64
+		MinimumTokenBAmount uint64
+	}{
+		Instruction:         InstructionWithdrawAllTokenTypes,
+		PoolTokenAmount:     param.PoolTokenAmount,
+		MinimumTokenAAmount: param.MinimumTokenAAmount,
+		MinimumTokenBAmount: param.MinimumTokenBAmount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TokenSwap, IsSigner: false, IsWritable: false},
+			{PubKey: mustAuthority(param.ProgramID, param.TokenSwap), IsSigner: false, IsWritable: false},
+			{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: param.Source, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenA, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenB, IsSigner: false, IsWritable: true},
+			{PubKey: param.DestinationA, IsSigner: false, IsWritable: true},
+			{PubKey: param.DestinationB, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type DepositSingleTokenTypeExactAmountInParam struct {
+	ProgramID             common.PublicKey
+	TokenSwap             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	// Source is a user token account of token a or token b
+	Source     common.PublicKey
+	SwapTokenA common.PublicKey
+	SwapTokenB common.PublicKey
+	PoolMint   common.PublicKey
+	// Destination is the user pool token account
+	Destination            common.PublicKey
+	TokenProgramID         common.PublicKey
+	SourceTokenAmount      uint64
+	MinimumPoolTokenAmount uint64
+}
+
+// DepositSingleTokenTypeExactAmountIn deposits one token for at least MinimumPoolTokenAmount pool tokens
+func DepositSingleTokenTypeExactAmountIn(param DepositSingleTokenTypeExactAmountInParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		SourceTokenAmount      uint64
+		MinimumPoolTokenAmount uint64
+	}{
+		Instruction:            InstructionDepositSingleTokenTypeExactAmountIn,
+		SourceTokenAmount:      param.SourceTokenAmount,
+		MinimumPoolTokenAmount: param.MinimumPoolTokenAmount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TokenSwap, IsSigner: false, IsWritable: false},
+			{PubKey: mustAuthority(param.ProgramID, param.TokenSwap), IsSigner: false, IsWritable: false},
+			{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.Source, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenA, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenB, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WithdrawSingleTokenTypeExactAmountOutParam struct {
+	ProgramID             common.PublicKey
+	TokenSwap             common.PublicKey
+	UserTransferAuthority common.PublicKey
+	PoolMint              common.PublicKey
+	// Source is the user pool token account
+	Source     common.PublicKey
+	SwapTokenA common.PublicKey
+	SwapTokenB common.PublicKey
+	// Destination is a user token account of token a or token b
+	Destination            common.PublicKey
+	PoolFeeAccount         common.PublicKey
+	TokenProgramID         common.PublicKey
+	DestinationTokenAmount uint64
+	MaximumPoolTokenAmount uint64
+}
+
+// WithdrawSingleTokenTypeExactAmountOut withdraws one token by burning at most MaximumPoolTokenAmount pool tokens
+func WithdrawSingleTokenTypeExactAmountOut(param WithdrawSingleTokenTypeExactAmountOutParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		DestinationTokenAmount uint64
+		MaximumPoolTokenAmount uint64
+	}{
+		Instruction:            InstructionWithdrawSingleTokenTypeExactAmountOut,
+		DestinationTokenAmount: param.DestinationTokenAmount,
+		MaximumPoolTokenAmount: param.MaximumPoolTokenAmount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: getProgramID(param.ProgramID),
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TokenSwap, IsSigner: false, IsWritable: false},
+			{PubKey: mustAuthority(param.ProgramID, param.TokenSwap), IsSigner: false, IsWritable: false},
+			{PubKey: param.UserTransferAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.PoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: param.Source, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenA, IsSigner: false, IsWritable: true},
+			{PubKey: param.SwapTokenB, IsSigner: false, IsWritable: true},
+			{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+			{PubKey: param.PoolFeeAccount, IsSigner: false, IsWritable: true},
+			{PubKey: tokenProgramID(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+func mustAuthority(programID, tokenSwap common.PublicKey) common.PublicKey {
+	authority, _, err := GetAuthorityAddress(programID, tokenSwap)
+	if err != nil {
+		panic(err)
+	}
+	return authority
+}
@@ -0,0 +1,119 @@
+package token_swap
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testTokenSwap = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	testTokenA    = common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	testTokenB    = common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	testPoolMint  = common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	testFee       = common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	testUser      = common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+)
+
+func TestInitialize(t *testing.T) {
+	authority, nonce, err := GetAuthorityAddress(common.PublicKey{}, testTokenSwap)
+	assert.Nil(t, err)
+
+	got := Initialize(InitializeParam{
+		TokenSwap:   testTokenSwap,
+		TokenA:      testTokenA,
+		TokenB:      testTokenB,
+		PoolMint:    testPoolMint,
+		FeeAccount:  testFee,
+		Destination: testUser,
+		Fees: Fees{
+			TradeFeeNumerator:   25,
+			TradeFeeDenominator: 10000,
+		},
+		SwapCurve: NewSwapCurve(CurveTypeConstantPrice, 2),
+	})
+
+	wantData := []byte{0, nonce, 25, 0, 0, 0, 0, 0, 0, 0, 16, 39, 0, 0, 0, 0, 0, 0}
+	wantData = append(wantData, make([]byte, 48)...)
+	wantData = append(wantData, 1, 2)
+	wantData = append(wantData, make([]byte, 31)...)
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLTokenSwapProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testTokenSwap, IsSigner: true, IsWritable: true},
+			{PubKey: authority, IsSigner: false, IsWritable: false},
+			{PubKey: testTokenA, IsSigner: false, IsWritable: false},
+			{PubKey: testTokenB, IsSigner: false, IsWritable: false},
+			{PubKey: testPoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: testFee, IsSigner: false, IsWritable: false},
+			{PubKey: testUser, IsSigner: false, IsWritable: true},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: wantData,
+	}, got)
+}
+
+func TestSwap(t *testing.T) {
+	authority, _, err := GetAuthorityAddress(common.PublicKey{}, testTokenSwap)
+	assert.Nil(t, err)
+	source := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	destination := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	hostFee := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+
+	got := Swap(SwapParam{
+		TokenSwap:             testTokenSwap,
+		UserTransferAuthority: testUser,
+		Source:                source,
+		Destination:           destination,
+		SwapSource:            testTokenA,
+		SwapDestination:       testTokenB,
+		PoolMint:              testPoolMint,
+		PoolFeeAccount:        testFee,
+		HostFeeAccount:        &hostFee,
+		AmountIn:              1000,
+		MinimumAmountOut:      990,
+	})
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.SPLTokenSwapProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testTokenSwap, IsSigner: false, IsWritable: false},
+			{PubKey: authority, IsSigner: false, IsWritable: false},
+			{PubKey: testUser, IsSigner: true, IsWritable: false},
+			{PubKey: source, IsSigner: false, IsWritable: true},
+			{PubKey: testTokenA, IsSigner: false, IsWritable: true},
+			{PubKey: testTokenB, IsSigner: false, IsWritable: true},
+			{PubKey: destination, IsSigner: false, IsWritable: true},
+			{PubKey: testPoolMint, IsSigner: false, IsWritable: true},
+			{PubKey: testFee, IsSigner: false, IsWritable: true},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: hostFee, IsSigner: false, IsWritable: true},
+		},
+		Data: []byte{1, 232, 3, 0, 0, 0, 0, 0, 0, 222, 3, 0, 0, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestDepositAndWithdrawAllTokenTypes(t *testing.T) {
+	deposit := DepositAllTokenTypes(DepositAllTokenTypesParam{
+		TokenSwap:             testTokenSwap,
+		UserTransferAuthority: testUser,
+		PoolTokenAmount:       1,
+		MaximumTokenAAmount:   2,
+		MaximumTokenBAmount:   3,
+	})
+	assert.Equal(t, []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0}, deposit.Data)
+	assert.Len(t, deposit.Accounts, 10)
+
+	withdraw := WithdrawAllTokenTypes(WithdrawAllTokenTypesParam{
+		TokenSwap:             testTokenSwap,
+		UserTransferAuthority: testUser,
+		TokenProgramID:        common.Token2022ProgramID,
+		PoolTokenAmount:       1,
+		MinimumTokenAAmount:   2,
+		MinimumTokenBAmount:   3,
+	})
+	assert.Equal(t, []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0}, withdraw.Data)
+	assert.Len(t, withdraw.Accounts, 11)
+	assert.Equal(t, common.Token2022ProgramID, withdraw.Accounts[10].PubKey)
+}
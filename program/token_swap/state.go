@@ -0,0 +1,122 @@
+package token_swap
+
+import (
+	"encoding/binary"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+type CurveType uint8
+
+const (
+	CurveTypeConstantProduct CurveType = iota
+	CurveTypeConstantPrice
+	CurveTypeStable
+	CurveTypeOffset
+)
+
+// SwapCurve is the curve type and its packed parameters,
+// the constant price curve takes the token b price, the stable curve takes the amp
+// and the offset curve takes the token b offset, all in the first 8 bytes as a little-endian u64.
+type SwapCurve struct {
+	CurveType  CurveType
+	Calculator [32]byte
+}
+
+// NewSwapCurve returns a curve with a u64 parameter, the parameter is ignored by the constant product curve
+func NewSwapCurve(curveType CurveType, parameter uint64) SwapCurve {
+	curve := SwapCurve{CurveType: curveType}
+	if curveType != CurveTypeConstantProduct {
+		binary.LittleEndian.PutUint64(curve.Calculator[:8], parameter)
+	}
+	return curve
+}
+
+type Fees struct {
+	TradeFeeNumerator           uint64
+	TradeFeeDenominator         uint64
+	OwnerTradeFeeNumerator      uint64
+	OwnerTradeFeeDenominator    uint64
+	OwnerWithdrawFeeNumerator   uint64
+	OwnerWithdrawFeeDenominator uint64
+	HostFeeNumerator            uint64
+	HostFeeDenominator          uint64
+}
+
+const SwapVersionV1 uint8 = 1
+
+// TokenSwapAccountSize is the size of a versioned swap account
+const TokenSwapAccountSize = 324
+
+// the layout of a swap account
+//
+//	[0]       version
+//	[1]       is initialized
+//	[2]       bump seed
+//	[3:35]    token program id
+//	[35:67]   token a
+//	[67:99]   token b
+//	[99:131]  pool mint
+//	[131:163] token a mint
+//	[163:195] token b mint
+//	[195:227] pool fee account
+//	[227:291] fees
+//	[291:324] swap curve
+type TokenSwap struct {
+	Version        uint8
+	IsInitialized  bool
+	BumpSeed       uint8
+	TokenProgramID common.PublicKey
+	TokenA         common.PublicKey
+	TokenB         common.PublicKey
+	PoolMint       common.PublicKey
+	TokenAMint     common.PublicKey
+	TokenBMint     common.PublicKey
+	PoolFeeAccount common.PublicKey
+	Fees           Fees
+	SwapCurve      SwapCurve
+}
+
+func TokenSwapFromData(data []byte) (TokenSwap, error) {
+	if len(data) != TokenSwapAccountSize {
+		return TokenSwap{}, ErrInvalidAccountDataSize
+	}
+	if data[0] != SwapVersionV1 {
+		return TokenSwap{}, ErrUnsupportedVersion
+	}
+
+	fees := make([]uint64, 8)
+	for i := range fees {
+		fees[i] = binary.LittleEndian.Uint64(data[227+i*8 : 235+i*8])
+	}
+
+	var calculator [32]byte
+	copy(calculator[:], data[292:324])
+
+	return TokenSwap{
+		Version:        data[0],
+		IsInitialized:  data[1] == 1,
+		BumpSeed:       data[2],
+		TokenProgramID: common.PublicKeyFromBytes(data[3:35]),
+		TokenA:         common.PublicKeyFromBytes(data[35:67]),
+		TokenB:         common.PublicKeyFromBytes(data[67:99]),
+		PoolMint:       common.PublicKeyFromBytes(data[99:131]),
+		TokenAMint:     common.PublicKeyFromBytes(data[131:163]),
+		TokenBMint:     common.PublicKeyFromBytes(data[163:195]),
+		PoolFeeAccount: common.PublicKeyFromBytes(data[195:227]),
+		Fees: Fees{
+			TradeFeeNumerator:           fees[0],
+			TradeFeeDenominator:         fees[1],
+			OwnerTradeFeeNumerator:      fees[2],
+			OwnerTradeFeeDenominator:    fees[3],
+			OwnerWithdrawFeeNumerator:   fees[4],
+			OwnerWithdrawFeeDenominator: fees[5],
+			HostFeeNumerator:            fees[6],
+			HostFeeDenominator:          fees[7],
+		},
+		SwapCurve: SwapCurve{
+			CurveType:  CurveType(data[291]),
+			Calculator: calculator,
+		},
+	}, nil
+}
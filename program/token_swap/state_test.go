@@ -0,0 +1,54 @@
+package token_swap
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSwapFromData(t *testing.T) {
+	data := make([]byte, TokenSwapAccountSize)
+	data[0] = SwapVersionV1
+	data[1] = 1
+	data[2] = 254
+	copy(data[3:35], common.TokenProgramID.Bytes())
+	copy(data[35:67], testTokenA.Bytes())
+	copy(data[67:99], testTokenB.Bytes())
+	copy(data[99:131], testPoolMint.Bytes())
+	copy(data[131:163], testTokenSwap.Bytes())
+	copy(data[163:195], testUser.Bytes())
+	copy(data[195:227], testFee.Bytes())
+	binary.LittleEndian.PutUint64(data[227:235], 25)
+	binary.LittleEndian.PutUint64(data[235:243], 10000)
+	data[291] = uint8(CurveTypeOffset)
+	data[292] = 7
+
+	got, err := TokenSwapFromData(data)
+	assert.Nil(t, err)
+	assert.Equal(t, TokenSwap{
+		Version:        SwapVersionV1,
+		IsInitialized:  true,
+		BumpSeed:       254,
+		TokenProgramID: common.TokenProgramID,
+		TokenA:         testTokenA,
+		TokenB:         testTokenB,
+		PoolMint:       testPoolMint,
+		TokenAMint:     testTokenSwap,
+		TokenBMint:     testUser,
+		PoolFeeAccount: testFee,
+		Fees: Fees{
+			TradeFeeNumerator:   25,
+			TradeFeeDenominator: 10000,
+		},
+		SwapCurve: NewSwapCurve(CurveTypeOffset, 7),
+	}, got)
+
+	_, err = TokenSwapFromData(data[:323])
+	assert.Equal(t, ErrInvalidAccountDataSize, err)
+
+	data[0] = 0
+	_, err = TokenSwapFromData(data)
+	assert.Equal(t, ErrUnsupportedVersion, err)
+}
@@ -0,0 +1,23 @@
+package token_swap
+
+import "github.com/liangjies/solana-go-sdk/common"
+
+// GetAuthorityAddress returns the authority of the swap token accounts and the pool mint and its bump seed,
+// leave programID empty to use common.SPLTokenSwapProgramID
+func GetAuthorityAddress(programID, tokenSwap common.PublicKey) (common.PublicKey, uint8, error) {
+	return common.FindProgramAddress([][]byte{tokenSwap.Bytes()}, getProgramID(programID))
+}
+
+func getProgramID(programID common.PublicKey) common.PublicKey {
+	if programID == (common.PublicKey{}) {
+		return common.SPLTokenSwapProgramID
+	}
+	return programID
+}
+
+func tokenProgramID(p common.PublicKey) common.PublicKey {
+	if p == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return p
+}
@@ -0,0 +1,250 @@
+package vote
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/bincode"
+	"github.com/liangjies/solana-go-sdk/program/system"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+// AccountSize is the size of a vote account
+const AccountSize uint64 = 3762
+
+type Instruction uint32
+
+const (
+	InstructionInitializeAccount Instruction = iota
+	InstructionAuthorize
+	InstructionVote
+	InstructionWithdraw
+	InstructionUpdateValidatorIdentity
+	InstructionUpdateCommission
+	InstructionVoteSwitch
+	InstructionAuthorizeChecked
+)
+
+type VoteAuthorizationType uint32
+
+const (
+	VoteAuthorizationTypeVoter VoteAuthorizationType = iota
+	VoteAuthorizationTypeWithdrawer
+)
+
+type InitializeAccountParam struct {
+	Vote                 common.PublicKey
+	Node                 common.PublicKey
+	AuthorizedVoter      common.PublicKey
+	AuthorizedWithdrawer common.PublicKey
+	Commission           uint8
+}
+
+func InitializeAccount(param InitializeAccountParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction          Instruction
+		Node                 common.PublicKey
+		AuthorizedVoter      common.PublicKey
+		AuthorizedWithdrawer common.PublicKey
+		Commission           uint8
+	}{
+		Instruction:          InstructionInitializeAccount,
+		Node:                 param.Node,
+		AuthorizedVoter:      param.AuthorizedVoter,
+		AuthorizedWithdrawer: param.AuthorizedWithdrawer,
+		Commission:           param.Commission,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Node, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type CreateVoteAccountParam struct {
+	From                 common.PublicKey
+	Vote                 common.PublicKey
+	Node                 common.PublicKey
+	AuthorizedVoter      common.PublicKey
+	AuthorizedWithdrawer common.PublicKey
+	Commission           uint8
+	Lamports             uint64
+}
+
+// CreateVoteAccount creates and initializes a vote account. the vote account and the node need to sign the tx.
+func CreateVoteAccount(param CreateVoteAccountParam) []types.Instruction {
+	return []types.Instruction{
+		system.CreateAccount(system.CreateAccountParam{
+			From:     param.From,
+			New:      param.Vote,
+			Owner:    common.VoteProgramID,
+			Lamports: param.Lamports,
+			Space:    AccountSize,
+		}),
+		InitializeAccount(InitializeAccountParam{
+			Vote:                 param.Vote,
+			Node:                 param.Node,
+			AuthorizedVoter:      param.AuthorizedVoter,
+			AuthorizedWithdrawer: param.AuthorizedWithdrawer,
+			Commission:           param.Commission,
+		}),
+	}
+}
+
+type AuthorizeParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	NewAuth  common.PublicKey
+	AuthType VoteAuthorizationType
+}
+
+func Authorize(param AuthorizeParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		NewAuthorized         common.PublicKey
+		VoteAuthorizationType VoteAuthorizationType
+	}{
+		Instruction:           InstructionAuthorize,
+		NewAuthorized:         param.NewAuth,
+		VoteAuthorizationType: param.AuthType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeCheckedParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	NewAuth  common.PublicKey
+	AuthType VoteAuthorizationType
+}
+
+// AuthorizeChecked is the same as Authorize but the new authority needs to sign the tx
+func AuthorizeChecked(param AuthorizeCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		VoteAuthorizationType VoteAuthorizationType
+	}{
+		Instruction:           InstructionAuthorizeChecked,
+		VoteAuthorizationType: param.AuthType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+			{PubKey: param.NewAuth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WithdrawParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	To       common.PublicKey
+	Lamports uint64
+}
+
+func Withdraw(param WithdrawParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Lamports    uint64
+	}{
+		Instruction: InstructionWithdraw,
+		Lamports:    param.Lamports,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.To, IsSigner: false, IsWritable: true},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpdateValidatorIdentityParam struct {
+	Vote    common.PublicKey
+	Auth    common.PublicKey
+	NewNode common.PublicKey
+}
+
+// UpdateValidatorIdentity updates the validator identity, the new identity and the withdraw authority need to sign the tx
+func UpdateValidatorIdentity(param UpdateValidatorIdentityParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionUpdateValidatorIdentity,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.NewNode, IsSigner: true, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpdateCommissionParam struct {
+	Vote       common.PublicKey
+	Auth       common.PublicKey
+	Commission uint8
+}
+
+// UpdateCommission updates the commission, the withdraw authority needs to sign the tx
+func UpdateCommission(param UpdateCommissionParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Commission  uint8
+	}{
+		Instruction: InstructionUpdateCommission,
+		Commission:  param.Commission,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,266 @@
+package vote
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/types"
+)
+
+func TestInitializeAccount(t *testing.T) {
+	type args struct {
+		param InitializeAccountParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeAccountParam{
+					Vote:                 common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Node:                 common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					AuthorizedVoter:      common.PublicKeyFromString("11111111111111111111111111111111"),
+					AuthorizedWithdrawer: common.PublicKeyFromString("11111111111111111111111111111111"),
+					Commission:           10,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: append(append([]byte{0, 0, 0, 0}, common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ").Bytes()...), append(make([]byte, 64), 10)...),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeAccount(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeAccount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateVoteAccount(t *testing.T) {
+	param := CreateVoteAccountParam{
+		From:                 common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		Vote:                 common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+		Node:                 common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+		AuthorizedVoter:      common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+		AuthorizedWithdrawer: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		Commission:           100,
+		Lamports:             27074400,
+	}
+	got := CreateVoteAccount(param)
+	if len(got) != 2 {
+		t.Fatalf("CreateVoteAccount() len = %v, want 2", len(got))
+	}
+	if got[0].ProgramID != common.SystemProgramID {
+		t.Errorf("CreateVoteAccount() got[0].ProgramID = %v, want %v", got[0].ProgramID, common.SystemProgramID)
+	}
+	wantInit := InitializeAccount(InitializeAccountParam{
+		Vote:                 param.Vote,
+		Node:                 param.Node,
+		AuthorizedVoter:      param.AuthorizedVoter,
+		AuthorizedWithdrawer: param.AuthorizedWithdrawer,
+		Commission:           param.Commission,
+	})
+	if !reflect.DeepEqual(got[1], wantInit) {
+		t.Errorf("CreateVoteAccount() got[1] = %v, want %v", got[1], wantInit)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	type args struct {
+		param AuthorizeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: AuthorizeParam{
+					Vote:     common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NewAuth:  common.PublicKeyFromString("11111111111111111111111111111111"),
+					AuthType: VoteAuthorizationTypeWithdrawer,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: append(append([]byte{1, 0, 0, 0}, make([]byte, 32)...), 1, 0, 0, 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Authorize(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeChecked(t *testing.T) {
+	type args struct {
+		param AuthorizeCheckedParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: AuthorizeCheckedParam{
+					Vote:     common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NewAuth:  common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					AuthType: VoteAuthorizationTypeVoter,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{7, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuthorizeChecked(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuthorizeChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	type args struct {
+		param WithdrawParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: WithdrawParam{
+					Vote:     common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					To:       common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Lamports: 1,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{3, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Withdraw(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Withdraw() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateValidatorIdentity(t *testing.T) {
+	type args struct {
+		param UpdateValidatorIdentityParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateValidatorIdentityParam{
+					Vote:    common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:    common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NewNode: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{4, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateValidatorIdentity(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateValidatorIdentity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateCommission(t *testing.T) {
+	type args struct {
+		param UpdateCommissionParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateCommissionParam{
+					Vote:       common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:       common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Commission: 5,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.VoteProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{5, 0, 0, 0, 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateCommission(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateCommission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
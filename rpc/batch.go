@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// BatchRequest is one call of a batch
+type BatchRequest struct {
+	Method string
+	Params []any
+	// Config is appended to Params with Options applied, it is omitted if both are empty
+	Config  any
+	Options []CallOption
+}
+
+// NewBatchRequest takes the same params as Call, e.g. NewBatchRequest("getAccountInfo", base58Addr, cfg)
+func NewBatchRequest(method string, params ...any) BatchRequest {
+	return BatchRequest{
+		Method: method,
+		Params: params,
+	}
+}
+
+// CallBatch sends all requests in a single http request. the responses are in the same order as the requests,
+// a failed call only sets the Error of its own response.
+func (c *RpcClient) CallBatch(ctx context.Context, requests []BatchRequest) ([]JsonRpcResponse[json.RawMessage], error) {
+	if len(requests) == 0 {
+		return []JsonRpcResponse[json.RawMessage]{}, nil
+	}
+
+	payload := make([]JsonRpcRequest, 0, len(requests))
+	for i, request := range requests {
+		opts := request.Options
+		if opt := c.defaultCommitmentOption(request.Method); opt != nil {
+			opts = append(opts[:len(opts):len(opts)], opt)
+		}
+		requestParams, cfg := request.Params, request.Config
+		if cfg == nil && len(opts) > 0 {
+			requestParams, cfg = splitTrailingConfig(requestParams)
+		}
+		params, err := appendConfig(requestParams, cfg, opts)
+		if err != nil {
+			return nil, fmt.Errorf("rpc: failed to apply options, err: %v", err)
+		}
+		payload = append(payload, JsonRpcRequest{
+			JsonRpc: "2.0",
+			Id:      uint64(i + 1),
+			Method:  request.Method,
+			Params:  params,
+		})
+	}
+	j, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("rpc: failed to prepare payload, err: %v", err)
+	}
+
+	start := time.Now()
+	output, err := c.sendBatch(ctx, requests, j)
+	c.runBatchHooks(ctx, payload, output, time.Since(start), err)
+	return output, err
+}
+
+// splitTrailingConfig takes the config object off the end of params, so a request built like
+// NewBatchRequest("getAccountInfo", addr, cfg) gets its options merged into cfg instead of another object.
+func splitTrailingConfig(params []any) ([]any, any) {
+	if len(params) == 0 {
+		return params, nil
+	}
+	last := params[len(params)-1]
+	b, err := json.Marshal(last)
+	if err != nil {
+		return params, nil
+	}
+	if b = bytes.TrimSpace(b); len(b) == 0 || b[0] != '{' {
+		return params, nil
+	}
+	return params[:len(params)-1], last
+}
+
+func (c *RpcClient) sendBatch(ctx context.Context, requests []BatchRequest, j []byte) ([]JsonRpcResponse[json.RawMessage], error) {
+	body, err := c.post(ctx, "", j)
+	if err != nil {
+		return nil, newCallError(err, body)
+	}
+
+	// the whole batch can be rejected with a single error object
+	if b := bytes.TrimSpace(body); len(b) > 0 && b[0] == '{' {
+		var res JsonRpcResponse[json.RawMessage]
+		if err := json.Unmarshal(b, &res); err != nil {
+			return nil, fmt.Errorf("rpc: failed to json decode body, err: %v", err)
+		}
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		return nil, fmt.Errorf("rpc: unexpected response, body: %v", string(body))
+	}
+
+	var list []JsonRpcResponse[json.RawMessage]
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("rpc: failed to json decode body, err: %v", err)
+	}
+
+	// responses may come back in any order, match them by id
+	output := make([]JsonRpcResponse[json.RawMessage], len(requests))
+	found := make([]bool, len(requests))
+	for _, res := range list {
+		if res.Id == 0 || res.Id > uint64(len(requests)) || found[res.Id-1] {
+			return nil, fmt.Errorf("rpc: unexpected response id: %v", res.Id)
+		}
+		output[res.Id-1] = res
+		found[res.Id-1] = true
+	}
+	for i := range found {
+		if !found[i] {
+			return nil, fmt.Errorf("rpc: missing response of request %v (%v)", i, requests[i].Method)
+		}
+	}
+
+	return output, nil
+}
+
+// BatchResult decodes the result of a response returned by CallBatch. if the call failed, its JsonRpcError returns.
+func BatchResult[T any](res JsonRpcResponse[json.RawMessage]) (T, error) {
+	var output T
+	if res.Error != nil {
+		return output, res.Error
+	}
+	if err := json.Unmarshal(res.Result, &output); err != nil {
+		return output, fmt.Errorf("rpc: failed to json decode result, err: %v", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallBatch(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				Name:         "out of order with a failed call",
+				RequestBody:  `[{"jsonrpc":"2.0","id":1,"method":"getBalance","params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"]},{"jsonrpc":"2.0","id":2,"method":"getBlockTime","params":[1]},{"jsonrpc":"2.0","id":3,"method":"getSlot"}]`,
+				ResponseBody: `[{"jsonrpc":"2.0","result":100,"id":3},{"jsonrpc":"2.0","error":{"code":-32009,"message":"Slot 1 was skipped, or missing in long-term storage"},"id":2},{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":6999995000},"id":1}]`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.CallBatch(
+						context.TODO(),
+						[]BatchRequest{
+							NewBatchRequest("getBalance", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+							NewBatchRequest("getBlockTime", 1),
+							NewBatchRequest("getSlot"),
+						},
+					)
+				},
+				ExpectedValue: []JsonRpcResponse[json.RawMessage]{
+					{
+						JsonRpc: "2.0",
+						Id:      1,
+						Result:  json.RawMessage(`{"context":{"slot":73914708},"value":6999995000}`),
+					},
+					{
+						JsonRpc: "2.0",
+						Id:      2,
+						Error: &JsonRpcError{
+							Code:    -32009,
+							Message: "Slot 1 was skipped, or missing in long-term storage",
+						},
+					},
+					{
+						JsonRpc: "2.0",
+						Id:      3,
+						Result:  json.RawMessage(`100`),
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "default commitment merged into the config in params",
+				RequestBody:  `[{"jsonrpc":"2.0","id":1,"method":"getAccountInfo","params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",{"commitment":"confirmed","encoding":"base64"}]},{"jsonrpc":"2.0","id":2,"method":"getBalance","params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",{"commitment":"confirmed"}]}]`,
+				ResponseBody: `[{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":null},"id":1},{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":6999995000},"id":2}]`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentConfirmed))
+					return c.CallBatch(
+						context.TODO(),
+						[]BatchRequest{
+							NewBatchRequest("getAccountInfo", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", GetAccountInfoConfig{Encoding: AccountEncodingBase64}),
+							NewBatchRequest("getBalance", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+						},
+					)
+				},
+				ExpectedValue: []JsonRpcResponse[json.RawMessage]{
+					{
+						JsonRpc: "2.0",
+						Id:      1,
+						Result:  json.RawMessage(`{"context":{"slot":73914708},"value":null}`),
+					},
+					{
+						JsonRpc: "2.0",
+						Id:      2,
+						Result:  json.RawMessage(`{"context":{"slot":73914708},"value":6999995000}`),
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "batch rejected",
+				RequestBody:  `[{"jsonrpc":"2.0","id":1,"method":"getSlot"}]`,
+				ResponseBody: `{"jsonrpc":"2.0","error":{"code":-32600,"message":"batch size too large"},"id":null}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.CallBatch(context.TODO(), []BatchRequest{NewBatchRequest("getSlot")})
+				},
+				ExpectedValue: []JsonRpcResponse[json.RawMessage](nil),
+				ExpectedError: &JsonRpcError{
+					Code:    -32600,
+					Message: "batch size too large",
+				},
+			},
+		},
+	)
+}
+
+func TestBatchResult(t *testing.T) {
+	balance, err := BatchResult[ValueWithContext[uint64]](JsonRpcResponse[json.RawMessage]{
+		Result: json.RawMessage(`{"context":{"slot":73914708},"value":6999995000}`),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ValueWithContext[uint64]{Context: Context{Slot: 73914708}, Value: 6999995000}, balance)
+
+	_, err = BatchResult[uint64](JsonRpcResponse[json.RawMessage]{
+		Error: &JsonRpcError{Code: -32009, Message: "skipped"},
+	})
+	assert.Equal(t, &JsonRpcError{Code: -32009, Message: "skipped"}, err)
+}
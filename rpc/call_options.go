@@ -0,0 +1,164 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CallOption sets a field of the config object of a rpc method. the options are applied on top of
+// the config passed to a XxxWithConfig method, so a method can be called with the fields its config struct
+// doesn't have yet.
+type CallOption func(cfg map[string]any)
+
+// WithCommitment sets `commitment`
+func WithCommitment(commitment Commitment) CallOption {
+	return WithConfigField("commitment", commitment)
+}
+
+// WithMinContextSlot sets `minContextSlot`, the minimum slot that the request can be evaluated at
+func WithMinContextSlot(slot uint64) CallOption {
+	return WithConfigField("minContextSlot", slot)
+}
+
+// WithMaxRetries sets `maxRetries` of `sendTransaction`, the max number of times the node retries
+// sending the transaction to the leader. unlike the config field, 0 is sent as it is.
+func WithMaxRetries(maxRetries uint64) CallOption {
+	return WithConfigField("maxRetries", maxRetries)
+}
+
+// WithEncoding sets `encoding`, e.g. WithEncoding(AccountEncodingBase64) or WithEncoding(TransactionEncodingJson)
+func WithEncoding[E ~string](encoding E) CallOption {
+	return WithConfigField("encoding", encoding)
+}
+
+// WithDataSlice sets `dataSlice`
+func WithDataSlice(dataSlice DataSlice) CallOption {
+	return WithConfigField("dataSlice", dataSlice)
+}
+
+// WithConfigField sets any field of the config object
+func WithConfigField(key string, value any) CallOption {
+	return func(cfg map[string]any) {
+		cfg[key] = value
+	}
+}
+
+// callWithConfig appends cfg with opts applied to params and calls the method.
+// if cfg is nil and there is no option, no config object is sent.
+func callWithConfig[T any](c *RpcClient, ctx context.Context, cfg any, opts []CallOption, params ...any) (T, error) {
+	if opt := c.defaultCommitmentOption(params[0].(string)); opt != nil {
+		opts = append(opts[:len(opts):len(opts)], opt)
+	}
+	params, err := appendConfig(params, cfg, opts)
+	if err != nil {
+		var output T
+		return output, fmt.Errorf("rpc: failed to apply options, err: %v", err)
+	}
+	return call[T](c, ctx, params...)
+}
+
+func appendConfig(params []any, cfg any, opts []CallOption) ([]any, error) {
+	if len(opts) > 0 {
+		m, err := applyCallOptions(cfg, opts)
+		if err != nil {
+			return nil, err
+		}
+		cfg = m
+	}
+	if cfg != nil {
+		// don't write into the caller's backing array
+		params = append(params[:len(params):len(params)], cfg)
+	}
+	return params, nil
+}
+
+func applyCallOptions(cfg any, opts []CallOption) (map[string]any, error) {
+	m := map[string]any{}
+	if cfg != nil {
+		b, err := json.Marshal(cfg)
+		if err != nil {
+			return nil, err
+		}
+		// keep u64 numbers as they are
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		if err := d.Decode(&m); err != nil {
+			return nil, err
+		}
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
+}
+
+// commitmentKeys are the config keys of the commitment of the methods which accept one.
+// the methods without a wrapper are listed too since they can be sent by Call or CallBatch.
+var commitmentKeys = map[string]string{
+	"getAccountInfo":                    "commitment",
+	"getBalance":                        "commitment",
+	"getBlock":                          "commitment",
+	"getBlockHeight":                    "commitment",
+	"getBlockProduction":                "commitment",
+	"getBlocks":                         "commitment",
+	"getBlocksWithLimit":                "commitment",
+	"getEpochInfo":                      "commitment",
+	"getFeeForMessage":                  "commitment",
+	"getInflationGovernor":              "commitment",
+	"getInflationReward":                "commitment",
+	"getLargestAccounts":                "commitment",
+	"getLatestBlockhash":                "commitment",
+	"getLeaderSchedule":                 "commitment",
+	"getMinimumBalanceForRentExemption": "commitment",
+	"getMultipleAccounts":               "commitment",
+	"getProgramAccounts":                "commitment",
+	"getSignaturesForAddress":           "commitment",
+	"getSlot":                           "commitment",
+	"getSlotLeader":                     "commitment",
+	"getStakeActivation":                "commitment",
+	"getStakeMinimumDelegation":         "commitment",
+	"getSupply":                         "commitment",
+	"getTokenAccountBalance":            "commitment",
+	"getTokenAccountsByDelegate":        "commitment",
+	"getTokenAccountsByOwner":           "commitment",
+	"getTokenLargestAccounts":           "commitment",
+	"getTokenSupply":                    "commitment",
+	"getTransaction":                    "commitment",
+	"getTransactionCount":               "commitment",
+	"getVoteAccounts":                   "commitment",
+	"isBlockhashValid":                  "commitment",
+	"requestAirdrop":                    "commitment",
+	"sendTransaction":                   "preflightCommitment",
+	"simulateTransaction":               "commitment",
+}
+
+// these methods reject "processed", confirmed is the closest commitment they accept
+var noProcessedCommitmentMethods = map[string]bool{
+	"getBlock":                true,
+	"getBlocks":               true,
+	"getBlocksWithLimit":      true,
+	"getSignaturesForAddress": true,
+	"getTransaction":          true,
+}
+
+// defaultCommitmentOption returns an option which sets the default commitment if the config doesn't have one
+func (c *RpcClient) defaultCommitmentOption(method string) CallOption {
+	if c.commitment == "" {
+		return nil
+	}
+	key, ok := commitmentKeys[method]
+	if !ok {
+		return nil
+	}
+	commitment := c.commitment
+	if commitment == CommitmentProcessed && noProcessedCommitmentMethods[method] {
+		commitment = CommitmentConfirmed
+	}
+	return func(cfg map[string]any) {
+		if _, ok := cfg[key]; !ok {
+			cfg[key] = commitment
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallOptions(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				Name:         "no option",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBalance", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":6999995000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					res, err := c.GetBalance(context.TODO(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+					return res.Result.Value, err
+				},
+				ExpectedValue: uint64(6999995000),
+				ExpectedError: nil,
+			},
+			{
+				Name:         "options without config",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBalance", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"commitment":"confirmed","minContextSlot":18446744073709551615}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":6999995000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					res, err := c.GetBalance(
+						context.TODO(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+						WithCommitment(CommitmentConfirmed),
+						WithMinContextSlot(18446744073709551615),
+					)
+					return res.Result.Value, err
+				},
+				ExpectedValue: uint64(6999995000),
+				ExpectedError: nil,
+			},
+			{
+				Name:         "options override config",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"commitment":"finalized","encoding":"base64","dataSlice":{"offset":4,"length":8},"minContextSlot":100}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":77382573},"value":null},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					res, err := c.GetAccountInfoWithConfig(
+						context.TODO(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+						GetAccountInfoConfig{
+							Commitment: CommitmentConfirmed,
+							Encoding:   AccountEncodingBase58,
+						},
+						WithCommitment(CommitmentFinalized),
+						WithEncoding(AccountEncodingBase64),
+						WithDataSlice(DataSlice{Offset: 4, Length: 8}),
+						WithConfigField("minContextSlot", 100),
+					)
+					return res.Result.Value, err
+				},
+				ExpectedValue: AccountInfo{},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "option after positional object",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenAccountsByOwner", "params":["27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ", {"mint":"So11111111111111111111111111111111111111112"}, {"commitment":"processed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":77382573},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					res, err := c.GetTokenAccountsByOwner(
+						context.TODO(),
+						"27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ",
+						GetTokenAccountsByOwnerConfigFilter{Mint: "So11111111111111111111111111111111111111112"},
+						WithCommitment(CommitmentProcessed),
+					)
+					return len(res.Result.Value), err
+				},
+				ExpectedValue: 0,
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestDefaultCommitment(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				Name:         "without config",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBalance", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"commitment":"confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":73914708},"value":6999995000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentConfirmed))
+					res, err := c.GetBalance(context.TODO(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+					return res.Result.Value, err
+				},
+				ExpectedValue: uint64(6999995000),
+				ExpectedError: nil,
+			},
+			{
+				Name:         "config overrides default",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7", {"commitment":"finalized","encoding":"base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":77382573},"value":null},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentProcessed))
+					res, err := c.GetAccountInfoWithConfig(
+						context.TODO(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+						GetAccountInfoConfig{
+							Commitment: CommitmentFinalized,
+							Encoding:   AccountEncodingBase64,
+						},
+					)
+					return res.Result.Value, err
+				},
+				ExpectedValue: AccountInfo{},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "option overrides default",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSlot", "params":[{"commitment":"finalized"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":100,"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentProcessed))
+					res, err := c.GetSlot(context.TODO(), WithCommitment(CommitmentFinalized))
+					return res.Result, err
+				},
+				ExpectedValue: uint64(100),
+				ExpectedError: nil,
+			},
+			{
+				Name:         "processed is not supported",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getBlocks", "params":[100, 101, {"commitment":"confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[100],"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentProcessed))
+					res, err := c.GetBlocks(context.TODO(), 100, 101)
+					return res.Result, err
+				},
+				ExpectedValue: []uint64{100},
+				ExpectedError: nil,
+			},
+			{
+				Name:         "preflight commitment",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["AQID", {"encoding":"base64","preflightCommitment":"processed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb","id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentProcessed))
+					res, err := c.SendTransactionWithConfig(context.TODO(), "AQID", SendTransactionConfig{Encoding: SendTransactionConfigEncodingBase64})
+					return res.Result, err
+				},
+				ExpectedValue: "2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb",
+				ExpectedError: nil,
+			},
+			{
+				Name:         "method without commitment",
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSignatureStatuses", "params":[["2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":100},"value":[null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := New(WithEndpoint(url), WithDefaultCommitment(CommitmentConfirmed))
+					res, err := c.GetSignatureStatuses(context.TODO(), []string{"2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb"})
+					return res.Result.Value, err
+				},
+				ExpectedValue: SignatureStatuses{nil},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+// TestCommitmentKeys checks commitmentKeys against all methods of the json rpc http api
+func TestCommitmentKeys(t *testing.T) {
+	methods := map[string]string{
+		"getAccountInfo":                    "commitment",
+		"getBalance":                        "commitment",
+		"getBlock":                          "commitment",
+		"getBlockCommitment":                "",
+		"getBlockHeight":                    "commitment",
+		"getBlockProduction":                "commitment",
+		"getBlockTime":                      "",
+		"getBlocks":                         "commitment",
+		"getBlocksWithLimit":                "commitment",
+		"getClusterNodes":                   "",
+		"getEpochInfo":                      "commitment",
+		"getEpochSchedule":                  "",
+		"getFeeForMessage":                  "commitment",
+		"getFirstAvailableBlock":            "",
+		"getGenesisHash":                    "",
+		"getHealth":                         "",
+		"getHighestSnapshotSlot":            "",
+		"getIdentity":                       "",
+		"getInflationGovernor":              "commitment",
+		"getInflationRate":                  "",
+		"getInflationReward":                "commitment",
+		"getLargestAccounts":                "commitment",
+		"getLatestBlockhash":                "commitment",
+		"getLeaderSchedule":                 "commitment",
+		"getMaxRetransmitSlot":              "",
+		"getMaxShredInsertSlot":             "",
+		"getMinimumBalanceForRentExemption": "commitment",
+		"getMultipleAccounts":               "commitment",
+		"getProgramAccounts":                "commitment",
+		"getRecentPerformanceSamples":       "",
+		"getRecentPrioritizationFees":       "",
+		"getSignatureStatuses":              "",
+		"getSignaturesForAddress":           "commitment",
+		"getSlot":                           "commitment",
+		"getSlotLeader":                     "commitment",
+		"getSlotLeaders":                    "",
+		"getStakeActivation":                "commitment",
+		"getStakeMinimumDelegation":         "commitment",
+		"getSupply":                         "commitment",
+		"getTokenAccountBalance":            "commitment",
+		"getTokenAccountsByDelegate":        "commitment",
+		"getTokenAccountsByOwner":           "commitment",
+		"getTokenLargestAccounts":           "commitment",
+		"getTokenSupply":                    "commitment",
+		"getTransaction":                    "commitment",
+		"getTransactionCount":               "commitment",
+		"getVersion":                        "",
+		"getVoteAccounts":                   "commitment",
+		"isBlockhashValid":                  "commitment",
+		"minimumLedgerSlot":                 "",
+		"requestAirdrop":                    "commitment",
+		"sendTransaction":                   "preflightCommitment",
+		"simulateTransaction":               "commitment",
+	}
+	for method, key := range methods {
+		got, ok := commitmentKeys[method]
+		assert.Equal(t, key != "", ok, method)
+		assert.Equal(t, key, got, method)
+	}
+	for method := range commitmentKeys {
+		_, ok := methods[method]
+		assert.True(t, ok, "unknown method: %v", method)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 const (
@@ -41,30 +42,19 @@ func (j JsonRpcResponse[T]) GetError() error {
 	return j.Error
 }
 
-type JsonRpcError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-	Data    any    `json:"data"`
-}
-
-func (e *JsonRpcError) Error() string {
-	s, err := json.Marshal(e)
-	if err == nil {
-		return string(s)
-	}
-
-	// ideally, it should never reach here
-	return fmt.Sprintf("failed to marshal JsonRpcError, err: %v, code: %v, message: %v, data: %v", err, e.Code, e.Message, e.Data)
-}
-
 type ValueWithContext[T any] struct {
 	Context Context `json:"context"`
 	Value   T       `json:"value"`
 }
 
 type RpcClient struct {
-	endpoint   string
-	httpClient *http.Client
+	endpoint    string
+	httpClient  *http.Client
+	retry       RetryPolicy
+	methodRetry map[string]RetryPolicy
+	commitment  Commitment
+	middlewares []Middleware
+	hooks       []CallHook
 }
 
 func NewRpcClient(endpoint string) RpcClient { return New(WithEndpoint(endpoint)) }
@@ -84,6 +74,11 @@ func New(opts ...Option) RpcClient {
 	return *client
 }
 
+// DefaultCommitment returns the commitment set by WithDefaultCommitment, it is empty if not set
+func (c *RpcClient) DefaultCommitment() Commitment {
+	return c.commitment
+}
+
 // Call will return body of response. if http code beyond 200~300, the error also returns.
 func (c *RpcClient) Call(ctx context.Context, params ...any) ([]byte, error) {
 	// prepare payload
@@ -91,33 +86,61 @@ func (c *RpcClient) Call(ctx context.Context, params ...any) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare payload, err: %v", err)
 	}
+	start := time.Now()
+	body, err := c.post(ctx, params[0].(string), j)
+	c.runHooks(ctx, params[0].(string), params[1:], time.Since(start), body, err)
+	return body, err
+}
+
+// post sends a json payload to the endpoint and returns the body of response.
+// the request is retried by the retry policy of method.
+func (c *RpcClient) post(ctx context.Context, method string, j []byte) ([]byte, error) {
+	policy := c.retryPolicy(method)
+	ctx = context.WithValue(ctx, methodContextKey{}, method)
+	for attempt := 0; ; attempt++ {
+		body, retryAfter, err := c.do(ctx, j, policy.maxBackoff())
+		if err == nil || attempt >= policy.MaxRetries || !isRetryable(ctx, err) {
+			return body, err
+		}
+		if retryAfter <= 0 {
+			retryAfter = policy.backoff(attempt)
+		}
+		select {
+		case <-time.After(retryAfter):
+		case <-ctx.Done():
+			return body, err
+		}
+	}
+}
 
+// do sends the request once, retryAfter is the Retry-After header of the response if any, capped by maxRetryAfter
+func (c *RpcClient) do(ctx context.Context, j []byte, maxRetryAfter time.Duration) (body []byte, retryAfter time.Duration, err error) {
 	// prepare request
 	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewBuffer(j))
 	if err != nil {
-		return nil, fmt.Errorf("failed to do http.NewRequestWithContext, err: %v", err)
+		return nil, 0, fmt.Errorf("failed to do http.NewRequestWithContext, err: %v", err)
 	}
 	req.Header.Add("Content-Type", "application/json")
 
 	// do request
-	res, err := c.httpClient.Do(req)
+	res, err := c.handler()(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to do request, err: %v", err)
+		return nil, 0, &requestError{err: err}
 	}
 	defer res.Body.Close()
 
 	// parse body
-	body, err := io.ReadAll(res.Body)
+	body, err = io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read body, err: %v", err)
+		return nil, 0, &requestError{err: fmt.Errorf("failed to read body, err: %v", err)}
 	}
 
 	// check response code
 	if res.StatusCode < 200 || res.StatusCode > 300 {
-		return body, fmt.Errorf("get status code: %v", res.StatusCode)
+		return body, parseRetryAfter(res.Header.Get("Retry-After"), time.Now(), maxRetryAfter), &statusError{code: res.StatusCode}
 	}
 
-	return body, nil
+	return body, 0, nil
 }
 
 func preparePayload(params []any) ([]byte, error) {
@@ -140,7 +163,7 @@ func call[T any](c *RpcClient, ctx context.Context, params ...any) (T, error) {
 	// rpc call
 	body, err := c.Call(ctx, params...)
 	if err != nil {
-		return output, fmt.Errorf("rpc: call error, err: %v, body: %v", err, string(body))
+		return output, newCallError(err, body)
 	}
 
 	// transfer data
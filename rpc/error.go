@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// the codes of the json rpc errors, the ones above -32100 are defined by the solana nodes
+const (
+	ErrorCodeParseError     = -32700
+	ErrorCodeInvalidRequest = -32600
+	ErrorCodeMethodNotFound = -32601
+	ErrorCodeInvalidParams  = -32602
+	ErrorCodeInternalError  = -32603
+
+	ErrorCodeBlockCleanedUp                           = -32001
+	ErrorCodeSendTransactionPreflightFailure          = -32002
+	ErrorCodeTransactionSignatureVerificationFailure  = -32003
+	ErrorCodeBlockNotAvailable                        = -32004
+	ErrorCodeNodeUnhealthy                            = -32005
+	ErrorCodeTransactionPrecompileVerificationFailure = -32006
+	ErrorCodeSlotSkipped                              = -32007
+	ErrorCodeNoSnapshot                               = -32008
+	ErrorCodeLongTermStorageSlotSkipped               = -32009
+	ErrorCodeKeyExcludedFromSecondaryIndex            = -32010
+	ErrorCodeTransactionHistoryNotAvailable           = -32011
+	ErrorCodeScanError                                = -32012
+	ErrorCodeTransactionSignatureLenMismatch          = -32013
+	ErrorCodeBlockStatusNotAvailableYet               = -32014
+	ErrorCodeUnsupportedTransactionVersion            = -32015
+	ErrorCodeMinContextSlotNotReached                 = -32016
+)
+
+// JsonRpcError is the error object of a response, get it from an error with errors.As.
+// errors.Is matches it with a *JsonRpcError of the same code, e.g. &JsonRpcError{Code: ErrorCodeNodeUnhealthy}
+type JsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data"`
+}
+
+func (e *JsonRpcError) Error() string {
+	s, err := json.Marshal(e)
+	if err == nil {
+		return string(s)
+	}
+
+	// ideally, it should never reach here
+	return fmt.Sprintf("failed to marshal JsonRpcError, err: %v, code: %v, message: %v, data: %v", err, e.Code, e.Message, e.Data)
+}
+
+func (e *JsonRpcError) Is(target error) bool {
+	t, ok := target.(*JsonRpcError)
+	return ok && t.Code == e.Code
+}
+
+// SendTransactionPreflightFailure returns the simulation result of a failed preflight,
+// false returns if it is another error or the data can't be decoded
+func (e *JsonRpcError) SendTransactionPreflightFailure() (SimulateTransactionValue, bool) {
+	var v SimulateTransactionValue
+	if e.Code != ErrorCodeSendTransactionPreflightFailure || !e.decodeData(&v) {
+		return SimulateTransactionValue{}, false
+	}
+	return v, true
+}
+
+type NodeUnhealthyErrorData struct {
+	// NumSlotsBehind is nil if the node doesn't know how far behind it is
+	NumSlotsBehind *uint64 `json:"numSlotsBehind"`
+}
+
+// NodeUnhealthy returns the data of a ErrorCodeNodeUnhealthy error
+func (e *JsonRpcError) NodeUnhealthy() (NodeUnhealthyErrorData, bool) {
+	var v NodeUnhealthyErrorData
+	if e.Code != ErrorCodeNodeUnhealthy {
+		return NodeUnhealthyErrorData{}, false
+	}
+	// the data is left out by the old nodes
+	if e.Data != nil && !e.decodeData(&v) {
+		return NodeUnhealthyErrorData{}, false
+	}
+	return v, true
+}
+
+type MinContextSlotNotReachedErrorData struct {
+	ContextSlot uint64 `json:"contextSlot"`
+}
+
+// MinContextSlotNotReached returns the data of a ErrorCodeMinContextSlotNotReached error
+func (e *JsonRpcError) MinContextSlotNotReached() (MinContextSlotNotReachedErrorData, bool) {
+	var v MinContextSlotNotReachedErrorData
+	if e.Code != ErrorCodeMinContextSlotNotReached || !e.decodeData(&v) {
+		return MinContextSlotNotReachedErrorData{}, false
+	}
+	return v, true
+}
+
+func (e *JsonRpcError) decodeData(v any) bool {
+	b, err := json.Marshal(e.Data)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+// callError is returned if the request fails or the status code is not 2xx. some providers respond
+// an error object with the status code, e.g. 429, it unwraps to the *JsonRpcError then.
+type callError struct {
+	err    error
+	body   []byte
+	rpcErr *JsonRpcError
+}
+
+func newCallError(err error, body []byte) error {
+	e := &callError{err: err, body: body}
+	var res struct {
+		Error *JsonRpcError `json:"error"`
+	}
+	if json.Unmarshal(body, &res) == nil && res.Error != nil {
+		e.rpcErr = res.Error
+	}
+	return e
+}
+
+func (e *callError) Error() string {
+	return fmt.Sprintf("rpc: call error, err: %v, body: %v", e.err, string(e.body))
+}
+
+func (e *callError) Unwrap() error {
+	if e.rpcErr != nil {
+		return e.rpcErr
+	}
+	return e.err
+}
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeJsonRpcError(t *testing.T, s string) *JsonRpcError {
+	var e JsonRpcError
+	assert.Nil(t, json.Unmarshal([]byte(s), &e))
+	return &e
+}
+
+func TestJsonRpcError_SendTransactionPreflightFailure(t *testing.T) {
+	e := decodeJsonRpcError(t, `{"code":-32002,"message":"Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1","data":{"accounts":null,"err":{"InstructionError":[0,{"Custom":1}]},"logs":["Program 11111111111111111111111111111111 invoke [1]","Program 11111111111111111111111111111111 failed: custom program error: 0x1"],"unitsConsumed":150}}`)
+	v, ok := e.SendTransactionPreflightFailure()
+	assert.True(t, ok)
+	assert.Equal(t, SimulateTransactionValue{
+		Err: map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}},
+		Logs: []string{
+			"Program 11111111111111111111111111111111 invoke [1]",
+			"Program 11111111111111111111111111111111 failed: custom program error: 0x1",
+		},
+		UnitsConsumed: pointer.Get[uint64](150),
+	}, v)
+
+	_, ok = decodeJsonRpcError(t, `{"code":-32005,"message":"Node is behind by 42 slots","data":{"numSlotsBehind":42}}`).SendTransactionPreflightFailure()
+	assert.False(t, ok)
+}
+
+func TestJsonRpcError_NodeUnhealthy(t *testing.T) {
+	v, ok := decodeJsonRpcError(t, `{"code":-32005,"message":"Node is behind by 42 slots","data":{"numSlotsBehind":42}}`).NodeUnhealthy()
+	assert.True(t, ok)
+	assert.Equal(t, NodeUnhealthyErrorData{NumSlotsBehind: pointer.Get[uint64](42)}, v)
+
+	v, ok = decodeJsonRpcError(t, `{"code":-32005,"message":"Node is unhealthy"}`).NodeUnhealthy()
+	assert.True(t, ok)
+	assert.Equal(t, NodeUnhealthyErrorData{}, v)
+}
+
+func TestJsonRpcError_MinContextSlotNotReached(t *testing.T) {
+	v, ok := decodeJsonRpcError(t, `{"code":-32016,"message":"Minimum context slot has not been reached","data":{"contextSlot":100}}`).MinContextSlotNotReached()
+	assert.True(t, ok)
+	assert.Equal(t, MinContextSlotNotReachedErrorData{ContextSlot: 100}, v)
+}
+
+func TestJsonRpcError_Is(t *testing.T) {
+	err := fmt.Errorf("failed to send, err: %w", &JsonRpcError{Code: ErrorCodeNodeUnhealthy, Message: "Node is unhealthy"})
+	assert.True(t, errors.Is(err, &JsonRpcError{Code: ErrorCodeNodeUnhealthy}))
+	assert.False(t, errors.Is(err, &JsonRpcError{Code: ErrorCodeSendTransactionPreflightFailure}))
+}
+
+func TestCallError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusTooManyRequests)
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":429,"message":"Too many requests"},"id":1}`))
+	}))
+	defer server.Close()
+
+	c := NewRpcClient(server.URL)
+	_, err := c.GetSlot(context.Background())
+	assert.EqualError(t, err, `rpc: call error, err: get status code: 429, body: {"jsonrpc":"2.0","error":{"code":429,"message":"Too many requests"},"id":1}`)
+	var rpcErr *JsonRpcError
+	assert.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, &JsonRpcError{Code: 429, Message: "Too many requests"}, rpcErr)
+}
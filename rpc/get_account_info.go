@@ -16,11 +16,11 @@ type GetAccountInfoConfig struct {
 }
 
 // GetAccountInfo returns all information associated with the account of provided Pubkey
-func (c *RpcClient) GetAccountInfo(ctx context.Context, base58Addr string) (JsonRpcResponse[ValueWithContext[AccountInfo]], error) {
-	return call[JsonRpcResponse[ValueWithContext[AccountInfo]]](c, ctx, "getAccountInfo", base58Addr)
+func (c *RpcClient) GetAccountInfo(ctx context.Context, base58Addr string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[AccountInfo]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[AccountInfo]]](c, ctx, nil, opts, "getAccountInfo", base58Addr)
 }
 
 // GetAccountInfo returns all information associated with the account of provided Pubkey
-func (c *RpcClient) GetAccountInfoWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig) (JsonRpcResponse[ValueWithContext[AccountInfo]], error) {
-	return call[JsonRpcResponse[ValueWithContext[AccountInfo]]](c, ctx, "getAccountInfo", base58Addr, cfg)
+func (c *RpcClient) GetAccountInfoWithConfig(ctx context.Context, base58Addr string, cfg GetAccountInfoConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[AccountInfo]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[AccountInfo]]](c, ctx, cfg, opts, "getAccountInfo", base58Addr)
 }
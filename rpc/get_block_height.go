@@ -12,11 +12,11 @@ type GetBlockHeightConfig struct {
 }
 
 // GetBlockHeight returns the current block height of the node
-func (c *RpcClient) GetBlockHeight(ctx context.Context) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getBlockHeight")
+func (c *RpcClient) GetBlockHeight(ctx context.Context, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, nil, opts, "getBlockHeight")
 }
 
 // GetBlockHeightWithConfig returns the current block height of the node
-func (c *RpcClient) GetBlockHeightWithConfig(ctx context.Context, cfg GetBlockHeightConfig) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getBlockHeight", cfg)
+func (c *RpcClient) GetBlockHeightWithConfig(ctx context.Context, cfg GetBlockHeightConfig, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, cfg, opts, "getBlockHeight")
 }
@@ -26,11 +26,11 @@ type GetBlockProductionRange struct {
 }
 
 // GetBlockProduction returns the current block height of the node
-func (c *RpcClient) GetBlockProduction(ctx context.Context) (JsonRpcResponse[GetBlockProduction], error) {
-	return call[JsonRpcResponse[GetBlockProduction]](c, ctx, "getBlockProduction")
+func (c *RpcClient) GetBlockProduction(ctx context.Context, opts ...CallOption) (JsonRpcResponse[GetBlockProduction], error) {
+	return callWithConfig[JsonRpcResponse[GetBlockProduction]](c, ctx, nil, opts, "getBlockProduction")
 }
 
 // GetBlockProductionWithConfig returns the current block height of the node
-func (c *RpcClient) GetBlockProductionWithConfig(ctx context.Context, cfg GetBlockProductionConfig) (JsonRpcResponse[GetBlockProduction], error) {
-	return call[JsonRpcResponse[GetBlockProduction]](c, ctx, "getBlockProduction", cfg)
+func (c *RpcClient) GetBlockProductionWithConfig(ctx context.Context, cfg GetBlockProductionConfig, opts ...CallOption) (JsonRpcResponse[GetBlockProduction], error) {
+	return callWithConfig[JsonRpcResponse[GetBlockProduction]](c, ctx, cfg, opts, "getBlockProduction")
 }
@@ -11,12 +11,12 @@ type GetBlocksConfig struct {
 
 // GetBlocks returns a list of confirmed blocks between two slots
 // Max range allowed is 500,000 slot
-func (c *RpcClient) GetBlocks(ctx context.Context, startSlot uint64, endSlot uint64) (JsonRpcResponse[[]uint64], error) {
-	return call[JsonRpcResponse[[]uint64]](c, ctx, "getBlocks", startSlot, endSlot)
+func (c *RpcClient) GetBlocks(ctx context.Context, startSlot uint64, endSlot uint64, opts ...CallOption) (JsonRpcResponse[[]uint64], error) {
+	return callWithConfig[JsonRpcResponse[[]uint64]](c, ctx, nil, opts, "getBlocks", startSlot, endSlot)
 }
 
 // GetBlocks returns a list of confirmed blocks between two slots
 // Max range allowed is 500,000 slot
-func (c *RpcClient) GetBlocksWithConfig(ctx context.Context, startSlot uint64, endSlot uint64, cfg GetBlocksConfig) (JsonRpcResponse[[]uint64], error) {
-	return call[JsonRpcResponse[[]uint64]](c, ctx, "getBlocks", startSlot, endSlot, cfg)
+func (c *RpcClient) GetBlocksWithConfig(ctx context.Context, startSlot uint64, endSlot uint64, cfg GetBlocksConfig, opts ...CallOption) (JsonRpcResponse[[]uint64], error) {
+	return callWithConfig[JsonRpcResponse[[]uint64]](c, ctx, cfg, opts, "getBlocks", startSlot, endSlot)
 }
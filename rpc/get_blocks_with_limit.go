@@ -10,11 +10,11 @@ type GetBlocksWithLimitConfig struct {
 }
 
 // GetBlocksWithLimit eturns a list of confirmed blocks starting at the given slot
-func (c *RpcClient) GetBlocksWithLimit(ctx context.Context, startSlot uint64, limit uint64) (JsonRpcResponse[[]uint64], error) {
-	return call[JsonRpcResponse[[]uint64]](c, ctx, "getBlocksWithLimit", startSlot, limit)
+func (c *RpcClient) GetBlocksWithLimit(ctx context.Context, startSlot uint64, limit uint64, opts ...CallOption) (JsonRpcResponse[[]uint64], error) {
+	return callWithConfig[JsonRpcResponse[[]uint64]](c, ctx, nil, opts, "getBlocksWithLimit", startSlot, limit)
 }
 
 // GetBlocksWithLimit eturns a list of confirmed blocks starting at the given slot
-func (c *RpcClient) GetBlocksWithLimitWithConfig(ctx context.Context, startSlot uint64, limit uint64, cfg GetBlocksWithLimitConfig) (JsonRpcResponse[[]uint64], error) {
-	return call[JsonRpcResponse[[]uint64]](c, ctx, "getBlocksWithLimit", startSlot, limit, cfg)
+func (c *RpcClient) GetBlocksWithLimitWithConfig(ctx context.Context, startSlot uint64, limit uint64, cfg GetBlocksWithLimitConfig, opts ...CallOption) (JsonRpcResponse[[]uint64], error) {
+	return callWithConfig[JsonRpcResponse[[]uint64]](c, ctx, cfg, opts, "getBlocksWithLimit", startSlot, limit)
 }
@@ -19,11 +19,11 @@ type GetEpochInfoConfig struct {
 }
 
 // GetEpochInfo returns the SOL balance
-func (c *RpcClient) GetEpochInfo(ctx context.Context) (JsonRpcResponse[GetEpochInfo], error) {
-	return call[JsonRpcResponse[GetEpochInfo]](c, ctx, "getEpochInfo")
+func (c *RpcClient) GetEpochInfo(ctx context.Context, opts ...CallOption) (JsonRpcResponse[GetEpochInfo], error) {
+	return callWithConfig[JsonRpcResponse[GetEpochInfo]](c, ctx, nil, opts, "getEpochInfo")
 }
 
 // GetEpochInfoWithConfig returns the SOL balance
-func (c *RpcClient) GetEpochInfoWithConfig(ctx context.Context, cfg GetEpochInfoConfig) (JsonRpcResponse[GetEpochInfo], error) {
-	return call[JsonRpcResponse[GetEpochInfo]](c, ctx, "getEpochInfo", cfg)
+func (c *RpcClient) GetEpochInfoWithConfig(ctx context.Context, cfg GetEpochInfoConfig, opts ...CallOption) (JsonRpcResponse[GetEpochInfo], error) {
+	return callWithConfig[JsonRpcResponse[GetEpochInfo]](c, ctx, cfg, opts, "getEpochInfo")
 }
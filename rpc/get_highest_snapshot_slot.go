@@ -0,0 +1,16 @@
+package rpc
+
+import "context"
+
+type GetHighestSnapshotSlotResponse JsonRpcResponse[GetHighestSnapshotSlot]
+
+type GetHighestSnapshotSlot struct {
+	Full uint64 `json:"full"`
+	// Incremental is nil if the node has no incremental snapshot based on the full snapshot
+	Incremental *uint64 `json:"incremental"`
+}
+
+// GetHighestSnapshotSlot returns the highest slot information that the node has snapshots for
+func (c *RpcClient) GetHighestSnapshotSlot(ctx context.Context) (JsonRpcResponse[GetHighestSnapshotSlot], error) {
+	return call[JsonRpcResponse[GetHighestSnapshotSlot]](c, ctx, "getHighestSnapshotSlot")
+}
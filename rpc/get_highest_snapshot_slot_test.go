@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+)
+
+func TestGetHighestSnapshotSlot(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getHighestSnapshotSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"full":100,"incremental":110},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetHighestSnapshotSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetHighestSnapshotSlot]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetHighestSnapshotSlot{
+						Full:        100,
+						Incremental: pointer.Get[uint64](110),
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getHighestSnapshotSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","error":{"code":-32008,"message":"No snapshot"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetHighestSnapshotSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetHighestSnapshotSlot]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error: &JsonRpcError{
+						Code:    -32008,
+						Message: "No snapshot",
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -20,11 +20,11 @@ type GetInflationGovernorConfig struct {
 }
 
 // GetInflationGovernor returns the current inflation governor
-func (c *RpcClient) GetInflationGovernor(ctx context.Context) (JsonRpcResponse[GetInflationGovernor], error) {
-	return call[JsonRpcResponse[GetInflationGovernor]](c, ctx, "getInflationGovernor")
+func (c *RpcClient) GetInflationGovernor(ctx context.Context, opts ...CallOption) (JsonRpcResponse[GetInflationGovernor], error) {
+	return callWithConfig[JsonRpcResponse[GetInflationGovernor]](c, ctx, nil, opts, "getInflationGovernor")
 }
 
 // GetInflationGovernorWithConfig returns the current inflation governor
-func (c *RpcClient) GetInflationGovernorWithConfig(ctx context.Context, cfg GetInflationGovernorConfig) (JsonRpcResponse[GetInflationGovernor], error) {
-	return call[JsonRpcResponse[GetInflationGovernor]](c, ctx, "getInflationGovernor", cfg)
+func (c *RpcClient) GetInflationGovernorWithConfig(ctx context.Context, cfg GetInflationGovernorConfig, opts ...CallOption) (JsonRpcResponse[GetInflationGovernor], error) {
+	return callWithConfig[JsonRpcResponse[GetInflationGovernor]](c, ctx, cfg, opts, "getInflationGovernor")
 }
@@ -22,11 +22,11 @@ type GetInflationRewardConfig struct {
 }
 
 // GetInflationReward returns the inflation reward for a list of addresses for an epoch
-func (c *RpcClient) GetInflationReward(ctx context.Context, stakeAccountAddrs []string) (JsonRpcResponse[[]*GetInflationReward], error) {
-	return call[JsonRpcResponse[[]*GetInflationReward]](c, ctx, "getInflationReward", stakeAccountAddrs)
+func (c *RpcClient) GetInflationReward(ctx context.Context, stakeAccountAddrs []string, opts ...CallOption) (JsonRpcResponse[[]*GetInflationReward], error) {
+	return callWithConfig[JsonRpcResponse[[]*GetInflationReward]](c, ctx, nil, opts, "getInflationReward", stakeAccountAddrs)
 }
 
 // GetInflationRewardWithConfig returns the inflation reward for a list of addresses for an epoch
-func (c *RpcClient) GetInflationRewardWithConfig(ctx context.Context, stakeAccountAddrs []string, cfg GetInflationRewardConfig) (JsonRpcResponse[[]*GetInflationReward], error) {
-	return call[JsonRpcResponse[[]*GetInflationReward]](c, ctx, "getInflationReward", stakeAccountAddrs, cfg)
+func (c *RpcClient) GetInflationRewardWithConfig(ctx context.Context, stakeAccountAddrs []string, cfg GetInflationRewardConfig, opts ...CallOption) (JsonRpcResponse[[]*GetInflationReward], error) {
+	return callWithConfig[JsonRpcResponse[[]*GetInflationReward]](c, ctx, cfg, opts, "getInflationReward", stakeAccountAddrs)
 }
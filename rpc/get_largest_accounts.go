@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"context"
+)
+
+type GetLargestAccountsResponse JsonRpcResponse[GetLargestAccounts]
+
+type GetLargestAccounts ValueWithContext[[]GetLargestAccountsResultValue]
+
+// GetLargestAccountsResultValue is a part of `getLargestAccounts` raw response
+type GetLargestAccountsResultValue struct {
+	Address  string `json:"address"`
+	Lamports uint64 `json:"lamports"`
+}
+
+type LargestAccountsFilter string
+
+const (
+	LargestAccountsFilterCirculating    LargestAccountsFilter = "circulating"
+	LargestAccountsFilterNonCirculating LargestAccountsFilter = "nonCirculating"
+)
+
+// GetLargestAccountsConfig is a option config for `getLargestAccounts`
+type GetLargestAccountsConfig struct {
+	Commitment Commitment            `json:"commitment,omitempty"`
+	Filter     LargestAccountsFilter `json:"filter,omitempty"`
+}
+
+// GetLargestAccounts returns the 20 largest accounts by lamport balance, results may be cached up to two hours
+func (c *RpcClient) GetLargestAccounts(ctx context.Context, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]]](c, ctx, nil, opts, "getLargestAccounts")
+}
+
+// GetLargestAccountsWithConfig returns the 20 largest accounts by lamport balance, results may be cached up to two hours
+func (c *RpcClient) GetLargestAccountsWithConfig(ctx context.Context, cfg GetLargestAccountsConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]]](c, ctx, cfg, opts, "getLargestAccounts")
+}
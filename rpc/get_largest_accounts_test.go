@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetLargestAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLargestAccounts"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":54},"value":[{"lamports":999974,"address":"99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ"},{"lamports":42,"address":"uPwWLo16MVehpyWqsLkK3Ka8nLowWvAHbBChqv2FZeL"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLargestAccounts(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[[]GetLargestAccountsResultValue]{
+						Context: Context{
+							Slot: 54,
+						},
+						Value: []GetLargestAccountsResultValue{
+							{Address: "99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ", Lamports: 999974},
+							{Address: "uPwWLo16MVehpyWqsLkK3Ka8nLowWvAHbBChqv2FZeL", Lamports: 42},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLargestAccounts", "params":[{"filter":"nonCirculating"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":54},"value":[{"lamports":999974,"address":"99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLargestAccountsWithConfig(
+						context.TODO(),
+						GetLargestAccountsConfig{
+							Filter: LargestAccountsFilterNonCirculating,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[[]GetLargestAccountsResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[[]GetLargestAccountsResultValue]{
+						Context: Context{
+							Slot: 54,
+						},
+						Value: []GetLargestAccountsResultValue{
+							{Address: "99P8ZgtJYe1buSK8JXkvpLh8xPsCFuLYhz9hQFNw93WJ", Lamports: 999974},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,35 @@
+package rpc
+
+import "context"
+
+type GetLeaderScheduleResponse JsonRpcResponse[GetLeaderSchedule]
+
+// GetLeaderSchedule maps a validator identity to its leader slot indices, relative to the first slot
+// of the epoch. It is nil if the epoch is not found.
+type GetLeaderSchedule map[string][]uint64
+
+// GetLeaderScheduleConfig is a option config for `getLeaderSchedule`
+type GetLeaderScheduleConfig struct {
+	Commitment Commitment `json:"commitment,omitempty"`
+	Identity   string     `json:"identity,omitempty"`
+}
+
+// GetLeaderSchedule returns the leader schedule of the current epoch
+func (c *RpcClient) GetLeaderSchedule(ctx context.Context, opts ...CallOption) (JsonRpcResponse[GetLeaderSchedule], error) {
+	return callWithConfig[JsonRpcResponse[GetLeaderSchedule]](c, ctx, nil, opts, "getLeaderSchedule")
+}
+
+// GetLeaderScheduleWithConfig returns the leader schedule of the current epoch
+func (c *RpcClient) GetLeaderScheduleWithConfig(ctx context.Context, cfg GetLeaderScheduleConfig, opts ...CallOption) (JsonRpcResponse[GetLeaderSchedule], error) {
+	return callWithConfig[JsonRpcResponse[GetLeaderSchedule]](c, ctx, cfg, opts, "getLeaderSchedule")
+}
+
+// GetLeaderScheduleForSlot returns the leader schedule of the epoch which contains the slot
+func (c *RpcClient) GetLeaderScheduleForSlot(ctx context.Context, slot uint64, opts ...CallOption) (JsonRpcResponse[GetLeaderSchedule], error) {
+	return callWithConfig[JsonRpcResponse[GetLeaderSchedule]](c, ctx, nil, opts, "getLeaderSchedule", slot)
+}
+
+// GetLeaderScheduleForSlotWithConfig returns the leader schedule of the epoch which contains the slot
+func (c *RpcClient) GetLeaderScheduleForSlotWithConfig(ctx context.Context, slot uint64, cfg GetLeaderScheduleConfig, opts ...CallOption) (JsonRpcResponse[GetLeaderSchedule], error) {
+	return callWithConfig[JsonRpcResponse[GetLeaderSchedule]](c, ctx, cfg, opts, "getLeaderSchedule", slot)
+}
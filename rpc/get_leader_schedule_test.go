@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetLeaderSchedule(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F":[0,1,2,3]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLeaderSchedule(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetLeaderSchedule]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetLeaderSchedule{
+						"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F": {0, 1, 2, 3},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule", "params":[{"commitment":"confirmed","identity":"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F":[0,1]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLeaderScheduleWithConfig(
+						context.TODO(),
+						GetLeaderScheduleConfig{
+							Commitment: CommitmentConfirmed,
+							Identity:   "4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F",
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetLeaderSchedule]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetLeaderSchedule{
+						"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F": {0, 1},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule", "params":[100000000000]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":null,"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLeaderScheduleForSlot(
+						context.TODO(),
+						100000000000,
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetLeaderSchedule]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result:  nil,
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLeaderSchedule", "params":[10, {"commitment":"finalized"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F":[0]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetLeaderScheduleForSlotWithConfig(
+						context.TODO(),
+						10,
+						GetLeaderScheduleConfig{
+							Commitment: CommitmentFinalized,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetLeaderSchedule]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetLeaderSchedule{
+						"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTP99F": {0},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,10 @@
+package rpc
+
+import "context"
+
+type GetMaxRetransmitSlotResponse JsonRpcResponse[uint64]
+
+// GetMaxRetransmitSlot returns the max slot seen from retransmit stage
+func (c *RpcClient) GetMaxRetransmitSlot(ctx context.Context) (JsonRpcResponse[uint64], error) {
+	return call[JsonRpcResponse[uint64]](c, ctx, "getMaxRetransmitSlot")
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetMaxRetransmitSlot(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMaxRetransmitSlot"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":1234,"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetMaxRetransmitSlot(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[uint64]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result:  1234,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
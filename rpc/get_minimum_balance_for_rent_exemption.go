@@ -11,11 +11,11 @@ type GetMinimumBalanceForRentExemptionConfig struct {
 }
 
 // GetMinimumBalanceForRentExemption returns minimum balance required to make account rent exempt
-func (c *RpcClient) GetMinimumBalanceForRentExemption(ctx context.Context, dataLen uint64) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getMinimumBalanceForRentExemption", dataLen)
+func (c *RpcClient) GetMinimumBalanceForRentExemption(ctx context.Context, dataLen uint64, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, nil, opts, "getMinimumBalanceForRentExemption", dataLen)
 }
 
 // GetMinimumBalanceForRentExemptionWithConfig returns minimum balance required to make account rent exempt
-func (c *RpcClient) GetMinimumBalanceForRentExemptionWithConfig(ctx context.Context, dataLen uint64, cfg GetMinimumBalanceForRentExemptionConfig) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getMinimumBalanceForRentExemption", dataLen, cfg)
+func (c *RpcClient) GetMinimumBalanceForRentExemptionWithConfig(ctx context.Context, dataLen uint64, cfg GetMinimumBalanceForRentExemptionConfig, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, cfg, opts, "getMinimumBalanceForRentExemption", dataLen)
 }
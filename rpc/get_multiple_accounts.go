@@ -4,6 +4,9 @@ import (
 	"context"
 )
 
+// MaxMultipleAccounts is the max number of accounts in a `getMultipleAccounts` request
+const MaxMultipleAccounts = 100
+
 type GetMultipleAccountsResponse JsonRpcResponse[GetMultipleAccounts]
 
 type GetMultipleAccounts ValueWithContext[[]AccountInfo]
@@ -19,11 +22,11 @@ type GetMultipleAccountsConfig struct {
 }
 
 // GetMultipleAccounts returns all information associated with the account of provided Pubkey
-func (c *RpcClient) GetMultipleAccounts(ctx context.Context, base58Addrs []string) (JsonRpcResponse[ValueWithContext[[]AccountInfo]], error) {
-	return call[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](c, ctx, "getMultipleAccounts", base58Addrs)
+func (c *RpcClient) GetMultipleAccounts(ctx context.Context, base58Addrs []string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]AccountInfo]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](c, ctx, nil, opts, "getMultipleAccounts", base58Addrs)
 }
 
 // GetMultipleAccounts returns all information associated with the account of provided Pubkey
-func (c *RpcClient) GetMultipleAccountsWithConfig(ctx context.Context, base58Addrs []string, cfg GetMultipleAccountsConfig) (JsonRpcResponse[ValueWithContext[[]AccountInfo]], error) {
-	return call[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](c, ctx, "getMultipleAccounts", base58Addrs, cfg)
+func (c *RpcClient) GetMultipleAccountsWithConfig(ctx context.Context, base58Addrs []string, cfg GetMultipleAccountsConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]AccountInfo]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](c, ctx, cfg, opts, "getMultipleAccounts", base58Addrs)
 }
@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+
+	"github.com/mr-tron/base58"
 )
 
 type GetProgramAccountsResponse JsonRpcResponse[GetProgramAccounts]
@@ -19,10 +21,11 @@ type GetProgramAccountsWithContext ValueWithContext[GetProgramAccounts]
 
 // GetProgramAccountsConfig is a option config for `getProgramAccounts`
 type GetProgramAccountsConfig struct {
-	Encoding   AccountEncoding                  `json:"encoding,omitempty"`
-	Commitment Commitment                       `json:"commitment,omitempty"`
-	DataSlice  *DataSlice                       `json:"dataSlice,omitempty"`
-	Filters    []GetProgramAccountsConfigFilter `json:"filters,omitempty"`
+	Encoding       AccountEncoding                  `json:"encoding,omitempty"`
+	Commitment     Commitment                       `json:"commitment,omitempty"`
+	DataSlice      *DataSlice                       `json:"dataSlice,omitempty"`
+	Filters        []GetProgramAccountsConfigFilter `json:"filters,omitempty"`
+	MinContextSlot *uint64                          `json:"minContextSlot,omitempty"`
 }
 
 type getProgramAccountsConfig struct {
@@ -39,22 +42,49 @@ type GetProgramAccountsConfigFilter struct {
 type GetProgramAccountsConfigFilterMemCmp struct {
 	Offset uint64 `json:"offset"`
 	Bytes  string `json:"bytes"`
+	// Encoding is the encoding of Bytes, default is base58
+	Encoding MemCmpEncoding `json:"encoding,omitempty"`
+}
+
+type MemCmpEncoding string
+
+const (
+	MemCmpEncodingBase58 MemCmpEncoding = "base58"
+	MemCmpEncodingBase64 MemCmpEncoding = "base64"
+)
+
+// NewMemCmpFilter matches accounts whose data at offset equals b.
+// e.g. token accounts of an owner: NewDataSizeFilter(165), NewMemCmpFilter(32, owner.Bytes())
+func NewMemCmpFilter(offset uint64, b []byte) GetProgramAccountsConfigFilter {
+	return GetProgramAccountsConfigFilter{
+		MemCmp: &GetProgramAccountsConfigFilterMemCmp{
+			Offset: offset,
+			Bytes:  base58.Encode(b),
+		},
+	}
+}
+
+// NewDataSizeFilter matches accounts whose data length equals size
+func NewDataSizeFilter(size uint64) GetProgramAccountsConfigFilter {
+	return GetProgramAccountsConfigFilter{
+		DataSize: size,
+	}
 }
 
-func (c *RpcClient) GetProgramAccounts(ctx context.Context, programId string) (JsonRpcResponse[GetProgramAccounts], error) {
-	return call[JsonRpcResponse[GetProgramAccounts]](c, ctx, "getProgramAccounts", programId)
+func (c *RpcClient) GetProgramAccounts(ctx context.Context, programId string, opts ...CallOption) (JsonRpcResponse[GetProgramAccounts], error) {
+	return callWithConfig[JsonRpcResponse[GetProgramAccounts]](c, ctx, nil, opts, "getProgramAccounts", programId)
 }
 
-func (c *RpcClient) GetProgramAccountsWithConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig) (JsonRpcResponse[GetProgramAccounts], error) {
-	return call[JsonRpcResponse[GetProgramAccounts]](c, ctx, "getProgramAccounts", programId, c.toInternalGetProgramAccountsConfig(cfg, false))
+func (c *RpcClient) GetProgramAccountsWithConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig, opts ...CallOption) (JsonRpcResponse[GetProgramAccounts], error) {
+	return callWithConfig[JsonRpcResponse[GetProgramAccounts]](c, ctx, c.toInternalGetProgramAccountsConfig(cfg, false), opts, "getProgramAccounts", programId)
 }
 
-func (c *RpcClient) GetProgramAccountsWithContext(ctx context.Context, programId string) (JsonRpcResponse[GetProgramAccountsWithContext], error) {
-	return call[JsonRpcResponse[GetProgramAccountsWithContext]](c, ctx, "getProgramAccounts", programId, c.toInternalGetProgramAccountsConfig(GetProgramAccountsConfig{}, true))
+func (c *RpcClient) GetProgramAccountsWithContext(ctx context.Context, programId string, opts ...CallOption) (JsonRpcResponse[GetProgramAccountsWithContext], error) {
+	return callWithConfig[JsonRpcResponse[GetProgramAccountsWithContext]](c, ctx, c.toInternalGetProgramAccountsConfig(GetProgramAccountsConfig{}, true), opts, "getProgramAccounts", programId)
 }
 
-func (c *RpcClient) GetProgramAccountsWithContextAndConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig) (JsonRpcResponse[GetProgramAccountsWithContext], error) {
-	return call[JsonRpcResponse[GetProgramAccountsWithContext]](c, ctx, "getProgramAccounts", programId, c.toInternalGetProgramAccountsConfig(cfg, true))
+func (c *RpcClient) GetProgramAccountsWithContextAndConfig(ctx context.Context, programId string, cfg GetProgramAccountsConfig, opts ...CallOption) (JsonRpcResponse[GetProgramAccountsWithContext], error) {
+	return callWithConfig[JsonRpcResponse[GetProgramAccountsWithContext]](c, ctx, c.toInternalGetProgramAccountsConfig(cfg, true), opts, "getProgramAccounts", programId)
 }
 
 func (c *RpcClient) toInternalGetProgramAccountsConfig(cfg GetProgramAccountsConfig, withContext bool) getProgramAccountsConfig {
@@ -0,0 +1,26 @@
+package rpc
+
+import "context"
+
+type GetRecentPerformanceSamplesResponse JsonRpcResponse[[]GetRecentPerformanceSample]
+
+// GetRecentPerformanceSample is a part of `getRecentPerformanceSamples` raw response
+type GetRecentPerformanceSample struct {
+	Slot                   uint64  `json:"slot"`
+	NumTransactions        uint64  `json:"numTransactions"`
+	NumNonVoteTransactions *uint64 `json:"numNonVoteTransactions"`
+	NumSlots               uint64  `json:"numSlots"`
+	SamplePeriodSecs       uint16  `json:"samplePeriodSecs"`
+}
+
+// GetRecentPerformanceSamples returns a list of recent performance samples, in reverse slot order.
+// Performance samples are taken every 60 seconds.
+func (c *RpcClient) GetRecentPerformanceSamples(ctx context.Context) (JsonRpcResponse[[]GetRecentPerformanceSample], error) {
+	return call[JsonRpcResponse[[]GetRecentPerformanceSample]](c, ctx, "getRecentPerformanceSamples")
+}
+
+// GetRecentPerformanceSamplesWithLimit returns at most limit recent performance samples, max limit is 720.
+// the method has no config object yet, opts are sent as one after limit.
+func (c *RpcClient) GetRecentPerformanceSamplesWithLimit(ctx context.Context, limit uint64, opts ...CallOption) (JsonRpcResponse[[]GetRecentPerformanceSample], error) {
+	return callWithConfig[JsonRpcResponse[[]GetRecentPerformanceSample]](c, ctx, nil, opts, "getRecentPerformanceSamples", limit)
+}
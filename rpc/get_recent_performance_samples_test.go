@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+)
+
+func TestGetRecentPerformanceSamples(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPerformanceSamples"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"numSlots":126,"numTransactions":126,"numNonVoteTransactions":1,"samplePeriodSecs":60,"slot":348125},{"numSlots":126,"numTransactions":126,"samplePeriodSecs":60,"slot":347999}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetRecentPerformanceSamples(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[[]GetRecentPerformanceSample]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: []GetRecentPerformanceSample{
+						{
+							Slot:                   348125,
+							NumTransactions:        126,
+							NumNonVoteTransactions: pointer.Get[uint64](1),
+							NumSlots:               126,
+							SamplePeriodSecs:       60,
+						},
+						{
+							Slot:             347999,
+							NumTransactions:  126,
+							NumSlots:         126,
+							SamplePeriodSecs: 60,
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPerformanceSamples", "params":[1]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"numSlots":126,"numTransactions":126,"numNonVoteTransactions":1,"samplePeriodSecs":60,"slot":348125}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetRecentPerformanceSamplesWithLimit(
+						context.TODO(),
+						1,
+					)
+				},
+				ExpectedValue: JsonRpcResponse[[]GetRecentPerformanceSample]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: []GetRecentPerformanceSample{
+						{
+							Slot:                   348125,
+							NumTransactions:        126,
+							NumNonVoteTransactions: pointer.Get[uint64](1),
+							NumSlots:               126,
+							SamplePeriodSecs:       60,
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,24 @@
+package rpc
+
+import (
+	"context"
+)
+
+type GetRecentPrioritizationFeesResponse JsonRpcResponse[GetRecentPrioritizationFees]
+
+type GetRecentPrioritizationFees []PrioritizationFee
+
+// PrioritizationFee is the min fee paid by a transaction which landed in the slot, in micro-lamports per compute unit
+type PrioritizationFee struct {
+	Slot              uint64 `json:"slot"`
+	PrioritizationFee uint64 `json:"prioritizationFee"`
+}
+
+// GetRecentPrioritizationFees returns the prioritization fees of recent slots. if addresses are given,
+// a fee is the min fee of the transactions which lock all of them as writable.
+func (c *RpcClient) GetRecentPrioritizationFees(ctx context.Context, addresses []string) (JsonRpcResponse[GetRecentPrioritizationFees], error) {
+	if len(addresses) == 0 {
+		return call[JsonRpcResponse[GetRecentPrioritizationFees]](c, ctx, "getRecentPrioritizationFees")
+	}
+	return call[JsonRpcResponse[GetRecentPrioritizationFees]](c, ctx, "getRecentPrioritizationFees", addresses)
+}
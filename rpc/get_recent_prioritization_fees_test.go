@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetRecentPrioritizationFees(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPrioritizationFees"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"prioritizationFee":0,"slot":348125},{"prioritizationFee":1000,"slot":348126}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetRecentPrioritizationFees(context.TODO(), nil)
+				},
+				ExpectedValue: JsonRpcResponse[GetRecentPrioritizationFees]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: GetRecentPrioritizationFees{
+						{Slot: 348125, PrioritizationFee: 0},
+						{Slot: 348126, PrioritizationFee: 1000},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getRecentPrioritizationFees", "params":[["CxELquR1gPP8wHe33gZ4QxqGB3sZ9RSwsJ2KshVewkFY"]]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"prioritizationFee":500,"slot":348125}],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetRecentPrioritizationFees(context.TODO(), []string{"CxELquR1gPP8wHe33gZ4QxqGB3sZ9RSwsJ2KshVewkFY"})
+				},
+				ExpectedValue: JsonRpcResponse[GetRecentPrioritizationFees]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: GetRecentPrioritizationFees{
+						{Slot: 348125, PrioritizationFee: 500},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
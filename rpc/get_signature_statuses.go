@@ -22,12 +22,13 @@ type GetSignatureStatusesConfig struct {
 	SearchTransactionHistory bool `json:"searchTransactionHistory,omitempty"`
 }
 
-// GetSignatureStatuses returns the SOL balance
-func (c *RpcClient) GetSignatureStatuses(ctx context.Context, signatures []string) (JsonRpcResponse[ValueWithContext[SignatureStatuses]], error) {
-	return call[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](c, ctx, "getSignatureStatuses", signatures)
+// GetSignatureStatuses returns the statuses of a list of signatures, the status is nil if the signature is not found.
+// Without SearchTransactionHistory the node only searches its recent status cache.
+func (c *RpcClient) GetSignatureStatuses(ctx context.Context, signatures []string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[SignatureStatuses]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](c, ctx, nil, opts, "getSignatureStatuses", signatures)
 }
 
-// GetSignatureStatusesWithConfig returns the SOL balance
-func (c *RpcClient) GetSignatureStatusesWithConfig(ctx context.Context, signatures []string, cfg GetSignatureStatusesConfig) (JsonRpcResponse[ValueWithContext[SignatureStatuses]], error) {
-	return call[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](c, ctx, "getSignatureStatuses", signatures, cfg)
+// GetSignatureStatusesWithConfig returns the statuses of a list of signatures, the status is nil if the signature is not found
+func (c *RpcClient) GetSignatureStatusesWithConfig(ctx context.Context, signatures []string, cfg GetSignatureStatusesConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[SignatureStatuses]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](c, ctx, cfg, opts, "getSignatureStatuses", signatures)
 }
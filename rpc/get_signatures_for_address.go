@@ -26,12 +26,12 @@ type GetSignaturesForAddressConfig struct {
 
 // GetSignaturesForAddress returns confirmed signatures for transactions involving an address backwards
 // in time from the provided signature or most recent confirmed block
-func (c *RpcClient) GetSignaturesForAddress(ctx context.Context, base58Addr string) (JsonRpcResponse[GetSignaturesForAddress], error) {
-	return call[JsonRpcResponse[GetSignaturesForAddress]](c, ctx, "getSignaturesForAddress", base58Addr)
+func (c *RpcClient) GetSignaturesForAddress(ctx context.Context, base58Addr string, opts ...CallOption) (JsonRpcResponse[GetSignaturesForAddress], error) {
+	return callWithConfig[JsonRpcResponse[GetSignaturesForAddress]](c, ctx, nil, opts, "getSignaturesForAddress", base58Addr)
 }
 
 // GetSignaturesForAddressWithConfig returns confirmed signatures for transactions involving an address backwards
 // in time from the provided signature or most recent confirmed block
-func (c *RpcClient) GetSignaturesForAddressWithConfig(ctx context.Context, base58Addr string, cfg GetSignaturesForAddressConfig) (JsonRpcResponse[GetSignaturesForAddress], error) {
-	return call[JsonRpcResponse[GetSignaturesForAddress]](c, ctx, "getSignaturesForAddress", base58Addr, cfg)
+func (c *RpcClient) GetSignaturesForAddressWithConfig(ctx context.Context, base58Addr string, cfg GetSignaturesForAddressConfig, opts ...CallOption) (JsonRpcResponse[GetSignaturesForAddress], error) {
+	return callWithConfig[JsonRpcResponse[GetSignaturesForAddress]](c, ctx, cfg, opts, "getSignaturesForAddress", base58Addr)
 }
@@ -12,11 +12,11 @@ type GetSlotConfig struct {
 }
 
 // GetSlot returns the SOL balance
-func (c *RpcClient) GetSlot(ctx context.Context) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getSlot")
+func (c *RpcClient) GetSlot(ctx context.Context, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, nil, opts, "getSlot")
 }
 
 // GetSlotWithConfig returns the SOL balance
-func (c *RpcClient) GetSlotWithConfig(ctx context.Context, cfg GetSlotConfig) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getSlot", cfg)
+func (c *RpcClient) GetSlotWithConfig(ctx context.Context, cfg GetSlotConfig, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, cfg, opts, "getSlot")
 }
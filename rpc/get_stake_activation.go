@@ -0,0 +1,39 @@
+package rpc
+
+import "context"
+
+type GetStakeActivationResponse JsonRpcResponse[GetStakeActivation]
+
+type StakeActivationState string
+
+const (
+	StakeActivationStateActive       StakeActivationState = "active"
+	StakeActivationStateInactive     StakeActivationState = "inactive"
+	StakeActivationStateActivating   StakeActivationState = "activating"
+	StakeActivationStateDeactivating StakeActivationState = "deactivating"
+)
+
+type GetStakeActivation struct {
+	State    StakeActivationState `json:"state"`
+	Active   uint64               `json:"active"`
+	Inactive uint64               `json:"inactive"`
+}
+
+// GetStakeActivationConfig is a option config for `getStakeActivation`
+type GetStakeActivationConfig struct {
+	Commitment Commitment `json:"commitment,omitempty"`
+	// Epoch default is the current epoch
+	Epoch *uint64 `json:"epoch,omitempty"`
+}
+
+// GetStakeActivation returns epoch activation information for a stake account.
+// The method is deprecated by the node since v1.18, and is not available after v2.0.
+func (c *RpcClient) GetStakeActivation(ctx context.Context, stakeAccountAddr string, opts ...CallOption) (JsonRpcResponse[GetStakeActivation], error) {
+	return callWithConfig[JsonRpcResponse[GetStakeActivation]](c, ctx, nil, opts, "getStakeActivation", stakeAccountAddr)
+}
+
+// GetStakeActivationWithConfig returns epoch activation information for a stake account.
+// The method is deprecated by the node since v1.18, and is not available after v2.0.
+func (c *RpcClient) GetStakeActivationWithConfig(ctx context.Context, stakeAccountAddr string, cfg GetStakeActivationConfig, opts ...CallOption) (JsonRpcResponse[GetStakeActivation], error) {
+	return callWithConfig[JsonRpcResponse[GetStakeActivation]](c, ctx, cfg, opts, "getStakeActivation", stakeAccountAddr)
+}
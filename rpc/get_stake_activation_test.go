@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
+)
+
+func TestGetStakeActivation(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeActivation", "params":["CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"active":197717120,"inactive":0,"state":"active"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetStakeActivation(
+						context.TODO(),
+						"CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT",
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetStakeActivation]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetStakeActivation{
+						State:    StakeActivationStateActive,
+						Active:   197717120,
+						Inactive: 0,
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeActivation", "params":["CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT", {"epoch":0}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"active":124429280,"inactive":73287840,"state":"activating"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetStakeActivationWithConfig(
+						context.TODO(),
+						"CYRJWqiSjLitBAcRxPvWpgX3s5TvmN2SuRY3eEYypFvT",
+						GetStakeActivationConfig{
+							Epoch: pointer.Get[uint64](0),
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[GetStakeActivation]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: GetStakeActivation{
+						State:    StakeActivationStateActivating,
+						Active:   124429280,
+						Inactive: 73287840,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
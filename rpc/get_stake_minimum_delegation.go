@@ -0,0 +1,22 @@
+package rpc
+
+import "context"
+
+type GetStakeMinimumDelegationResponse JsonRpcResponse[GetStakeMinimumDelegation]
+
+type GetStakeMinimumDelegation ValueWithContext[uint64]
+
+// GetStakeMinimumDelegationConfig is a option config for `getStakeMinimumDelegation`
+type GetStakeMinimumDelegationConfig struct {
+	Commitment Commitment `json:"commitment,omitempty"`
+}
+
+// GetStakeMinimumDelegation returns the stake minimum delegation, in lamports
+func (c *RpcClient) GetStakeMinimumDelegation(ctx context.Context, opts ...CallOption) (JsonRpcResponse[ValueWithContext[uint64]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[uint64]]](c, ctx, nil, opts, "getStakeMinimumDelegation")
+}
+
+// GetStakeMinimumDelegationWithConfig returns the stake minimum delegation, in lamports
+func (c *RpcClient) GetStakeMinimumDelegationWithConfig(ctx context.Context, cfg GetStakeMinimumDelegationConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[uint64]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[uint64]]](c, ctx, cfg, opts, "getStakeMinimumDelegation")
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetStakeMinimumDelegation(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeMinimumDelegation"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":501},"value":1000000000},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetStakeMinimumDelegation(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[uint64]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[uint64]{
+						Context: Context{
+							Slot: 501,
+						},
+						Value: 1000000000,
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getStakeMinimumDelegation", "params":[{"commitment":"confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":501},"value":1},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetStakeMinimumDelegationWithConfig(
+						context.TODO(),
+						GetStakeMinimumDelegationConfig{
+							Commitment: CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[uint64]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[uint64]{
+						Context: Context{
+							Slot: 501,
+						},
+						Value: 1,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
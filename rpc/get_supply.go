@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+)
+
+type GetSupplyResponse JsonRpcResponse[GetSupply]
+
+type GetSupply ValueWithContext[GetSupplyResultValue]
+
+// GetSupplyResultValue is a part of `getSupply` raw response
+type GetSupplyResultValue struct {
+	Total                  uint64   `json:"total"`
+	Circulating            uint64   `json:"circulating"`
+	NonCirculating         uint64   `json:"nonCirculating"`
+	NonCirculatingAccounts []string `json:"nonCirculatingAccounts"`
+}
+
+// GetSupplyConfig is a option config for `getSupply`
+type GetSupplyConfig struct {
+	Commitment                        Commitment `json:"commitment,omitempty"`
+	ExcludeNonCirculatingAccountsList bool       `json:"excludeNonCirculatingAccountsList,omitempty"`
+}
+
+// GetSupply returns information about the current supply
+func (c *RpcClient) GetSupply(ctx context.Context, opts ...CallOption) (JsonRpcResponse[ValueWithContext[GetSupplyResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[GetSupplyResultValue]]](c, ctx, nil, opts, "getSupply")
+}
+
+// GetSupplyWithConfig returns information about the current supply
+func (c *RpcClient) GetSupplyWithConfig(ctx context.Context, cfg GetSupplyConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[GetSupplyResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[GetSupplyResultValue]]](c, ctx, cfg, opts, "getSupply")
+}
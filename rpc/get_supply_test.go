@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetSupply(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSupply"}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":1114},"value":{"circulating":16000,"nonCirculating":1000000,"nonCirculatingAccounts":["FEy8pTbP5fEoqMV1GdTz83byuJ8EbYCNYg6gj8RmKhRx","9huDUZfxoJ7wGMTffUE7vh1xePqef7gyrLJu9NApncqA"],"total":1016000}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetSupply(
+						context.TODO(),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[GetSupplyResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[GetSupplyResultValue]{
+						Context: Context{
+							Slot: 1114,
+						},
+						Value: GetSupplyResultValue{
+							Total:          1016000,
+							Circulating:    16000,
+							NonCirculating: 1000000,
+							NonCirculatingAccounts: []string{
+								"FEy8pTbP5fEoqMV1GdTz83byuJ8EbYCNYg6gj8RmKhRx",
+								"9huDUZfxoJ7wGMTffUE7vh1xePqef7gyrLJu9NApncqA",
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSupply", "params":[{"commitment":"confirmed","excludeNonCirculatingAccountsList":true}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":1114},"value":{"circulating":16000,"nonCirculating":1000000,"nonCirculatingAccounts":[],"total":1016000}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetSupplyWithConfig(
+						context.TODO(),
+						GetSupplyConfig{
+							Commitment:                        CommitmentConfirmed,
+							ExcludeNonCirculatingAccountsList: true,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[GetSupplyResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[GetSupplyResultValue]{
+						Context: Context{
+							Slot: 1114,
+						},
+						Value: GetSupplyResultValue{
+							Total:                  1016000,
+							Circulating:            16000,
+							NonCirculating:         1000000,
+							NonCirculatingAccounts: []string{},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
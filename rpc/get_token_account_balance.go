@@ -11,11 +11,11 @@ type GetTokenAccountBalanceConfig struct {
 }
 
 // GetTokenAccountBalance returns the token balance of an SPL Token account
-func (c *RpcClient) GetTokenAccountBalance(ctx context.Context, base58Addr string) (JsonRpcResponse[ValueWithContext[TokenAccountBalance]], error) {
-	return call[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](c, ctx, "getTokenAccountBalance", base58Addr)
+func (c *RpcClient) GetTokenAccountBalance(ctx context.Context, base58Addr string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[TokenAccountBalance]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](c, ctx, nil, opts, "getTokenAccountBalance", base58Addr)
 }
 
 // GetTokenAccountBalance returns the token balance of an SPL Token account
-func (c *RpcClient) GetTokenAccountBalanceWithConfig(ctx context.Context, base58Addr string, cfg GetTokenAccountBalanceConfig) (JsonRpcResponse[ValueWithContext[TokenAccountBalance]], error) {
-	return call[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](c, ctx, "getTokenAccountBalance", base58Addr, cfg)
+func (c *RpcClient) GetTokenAccountBalanceWithConfig(ctx context.Context, base58Addr string, cfg GetTokenAccountBalanceConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[TokenAccountBalance]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](c, ctx, cfg, opts, "getTokenAccountBalance", base58Addr)
 }
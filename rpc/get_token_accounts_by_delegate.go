@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+)
+
+type GetTokenAccountsByDelegateResponse JsonRpcResponse[GetTokenAccountsByDelegate]
+
+type GetTokenAccountsByDelegate ValueWithContext[GetProgramAccounts]
+
+// GetTokenAccountsByDelegateConfig is a option config for `getTokenAccountsByDelegate`
+type GetTokenAccountsByDelegateConfig struct {
+	Commitment Commitment      `json:"commitment,omitempty"`
+	Encoding   AccountEncoding `json:"encoding,omitempty"`
+	DataSlice  *DataSlice      `json:"dataSlice,omitempty"`
+}
+
+// GetTokenAccountsByDelegateConfigFilter either mint or programId
+type GetTokenAccountsByDelegateConfigFilter struct {
+	Mint      string `json:"mint,omitempty"`
+	ProgramId string `json:"programId,omitempty"`
+}
+
+// GetTokenAccountsByDelegate returns all token accounts approved to the delegate
+func (c *RpcClient) GetTokenAccountsByDelegate(ctx context.Context, base58Addr string, filter GetTokenAccountsByDelegateConfigFilter, opts ...CallOption) (JsonRpcResponse[ValueWithContext[GetProgramAccounts]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[GetProgramAccounts]]](c, ctx, nil, opts, "getTokenAccountsByDelegate", base58Addr, filter)
+}
+
+// GetTokenAccountsByDelegateWithConfig returns all token accounts approved to the delegate
+func (c *RpcClient) GetTokenAccountsByDelegateWithConfig(ctx context.Context, base58Addr string, filter GetTokenAccountsByDelegateConfigFilter, cfg GetTokenAccountsByDelegateConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[GetProgramAccounts]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[GetProgramAccounts]]](c, ctx, cfg, opts, "getTokenAccountsByDelegate", base58Addr, filter)
+}
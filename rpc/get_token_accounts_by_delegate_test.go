@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetTokenAccountsByDelegate(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenAccountsByDelegate", "params":["27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ", {"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":88024144},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetTokenAccountsByDelegate(
+						context.TODO(),
+						"27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ",
+						GetTokenAccountsByDelegateConfigFilter{
+							ProgramId: "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[GetProgramAccounts]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[GetProgramAccounts]{
+						Context: Context{
+							Slot: 88024144,
+						},
+						Value: GetProgramAccounts{},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenAccountsByDelegate", "params":["27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ", {"mint": "4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3"}, {"encoding": "base64", "commitment": "confirmed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":88024144},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetTokenAccountsByDelegateWithConfig(
+						context.TODO(),
+						"27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ",
+						GetTokenAccountsByDelegateConfigFilter{
+							Mint: "4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3",
+						},
+						GetTokenAccountsByDelegateConfig{
+							Encoding:   AccountEncodingBase64,
+							Commitment: CommitmentConfirmed,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[GetProgramAccounts]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[GetProgramAccounts]{
+						Context: Context{
+							Slot: 88024144,
+						},
+						Value: GetProgramAccounts{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
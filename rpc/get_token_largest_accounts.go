@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+)
+
+type GetTokenLargestAccountsResponse JsonRpcResponse[GetTokenLargestAccounts]
+
+type GetTokenLargestAccounts ValueWithContext[[]GetTokenLargestAccountsResultValue]
+
+// GetTokenLargestAccountsResultValue is a part of `getTokenLargestAccounts` raw response
+type GetTokenLargestAccountsResultValue struct {
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+	Decimals       uint8  `json:"decimals"`
+	UIAmountString string `json:"uiAmountString"`
+}
+
+// GetTokenLargestAccountsConfig is option config of `getTokenLargestAccounts`
+type GetTokenLargestAccountsConfig struct {
+	Commitment Commitment `json:"commitment,omitempty"`
+}
+
+// GetTokenLargestAccounts returns the 20 largest accounts of a particular SPL Token type
+func (c *RpcClient) GetTokenLargestAccounts(ctx context.Context, mintAddr string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]]](c, ctx, nil, opts, "getTokenLargestAccounts", mintAddr)
+}
+
+// GetTokenLargestAccountsWithConfig returns the 20 largest accounts of a particular SPL Token type
+func (c *RpcClient) GetTokenLargestAccountsWithConfig(ctx context.Context, mintAddr string, cfg GetTokenLargestAccountsConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]]](c, ctx, cfg, opts, "getTokenLargestAccounts", mintAddr)
+}
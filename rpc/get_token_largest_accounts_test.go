@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/internal/client_test"
+)
+
+func TestGetTokenLargestAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenLargestAccounts", "params":["4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":85609218},"value":[{"address":"FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH","amount":"771","decimals":2,"uiAmount":7.71,"uiAmountString":"7.71"},{"address":"BnsywxTcaYeNUtzrPxQUvzAWxfzZe3ZLUJ4wMMuLESnu","amount":"229","decimals":2,"uiAmount":2.29,"uiAmountString":"2.29"}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetTokenLargestAccounts(
+						context.TODO(),
+						"4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3",
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[[]GetTokenLargestAccountsResultValue]{
+						Context: Context{
+							Slot: 85609218,
+						},
+						Value: []GetTokenLargestAccountsResultValue{
+							{
+								Address:        "FrXc3Ux7nQG5gk5mJLNBfjkeVLGGNcGhBU7ELH8SoQzH",
+								Amount:         "771",
+								Decimals:       2,
+								UIAmountString: "7.71",
+							},
+							{
+								Address:        "BnsywxTcaYeNUtzrPxQUvzAWxfzZe3ZLUJ4wMMuLESnu",
+								Amount:         "229",
+								Decimals:       2,
+								UIAmountString: "2.29",
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenLargestAccounts", "params":["4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3", {"commitment":"processed"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":85609258},"value":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetTokenLargestAccountsWithConfig(
+						context.TODO(),
+						"4UyUTBdhPkFiu7ZE8zfxnE6hbbzf8LKo1uR5wSi5MYE3",
+						GetTokenLargestAccountsConfig{
+							Commitment: CommitmentProcessed,
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[[]GetTokenLargestAccountsResultValue]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[[]GetTokenLargestAccountsResultValue]{
+						Context: Context{
+							Slot: 85609258,
+						},
+						Value: []GetTokenLargestAccountsResultValue{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -66,11 +66,11 @@ type GetTransactionConfig struct {
 }
 
 // GetTransaction returns transaction details for a confirmed transaction
-func (c *RpcClient) GetTransaction(ctx context.Context, txhash string) (JsonRpcResponse[*GetTransaction], error) {
-	return call[JsonRpcResponse[*GetTransaction]](c, ctx, "getTransaction", txhash)
+func (c *RpcClient) GetTransaction(ctx context.Context, txhash string, opts ...CallOption) (JsonRpcResponse[*GetTransaction], error) {
+	return callWithConfig[JsonRpcResponse[*GetTransaction]](c, ctx, nil, opts, "getTransaction", txhash)
 }
 
 // GetTransactionWithConfig returns transaction details for a confirmed transaction
-func (c *RpcClient) GetTransactionWithConfig(ctx context.Context, txhash string, cfg GetTransactionConfig) (JsonRpcResponse[*GetTransaction], error) {
-	return call[JsonRpcResponse[*GetTransaction]](c, ctx, "getTransaction", txhash, cfg)
+func (c *RpcClient) GetTransactionWithConfig(ctx context.Context, txhash string, cfg GetTransactionConfig, opts ...CallOption) (JsonRpcResponse[*GetTransaction], error) {
+	return callWithConfig[JsonRpcResponse[*GetTransaction]](c, ctx, cfg, opts, "getTransaction", txhash)
 }
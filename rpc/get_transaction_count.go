@@ -12,11 +12,11 @@ type GetTransactionCountConfig struct {
 }
 
 // GetTransactionCount returns the current Transaction count from the ledger
-func (c *RpcClient) GetTransactionCount(ctx context.Context) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getTransactionCount")
+func (c *RpcClient) GetTransactionCount(ctx context.Context, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, nil, opts, "getTransactionCount")
 }
 
 // GetTransactionCountWithConfig returns the current Transaction count from the ledger
-func (c *RpcClient) GetTransactionCountWithConfig(ctx context.Context, cfg GetTransactionCountConfig) (JsonRpcResponse[uint64], error) {
-	return call[JsonRpcResponse[uint64]](c, ctx, "getTransactionCount", cfg)
+func (c *RpcClient) GetTransactionCountWithConfig(ctx context.Context, cfg GetTransactionCountConfig, opts ...CallOption) (JsonRpcResponse[uint64], error) {
+	return callWithConfig[JsonRpcResponse[uint64]](c, ctx, cfg, opts, "getTransactionCount")
 }
@@ -30,11 +30,11 @@ type GetVoteAccountsConfig struct {
 }
 
 // GetVoteAccounts returns the account info and associated stake for all the voting accounts in the current bank.
-func (c *RpcClient) GetVoteAccounts(ctx context.Context) (JsonRpcResponse[GetVoteAccounts], error) {
-	return call[JsonRpcResponse[GetVoteAccounts]](c, ctx, "getVoteAccounts")
+func (c *RpcClient) GetVoteAccounts(ctx context.Context, opts ...CallOption) (JsonRpcResponse[GetVoteAccounts], error) {
+	return callWithConfig[JsonRpcResponse[GetVoteAccounts]](c, ctx, nil, opts, "getVoteAccounts")
 }
 
 // GetVoteAccountsWithConfig returns the account info and associated stake for all the voting accounts in the current bank.
-func (c *RpcClient) GetVoteAccountsWithConfig(ctx context.Context, cfg GetVoteAccountsConfig) (JsonRpcResponse[GetVoteAccounts], error) {
-	return call[JsonRpcResponse[GetVoteAccounts]](c, ctx, "getVoteAccounts", cfg)
+func (c *RpcClient) GetVoteAccountsWithConfig(ctx context.Context, cfg GetVoteAccountsConfig, opts ...CallOption) (JsonRpcResponse[GetVoteAccounts], error) {
+	return callWithConfig[JsonRpcResponse[GetVoteAccounts]](c, ctx, cfg, opts, "getVoteAccounts")
 }
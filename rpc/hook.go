@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// CallInfo describes a finished rpc call
+type CallInfo struct {
+	Method string
+	// Params are the params of the method, the config object is included if any
+	Params  []any
+	Latency time.Duration
+	// Err is the error of the call, a *JsonRpcError if the node returned an error object
+	Err error
+}
+
+// CallHook receives every rpc call after it finished, e.g. to log slow or failing calls.
+// it runs synchronously, a slow hook slows down the caller.
+type CallHook interface {
+	AfterCall(ctx context.Context, info CallInfo)
+}
+
+// CallHookFunc is a function that implements CallHook
+type CallHookFunc func(ctx context.Context, info CallInfo)
+
+func (f CallHookFunc) AfterCall(ctx context.Context, info CallInfo) {
+	f(ctx, info)
+}
+
+// WithCallHook is an Option that appends hooks which receive every rpc call, calls of a batch
+// are reported one by one with the latency of the whole batch.
+func WithCallHook(hooks ...CallHook) Option {
+	return func(r *RpcClient) {
+		r.hooks = append(r.hooks[:len(r.hooks):len(r.hooks)], hooks...)
+	}
+}
+
+func (c *RpcClient) runHooks(ctx context.Context, method string, params []any, latency time.Duration, body []byte, err error) {
+	if len(c.hooks) == 0 {
+		return
+	}
+	if err == nil {
+		var res struct {
+			Error *JsonRpcError `json:"error"`
+		}
+		if json.Unmarshal(body, &res) == nil && res.Error != nil {
+			err = res.Error
+		}
+	}
+	info := CallInfo{
+		Method:  method,
+		Params:  params,
+		Latency: latency,
+		Err:     err,
+	}
+	for _, hook := range c.hooks {
+		hook.AfterCall(ctx, info)
+	}
+}
+
+func (c *RpcClient) runBatchHooks(ctx context.Context, payload []JsonRpcRequest, output []JsonRpcResponse[json.RawMessage], latency time.Duration, err error) {
+	if len(c.hooks) == 0 {
+		return
+	}
+	for i, request := range payload {
+		info := CallInfo{
+			Method:  request.Method,
+			Params:  request.Params,
+			Latency: latency,
+			Err:     err,
+		}
+		if err == nil && output[i].Error != nil {
+			info.Err = output[i].Error
+		}
+		for _, hook := range c.hooks {
+			hook.AfterCall(ctx, info)
+		}
+	}
+}
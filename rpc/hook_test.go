@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallHook(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		responseBody string
+		call         func(c RpcClient) error
+		expected     []CallInfo
+	}{
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"jsonrpc":"2.0","result":100,"id":1}`,
+			call: func(c RpcClient) error {
+				_, err := c.GetSlot(context.Background(), WithCommitment(CommitmentConfirmed))
+				return err
+			},
+			expected: []CallInfo{
+				{Method: "getSlot", Params: []any{map[string]any{"commitment": CommitmentConfirmed}}},
+			},
+		},
+		{
+			name:         "json rpc error",
+			statusCode:   http.StatusOK,
+			responseBody: `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param"},"id":1}`,
+			call: func(c RpcClient) error {
+				_, err := c.GetBalance(context.Background(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+				return err
+			},
+			expected: []CallInfo{
+				{
+					Method: "getBalance",
+					Params: []any{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},
+					Err:    &JsonRpcError{Code: -32602, Message: "Invalid param"},
+				},
+			},
+		},
+		{
+			name:         "http error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `forbidden`,
+			call: func(c RpcClient) error {
+				_, err := c.GetSlot(context.Background())
+				return err
+			},
+			expected: []CallInfo{
+				{Method: "getSlot", Params: []any{}, Err: &statusError{code: http.StatusForbidden}},
+			},
+		},
+		{
+			name:         "batch",
+			statusCode:   http.StatusOK,
+			responseBody: `[{"jsonrpc":"2.0","result":100,"id":1},{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param"},"id":2}]`,
+			call: func(c RpcClient) error {
+				_, err := c.CallBatch(context.Background(), []BatchRequest{
+					NewBatchRequest("getSlot"),
+					NewBatchRequest("getBalance", "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+				})
+				return err
+			},
+			expected: []CallInfo{
+				{Method: "getSlot"},
+				{
+					Method: "getBalance",
+					Params: []any{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},
+					Err:    &JsonRpcError{Code: -32602, Message: "Invalid param"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(tt.statusCode)
+				_, _ = rw.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			var got []CallInfo
+			c := New(
+				WithEndpoint(server.URL),
+				WithCallHook(CallHookFunc(func(ctx context.Context, info CallInfo) {
+					assert.Greater(t, info.Latency, time.Duration(0))
+					info.Latency = 0
+					got = append(got, info)
+				})),
+			)
+			_ = tt.call(c)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -8,17 +8,17 @@ type IsBlockhashValidResponse JsonRpcResponse[IsBlockhashValid]
 
 type IsBlockhashValid ValueWithContext[bool]
 
-// IsBlockhashValidConfig is a option config for `IsBlockhashValid`
+// IsBlockhashValidConfig is a option config for `isBlockhashValid`
 type IsBlockhashValidConfig struct {
 	Commitment Commitment `json:"commitment,omitempty"`
 }
 
-// IsBlockhashValid get the fee the network will charge for a particular Message
-func (c *RpcClient) IsBlockhashValid(ctx context.Context, message string) (JsonRpcResponse[ValueWithContext[bool]], error) {
-	return call[JsonRpcResponse[ValueWithContext[bool]]](c, ctx, "isBlockhashValid", message)
+// IsBlockhashValid returns whether a blockhash is still valid or not
+func (c *RpcClient) IsBlockhashValid(ctx context.Context, blockhash string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[bool]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[bool]]](c, ctx, nil, opts, "isBlockhashValid", blockhash)
 }
 
-// IsBlockhashValidWithConfig get the fee the network will charge for a particular Message
-func (c *RpcClient) IsBlockhashValidWithConfig(ctx context.Context, message string, cfg IsBlockhashValidConfig) (JsonRpcResponse[ValueWithContext[bool]], error) {
-	return call[JsonRpcResponse[ValueWithContext[bool]]](c, ctx, "isBlockhashValid", message, cfg)
+// IsBlockhashValidWithConfig returns whether a blockhash is still valid or not
+func (c *RpcClient) IsBlockhashValidWithConfig(ctx context.Context, blockhash string, cfg IsBlockhashValidConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[bool]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[bool]]](c, ctx, cfg, opts, "isBlockhashValid", blockhash)
 }
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestHandler sends a http request to the rpc node
+type RequestHandler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the handler of every http request, e.g. to inject an api key or to record metrics.
+// a middleware can change the request, return its own response or error without calling next.
+type Middleware func(next RequestHandler) RequestHandler
+
+// WithMiddleware is an Option that appends middlewares, the first one is the outermost.
+// a request retried by the retry policy goes through the middlewares again.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(r *RpcClient) {
+		r.middlewares = append(r.middlewares[:len(r.middlewares):len(r.middlewares)], middlewares...)
+	}
+}
+
+// WithHeader is an Option that sets a header of every request, e.g. the api key of a rpc provider
+func WithHeader(key, value string) Option {
+	return WithMiddleware(func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(key, value)
+			return next(req)
+		}
+	})
+}
+
+type methodContextKey struct{}
+
+// MethodFromContext returns the rpc method of a request, it is for middlewares to read from req.Context().
+// it is empty for batch requests.
+func MethodFromContext(ctx context.Context) string {
+	method, _ := ctx.Value(methodContextKey{}).(string)
+	return method
+}
+
+func (c *RpcClient) handler() RequestHandler {
+	h := c.httpClient.Do
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h
+}
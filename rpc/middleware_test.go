@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	var statusCodes = []int{http.StatusTooManyRequests, http.StatusOK}
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "secret", req.Header.Get("x-api-key"))
+		n := atomic.AddInt32(&calls, 1)
+		rw.WriteHeader(statusCodes[n-1])
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":100,"id":1}`))
+	}))
+	defer server.Close()
+
+	var trace []string
+	record := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(req *http.Request) (*http.Response, error) {
+				trace = append(trace, name+" "+MethodFromContext(req.Context()))
+				res, err := next(req)
+				trace = append(trace, name+" done")
+				return res, err
+			}
+		}
+	}
+
+	c := New(
+		WithEndpoint(server.URL),
+		WithRetry(RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}),
+		WithHeader("x-api-key", "secret"),
+		WithMiddleware(record("a"), record("b")),
+	)
+	res, err := c.GetSlot(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), res.Result)
+	// every attempt goes through the middlewares, the first one is the outermost
+	assert.Equal(t, []string{
+		"a getSlot", "b getSlot", "b done", "a done",
+		"a getSlot", "b getSlot", "b done", "a done",
+	}, trace)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	c := New(
+		WithEndpoint(server.URL),
+		WithMiddleware(func(next RequestHandler) RequestHandler {
+			return func(req *http.Request) (*http.Response, error) {
+				return nil, errors.New("blocked")
+			}
+		}),
+	)
+	_, err := c.GetSlot(context.Background())
+	assert.EqualError(t, err, "rpc: call error, err: failed to do request, err: blocked, body: ")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
@@ -7,7 +7,7 @@ import (
 // Option is a configuration type for the Client
 type Option func(*RpcClient)
 
-// HTTPClient is an Option type that allows you provide your own HTTP client
+// WithHTTPClient is an Option that allows you provide your own HTTP client, e.g. one with a proxy or a timeout
 func WithHTTPClient(h *http.Client) Option {
 	return func(r *RpcClient) {
 		r.httpClient = h
@@ -22,6 +22,14 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithDefaultCommitment is an Option that sets the commitment of every method which accepts one,
+// unless the commitment is set by its config or a CallOption.
+func WithDefaultCommitment(commitment Commitment) Option {
+	return func(r *RpcClient) {
+		r.commitment = commitment
+	}
+}
+
 func setDefaultOptions(r *RpcClient) {
 	r.httpClient = &http.Client{}
 	r.endpoint = MainnetRPCEndpoint
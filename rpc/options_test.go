@@ -27,3 +27,10 @@ func TestOption_WithEndpoint(t *testing.T) {
 
 	require.Equal(t, endpoint, c.endpoint)
 }
+
+func TestOption_WithDefaultCommitment(t *testing.T) {
+
+	c := New(WithDefaultCommitment(CommitmentConfirmed))
+
+	require.Equal(t, CommitmentConfirmed, c.DefaultCommitment())
+}
@@ -12,11 +12,11 @@ type RequestAirdropConfig struct {
 }
 
 // RequestAirdrop requests an airdrop of lamports to a Pubkey
-func (c *RpcClient) RequestAirdrop(ctx context.Context, base58Addr string, lamports uint64) (JsonRpcResponse[string], error) {
-	return call[JsonRpcResponse[string]](c, ctx, "requestAirdrop", base58Addr, lamports)
+func (c *RpcClient) RequestAirdrop(ctx context.Context, base58Addr string, lamports uint64, opts ...CallOption) (JsonRpcResponse[string], error) {
+	return callWithConfig[JsonRpcResponse[string]](c, ctx, nil, opts, "requestAirdrop", base58Addr, lamports)
 }
 
 // RequestAirdropWithConfig requests an airdrop of lamports to a Pubkey
-func (c *RpcClient) RequestAirdropWithConfig(ctx context.Context, base58Addr string, lamports uint64, cfg RequestAirdropConfig) (JsonRpcResponse[string], error) {
-	return call[JsonRpcResponse[string]](c, ctx, "requestAirdrop", base58Addr, lamports, cfg)
+func (c *RpcClient) RequestAirdropWithConfig(ctx context.Context, base58Addr string, lamports uint64, cfg RequestAirdropConfig, opts ...CallOption) (JsonRpcResponse[string], error) {
+	return callWithConfig[JsonRpcResponse[string]](c, ctx, cfg, opts, "requestAirdrop", base58Addr, lamports)
 }
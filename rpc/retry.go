@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 10 * time.Second
+)
+
+// RetryPolicy controls how a failed http request is retried. a request is retried on network errors,
+// http 429 and http 5xx. the Retry-After header of the response is honored up to MaxBackoff, otherwise it waits
+// an exponential backoff with jitter.
+type RetryPolicy struct {
+	// MaxRetries is the max number of retries, 0 means no retry
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, default is 500ms
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries, default is 10s
+	MaxBackoff time.Duration
+}
+
+// WithRetry is an Option that retries requests of all methods by policy
+func WithRetry(policy RetryPolicy) Option {
+	return func(r *RpcClient) {
+		r.retry = policy
+	}
+}
+
+// WithMethodRetry is an Option that overrides the retry policy of a method,
+// e.g. WithMethodRetry("sendTransaction", RetryPolicy{}) disables retry of sendTransaction
+func WithMethodRetry(method string, policy RetryPolicy) Option {
+	return func(r *RpcClient) {
+		if r.methodRetry == nil {
+			r.methodRetry = map[string]RetryPolicy{}
+		}
+		r.methodRetry[method] = policy
+	}
+}
+
+func (c *RpcClient) retryPolicy(method string) RetryPolicy {
+	if policy, ok := c.methodRetry[method]; ok {
+		return policy
+	}
+	return c.retry
+}
+
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// backoff returns the wait before the retry after attempt, which is a random duration in [d/2, d]
+// where d = InitialBackoff * 2^attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial, max := p.InitialBackoff, p.maxBackoff()
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+	d := initial
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// requestError is an error before a response is received
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("failed to do request, err: %v", e.err)
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// statusError is a response with a non 2xx status code
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("get status code: %v", e.code)
+}
+
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch e := err.(type) {
+	case *requestError:
+		return true
+	case *statusError:
+		return e.code == http.StatusTooManyRequests || e.code >= 500
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header which is either seconds or a http date.
+// the wait is capped by max, so a server can't stall the client for long.
+func parseRetryAfter(s string, now time.Time, max time.Duration) time.Duration {
+	var d time.Duration
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		// don't overflow on a huge number of seconds
+		if seconds > int64(max/time.Second) {
+			return max
+		}
+		d = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(s); err == nil && t.After(now) {
+		d = t.Sub(now)
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
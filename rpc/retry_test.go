@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []Option
+		statusCodes  []int
+		expectedErr  bool
+		expectedCall int32
+	}{
+		{
+			name:         "no retry by default",
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusOK},
+			expectedErr:  true,
+			expectedCall: 1,
+		},
+		{
+			name:         "retry 429 and 5xx",
+			opts:         []Option{WithRetry(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})},
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusOK},
+			expectedErr:  false,
+			expectedCall: 3,
+		},
+		{
+			name:         "give up after max retries",
+			opts:         []Option{WithRetry(RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond})},
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			expectedErr:  true,
+			expectedCall: 3,
+		},
+		{
+			name:         "not retry 4xx",
+			opts:         []Option{WithRetry(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})},
+			statusCodes:  []int{http.StatusBadRequest, http.StatusOK},
+			expectedErr:  true,
+			expectedCall: 1,
+		},
+		{
+			name: "method policy",
+			opts: []Option{
+				WithRetry(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond}),
+				WithMethodRetry("getSlot", RetryPolicy{}),
+			},
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusOK},
+			expectedErr:  true,
+			expectedCall: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				n := atomic.AddInt32(&calls, 1)
+				rw.WriteHeader(tt.statusCodes[n-1])
+				_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":100,"id":1}`))
+			}))
+			defer server.Close()
+
+			c := New(append([]Option{WithEndpoint(server.URL)}, tt.opts...)...)
+			_, err := c.GetSlot(context.Background())
+			assert.Equal(t, tt.expectedErr, err != nil, err)
+			assert.Equal(t, tt.expectedCall, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	start := time.Now()
+	c := New(WithEndpoint(url), WithRetry(RetryPolicy{MaxRetries: 2, InitialBackoff: 20 * time.Millisecond}))
+	_, err := c.GetSlot(context.Background())
+	assert.NotNil(t, err)
+	// 10~20ms + 20~40ms
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestRetryContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Retry-After", "60")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	c := New(WithEndpoint(server.URL), WithRetry(RetryPolicy{MaxRetries: 3}))
+	_, err := c.GetSlot(ctx)
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		for i := 0; i < 10; i++ {
+			d := p.backoff(attempt)
+			assert.GreaterOrEqual(t, d, max/2)
+			assert.LessOrEqual(t, d, max)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		header   string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-1", 0},
+		{"Sat, 01 Jan 2022 00:00:05 GMT", 5 * time.Second},
+		{"Fri, 31 Dec 2021 23:59:00 GMT", 0},
+		{"soon", 0},
+		// capped by the max backoff
+		{"3600", 10 * time.Second},
+		{"9223372036854775807", 10 * time.Second},
+		{"Sun, 02 Jan 2022 00:00:00 GMT", 10 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, parseRetryAfter(tt.header, now, 10*time.Second), tt.header)
+	}
+}
@@ -17,15 +17,17 @@ type SendTransactionConfig struct {
 	SkipPreflight       bool                          `json:"skipPreflight,omitempty"`       // default: false
 	PreflightCommitment Commitment                    `json:"preflightCommitment,omitempty"` // default: finalized
 	Encoding            SendTransactionConfigEncoding `json:"encoding,omitempty"`            // default: base58
-	MaxRetries          uint64                        `json:"maxRetries,omitempty"`
+	// MaxRetries 0 means the node default, use WithMaxRetries(0) to stop the node from retrying
+	MaxRetries     uint64  `json:"maxRetries,omitempty"`
+	MinContextSlot *uint64 `json:"minContextSlot,omitempty"`
 }
 
 // SendTransaction submits a signed transaction to the cluster for processing
-func (c *RpcClient) SendTransaction(ctx context.Context, tx string) (JsonRpcResponse[string], error) {
-	return call[JsonRpcResponse[string]](c, ctx, "sendTransaction", tx)
+func (c *RpcClient) SendTransaction(ctx context.Context, tx string, opts ...CallOption) (JsonRpcResponse[string], error) {
+	return callWithConfig[JsonRpcResponse[string]](c, ctx, nil, opts, "sendTransaction", tx)
 }
 
-// SendTransaction submits a signed transaction to the cluster for processing
-func (c *RpcClient) SendTransactionWithConfig(ctx context.Context, tx string, cfg SendTransactionConfig) (JsonRpcResponse[string], error) {
-	return call[JsonRpcResponse[string]](c, ctx, "sendTransaction", tx, cfg)
+// SendTransactionWithConfig submits a signed transaction to the cluster for processing
+func (c *RpcClient) SendTransactionWithConfig(ctx context.Context, tx string, cfg SendTransactionConfig, opts ...CallOption) (JsonRpcResponse[string], error) {
+	return callWithConfig[JsonRpcResponse[string]](c, ctx, cfg, opts, "sendTransaction", tx)
 }
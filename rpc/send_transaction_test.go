@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/liangjies/solana-go-sdk/internal/client_test"
+	"github.com/liangjies/solana-go-sdk/pkg/pointer"
 )
 
 func TestSendTransaction(t *testing.T) {
@@ -152,6 +153,50 @@ func TestSendTransaction(t *testing.T) {
 				},
 				ExpectedError: nil,
 			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"sendTransaction","params":["HvPMZonNNzD9M2VY3DBJUHVw8fXuym23SB193SX7qMgHu2BhTwaanTDmaCg4XiTFqHnLAx5Tirim87BqYuvEdZsEcEaTRjPBnFhMR8cXBbKGkZnhNNoU6F8GcZ2gjYfFV8WkABQa2gimsyiTLzifHroVYuB7qpH8VFUGkbvDuqsJPykmhWx1dk94LUsic2e1PRLJkeKTPojSvRZomjXHDQV2d4izfNNZVTViKRfhwvdqiauX7niFBraes",{"encoding":"base64","minContextSlot":100}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.SendTransactionWithConfig(
+						context.Background(),
+						"HvPMZonNNzD9M2VY3DBJUHVw8fXuym23SB193SX7qMgHu2BhTwaanTDmaCg4XiTFqHnLAx5Tirim87BqYuvEdZsEcEaTRjPBnFhMR8cXBbKGkZnhNNoU6F8GcZ2gjYfFV8WkABQa2gimsyiTLzifHroVYuB7qpH8VFUGkbvDuqsJPykmhWx1dk94LUsic2e1PRLJkeKTPojSvRZomjXHDQV2d4izfNNZVTViKRfhwvdqiauX7niFBraes",
+						SendTransactionConfig{
+							Encoding:       SendTransactionConfigEncodingBase64,
+							MinContextSlot: pointer.Get[uint64](100),
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[string]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result:  "2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb",
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"sendTransaction","params":["HvPMZonNNzD9M2VY3DBJUHVw8fXuym23SB193SX7qMgHu2BhTwaanTDmaCg4XiTFqHnLAx5Tirim87BqYuvEdZsEcEaTRjPBnFhMR8cXBbKGkZnhNNoU6F8GcZ2gjYfFV8WkABQa2gimsyiTLzifHroVYuB7qpH8VFUGkbvDuqsJPykmhWx1dk94LUsic2e1PRLJkeKTPojSvRZomjXHDQV2d4izfNNZVTViKRfhwvdqiauX7niFBraes",{"encoding":"base64","maxRetries":0}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":"2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb","id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.SendTransactionWithConfig(
+						context.Background(),
+						"HvPMZonNNzD9M2VY3DBJUHVw8fXuym23SB193SX7qMgHu2BhTwaanTDmaCg4XiTFqHnLAx5Tirim87BqYuvEdZsEcEaTRjPBnFhMR8cXBbKGkZnhNNoU6F8GcZ2gjYfFV8WkABQa2gimsyiTLzifHroVYuB7qpH8VFUGkbvDuqsJPykmhWx1dk94LUsic2e1PRLJkeKTPojSvRZomjXHDQV2d4izfNNZVTViKRfhwvdqiauX7niFBraes",
+						SendTransactionConfig{
+							Encoding: SendTransactionConfigEncodingBase64,
+						},
+						WithMaxRetries(0),
+					)
+				},
+				ExpectedValue: JsonRpcResponse[string]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result:  "2F53DggXYWLzczigoMr7smSEZtWSKmsWr7HMJQiNbTBdjjcN54LUMWdvTLj46MH7rAnJVPjJEjRjjXKeG7mssmZb",
+				},
+				ExpectedError: nil,
+			},
 		},
 	)
 }
@@ -10,10 +10,12 @@ type SimulateTransaction ValueWithContext[SimulateTransactionValue]
 
 // SimulateTransactionValue is a part of SimulateTransactionResponseResult
 type SimulateTransactionValue struct {
-	Err        any            `json:"err"`
-	Logs       []string       `json:"logs,omitempty"`
-	Accounts   []*AccountInfo `json:"accounts,omitempty"`
-	ReturnData *ReturnData    `json:"returnData,omitempty"`
+	Err               any                               `json:"err"`
+	Logs              []string                          `json:"logs,omitempty"`
+	Accounts          []*AccountInfo                    `json:"accounts,omitempty"`
+	ReturnData        *ReturnData                       `json:"returnData,omitempty"`
+	UnitsConsumed     *uint64                           `json:"unitsConsumed,omitempty"`
+	InnerInstructions []TransactionMetaInnerInstruction `json:"innerInstructions,omitempty"`
 }
 
 type SimulateTransactionConfig struct {
@@ -22,6 +24,8 @@ type SimulateTransactionConfig struct {
 	Encoding               SimulateTransactionEncoding        `json:"encoding,omitempty"`               // default: "base58"
 	ReplaceRecentBlockhash bool                               `json:"replaceRecentBlockhash,omitempty"` // default: false, conflicts with sigVerify
 	Accounts               *SimulateTransactionConfigAccounts `json:"accounts,omitempty"`
+	InnerInstructions      bool                               `json:"innerInstructions,omitempty"` // default: false
+	MinContextSlot         *uint64                            `json:"minContextSlot,omitempty"`
 }
 
 type SimulateTransactionConfigAccounts struct {
@@ -38,11 +42,11 @@ const (
 )
 
 // SimulateTransaction simulate sending a transaction
-func (c *RpcClient) SimulateTransaction(ctx context.Context, rawTx string) (JsonRpcResponse[ValueWithContext[SimulateTransactionValue]], error) {
-	return call[JsonRpcResponse[ValueWithContext[SimulateTransactionValue]]](c, ctx, "simulateTransaction", rawTx)
+func (c *RpcClient) SimulateTransaction(ctx context.Context, rawTx string, opts ...CallOption) (JsonRpcResponse[ValueWithContext[SimulateTransactionValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[SimulateTransactionValue]]](c, ctx, nil, opts, "simulateTransaction", rawTx)
 }
 
 // SimulateTransaction simulate sending a transaction
-func (c *RpcClient) SimulateTransactionWithConfig(ctx context.Context, rawTx string, cfg SimulateTransactionConfig) (JsonRpcResponse[ValueWithContext[SimulateTransactionValue]], error) {
-	return call[JsonRpcResponse[ValueWithContext[SimulateTransactionValue]]](c, ctx, "simulateTransaction", rawTx, cfg)
+func (c *RpcClient) SimulateTransactionWithConfig(ctx context.Context, rawTx string, cfg SimulateTransactionConfig, opts ...CallOption) (JsonRpcResponse[ValueWithContext[SimulateTransactionValue]], error) {
+	return callWithConfig[JsonRpcResponse[ValueWithContext[SimulateTransactionValue]]](c, ctx, cfg, opts, "simulateTransaction", rawTx)
 }
@@ -3,19 +3,29 @@ package types
 import (
 	"crypto/ed25519"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/liangjies/solana-go-sdk/pkg/hdwallet"
 	"github.com/mr-tron/base58"
+	"github.com/tyler-smith/go-bip39"
 )
 
 var (
 	ErrAccountFailedToBase58Decode     = errors.New("failed to base58 decode")
 	ErrAccountFailedToHexDecode        = errors.New("failed to hex decode")
 	ErrAccountPrivateKeyLengthMismatch = errors.New("key length mismatch")
+	ErrAccountInvalidMnemonic          = errors.New("invalid mnemonic")
+	ErrAccountFailedToJSONDecode       = errors.New("failed to json decode")
 )
 
+// DefaultDerivationPath is the path used by wallets like phantom and solflare for the first account
+const DefaultDerivationPath = `m/44'/501'/0'/0'`
+
 type Account struct {
 	PublicKey  common.PublicKey
 	PrivateKey ed25519.PrivateKey
@@ -64,6 +74,78 @@ func AccountFromSeed(seed []byte) (Account, error) {
 	return AccountFromBytes(pk)
 }
 
+// AccountFromMnemonic generate a account by bip39 mnemonic with DefaultDerivationPath,
+// which is the same as the first account imported into phantom.
+func AccountFromMnemonic(mnemonic, passphrase string) (Account, error) {
+	return AccountFromMnemonicWithPath(mnemonic, passphrase, DefaultDerivationPath)
+}
+
+// AccountFromMnemonicWithPath generate a account by bip39 mnemonic and a bip44 path, e.g. m/44'/501'/1'/0'.
+// pass an empty path to get the account which `solana-keygen new` prints the mnemonic for.
+func AccountFromMnemonicWithPath(mnemonic, passphrase, path string) (Account, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return Account{}, fmt.Errorf("%w, err: %v", ErrAccountInvalidMnemonic, err)
+	}
+	if path == "" {
+		return AccountFromSeed(seed[:32])
+	}
+	key, err := hdwallet.Derived(path, seed)
+	if err != nil {
+		return Account{}, err
+	}
+	return AccountFromSeed(key.PrivateKey)
+}
+
+// AccountFromKeyfileJSON generate a account by the content of a solana-keygen keyfile, e.g. [1,2,...,64]
+func AccountFromKeyfileJSON(data []byte) (Account, error) {
+	// []uint8 is decoded from base64 by encoding/json, so decode it as numbers first
+	var numbers []uint16
+	if err := json.Unmarshal(data, &numbers); err != nil {
+		return Account{}, fmt.Errorf("%w, err: %v", ErrAccountFailedToJSONDecode, err)
+	}
+	key := make([]byte, 0, len(numbers))
+	for _, n := range numbers {
+		if n > 255 {
+			return Account{}, fmt.Errorf("%w, err: %v is not a byte", ErrAccountFailedToJSONDecode, n)
+		}
+		key = append(key, uint8(n))
+	}
+	return AccountFromBytes(key)
+}
+
+// AccountFromKeyfile generate a account by a solana-keygen keyfile, e.g. ~/.config/solana/id.json
+func AccountFromKeyfile(path string) (Account, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Account{}, err
+	}
+	return AccountFromKeyfileJSON(data)
+}
+
+// KeyfileJSON returns the private key in the solana-keygen keyfile format
+func (a Account) KeyfileJSON() []byte {
+	b := make([]byte, 0, 4*len(a.PrivateKey)+2)
+	b = append(b, '[')
+	for i, v := range a.PrivateKey {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendUint(b, uint64(v), 10)
+	}
+	return append(b, ']')
+}
+
+// WriteKeyfile writes the private key to path in the solana-keygen keyfile format. the file is only readable by the owner.
+func (a Account) WriteKeyfile(path string) error {
+	return os.WriteFile(path, a.KeyfileJSON(), 0600)
+}
+
+// PrivateKeyBase58 returns the base58 encoded private key which AccountFromBase58 accepts
+func (a Account) PrivateKeyBase58() string {
+	return base58.Encode(a.PrivateKey)
+}
+
 func (a Account) Sign(message []byte) []byte {
 	return ed25519.Sign(a.PrivateKey, message)
 }
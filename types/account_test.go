@@ -2,6 +2,8 @@ package types
 
 import (
 	"crypto/ed25519"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -132,3 +134,88 @@ func TestAccount_Sign(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountFromMnemonic(t *testing.T) {
+	account, err := AccountFromMnemonic("neither lonely flavor argue grass remind eye tag avocado spot unusual intact", "")
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromString("5vftMkHL72JaJG6ExQfGAsT2uGVHpRR7oTNUPMs68Y2N"), account.PublicKey)
+
+	_, err = AccountFromMnemonic("neither lonely flavor argue grass remind eye tag avocado spot unusual unusual", "")
+	assert.ErrorIs(t, err, ErrAccountInvalidMnemonic)
+}
+
+func TestAccountFromMnemonicWithPath(t *testing.T) {
+	type args struct {
+		mnemonic   string
+		passphrase string
+		path       string
+	}
+	tests := []struct {
+		name string
+		args args
+		want common.PublicKey
+		err  bool
+	}{
+		{
+			args: args{
+				mnemonic: "neither lonely flavor argue grass remind eye tag avocado spot unusual intact",
+				path:     `m/44'/501'/1'/0'`,
+			},
+			want: common.PublicKeyFromString("GcXbfQ5yY3uxCyBNDPBbR5FjumHf89E7YHXuULfGDBBv"),
+		},
+		{
+			args: args{
+				mnemonic: "neither lonely flavor argue grass remind eye tag avocado spot unusual intact",
+				path:     `m/44'/501'/9'/0'`,
+			},
+			want: common.PublicKeyFromString("6frdqXQAgJMyKwmZxkLYbdGjnYTvUceh6LNhkQt2siQp"),
+		},
+		{
+			args: args{
+				mnemonic: "neither lonely flavor argue grass remind eye tag avocado spot unusual intact",
+				path:     `m/44/501`,
+			},
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AccountFromMnemonicWithPath(tt.args.mnemonic, tt.args.passphrase, tt.args.path)
+			if tt.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got.PublicKey)
+		})
+	}
+}
+
+func TestAccountKeyfile(t *testing.T) {
+	keyfile := []byte("[214,49,53,208,232,140,85,41,45,128,173,3,79,105,136,236,132,164,35,93,59,196,51,59,127,139,1,155,245,83,230,184,21,109,62,131,66,207,210,237,39,93,125,50,137,69,236,28,138,68,1,30,175,228,109,140,77,52,105,79,223,111,131,31]")
+
+	account, err := AccountFromKeyfileJSON(keyfile)
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromString("2SeBK1pUxnVbY82vN4TEJiWh4GwaGDkffxPegQP3DFPk"), account.PublicKey)
+	assert.Equal(t, keyfile, account.KeyfileJSON())
+	assert.Equal(t, "5HNxRJoirY4oRTcRwiEYFALSSLn9nMAyLQKDuSuiCJ966816BjwGuamRdTLTsR2FBHiB7CQkGaw6B4ehBMogPRvW", account.PrivateKeyBase58())
+
+	path := filepath.Join(t.TempDir(), "id.json")
+	assert.Nil(t, account.WriteKeyfile(path))
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := AccountFromKeyfile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, account, loaded)
+
+	_, err = AccountFromKeyfileJSON([]byte(`"not an array"`))
+	assert.ErrorIs(t, err, ErrAccountFailedToJSONDecode)
+
+	_, err = AccountFromKeyfileJSON([]byte(`[256]`))
+	assert.ErrorIs(t, err, ErrAccountFailedToJSONDecode)
+
+	_, err = AccountFromKeyfileJSON([]byte(`[1,2,3]`))
+	assert.ErrorIs(t, err, ErrAccountPrivateKeyLengthMismatch)
+}
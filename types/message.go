@@ -41,6 +41,14 @@ type CompiledAddressLookupTable struct {
 	ReadonlyIndexes []uint8
 }
 
+// FeePayer returns the account which pays the fee, it is the first account of the message
+func (m *Message) FeePayer() common.PublicKey {
+	if len(m.Accounts) == 0 {
+		return common.PublicKey{}
+	}
+	return m.Accounts[0]
+}
+
 func (m *Message) Serialize() ([]byte, error) {
 	b := []byte{}
 
@@ -105,7 +113,7 @@ func (m *Message) Serialize() ([]byte, error) {
 	return b, nil
 }
 
-// DecompileInstructions hasn't support v0 message decode
+// DecompileInstructions hasn't support v0 message decode, please use DecompileInstructionsWithAddressLookupTables
 func (m *Message) DecompileInstructions() []Instruction {
 	switch m.Version {
 	case MessageVersionLegacy:
@@ -139,6 +147,88 @@ func (m Message) decompileLegacyMessageInstructions() []Instruction {
 	return instructions
 }
 
+// LoadAddresses resolves the addresses which are referenced by the address lookup tables of a v0 message.
+// the accounts of all the lookup tables used by the message should be provided.
+func (m *Message) LoadAddresses(addressLookupTableAccounts []AddressLookupTableAccount) (writable []common.PublicKey, readonly []common.PublicKey, err error) {
+	tables := make(map[common.PublicKey][]common.PublicKey, len(addressLookupTableAccounts))
+	for _, addressLookupTableAccount := range addressLookupTableAccounts {
+		tables[addressLookupTableAccount.Key] = addressLookupTableAccount.Addresses
+	}
+
+	writable = []common.PublicKey{}
+	readonly = []common.PublicKey{}
+	for _, compiledAddressLookupTable := range m.AddressLookupTables {
+		addresses, ok := tables[compiledAddressLookupTable.AccountKey]
+		if !ok {
+			return nil, nil, fmt.Errorf("address lookup table %v not found", compiledAddressLookupTable.AccountKey)
+		}
+		for _, idx := range compiledAddressLookupTable.WritableIndexes {
+			if int(idx) >= len(addresses) {
+				return nil, nil, fmt.Errorf("address lookup table %v index %v out of range", compiledAddressLookupTable.AccountKey, idx)
+			}
+			writable = append(writable, addresses[idx])
+		}
+		for _, idx := range compiledAddressLookupTable.ReadonlyIndexes {
+			if int(idx) >= len(addresses) {
+				return nil, nil, fmt.Errorf("address lookup table %v index %v out of range", compiledAddressLookupTable.AccountKey, idx)
+			}
+			readonly = append(readonly, addresses[idx])
+		}
+	}
+	return writable, readonly, nil
+}
+
+// DecompileInstructionsWithAddressLookupTables decompiles both legacy and v0 message.
+// the accounts of all the lookup tables used by the message should be provided for v0 message.
+func (m *Message) DecompileInstructionsWithAddressLookupTables(addressLookupTableAccounts []AddressLookupTableAccount) ([]Instruction, error) {
+	if m.Version != MessageVersionV0 {
+		return m.decompileLegacyMessageInstructions(), nil
+	}
+
+	writable, readonly, err := m.LoadAddresses(addressLookupTableAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]common.PublicKey, 0, len(m.Accounts)+len(writable)+len(readonly))
+	accounts = append(accounts, m.Accounts...)
+	accounts = append(accounts, writable...)
+	accounts = append(accounts, readonly...)
+
+	isWritable := func(idx int) bool {
+		if idx >= len(m.Accounts) {
+			return idx < len(m.Accounts)+len(writable)
+		}
+		return idx < int(m.Header.NumRequireSignatures-m.Header.NumReadonlySignedAccounts) ||
+			(idx >= int(m.Header.NumRequireSignatures) &&
+				idx < len(m.Accounts)-int(m.Header.NumReadonlyUnsignedAccounts))
+	}
+
+	instructions := make([]Instruction, 0, len(m.Instructions))
+	for i, cins := range m.Instructions {
+		if cins.ProgramIDIndex >= len(accounts) {
+			return nil, fmt.Errorf("instruction #%d program id index out of range", i+1)
+		}
+		accountMetas := make([]AccountMeta, 0, len(cins.Accounts))
+		for _, idx := range cins.Accounts {
+			if idx >= len(accounts) {
+				return nil, fmt.Errorf("instruction #%d account index out of range", i+1)
+			}
+			accountMetas = append(accountMetas, AccountMeta{
+				PubKey:     accounts[idx],
+				IsSigner:   idx < int(m.Header.NumRequireSignatures),
+				IsWritable: isWritable(idx),
+			})
+		}
+		instructions = append(instructions, Instruction{
+			ProgramID: accounts[cins.ProgramIDIndex],
+			Accounts:  accountMetas,
+			Data:      cins.Data,
+		})
+	}
+	return instructions, nil
+}
+
 func MessageDeserialize(messageData []byte) (Message, error) {
 	if len(messageData) == 0 {
 		return Message{}, errors.New("empty message data")
@@ -147,6 +237,9 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 	var version MessageVersion
 	if v := uint8(messageData[0]); v > 127 {
 		version = MessageVersion(fmt.Sprintf("v%v", v-128))
+		if version != MessageVersionV0 {
+			return Message{}, fmt.Errorf("unsupported message version: %v", version)
+		}
 		messageData = messageData[1:]
 	} else {
 		version = MessageVersionLegacy
@@ -168,7 +261,7 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 	if err != nil {
 		return Message{}, fmt.Errorf("falied to parse count of account, err: %v", err)
 	}
-	if len(messageData) < int(accountCount)*32 {
+	if uint64(len(messageData))/32 < accountCount {
 		return Message{}, errors.New("parse account error")
 	}
 	accounts := make([]common.PublicKey, 0, accountCount)
@@ -188,6 +281,10 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 		return Message{}, fmt.Errorf("parse instruction count error: %v", err)
 	}
 
+	// an instruction takes 3 bytes at least
+	if uint64(len(messageData))/3 < instructionCount {
+		return Message{}, errors.New("parse instruction error")
+	}
 	instructions := make([]CompiledInstruction, 0, instructionCount)
 	for i := 0; i < int(instructionCount); i++ {
 		programID, err := parseUvarint(&messageData)
@@ -198,6 +295,9 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 		if err != nil {
 			return Message{}, fmt.Errorf("parse instruction #%d account count error: %v", i+1, err)
 		}
+		if uint64(len(messageData)) < accountCount {
+			return Message{}, fmt.Errorf("parse instruction #%d account idx error", i+1)
+		}
 		accounts := make([]int, 0, accountCount)
 		for j := 0; j < int(accountCount); j++ {
 			accountIdx, err := parseUvarint(&messageData)
@@ -210,6 +310,9 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 		if err != nil {
 			return Message{}, fmt.Errorf("parse instruction #%d data length error: %v", i+1, err)
 		}
+		if uint64(len(messageData)) < dataLen {
+			return Message{}, fmt.Errorf("parse instruction #%d data error", i+1)
+		}
 		var data []byte
 		data, messageData = messageData[:dataLen], messageData[dataLen:]
 
@@ -224,10 +327,13 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 	if version == MessageVersionV0 {
 		addressLookupTableCount, err := parseUvarint(&messageData)
 		if err != nil {
-			return Message{}, fmt.Errorf("parse instruction count error: %v", err)
+			return Message{}, fmt.Errorf("parse address lookup table count error: %v", err)
 		}
 
 		for i := uint64(0); i < addressLookupTableCount; i++ {
+			if len(messageData) < 32 {
+				return Message{}, fmt.Errorf("failed to parse address lookup table #%d account key", i+1)
+			}
 			addressLookupTablePubkey := common.PublicKeyFromBytes(messageData[:32])
 			messageData = messageData[32:]
 
@@ -235,6 +341,9 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 			if err != nil {
 				return Message{}, fmt.Errorf("failed to parse address lookup table writable account idx count, err: %v", err)
 			}
+			if uint64(len(messageData)) < writableAccountIdxCount {
+				return Message{}, fmt.Errorf("failed to parse address lookup table #%d writable account idx", i+1)
+			}
 			var writableAccountIdxList []uint8
 			writableAccountIdxList, messageData = messageData[:writableAccountIdxCount], messageData[writableAccountIdxCount:]
 
@@ -242,6 +351,9 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 			if err != nil {
 				return Message{}, fmt.Errorf("failed to parse address lookup table readOnly account idx count, err: %v", err)
 			}
+			if uint64(len(messageData)) < readOnlyAccountIdxCount {
+				return Message{}, fmt.Errorf("failed to parse address lookup table #%d readOnly account idx", i+1)
+			}
 			var readOnlyAccountIdxList []uint8
 			readOnlyAccountIdxList, messageData = messageData[:readOnlyAccountIdxCount], messageData[readOnlyAccountIdxCount:]
 
@@ -289,6 +401,9 @@ type NewMessageParam struct {
 	RecentBlockhash string
 	// v0 transaction
 	AddressLookupTableAccounts []AddressLookupTableAccount
+	// SkipSingleKeyLookupTables keeps a key static instead of loading it from a table which covers no other key,
+	// the key is smaller as a static key than the table key plus its index
+	SkipSingleKeyLookupTables bool
 }
 
 type CompiledKeys struct {
@@ -335,6 +450,13 @@ func NewCompiledKeys(instructions []Instruction, payer *common.PublicKey) Compil
 	}
 }
 
+// NewMessageV0 compiles a v0 message even if there is no address lookup table
+func NewMessageV0(param NewMessageParam) Message {
+	message := NewMessage(param)
+	message.Version = MessageVersionV0
+	return message
+}
+
 func NewMessage(param NewMessageParam) Message {
 	writableSignedAccount := []common.PublicKey{}
 	readOnlySignedAccount := []common.PublicKey{}
@@ -364,6 +486,11 @@ func NewMessage(param NewMessageParam) Message {
 	sort.Slice(allKeys, func(i, j int) bool {
 		return bytes.Compare(allKeys[i].Bytes(), allKeys[j].Bytes()) < 0
 	})
+	minTableKeys := 1
+	if param.SkipSingleKeyLookupTables {
+		minTableKeys = 2
+	}
+	lookupTableOf := assignLookupTables(allKeys, compiledKeys.KeyMetaMap, addressLookupTableMaps, minTableKeys)
 
 NEXT_ACCOUNT:
 	for _, key := range allKeys {
@@ -379,24 +506,18 @@ NEXT_ACCOUNT:
 			}
 		} else {
 			if meta.IsWritable {
-				for n, addressLookupTableMap := range addressLookupTableMaps {
-					idx, exist := addressLookupTableMap[key]
-					if exist && !meta.IsInvoked {
-						addressLookupTableWritable[n] = append(addressLookupTableWritable[n], key)
-						addressLookupTableWritableIdx[n] = append(addressLookupTableWritableIdx[n], idx)
-						continue NEXT_ACCOUNT
-					}
+				if n, exist := lookupTableOf[key]; exist {
+					addressLookupTableWritable[n] = append(addressLookupTableWritable[n], key)
+					addressLookupTableWritableIdx[n] = append(addressLookupTableWritableIdx[n], addressLookupTableMaps[n][key])
+					continue NEXT_ACCOUNT
 				}
 				// if not found in address lookup table
 				writableUnsignedAccount = append(writableUnsignedAccount, key)
 			} else {
-				for n, addressLookupTableMap := range addressLookupTableMaps {
-					idx, exist := addressLookupTableMap[key]
-					if exist && !meta.IsInvoked {
-						addressLookupTableReadonly[n] = append(addressLookupTableReadonly[n], key)
-						addressLookupTableReadonlyIdx[n] = append(addressLookupTableReadonlyIdx[n], idx)
-						continue NEXT_ACCOUNT
-					}
+				if n, exist := lookupTableOf[key]; exist {
+					addressLookupTableReadonly[n] = append(addressLookupTableReadonly[n], key)
+					addressLookupTableReadonlyIdx[n] = append(addressLookupTableReadonlyIdx[n], addressLookupTableMaps[n][key])
+					continue NEXT_ACCOUNT
 				}
 				// if not found in address lookup table
 				readOnlyUnsignedAccount = append(readOnlyUnsignedAccount, key)
@@ -411,9 +532,7 @@ NEXT_ACCOUNT:
 		len(writableSignedAccount) +
 		len(readOnlySignedAccount) +
 		len(writableUnsignedAccount) +
-		len(readOnlyUnsignedAccount) +
-		len(addressLookupTableWritable) +
-		len(addressLookupTableReadonly)
+		len(readOnlyUnsignedAccount)
 
 	publicKeys := make([]common.PublicKey, 0, l)
 	publicKeys = append(publicKeys, writableSignedAccount...)
@@ -476,3 +595,55 @@ NEXT_ACCOUNT:
 		AddressLookupTables: compiledAddressLookupTables,
 	}
 }
+
+// assignLookupTables picks a lookup table for every key which can be loaded from one, signers and invoked programs
+// have to be static keys. every table used costs its own key in the message, so a key
+// found in several tables goes to the table covering the most keys and the message uses as few tables as possible.
+// a table covering less than minTableKeys of the remaining keys isn't used.
+// it returns the index of the table of each key, ties are broken by the order of the tables.
+func assignLookupTables(keys []common.PublicKey, metas map[common.PublicKey]CompiledKeyMeta, tables []map[common.PublicKey]uint8, minTableKeys int) map[common.PublicKey]int {
+	remaining := map[common.PublicKey]struct{}{}
+	for _, key := range keys {
+		meta := metas[key]
+		if meta.IsSigner || meta.IsInvoked {
+			continue
+		}
+		for _, table := range tables {
+			if _, exist := table[key]; exist {
+				remaining[key] = struct{}{}
+				break
+			}
+		}
+	}
+
+	assigned := make(map[common.PublicKey]int, len(remaining))
+	used := make([]bool, len(tables))
+	for len(remaining) > 0 {
+		best, bestCount := -1, 0
+		for n, table := range tables {
+			if used[n] {
+				continue
+			}
+			count := 0
+			for key := range remaining {
+				if _, exist := table[key]; exist {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = n, count
+			}
+		}
+		if best < 0 || bestCount < minTableKeys {
+			break
+		}
+		used[best] = true
+		for key := range remaining {
+			if _, exist := tables[best][key]; exist {
+				assigned[key] = best
+				delete(remaining, key)
+			}
+		}
+	}
+	return assigned
+}
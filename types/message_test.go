@@ -853,3 +853,255 @@ func TestMessageDeserialize(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_DecompileInstructionsWithAddressLookupTables(t *testing.T) {
+	type args struct {
+		addressLookupTableAccounts []AddressLookupTableAccount
+	}
+	tests := []struct {
+		name    string
+		message Message
+		args    args
+		want    []Instruction
+		wantErr error
+	}{
+		{
+			message: Message{
+				Version: MessageVersionV0,
+				Header: MessageHeader{
+					NumRequireSignatures:        1,
+					NumReadonlySignedAccounts:   0,
+					NumReadonlyUnsignedAccounts: 1,
+				},
+				Accounts: []common.PublicKey{
+					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+					common.SystemProgramID,
+				},
+				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				Instructions: []CompiledInstruction{
+					{
+						ProgramIDIndex: 1,
+						Accounts:       []int{0, 2, 3},
+						Data:           []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+					},
+				},
+				AddressLookupTables: []CompiledAddressLookupTable{
+					{
+						AccountKey:      common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
+						WritableIndexes: []uint8{1},
+						ReadonlyIndexes: []uint8{0},
+					},
+				},
+			},
+			args: args{
+				addressLookupTableAccounts: []AddressLookupTableAccount{
+					{
+						Key: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
+						Addresses: []common.PublicKey{
+							common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
+							common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
+						},
+					},
+				},
+			},
+			want: []Instruction{
+				{
+					ProgramID: common.SystemProgramID,
+					Accounts: []AccountMeta{
+						{PubKey: common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"), IsSigner: true, IsWritable: true},
+						{PubKey: common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"), IsSigner: false, IsWritable: true},
+						{PubKey: common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"), IsSigner: false, IsWritable: false},
+					},
+					Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+				},
+			},
+		},
+		{
+			message: Message{
+				Version: MessageVersionV0,
+				Header: MessageHeader{
+					NumRequireSignatures:        1,
+					NumReadonlySignedAccounts:   0,
+					NumReadonlyUnsignedAccounts: 1,
+				},
+				Accounts: []common.PublicKey{
+					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+					common.SystemProgramID,
+				},
+				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				Instructions: []CompiledInstruction{
+					{
+						ProgramIDIndex: 1,
+						Accounts:       []int{0, 2},
+						Data:           []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+					},
+				},
+				AddressLookupTables: []CompiledAddressLookupTable{
+					{
+						AccountKey:      common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
+						WritableIndexes: []uint8{1},
+						ReadonlyIndexes: []uint8{},
+					},
+				},
+			},
+			args: args{
+				addressLookupTableAccounts: []AddressLookupTableAccount{},
+			},
+			wantErr: fmt.Errorf("address lookup table HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.message.DecompileInstructionsWithAddressLookupTables(tt.args.addressLookupTableAccounts)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewMessageV0(t *testing.T) {
+	feePayer := common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	message := NewMessageV0(NewMessageParam{
+		FeePayer: feePayer,
+		Instructions: []Instruction{
+			{
+				ProgramID: common.SystemProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: feePayer, IsSigner: true, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		RecentBlockhash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+	})
+	assert.Equal(t, MessageVersion(MessageVersionV0), message.Version)
+
+	b, err := message.Serialize()
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0x80), b[0])
+
+	deserialized, err := MessageDeserialize(b)
+	assert.Nil(t, err)
+	assert.Equal(t, message, deserialized)
+}
+
+func TestNewMessageLookupTableSelection(t *testing.T) {
+	feePayer := common.PublicKeyFromString("FUarP2p5EnxD66vVDL4PWRoWMzA56ZVHG24hpEDFShEz")
+	a := common.PublicKeyFromString("8YNmYW9rWwpmLxUDycqHj1JMAMdm1v2VBB55tXqt7jej")
+	b := common.PublicKeyFromString("5XaEXmAEiA4t3EdFWADixN9537Nct5Y5PMRz391eD9N1")
+	c := common.PublicKeyFromString("CPaB3EuV5qJK25stSWzH3815BspeyGgYvaR1Z8B72hbp")
+	small := common.PublicKeyFromString("4jBXhGD8X8i2MCkunSDnqvyzQrGcfV6rqy5A4ETJBtaA")
+	large := common.PublicKeyFromString("F5wakDtup2KKx1SACvLyYDJn2r6eMGRwQDTw7ZKBWATb")
+
+	message := NewMessage(NewMessageParam{
+		FeePayer: feePayer,
+		Instructions: []Instruction{
+			{
+				ProgramID: common.TokenProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: a, IsSigner: false, IsWritable: true},
+					{PubKey: b, IsSigner: false, IsWritable: false},
+					{PubKey: c, IsSigner: false, IsWritable: true},
+					{PubKey: feePayer, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{1},
+			},
+		},
+		RecentBlockhash: "5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd",
+		AddressLookupTableAccounts: []AddressLookupTableAccount{
+			{
+				Key:       small,
+				Addresses: []common.PublicKey{b},
+			},
+			{
+				Key:       large,
+				Addresses: []common.PublicKey{common.TokenProgramID, c, a, b},
+			},
+		},
+	})
+
+	assert.Equal(t, []common.PublicKey{feePayer, common.TokenProgramID}, message.Accounts)
+	assert.Equal(t, []CompiledAddressLookupTable{
+		{
+			AccountKey:      large,
+			WritableIndexes: []uint8{2, 1},
+			ReadonlyIndexes: []uint8{3},
+		},
+	}, message.AddressLookupTables)
+	assert.Equal(t, []CompiledInstruction{
+		{
+			ProgramIDIndex: 1,
+			Accounts:       []int{2, 4, 3, 0},
+			Data:           []byte{1},
+		},
+	}, message.Instructions)
+}
+
+func TestNewMessageSkipSingleKeyLookupTables(t *testing.T) {
+	feePayer := common.PublicKeyFromString("FUarP2p5EnxD66vVDL4PWRoWMzA56ZVHG24hpEDFShEz")
+	a := common.PublicKeyFromString("8YNmYW9rWwpmLxUDycqHj1JMAMdm1v2VBB55tXqt7jej")
+	b := common.PublicKeyFromString("5XaEXmAEiA4t3EdFWADixN9537Nct5Y5PMRz391eD9N1")
+	c := common.PublicKeyFromString("CPaB3EuV5qJK25stSWzH3815BspeyGgYvaR1Z8B72hbp")
+	single := common.PublicKeyFromString("4jBXhGD8X8i2MCkunSDnqvyzQrGcfV6rqy5A4ETJBtaA")
+	double := common.PublicKeyFromString("F5wakDtup2KKx1SACvLyYDJn2r6eMGRwQDTw7ZKBWATb")
+
+	param := NewMessageParam{
+		FeePayer: feePayer,
+		Instructions: []Instruction{
+			{
+				ProgramID: common.TokenProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: a, IsSigner: false, IsWritable: true},
+					{PubKey: b, IsSigner: false, IsWritable: false},
+					{PubKey: c, IsSigner: false, IsWritable: true},
+					{PubKey: feePayer, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{1},
+			},
+		},
+		RecentBlockhash: "5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd",
+		AddressLookupTableAccounts: []AddressLookupTableAccount{
+			{
+				Key:       single,
+				Addresses: []common.PublicKey{a},
+			},
+			{
+				Key:       double,
+				Addresses: []common.PublicKey{b, c},
+			},
+		},
+	}
+
+	message := NewMessage(param)
+	assert.Equal(t, []common.PublicKey{feePayer, common.TokenProgramID}, message.Accounts)
+	assert.Equal(t, []CompiledAddressLookupTable{
+		{
+			AccountKey:      single,
+			WritableIndexes: []uint8{0},
+		},
+		{
+			AccountKey:      double,
+			WritableIndexes: []uint8{1},
+			ReadonlyIndexes: []uint8{0},
+		},
+	}, message.AddressLookupTables)
+
+	param.SkipSingleKeyLookupTables = true
+	message = NewMessage(param)
+	assert.Equal(t, []common.PublicKey{feePayer, a, common.TokenProgramID}, message.Accounts)
+	assert.Equal(t, []CompiledAddressLookupTable{
+		{
+			AccountKey:      double,
+			WritableIndexes: []uint8{1},
+			ReadonlyIndexes: []uint8{0},
+		},
+	}, message.AddressLookupTables)
+	assert.Equal(t, []CompiledInstruction{
+		{
+			ProgramIDIndex: 2,
+			Accounts:       []int{1, 4, 3, 0},
+			Data:           []byte{1},
+		},
+	}, message.Instructions)
+}
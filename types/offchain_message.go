@@ -0,0 +1,171 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// OffchainMessageSigningDomain is the prefix of an off-chain message, it can't be a valid tx message
+var OffchainMessageSigningDomain = []byte("\xffsolana offchain")
+
+const (
+	// the signing domain and the version
+	offchainMessageBaseHeaderLen = 16 + 1
+	// the format and the message length of v0
+	offchainMessageV0HeaderLen = 1 + 2
+
+	// OffchainMessageMaxLen is the max length of a v0 message
+	OffchainMessageMaxLen = 65535 - offchainMessageBaseHeaderLen - offchainMessageV0HeaderLen
+	// OffchainMessageMaxLenLedger is the max length of a v0 message which can be signed by a ledger
+	OffchainMessageMaxLenLedger = 1232 - offchainMessageBaseHeaderLen - offchainMessageV0HeaderLen
+)
+
+var (
+	ErrOffchainMessageEmpty          = errors.New("off-chain message is empty")
+	ErrOffchainMessageTooLong        = errors.New("off-chain message is too long")
+	ErrOffchainMessageInvalidUtf8    = errors.New("off-chain message is not a valid utf-8 string")
+	ErrOffchainMessageInvalidVersion = errors.New("unsupported off-chain message version")
+)
+
+type OffchainMessageFormat uint8
+
+const (
+	OffchainMessageFormatRestrictedAscii OffchainMessageFormat = iota
+	OffchainMessageFormatLimitedUtf8
+	OffchainMessageFormatExtendedUtf8
+)
+
+// OffchainMessage is a message signed by a wallet off chain, e.g. to prove the ownership of an address.
+// the layout is the same as `solana sign-offchain-message`.
+//
+//	[0:16]  signing domain "\xffsolana offchain"
+//	[16]    version
+//	[17]    format
+//	[18:20] message length
+//	[20:]   message
+type OffchainMessage struct {
+	Version uint8
+	Format  OffchainMessageFormat
+	Message []byte
+}
+
+// NewOffchainMessage returns a v0 message, the format is decided by the content and the length
+func NewOffchainMessage(message []byte) (OffchainMessage, error) {
+	format, err := offchainMessageFormat(message)
+	if err != nil {
+		return OffchainMessage{}, err
+	}
+	return OffchainMessage{
+		Version: 0,
+		Format:  format,
+		Message: message,
+	}, nil
+}
+
+func offchainMessageFormat(message []byte) (OffchainMessageFormat, error) {
+	switch {
+	case len(message) == 0:
+		return 0, ErrOffchainMessageEmpty
+	case len(message) > OffchainMessageMaxLen:
+		return 0, ErrOffchainMessageTooLong
+	case !utf8.Valid(message):
+		return 0, ErrOffchainMessageInvalidUtf8
+	case len(message) > OffchainMessageMaxLenLedger:
+		return OffchainMessageFormatExtendedUtf8, nil
+	case isPrintableASCII(message):
+		return OffchainMessageFormatRestrictedAscii, nil
+	}
+	return OffchainMessageFormatLimitedUtf8, nil
+}
+
+func isPrintableASCII(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// Serialize returns the bytes to sign
+func (m OffchainMessage) Serialize() ([]byte, error) {
+	if m.Version != 0 {
+		return nil, fmt.Errorf("%w: %v", ErrOffchainMessageInvalidVersion, m.Version)
+	}
+	format, err := offchainMessageFormat(m.Message)
+	if err != nil {
+		return nil, err
+	}
+	if format != m.Format {
+		return nil, fmt.Errorf("invalid off-chain message format, expected %v, got %v", format, m.Format)
+	}
+
+	b := make([]byte, 0, offchainMessageBaseHeaderLen+offchainMessageV0HeaderLen+len(m.Message))
+	b = append(b, OffchainMessageSigningDomain...)
+	b = append(b, m.Version, uint8(m.Format))
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(m.Message)))
+	return append(b, m.Message...), nil
+}
+
+func OffchainMessageDeserialize(data []byte) (OffchainMessage, error) {
+	if len(data) < offchainMessageBaseHeaderLen || !bytes.Equal(data[:16], OffchainMessageSigningDomain) {
+		return OffchainMessage{}, errors.New("invalid off-chain message signing domain")
+	}
+	if version := data[16]; version != 0 {
+		return OffchainMessage{}, fmt.Errorf("%w: %v", ErrOffchainMessageInvalidVersion, version)
+	}
+	data = data[offchainMessageBaseHeaderLen:]
+	if len(data) < offchainMessageV0HeaderLen {
+		return OffchainMessage{}, errors.New("off-chain message header is too short")
+	}
+	format := OffchainMessageFormat(data[0])
+	messageLen := int(binary.LittleEndian.Uint16(data[1:3]))
+	if len(data) != offchainMessageV0HeaderLen+messageLen {
+		return OffchainMessage{}, errors.New("off-chain message length mismatch")
+	}
+
+	m := OffchainMessage{
+		Version: 0,
+		Format:  format,
+		Message: data[offchainMessageV0HeaderLen:],
+	}
+	if expected, err := offchainMessageFormat(m.Message); err != nil {
+		return OffchainMessage{}, err
+	} else if expected != format {
+		return OffchainMessage{}, fmt.Errorf("invalid off-chain message format, expected %v, got %v", expected, format)
+	}
+	return m, nil
+}
+
+// Sign signs the serialized message
+func (m OffchainMessage) Sign(account Account) ([]byte, error) {
+	data, err := m.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return account.Sign(data), nil
+}
+
+// SignWithSigner signs the serialized message with a Signer
+func (m OffchainMessage) SignWithSigner(signer Signer) ([]byte, error) {
+	data, err := m.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return signer.Sign(data)
+}
+
+// Verify checks the signature is signed by pubkey
+func (m OffchainMessage) Verify(pubkey common.PublicKey, sig []byte) (bool, error) {
+	data, err := m.Serialize()
+	if err != nil {
+		return false, err
+	}
+	return len(sig) == ed25519.SignatureSize && ed25519.Verify(pubkey.Bytes(), data, sig), nil
+}
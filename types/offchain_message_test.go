@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOffchainMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message []byte
+		want    OffchainMessageFormat
+		wantErr error
+	}{
+		{
+			name:    "ascii",
+			message: []byte("Hello, World!"),
+			want:    OffchainMessageFormatRestrictedAscii,
+		},
+		{
+			name:    "utf8",
+			message: []byte("こんにちは"),
+			want:    OffchainMessageFormatLimitedUtf8,
+		},
+		{
+			name:    "newline is not restricted ascii",
+			message: []byte("a\nb"),
+			want:    OffchainMessageFormatLimitedUtf8,
+		},
+		{
+			name:    "longer than ledger limit",
+			message: bytes.Repeat([]byte("a"), OffchainMessageMaxLenLedger+1),
+			want:    OffchainMessageFormatExtendedUtf8,
+		},
+		{
+			name:    "empty",
+			message: []byte{},
+			wantErr: ErrOffchainMessageEmpty,
+		},
+		{
+			name:    "too long",
+			message: bytes.Repeat([]byte("a"), OffchainMessageMaxLen+1),
+			wantErr: ErrOffchainMessageTooLong,
+		},
+		{
+			name:    "invalid utf8",
+			message: []byte{0xff, 0xfe},
+			wantErr: ErrOffchainMessageInvalidUtf8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOffchainMessage(tt.message)
+			assert.Equal(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.want, got.Format)
+			}
+		})
+	}
+}
+
+func TestOffchainMessage_Serialize(t *testing.T) {
+	m, err := NewOffchainMessage([]byte("Hello"))
+	assert.Nil(t, err)
+	b, err := m.Serialize()
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("\xffsolana offchain"), 0, 0, 5, 0, 'H', 'e', 'l', 'l', 'o'), b)
+
+	got, err := OffchainMessageDeserialize(b)
+	assert.Nil(t, err)
+	assert.Equal(t, m, got)
+
+	// a wrong format is rejected
+	_, err = OffchainMessage{Format: OffchainMessageFormatLimitedUtf8, Message: []byte("Hello")}.Serialize()
+	assert.NotNil(t, err)
+	_, err = OffchainMessageDeserialize(append([]byte("\xffsolana offchain"), 1, 0, 5, 0, 'H', 'e', 'l', 'l', 'o'))
+	assert.ErrorIs(t, err, ErrOffchainMessageInvalidVersion)
+	_, err = OffchainMessageDeserialize(append([]byte("\xffsolana offchain"), 0, 0, 6, 0, 'H', 'e', 'l', 'l', 'o'))
+	assert.NotNil(t, err)
+}
+
+func TestOffchainMessage_SignVerify(t *testing.T) {
+	account := NewAccount()
+	m, err := NewOffchainMessage([]byte(strings.Repeat("sign in ", 10)))
+	assert.Nil(t, err)
+
+	sig, err := m.Sign(account)
+	assert.Nil(t, err)
+
+	ok, err := m.Verify(account.PublicKey, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Verify(NewAccount().PublicKey, sig)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// the signature of the raw message is not valid
+	ok, err = m.Verify(account.PublicKey, account.Sign(m.Message))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/mr-tron/base58"
+)
+
+const OfflineTransactionVersion = 1
+
+// OfflineTransaction is the wire format to move a tx between an online and an air-gapped machine.
+//
+//	{
+//	  "version": 1,
+//	  "message": "<base64 serialized message>",
+//	  "signers": ["<base58 pubkey>", ...],
+//	  "signatures": ["<base58 signature>" or "" if it is not signed yet, ...]
+//	}
+//
+// the signers are the required signers of the message in order, they are listed so the signing
+// machine can tell which keys it needs without parsing the message.
+type OfflineTransaction struct {
+	Version    int                `json:"version"`
+	Message    string             `json:"message"`
+	Signers    []common.PublicKey `json:"signers"`
+	Signatures []string           `json:"signatures"`
+}
+
+// ExportTransaction encodes a unsigned or partially signed tx into the offline wire format
+func ExportTransaction(tx Transaction) ([]byte, error) {
+	messageData, err := tx.Message.Serialize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	n := int(tx.Message.Header.NumRequireSignatures)
+	if len(tx.Message.Accounts) < n {
+		return nil, fmt.Errorf("not enough accounts for signers")
+	}
+
+	signatures := make([]string, n)
+	for i := 0; i < n && i < len(tx.Signatures); i++ {
+		if !isEmptySignature(tx.Signatures[i]) {
+			signatures[i] = base58.Encode(tx.Signatures[i])
+		}
+	}
+
+	return json.Marshal(OfflineTransaction{
+		Version:    OfflineTransactionVersion,
+		Message:    base64.StdEncoding.EncodeToString(messageData),
+		Signers:    tx.Message.Accounts[:n],
+		Signatures: signatures,
+	})
+}
+
+// ImportTransaction decodes a tx from the offline wire format.
+// the signers must match the message and every present signature must be valid.
+func ImportTransaction(data []byte) (Transaction, error) {
+	var offlineTx OfflineTransaction
+	if err := json.Unmarshal(data, &offlineTx); err != nil {
+		return Transaction{}, fmt.Errorf("failed to decode offline transaction, err: %v", err)
+	}
+	if offlineTx.Version != OfflineTransactionVersion {
+		return Transaction{}, fmt.Errorf("unsupported offline transaction version: %v", offlineTx.Version)
+	}
+
+	messageData, err := base64.StdEncoding.DecodeString(offlineTx.Message)
+	if err != nil {
+		return Transaction{}, fmt.Errorf("failed to decode message, err: %v", err)
+	}
+	message, err := MessageDeserialize(messageData)
+	if err != nil {
+		return Transaction{}, fmt.Errorf("failed to parse message, err: %v", err)
+	}
+
+	n := int(message.Header.NumRequireSignatures)
+	if len(offlineTx.Signers) != n || len(message.Accounts) < n {
+		return Transaction{}, fmt.Errorf("signers mismatch, expected %v signers", n)
+	}
+	for i, signer := range offlineTx.Signers {
+		if message.Accounts[i] != signer {
+			return Transaction{}, fmt.Errorf("signers mismatch, #%d expected %v, got %v", i, message.Accounts[i], signer)
+		}
+	}
+	if len(offlineTx.Signatures) > n {
+		return Transaction{}, fmt.Errorf("too many signatures, expected %v", n)
+	}
+
+	tx := Transaction{
+		Signatures: make([]Signature, n),
+		Message:    message,
+	}
+	for i := range tx.Signatures {
+		tx.Signatures[i] = make([]byte, 64)
+	}
+	for i, s := range offlineTx.Signatures {
+		if s == "" {
+			continue
+		}
+		sig, err := base58.Decode(s)
+		if err != nil {
+			return Transaction{}, fmt.Errorf("failed to decode signature #%d, err: %v", i, err)
+		}
+		if err := tx.AddSignatureForPubkey(offlineTx.Signers[i], sig); err != nil {
+			return Transaction{}, err
+		}
+	}
+	return tx, nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImportTransaction(t *testing.T) {
+	feePayer := NewAccount()
+	offlineSigner := NewAccount()
+	message := NewMessage(NewMessageParam{
+		FeePayer:        feePayer.PublicKey,
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		Instructions: []Instruction{
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: offlineSigner.PublicKey, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte("offline"),
+			},
+		},
+	})
+
+	// online: build and sign with the fee payer
+	tx, err := NewTransaction(NewTransactionParam{Message: message, Signers: []Account{feePayer}})
+	assert.Nil(t, err)
+	exported, err := ExportTransaction(tx)
+	assert.Nil(t, err)
+
+	var offlineTx OfflineTransaction
+	assert.Nil(t, json.Unmarshal(exported, &offlineTx))
+	assert.Equal(t, OfflineTransactionVersion, offlineTx.Version)
+	assert.Equal(t, []common.PublicKey{feePayer.PublicKey, offlineSigner.PublicKey}, offlineTx.Signers)
+	assert.NotEmpty(t, offlineTx.Signatures[0])
+	assert.Empty(t, offlineTx.Signatures[1])
+
+	// offline: import, sign and export
+	tx, err = ImportTransaction(exported)
+	assert.Nil(t, err)
+	assert.Equal(t, []common.PublicKey{offlineSigner.PublicKey}, tx.MissingSigners())
+	assert.Nil(t, tx.Sign(offlineSigner))
+	exported, err = ExportTransaction(tx)
+	assert.Nil(t, err)
+
+	// online: import and broadcast
+	tx, err = ImportTransaction(exported)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.VerifySignatures())
+	assert.Equal(t, message, tx.Message)
+}
+
+func TestImportTransaction_Invalid(t *testing.T) {
+	feePayer := NewAccount()
+	tx, err := NewTransaction(NewTransactionParam{
+		Message: NewMessage(NewMessageParam{
+			FeePayer:        feePayer.PublicKey,
+			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		}),
+		Signers: []Account{feePayer},
+	})
+	assert.Nil(t, err)
+	exported, err := ExportTransaction(tx)
+	assert.Nil(t, err)
+	var valid OfflineTransaction
+	assert.Nil(t, json.Unmarshal(exported, &valid))
+
+	tests := []struct {
+		name   string
+		modify func(o *OfflineTransaction)
+		err    string
+	}{
+		{
+			name:   "unsupported version",
+			modify: func(o *OfflineTransaction) { o.Version = 2 },
+			err:    "unsupported offline transaction version: 2",
+		},
+		{
+			name:   "signers mismatch",
+			modify: func(o *OfflineTransaction) { o.Signers = []common.PublicKey{NewAccount().PublicKey} },
+			err:    "signers mismatch",
+		},
+		{
+			name: "invalid signature",
+			modify: func(o *OfflineTransaction) {
+				o.Signatures = []string{"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"}
+			},
+			err: ErrTransactionInvalidSignature.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := valid
+			tt.modify(&o)
+			data, err := json.Marshal(o)
+			assert.Nil(t, err)
+			_, err = ImportTransaction(data)
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
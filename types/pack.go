@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// MaxTransactionAccountLocks is the max number of accounts a transaction can use, the loaded addresses are included
+const MaxTransactionAccountLocks = 64
+
+var ErrInstructionTooLarge = errors.New("instruction doesn't fit in a transaction")
+
+type PackInstructionsParam struct {
+	Instructions []Instruction
+	FeePayer     common.PublicKey
+	// Prefix is put before the instructions of every transaction, e.g. the compute budget instructions
+	Prefix []Instruction
+	// v0 transaction
+	AddressLookupTableAccounts []AddressLookupTableAccount
+}
+
+// PackInstructions splits the instructions in order into the fewest groups, each of which fits in a transaction
+// within PacketDataSize and MaxTransactionAccountLocks. build a transaction of each group with its own blockhash.
+// ErrInstructionTooLarge returns if an instruction doesn't fit even in a transaction of its own.
+func PackInstructions(param PackInstructionsParam) ([][]Instruction, error) {
+	groups := [][]Instruction{}
+	current := []Instruction{}
+	for i, instruction := range param.Instructions {
+		next := append(current[:len(current):len(current)], instruction)
+		ok, err := fitsInTransaction(param, next)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			current = next
+			continue
+		}
+		if len(current) == 0 {
+			return nil, fmt.Errorf("%w, index: %v", ErrInstructionTooLarge, i)
+		}
+
+		groups = append(groups, current)
+		current = []Instruction{instruction}
+		ok, err = fitsInTransaction(param, current)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w, index: %v", ErrInstructionTooLarge, i)
+		}
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	return groups, nil
+}
+
+func fitsInTransaction(param PackInstructionsParam, instructions []Instruction) (bool, error) {
+	// the blockhash doesn't change the size
+	message := NewMessage(NewMessageParam{
+		FeePayer:                   param.FeePayer,
+		Instructions:               append(param.Prefix[:len(param.Prefix):len(param.Prefix)], instructions...),
+		RecentBlockhash:            common.PublicKey{}.ToBase58(),
+		AddressLookupTableAccounts: param.AddressLookupTableAccounts,
+	})
+
+	accounts := len(message.Accounts)
+	for _, table := range message.AddressLookupTables {
+		accounts += len(table.WritableIndexes) + len(table.ReadonlyIndexes)
+	}
+	if accounts > MaxTransactionAccountLocks {
+		return false, nil
+	}
+
+	tx := Transaction{Message: message}
+	size, err := tx.Size()
+	if err != nil {
+		return false, err
+	}
+	return size <= PacketDataSize, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackInstructions(t *testing.T) {
+	feePayer := common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	memo := func(n int) Instruction {
+		return Instruction{ProgramID: common.MemoProgramID, Data: make([]byte, n)}
+	}
+	// an instruction which uses a new account
+	touch := func() Instruction {
+		return Instruction{
+			ProgramID: common.MemoProgramID,
+			Accounts:  []AccountMeta{{PubKey: NewAccount().PublicKey, IsSigner: false, IsWritable: true}},
+		}
+	}
+
+	t.Run("size", func(t *testing.T) {
+		instructions := []Instruction{memo(500), memo(500), memo(500), memo(100), memo(1000)}
+		groups, err := PackInstructions(PackInstructionsParam{Instructions: instructions, FeePayer: feePayer})
+		assert.Nil(t, err)
+		assert.Equal(t, [][]Instruction{instructions[:2], instructions[2:4], instructions[4:]}, groups)
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		instructions := make([]Instruction, 0, 100)
+		for i := 0; i < 100; i++ {
+			instructions = append(instructions, touch())
+		}
+		groups, err := PackInstructions(PackInstructionsParam{Instructions: instructions, FeePayer: feePayer})
+		assert.Nil(t, err)
+		assert.Greater(t, len(groups), 1)
+
+		total := 0
+		for _, group := range groups {
+			total += len(group)
+			tx := Transaction{Message: NewMessage(NewMessageParam{FeePayer: feePayer, Instructions: group, RecentBlockhash: common.PublicKey{}.ToBase58()})}
+			assert.LessOrEqual(t, len(tx.Message.Accounts), MaxTransactionAccountLocks)
+			assert.Nil(t, tx.ValidateSize())
+		}
+		assert.Equal(t, 100, total)
+	})
+
+	t.Run("account locks", func(t *testing.T) {
+		// with a lookup table, the account locks run out before the size
+		table := AddressLookupTableAccount{Key: NewAccount().PublicKey}
+		instructions := make([]Instruction, 0, 100)
+		for i := 0; i < 100; i++ {
+			instruction := touch()
+			table.Addresses = append(table.Addresses, instruction.Accounts[0].PubKey)
+			instructions = append(instructions, instruction)
+		}
+		groups, err := PackInstructions(PackInstructionsParam{
+			Instructions:               instructions,
+			FeePayer:                   feePayer,
+			AddressLookupTableAccounts: []AddressLookupTableAccount{table},
+		})
+		assert.Nil(t, err)
+		// the fee payer, the memo program and 62 accounts
+		assert.Equal(t, [][]Instruction{instructions[:62], instructions[62:]}, groups)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		instructions := []Instruction{memo(500), memo(500)}
+		groups, err := PackInstructions(PackInstructionsParam{Instructions: instructions, FeePayer: feePayer, Prefix: []Instruction{memo(200)}})
+		assert.Nil(t, err)
+		assert.Equal(t, [][]Instruction{instructions[:1], instructions[1:]}, groups)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := PackInstructions(PackInstructionsParam{Instructions: []Instruction{memo(10), memo(1200)}, FeePayer: feePayer})
+		assert.EqualError(t, err, "instruction doesn't fit in a transaction, index: 1")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		groups, err := PackInstructions(PackInstructionsParam{FeePayer: feePayer})
+		assert.Nil(t, err)
+		assert.Equal(t, [][]Instruction{}, groups)
+	})
+}
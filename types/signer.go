@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/liangjies/solana-go-sdk/common"
+)
+
+// Signer signs messages with a key which may not be held in memory, e.g. a hardware wallet or a KMS.
+// Sign returns the ed25519 signature of the message.
+type Signer interface {
+	PublicKey() common.PublicKey
+	Sign(message []byte) ([]byte, error)
+}
+
+type accountSigner struct {
+	account Account
+}
+
+// AsSigner returns the account as a Signer
+func (a Account) AsSigner() Signer {
+	return accountSigner{account: a}
+}
+
+func (s accountSigner) PublicKey() common.PublicKey {
+	return s.account.PublicKey
+}
+
+func (s accountSigner) Sign(message []byte) ([]byte, error) {
+	return s.account.Sign(message), nil
+}
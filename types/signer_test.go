@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/liangjies/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRemoteSigner struct {
+	account Account
+	err     error
+	// truncate cuts the signature to the length if it is set
+	truncate int
+}
+
+func (s testRemoteSigner) PublicKey() common.PublicKey {
+	return s.account.PublicKey
+}
+
+func (s testRemoteSigner) Sign(message []byte) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	sig := s.account.Sign(message)
+	if s.truncate > 0 {
+		sig = sig[:s.truncate]
+	}
+	return sig, nil
+}
+
+func TestNewTransaction_ExternalSigners(t *testing.T) {
+	feePayer := NewAccount()
+	remote := NewAccount()
+	message := NewMessage(NewMessageParam{
+		FeePayer:        feePayer.PublicKey,
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		Instructions: []Instruction{
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: remote.PublicKey, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte("remote"),
+			},
+		},
+	})
+
+	tx, err := NewTransaction(NewTransactionParam{
+		Message:         message,
+		Signers:         []Account{feePayer},
+		ExternalSigners: []Signer{testRemoteSigner{account: remote}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, tx.VerifySignatures())
+
+	_, err = NewTransaction(NewTransactionParam{
+		Message:         message,
+		Signers:         []Account{feePayer},
+		ExternalSigners: []Signer{testRemoteSigner{account: remote, err: errors.New("device is locked")}},
+	})
+	assert.EqualError(t, err, "failed to sign by "+remote.PublicKey.ToBase58()+", err: device is locked")
+
+	_, err = NewTransaction(NewTransactionParam{
+		Message:         message,
+		Signers:         []Account{feePayer},
+		ExternalSigners: []Signer{testRemoteSigner{account: remote, truncate: 32}},
+	})
+	assert.ErrorIs(t, err, ErrTransactionInvalidSignature)
+
+	_, err = NewTransaction(NewTransactionParam{
+		Message:         message,
+		ExternalSigners: []Signer{NewAccount().AsSigner()},
+	})
+	assert.ErrorIs(t, err, ErrTransactionAddNotNecessarySignatures)
+}
+
+func TestAccount_AsSigner(t *testing.T) {
+	account := NewAccount()
+	signer := account.AsSigner()
+	assert.Equal(t, account.PublicKey, signer.PublicKey())
+	sig, err := signer.Sign([]byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, account.Sign([]byte("hello")), sig)
+}
@@ -12,8 +12,15 @@ import (
 
 var (
 	ErrTransactionAddNotNecessarySignatures = errors.New("add not necessary signatures")
+	ErrTransactionMissingSignature          = errors.New("missing signature")
+	ErrTransactionInvalidSignature          = errors.New("invalid signature")
+	ErrTransactionFeePayerMismatch          = errors.New("fee payer mismatch")
+	ErrTransactionTooLarge                  = errors.New("transaction too large")
 )
 
+// PacketDataSize is the max size of a serialized transaction, it is the size of an ipv6 packet minus the headers
+const PacketDataSize = 1232
+
 type Signature []byte
 
 type Transaction struct {
@@ -24,6 +31,8 @@ type Transaction struct {
 type NewTransactionParam struct {
 	Message Message
 	Signers []Account
+	// ExternalSigners are signers without a local private key, e.g. a ledger
+	ExternalSigners []Signer
 }
 
 // NewTransaction create a new tx by message and signer. it will reserve signatures slot.
@@ -32,28 +41,20 @@ func NewTransaction(param NewTransactionParam) (Transaction, error) {
 	for i := uint8(0); i < param.Message.Header.NumRequireSignatures; i++ {
 		signatures = append(signatures, make([]byte, 64))
 	}
-
-	m := map[common.PublicKey]uint8{}
-	for i := uint8(0); i < param.Message.Header.NumRequireSignatures; i++ {
-		m[param.Message.Accounts[i]] = i
+	tx := Transaction{
+		Signatures: signatures,
+		Message:    param.Message,
 	}
 
-	data, err := param.Message.Serialize()
-	if err != nil {
-		return Transaction{}, fmt.Errorf("failed to serialize message, err: %v", err)
-	}
+	signers := make([]Signer, 0, len(param.Signers)+len(param.ExternalSigners))
 	for _, signer := range param.Signers {
-		idx, ok := m[signer.PublicKey]
-		if !ok {
-			return Transaction{}, fmt.Errorf("%w, %v is not a signer", ErrTransactionAddNotNecessarySignatures, signer.PublicKey)
-		}
-		signatures[idx] = signer.Sign(data)
+		signers = append(signers, signer.AsSigner())
 	}
-
-	return Transaction{
-		Signatures: signatures,
-		Message:    param.Message,
-	}, nil
+	signers = append(signers, param.ExternalSigners...)
+	if err := tx.SignWithSigners(signers...); err != nil {
+		return Transaction{}, err
+	}
+	return tx, nil
 }
 
 // AddSignature will add or replace signature into the correct order signature's slot.
@@ -72,6 +73,168 @@ func (tx *Transaction) AddSignature(sig []byte) error {
 	return fmt.Errorf("%w, no match signer", ErrTransactionAddNotNecessarySignatures)
 }
 
+// AddSignatureForPubkey verifies the signature of pubkey and puts it into the slot of the signer
+func (tx *Transaction) AddSignatureForPubkey(pubkey common.PublicKey, sig []byte) error {
+	idx, err := tx.signerIndex(pubkey)
+	if err != nil {
+		return err
+	}
+	data, err := tx.Message.Serialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	if len(sig) != ed25519.SignatureSize || !ed25519.Verify(pubkey.Bytes(), data, sig) {
+		return fmt.Errorf("%w, %v", ErrTransactionInvalidSignature, pubkey)
+	}
+	tx.Signatures[idx] = sig
+	return nil
+}
+
+// Sign signs the tx with signers, the signatures of the other signers are kept.
+// it is used to sign a partially signed tx.
+func (tx *Transaction) Sign(signers ...Account) error {
+	s := make([]Signer, 0, len(signers))
+	for _, signer := range signers {
+		s = append(s, signer.AsSigner())
+	}
+	return tx.SignWithSigners(s...)
+}
+
+// SignWithSigners is the same as Sign but accepts any Signer
+func (tx *Transaction) SignWithSigners(signers ...Signer) error {
+	if len(signers) == 0 {
+		return nil
+	}
+	data, err := tx.Message.Serialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	for _, signer := range signers {
+		idx, err := tx.signerIndex(signer.PublicKey())
+		if err != nil {
+			return err
+		}
+		sig, err := signer.Sign(data)
+		if err != nil {
+			return fmt.Errorf("failed to sign by %v, err: %v", signer.PublicKey(), err)
+		}
+		if len(sig) != ed25519.SignatureSize {
+			return fmt.Errorf("%w, %v returns a signature of %v bytes", ErrTransactionInvalidSignature, signer.PublicKey(), len(sig))
+		}
+		tx.Signatures[idx] = sig
+	}
+	return nil
+}
+
+// SignAsFeePayer is for a relay which pays the fee of a transaction built by others, i.e. a gasless transaction.
+// it checks feePayer is the fee payer of the message and the signatures of the other signers are valid, then signs it.
+func (tx *Transaction) SignAsFeePayer(feePayer Signer) error {
+	if len(tx.Message.Accounts) == 0 || tx.Message.Accounts[0] != feePayer.PublicKey() {
+		return fmt.Errorf("%w, expected %v", ErrTransactionFeePayerMismatch, feePayer.PublicKey())
+	}
+	if len(tx.Signatures) != int(tx.Message.Header.NumRequireSignatures) {
+		return fmt.Errorf("%w, expected %v signatures, got %v", ErrTransactionMissingSignature, tx.Message.Header.NumRequireSignatures, len(tx.Signatures))
+	}
+	if int(tx.Message.Header.NumRequireSignatures) > len(tx.Message.Accounts) {
+		return fmt.Errorf("%w, not enough accounts for signers", ErrTransactionInvalidSignature)
+	}
+	data, err := tx.Message.Serialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	for i := 1; i < len(tx.Signatures); i++ {
+		signer := tx.Message.Accounts[i]
+		if isEmptySignature(tx.Signatures[i]) {
+			return fmt.Errorf("%w, %v", ErrTransactionMissingSignature, signer)
+		}
+		if len(tx.Signatures[i]) != ed25519.SignatureSize || !ed25519.Verify(signer.Bytes(), data, tx.Signatures[i]) {
+			return fmt.Errorf("%w, %v", ErrTransactionInvalidSignature, signer)
+		}
+	}
+	return tx.SignWithSigners(feePayer)
+}
+
+// MissingSigners returns the signers whose signature is still empty
+func (tx *Transaction) MissingSigners() []common.PublicKey {
+	missing := []common.PublicKey{}
+	for i, sig := range tx.Signatures {
+		if isEmptySignature(sig) && i < len(tx.Message.Accounts) {
+			missing = append(missing, tx.Message.Accounts[i])
+		}
+	}
+	return missing
+}
+
+func (tx *Transaction) signerIndex(pubkey common.PublicKey) (int, error) {
+	if len(tx.Signatures) != int(tx.Message.Header.NumRequireSignatures) {
+		return 0, fmt.Errorf("signature slots are not reserved, expected %v, got %v", tx.Message.Header.NumRequireSignatures, len(tx.Signatures))
+	}
+	for i := 0; i < int(tx.Message.Header.NumRequireSignatures) && i < len(tx.Message.Accounts); i++ {
+		if tx.Message.Accounts[i] == pubkey {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w, %v is not a signer", ErrTransactionAddNotNecessarySignatures, pubkey)
+}
+
+// VerifySignatures checks the tx has a signature for every required signer and each of them is valid for the message
+func (tx *Transaction) VerifySignatures() error {
+	if len(tx.Signatures) != int(tx.Message.Header.NumRequireSignatures) {
+		return fmt.Errorf("%w, expected %v signatures, got %v", ErrTransactionMissingSignature, tx.Message.Header.NumRequireSignatures, len(tx.Signatures))
+	}
+	if len(tx.Message.Accounts) < len(tx.Signatures) {
+		return fmt.Errorf("%w, not enough accounts for signers", ErrTransactionInvalidSignature)
+	}
+	data, err := tx.Message.Serialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	for i, sig := range tx.Signatures {
+		signer := tx.Message.Accounts[i]
+		if isEmptySignature(sig) {
+			return fmt.Errorf("%w, %v", ErrTransactionMissingSignature, signer)
+		}
+		if len(sig) != ed25519.SignatureSize || !ed25519.Verify(signer.Bytes(), data, sig) {
+			return fmt.Errorf("%w, %v", ErrTransactionInvalidSignature, signer)
+		}
+	}
+	return nil
+}
+
+func isEmptySignature(sig Signature) bool {
+	for _, b := range sig {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the size of the serialized tx, the signatures are counted even if they are not reserved yet
+func (tx *Transaction) Size() (int, error) {
+	messageData, err := tx.Message.Serialize()
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	numSignatures := uint64(tx.Message.Header.NumRequireSignatures)
+	return len(bincode.UintToVarLenBytes(numSignatures)) + int(numSignatures)*ed25519.SignatureSize + len(messageData), nil
+}
+
+// ValidateSize returns ErrTransactionTooLarge if the serialized tx exceeds PacketDataSize, which the rpc node rejects
+func (tx *Transaction) ValidateSize() error {
+	size, err := tx.Size()
+	if err != nil {
+		return err
+	}
+	if size > PacketDataSize {
+		return fmt.Errorf(
+			"%w, size: %v, max: %v, %v bytes over with %v accounts and %v instructions, try fewer instructions or an address lookup table",
+			ErrTransactionTooLarge, size, PacketDataSize, size-PacketDataSize, len(tx.Message.Accounts), len(tx.Message.Instructions),
+		)
+	}
+	return nil
+}
+
 // Serialize pack tx into byte array
 func (tx *Transaction) Serialize() ([]byte, error) {
 	if len(tx.Signatures) == 0 || len(tx.Signatures) != int(tx.Message.Header.NumRequireSignatures) {
@@ -103,7 +266,7 @@ func TransactionDeserialize(tx []byte) (Transaction, error) {
 	if signatureCount < 1 {
 		return Transaction{}, errors.New("signature count must be greater than or equal to 1")
 	}
-	if len(tx) < int(signatureCount)*64 {
+	if uint64(len(tx))/64 < signatureCount {
 		return Transaction{}, errors.New("parse signature error")
 	}
 	signatures := make([]Signature, 0, signatureCount)
@@ -495,3 +495,282 @@ func TestTransaction_AddSignature(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionDeserialize_RoundTrip(t *testing.T) {
+	feePayer := NewAccount()
+	to := common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b")
+	lookupTable := AddressLookupTableAccount{
+		Key:       common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
+		Addresses: []common.PublicKey{to},
+	}
+	instructions := []Instruction{
+		{
+			ProgramID: common.SystemProgramID,
+			Accounts: []AccountMeta{
+				{PubKey: feePayer.PublicKey, IsSigner: true, IsWritable: true},
+				{PubKey: to, IsSigner: false, IsWritable: true},
+			},
+			Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, lookupTables := range [][]AddressLookupTableAccount{nil, {lookupTable}} {
+		tx, err := NewTransaction(NewTransactionParam{
+			Message: NewMessage(NewMessageParam{
+				FeePayer:                   feePayer.PublicKey,
+				RecentBlockhash:            "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				Instructions:               instructions,
+				AddressLookupTableAccounts: lookupTables,
+			}),
+			Signers: []Account{feePayer},
+		})
+		assert.Nil(t, err)
+		raw, err := tx.Serialize()
+		assert.Nil(t, err)
+
+		got, err := TransactionDeserialize(raw)
+		assert.Nil(t, err)
+		gotRaw, err := got.Serialize()
+		assert.Nil(t, err)
+		assert.Equal(t, raw, gotRaw)
+
+		decompiled, err := got.Message.DecompileInstructionsWithAddressLookupTables(lookupTables)
+		assert.Nil(t, err)
+		assert.Equal(t, instructions, decompiled)
+
+		// a truncated tx returns an error instead of panicking
+		for i := 0; i < len(raw); i++ {
+			_, err := TransactionDeserialize(raw[:i])
+			assert.NotNil(t, err, "length %v", i)
+		}
+	}
+}
+
+func TestTransactionDeserialize_Malformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "unsupported version",
+			data: append(append([]byte{1}, make([]byte, 64)...), 129, 1, 0, 0, 0),
+		},
+		{
+			name: "huge signature count",
+			data: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		},
+		{
+			name: "huge account count",
+			data: append(append([]byte{1}, make([]byte, 64)...), 1, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := TransactionDeserialize(tt.data)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestTransaction_VerifySignatures(t *testing.T) {
+	feePayer := NewAccount()
+	alice := NewAccount()
+	message := NewMessage(NewMessageParam{
+		FeePayer:        feePayer.PublicKey,
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		Instructions: []Instruction{
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: alice.PublicKey, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte("hello"),
+			},
+		},
+	})
+
+	newTx := func(signers ...Account) Transaction {
+		tx, err := NewTransaction(NewTransactionParam{Message: message, Signers: signers})
+		assert.Nil(t, err)
+		return tx
+	}
+
+	tests := []struct {
+		name string
+		tx   func() Transaction
+		err  error
+	}{
+		{
+			name: "all signed",
+			tx:   func() Transaction { return newTx(feePayer, alice) },
+			err:  nil,
+		},
+		{
+			name: "missing signature",
+			tx:   func() Transaction { return newTx(feePayer) },
+			err:  ErrTransactionMissingSignature,
+		},
+		{
+			name: "missing signature slot",
+			tx: func() Transaction {
+				tx := newTx(feePayer, alice)
+				tx.Signatures = tx.Signatures[:1]
+				return tx
+			},
+			err: ErrTransactionMissingSignature,
+		},
+		{
+			name: "signed by another account",
+			tx: func() Transaction {
+				tx := newTx(feePayer, alice)
+				tx.Signatures[1] = NewAccount().Sign([]byte("hello"))
+				return tx
+			},
+			err: ErrTransactionInvalidSignature,
+		},
+		{
+			name: "message modified",
+			tx: func() Transaction {
+				tx := newTx(feePayer, alice)
+				tx.Message.RecentBlockHash = "A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"
+				return tx
+			},
+			err: ErrTransactionInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := tt.tx()
+			assert.ErrorIs(t, tx.VerifySignatures(), tt.err)
+		})
+	}
+}
+
+func TestTransaction_PartialSign(t *testing.T) {
+	feePayer := NewAccount()
+	alice := NewAccount()
+	bob := NewAccount()
+	message := NewMessage(NewMessageParam{
+		FeePayer:        feePayer.PublicKey,
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		Instructions: []Instruction{
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: alice.PublicKey, IsSigner: true, IsWritable: false},
+					{PubKey: bob.PublicKey, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte("escrow"),
+			},
+		},
+	})
+
+	// the fee payer signs first and passes the tx on
+	tx, err := NewTransaction(NewTransactionParam{Message: message, Signers: []Account{feePayer}})
+	assert.Nil(t, err)
+	raw, err := tx.Serialize()
+	assert.Nil(t, err)
+
+	tx, err = TransactionDeserialize(raw)
+	assert.Nil(t, err)
+	// the order of signers is decided by the message
+	assert.ElementsMatch(t, []common.PublicKey{alice.PublicKey, bob.PublicKey}, tx.MissingSigners())
+
+	// alice signs the message elsewhere
+	messageData, err := tx.Message.Serialize()
+	assert.Nil(t, err)
+	aliceSig := alice.Sign(messageData)
+	assert.ErrorIs(t, tx.AddSignatureForPubkey(bob.PublicKey, aliceSig), ErrTransactionInvalidSignature)
+	assert.ErrorIs(t, tx.AddSignatureForPubkey(NewAccount().PublicKey, aliceSig), ErrTransactionAddNotNecessarySignatures)
+	assert.Nil(t, tx.AddSignatureForPubkey(alice.PublicKey, aliceSig))
+	assert.Equal(t, []common.PublicKey{bob.PublicKey}, tx.MissingSigners())
+	assert.ErrorIs(t, tx.VerifySignatures(), ErrTransactionMissingSignature)
+
+	// bob signs with the sdk
+	assert.ErrorIs(t, tx.Sign(NewAccount()), ErrTransactionAddNotNecessarySignatures)
+	assert.Nil(t, tx.Sign(bob))
+	assert.Equal(t, []common.PublicKey{}, tx.MissingSigners())
+	assert.Nil(t, tx.VerifySignatures())
+}
+
+func TestTransaction_SignAsFeePayer(t *testing.T) {
+	relay := NewAccount()
+	alice := NewAccount()
+	message := NewMessage(NewMessageParam{
+		FeePayer:        relay.PublicKey,
+		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		Instructions: []Instruction{
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []AccountMeta{
+					{PubKey: alice.PublicKey, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte("gasless"),
+			},
+		},
+	})
+
+	tx, err := NewTransaction(NewTransactionParam{Message: message})
+	assert.Nil(t, err)
+	assert.Equal(t, relay.PublicKey, tx.Message.FeePayer())
+
+	// the other signers must sign first
+	assert.ErrorIs(t, tx.SignAsFeePayer(relay.AsSigner()), ErrTransactionMissingSignature)
+	tx.Signatures[1] = NewAccount().Sign([]byte("gasless"))
+	assert.ErrorIs(t, tx.SignAsFeePayer(relay.AsSigner()), ErrTransactionInvalidSignature)
+
+	assert.Nil(t, tx.Sign(alice))
+	assert.ErrorIs(t, tx.SignAsFeePayer(alice.AsSigner()), ErrTransactionFeePayerMismatch)
+	assert.Nil(t, tx.SignAsFeePayer(relay.AsSigner()))
+	assert.Nil(t, tx.VerifySignatures())
+
+	// a malformed message with more signers than accounts doesn't panic
+	malformed := Transaction{
+		Signatures: make([]Signature, 3),
+		Message: Message{
+			Version:         MessageVersionLegacy,
+			Header:          MessageHeader{NumRequireSignatures: 3},
+			Accounts:        []common.PublicKey{relay.PublicKey},
+			RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		},
+	}
+	assert.ErrorIs(t, malformed.SignAsFeePayer(relay.AsSigner()), ErrTransactionInvalidSignature)
+}
+
+func TestTransaction_ValidateSize(t *testing.T) {
+	feePayer := NewAccount()
+	newTx := func(memoLen int) Transaction {
+		tx, err := NewTransaction(NewTransactionParam{
+			Message: NewMessage(NewMessageParam{
+				FeePayer:        feePayer.PublicKey,
+				RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				Instructions: []Instruction{
+					{ProgramID: common.MemoProgramID, Data: make([]byte, memoLen)},
+				},
+			}),
+			Signers: []Account{feePayer},
+		})
+		assert.Nil(t, err)
+		return tx
+	}
+
+	// 1 + 64 signature, 3 header, 1 + 64 accounts, 32 blockhash, 1 + 1 + 1 + 2 + memo instruction
+	tx := newTx(PacketDataSize - 170)
+	size, err := tx.Size()
+	assert.Nil(t, err)
+	raw, err := tx.Serialize()
+	assert.Nil(t, err)
+	assert.Equal(t, len(raw), size)
+	assert.Equal(t, PacketDataSize, size)
+	assert.Nil(t, tx.ValidateSize())
+
+	tx = newTx(PacketDataSize - 169)
+	assert.ErrorIs(t, tx.ValidateSize(), ErrTransactionTooLarge)
+	assert.EqualError(t, tx.ValidateSize(), "transaction too large, size: 1233, max: 1232, 1 bytes over with 2 accounts and 1 instructions, try fewer instructions or an address lookup table")
+
+	// the size is known before the tx is signed
+	unsigned := Transaction{Message: tx.Message}
+	size, err = unsigned.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 1233, size)
+}